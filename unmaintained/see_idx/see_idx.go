@@ -35,7 +35,7 @@ func main() {
 	}
 	defer indexFile.Close()
 
-	idx.WalkIndexFile(indexFile, func(key types.NeedleId, offset types.Offset, size uint32) error {
+	idx.WalkIndexFile(indexFile, types.OffsetSize, func(key types.NeedleId, offset types.Offset, size uint32) error {
 		fmt.Printf("key:%v offset:%v size:%v\n", key, offset, size)
 		return nil
 	})
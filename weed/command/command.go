@@ -13,7 +13,9 @@ var Commands = []*Command{
 	cmdCompact,
 	cmdCopy,
 	cmdFix,
+	cmdFixOffset,
 	cmdFilerReplicate,
+	cmdFilerMetaMigrate,
 	cmdServer,
 	cmdMaster,
 	cmdFiler,
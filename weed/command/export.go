@@ -18,6 +18,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/storage"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/storage/volume_info"
 )
 
 const (
@@ -198,7 +199,12 @@ func runExport(cmd *Command, args []string) bool {
 	}
 	defer indexFile.Close()
 
-	needleMap, err := storage.LoadBtreeNeedleMap(indexFile)
+	offsetSize, err := volume_info.LoadOffsetSize(path.Join(*export.dir, fileName+".vif"))
+	if err != nil {
+		glog.Fatalf("cannot read volume info of %s: %v", fileName, err)
+	}
+
+	needleMap, err := storage.LoadBtreeNeedleMap(indexFile, offsetSize)
 	if err != nil {
 		glog.Fatalf("cannot load needle map from %s: %s", indexFile.Name(), err)
 	}
@@ -247,6 +253,9 @@ func writeFile(vid needle.VolumeId, n *needle.Needle) (err error) {
 	if n.IsGzipped() && path.Ext(fileName) != ".gz" {
 		fileName = fileName + ".gz"
 	}
+	if n.IsZstd() && path.Ext(fileName) != ".zst" {
+		fileName = fileName + ".zst"
+	}
 
 	tarHeader.Name, tarHeader.Size = fileName, int64(len(n.Data))
 	if n.HasLastModifiedDate() {
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/chrislusf/raft/protobuf"
 	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/spf13/viper"
 
@@ -34,6 +35,9 @@ type FilerOptions struct {
 	dataCenter              *string
 	enableNotification      *bool
 	disableHttp             *bool
+	peers                   *string
+	raftDir                 *string
+	compression             *string
 
 	// default leveldb directory, used in "weed server" mode
 	defaultLevelDbDirectory *string
@@ -53,6 +57,9 @@ func init() {
 	f.dirListingLimit = cmdFiler.Flag.Int("dirListLimit", 100000, "limit sub dir listing size")
 	f.dataCenter = cmdFiler.Flag.String("dataCenter", "", "prefer to write to volumes in this data center")
 	f.disableHttp = cmdFiler.Flag.Bool("disableHttp", false, "disable http request, only gRpc operations are allowed")
+	f.peers = cmdFiler.Flag.String("peers", "", "comma-separated filer peers <ip:port>, to replicate an embedded filer store with raft")
+	f.raftDir = cmdFiler.Flag.String("raftDir", "./filerraft", "directory to store the raft log when replicating the filer store")
+	f.compression = cmdFiler.Flag.String("compression", util.GzipCompression, "the codec to compress the compressible uploads, gzip or zstd")
 }
 
 var cmdFiler = &Command{
@@ -73,6 +80,10 @@ var cmdFiler = &Command{
 
 	The example filer.toml configuration file can be generated by "weed scaffold -config=filer"
 
+	With "-peers", the filers form a raft group and replicate all changes to the embedded
+	filer store, e.g., leveldb2 or badger, so that each filer has a full copy of the meta data.
+	The "-ip" should be the same address as this filer is listed in "-peers".
+
 `,
 }
 
@@ -87,6 +98,8 @@ func runFiler(cmd *Command, args []string) bool {
 
 func (fo *FilerOptions) startFiler() {
 
+	setDefaultCompression(*fo.compression)
+
 	defaultMux := http.NewServeMux()
 	publicVolumeMux := defaultMux
 
@@ -99,6 +112,16 @@ func (fo *FilerOptions) startFiler() {
 		defaultLevelDbDirectory = *fo.defaultLevelDbDirectory + "/filerldb2"
 	}
 
+	var peers []string
+	raftDir := ""
+	if fo.peers != nil && *fo.peers != "" {
+		peers = strings.Split(*fo.peers, ",")
+		raftDir = *fo.raftDir
+		if *fo.ip == "" {
+			glog.Fatalf("filer -ip is required to join filer peers %v", peers)
+		}
+	}
+
 	fs, nfs_err := weed_server.NewFilerServer(defaultMux, publicVolumeMux, &weed_server.FilerOption{
 		Masters:            strings.Split(*fo.masters, ","),
 		Collection:         *fo.collection,
@@ -111,6 +134,9 @@ func (fo *FilerOptions) startFiler() {
 		DefaultLevelDbDir:  defaultLevelDbDirectory,
 		DisableHttp:        *fo.disableHttp,
 		Port:               *fo.port,
+		Host:               *fo.ip,
+		Peers:              peers,
+		RaftDir:            raftDir,
 	})
 	if nfs_err != nil {
 		glog.Fatalf("Filer startup error: %v", nfs_err)
@@ -147,6 +173,9 @@ func (fo *FilerOptions) startFiler() {
 	}
 	grpcS := util.NewGrpcServer(security.LoadServerTLS(viper.Sub("grpc"), "filer"))
 	filer_pb.RegisterSeaweedFilerServer(grpcS, fs)
+	if raftServer := fs.RaftServer(); raftServer != nil {
+		protobuf.RegisterRaftServer(grpcS, raftServer)
+	}
 	reflection.Register(grpcS)
 	go grpcS.Serve(grpcL)
 
@@ -38,6 +38,7 @@ type CopyOptions struct {
 	concurrenctFiles  *int
 	concurrenctChunks *int
 	compressionLevel  *int
+	compression       *string
 	grpcDialOption    grpc.DialOption
 	masters           []string
 }
@@ -52,7 +53,8 @@ func init() {
 	copy.maxMB = cmdCopy.Flag.Int("maxMB", 32, "split files larger than the limit")
 	copy.concurrenctFiles = cmdCopy.Flag.Int("c", 8, "concurrent file copy goroutines")
 	copy.concurrenctChunks = cmdCopy.Flag.Int("concurrentChunks", 8, "concurrent chunk copy goroutines for each file")
-	copy.compressionLevel = cmdCopy.Flag.Int("compressionLevel", 9, "local file compression level 1 ~ 9")
+	copy.compressionLevel = cmdCopy.Flag.Int("compressionLevel", 9, "local file gzip compression level 1 ~ 9")
+	copy.compression = cmdCopy.Flag.String("compression", util.GzipCompression, "the codec to compress the compressible uploads, gzip or zstd")
 }
 
 var cmdCopy = &Command{
@@ -78,6 +80,8 @@ func runCopy(cmd *Command, args []string) bool {
 	if len(args) <= 1 {
 		return false
 	}
+	setDefaultCompression(*copy.compression)
+
 	filerDestination := args[len(args)-1]
 	fileOrDirs := args[0 : len(args)-1]
 
@@ -106,6 +110,7 @@ func runCopy(cmd *Command, args []string) bool {
 	filerGrpcPort := filerPort + 10000
 	filerGrpcAddress := fmt.Sprintf("%s:%d", filerUrl.Hostname(), filerGrpcPort)
 	copy.grpcDialOption = security.LoadClientTLS(viper.Sub("grpc"), "client")
+	operation.EnableGrpcDataPlane(copy.grpcDialOption)
 
 	ctx := context.Background()
 
@@ -290,7 +295,7 @@ func (worker *FileCopyWorker) uploadFileAsOne(ctx context.Context, task FileCopy
 
 		targetUrl := "http://" + assignResult.Url + "/" + assignResult.Fid
 
-		uploadResult, err := operation.UploadWithLocalCompressionLevel(targetUrl, fileName, f, false, mimeType, nil, assignResult.Auth, *worker.options.compressionLevel)
+		uploadResult, err := operation.UploadWithLocalCompressionLevel(targetUrl, fileName, f, "", mimeType, nil, assignResult.Auth, *worker.options.compressionLevel)
 		if err != nil {
 			return fmt.Errorf("upload data %v to %s: %v\n", fileName, targetUrl, err)
 		}
@@ -305,6 +310,7 @@ func (worker *FileCopyWorker) uploadFileAsOne(ctx context.Context, task FileCopy
 			Size:   uint64(uploadResult.Size),
 			Mtime:  time.Now().UnixNano(),
 			ETag:   uploadResult.ETag,
+			Md5:    uploadResult.ContentMd5,
 		})
 
 		fmt.Printf("copied %s => http://%s%s%s\n", fileName, worker.filerHost, task.destinationUrlPath, fileName)
@@ -378,7 +384,7 @@ func (worker *FileCopyWorker) uploadFileInChunks(ctx context.Context, task FileC
 			uploadResult, err := operation.Upload(targetUrl,
 				fileName+"-"+strconv.FormatInt(i+1, 10),
 				io.NewSectionReader(f, i*chunkSize, chunkSize),
-				false, "application/octet-stream", nil, assignResult.Auth)
+				"", "application/octet-stream", nil, assignResult.Auth)
 			if err != nil {
 				uploadError = fmt.Errorf("upload data %v to %s: %v\n", fileName, targetUrl, err)
 				return
@@ -393,6 +399,7 @@ func (worker *FileCopyWorker) uploadFileInChunks(ctx context.Context, task FileC
 				Size:   uint64(uploadResult.Size),
 				Mtime:  time.Now().UnixNano(),
 				ETag:   uploadResult.ETag,
+				Md5:    uploadResult.ContentMd5,
 			}
 			fmt.Printf("uploaded %s-%d to %s [%d,%d)\n", fileName, i+1, targetUrl, i*chunkSize, i*chunkSize+int64(uploadResult.Size))
 		}(i)
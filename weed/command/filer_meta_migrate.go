@@ -0,0 +1,343 @@
+package command
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/replication/sub"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/golang/protobuf/proto"
+	"github.com/spf13/viper"
+)
+
+var (
+	mm FilerMetaMigrateOptions
+)
+
+type FilerMetaMigrateOptions struct {
+	from               *string
+	to                 *string
+	dir                *string
+	concurrency        *int
+	catchUp            *bool
+	catchUpIdleSeconds *int
+	verify             *bool
+}
+
+func init() {
+	cmdFilerMetaMigrate.Run = runFilerMetaMigrate // break init cycle
+	mm.from = cmdFilerMetaMigrate.Flag.String("from", "", "source filer store name, a section in filer.toml")
+	mm.to = cmdFilerMetaMigrate.Flag.String("to", "", "destination filer store name, a section in filer.toml")
+	mm.dir = cmdFilerMetaMigrate.Flag.String("dir", "/", "only migrate this directory tree")
+	mm.concurrency = cmdFilerMetaMigrate.Flag.Int("concurrency", 16, "number of directories to list in parallel")
+	mm.catchUp = cmdFilerMetaMigrate.Flag.Bool("catchUp", false, "after the bulk copy, apply changes received from the notification input in notification.toml")
+	mm.catchUpIdleSeconds = cmdFilerMetaMigrate.Flag.Int("catchUp.idleSeconds", 60, "stop catching up after no changes are received for this many seconds")
+	mm.verify = cmdFilerMetaMigrate.Flag.Bool("verify", true, "compare the entry counts and checksums of the source and destination stores, after the copy and before catching up")
+}
+
+var cmdFilerMetaMigrate = &Command{
+	UsageLine: "filer.meta.migrate -from=leveldb2 -to=postgres",
+	Short:     "copy all filer meta data from one filer store to another",
+	Long: `copy all filer meta data from one filer store to another
+
+	Both the source and destination filer stores are configured as sections in filer.toml.
+	The "enabled" option is ignored, and both stores are opened directly.
+
+	1. All entries are copied from the source to the destination store, walking directories in parallel.
+	2. With "-verify", both stores are walked again to compare the number of directories, files,
+	   and a checksum of all entries. The migration stops here if they are different.
+	3. With "-catchUp", the changes received from the notification input in notification.toml are applied
+	   to the destination store, until no changes are received for "-catchUp.idleSeconds".
+	   Enable the notification on the live filer before starting the migration, so that no changes are missed.
+
+	Embedded stores, e.g., leveldb2, can not be opened while the filer is running.
+	Point the source section to a copy of the store directory, and catch up the changes made after the copy.
+	The verification runs before catching up, since the copy does not have these changes.
+
+  `,
+}
+
+func runFilerMetaMigrate(cmd *Command, args []string) bool {
+
+	util.LoadConfiguration("security", false)
+	util.LoadConfiguration("filer", true)
+	config := viper.GetViper()
+
+	if *mm.from == "" || *mm.to == "" || *mm.from == *mm.to {
+		glog.Fatalf("need two different filer stores for -from and -to: %s, %s", *mm.from, *mm.to)
+	}
+
+	sourceStore := loadFilerStore(config, *mm.from)
+	destinationStore := loadFilerStore(config, *mm.to)
+
+	ctx := context.Background()
+	dir := filer2.FullPath(*mm.dir)
+
+	startTime := time.Now()
+	var dirCount, fileCount int64
+	err := traverseFilerStore(ctx, sourceStore, dir, *mm.concurrency, func(entry *filer2.Entry) error {
+		if err := saveFilerStoreEntry(ctx, destinationStore, entry); err != nil {
+			return err
+		}
+		if entry.IsDirectory() {
+			atomic.AddInt64(&dirCount, 1)
+		} else {
+			atomic.AddInt64(&fileCount, 1)
+		}
+		return nil
+	})
+	if err != nil {
+		glog.Fatalf("copy from %s to %s: %v", *mm.from, *mm.to, err)
+	}
+	fmt.Printf("copied %d directories, %d files from %s to %s in %v\n", dirCount, fileCount, *mm.from, *mm.to, time.Since(startTime))
+
+	// verified before catching up, since the changes caught up are missing in a stale source copy
+	if *mm.verify {
+		sourceSummary, err := summarizeFilerStore(ctx, sourceStore, dir, *mm.concurrency)
+		if err != nil {
+			glog.Fatalf("verify %s: %v", *mm.from, err)
+		}
+		destinationSummary, err := summarizeFilerStore(ctx, destinationStore, dir, *mm.concurrency)
+		if err != nil {
+			glog.Fatalf("verify %s: %v", *mm.to, err)
+		}
+		fmt.Printf("%-12s %s\n", *mm.from+":", sourceSummary)
+		fmt.Printf("%-12s %s\n", *mm.to+":", destinationSummary)
+		if *sourceSummary != *destinationSummary {
+			fmt.Printf("verification failed: %s and %s are different\n", *mm.from, *mm.to)
+			return false
+		}
+		fmt.Printf("verification passed\n")
+	}
+
+	if *mm.catchUp {
+		changeCount, err := catchUpFilerStore(ctx, config, destinationStore, dir, time.Duration(*mm.catchUpIdleSeconds)*time.Second)
+		if err != nil {
+			glog.Fatalf("catch up changes to %s: %v", *mm.to, err)
+		}
+		fmt.Printf("applied %d changes to %s\n", changeCount, *mm.to)
+	}
+
+	return true
+}
+
+func loadFilerStore(config *viper.Viper, name string) *filer2.FilerStoreWrapper {
+	viperSub := config.Sub(name)
+	if viperSub == nil {
+		glog.Fatalf("filer store %s is not configured in filer.toml", name)
+	}
+	for _, store := range filer2.Stores {
+		if store.GetName() == name {
+			if err := store.Initialize(viperSub); err != nil {
+				glog.Fatalf("Failed to initialize store for %s: %+v", name, err)
+			}
+			return filer2.NewFilerStoreWrapper(store)
+		}
+	}
+	glog.Fatalf("filer store %s is not found", name)
+	return nil
+}
+
+// traverseFilerStore visits all entries under the directory, listing up to concurrency directories in parallel.
+// The eachEntryFunc can be called concurrently.
+func traverseFilerStore(ctx context.Context, store filer2.FilerStore, dir filer2.FullPath, concurrency int,
+	eachEntryFunc func(entry *filer2.Entry) error) error {
+
+	var wg sync.WaitGroup
+	var errLock sync.Mutex
+	var firstErr error
+	limiter := make(chan struct{}, concurrency)
+
+	var walk func(dirPath filer2.FullPath)
+	walk = func(dirPath filer2.FullPath) {
+		defer wg.Done()
+
+		limiter <- struct{}{}
+		subDirs, err := listFilerStoreDirectory(ctx, store, dirPath, eachEntryFunc)
+		<-limiter
+
+		if err != nil {
+			errLock.Lock()
+			if firstErr == nil {
+				firstErr = fmt.Errorf("list %s: %v", dirPath, err)
+			}
+			errLock.Unlock()
+			return
+		}
+
+		for _, subDir := range subDirs {
+			wg.Add(1)
+			go walk(subDir)
+		}
+	}
+
+	wg.Add(1)
+	walk(dir)
+	wg.Wait()
+
+	return firstErr
+}
+
+func listFilerStoreDirectory(ctx context.Context, store filer2.FilerStore, dirPath filer2.FullPath,
+	eachEntryFunc func(entry *filer2.Entry) error) (subDirs []filer2.FullPath, err error) {
+
+	paginateSize := 1024
+	lastFileName := ""
+	for {
+		entries, listErr := store.ListDirectoryEntries(ctx, dirPath, lastFileName, false, paginateSize)
+		if listErr != nil {
+			return nil, listErr
+		}
+		for _, entry := range entries {
+			if err = eachEntryFunc(entry); err != nil {
+				return nil, err
+			}
+			if entry.IsDirectory() {
+				subDirs = append(subDirs, entry.FullPath)
+			}
+			lastFileName = entry.Name()
+		}
+		if len(entries) < paginateSize {
+			return subDirs, nil
+		}
+	}
+}
+
+func saveFilerStoreEntry(ctx context.Context, store filer2.FilerStore, entry *filer2.Entry) error {
+	if err := store.InsertEntry(ctx, entry); err != nil {
+		if updateErr := store.UpdateEntry(ctx, entry); updateErr != nil {
+			return fmt.Errorf("save %s: %v", entry.FullPath, updateErr)
+		}
+	}
+	return nil
+}
+
+func catchUpFilerStore(ctx context.Context, config *viper.Viper, store filer2.FilerStore, dir filer2.FullPath, idleTimeout time.Duration) (changeCount int64, err error) {
+
+	util.LoadConfiguration("notification", true)
+
+	validateOneEnabledInput(config)
+
+	var notificationInput sub.NotificationInput
+	for _, input := range sub.NotificationInputs {
+		if config.GetBool("notification." + input.GetName() + ".enabled") {
+			if err := input.Initialize(config.Sub("notification." + input.GetName())); err != nil {
+				return 0, fmt.Errorf("initialize notification input %s: %v", input.GetName(), err)
+			}
+			notificationInput = input
+			break
+		}
+	}
+	if notificationInput == nil {
+		return 0, fmt.Errorf("no notification input is defined in notification.toml")
+	}
+
+	type change struct {
+		key     string
+		message *filer_pb.EventNotification
+	}
+	changes := make(chan change, 1024)
+	go func() {
+		for {
+			key, m, err := notificationInput.ReceiveMessage()
+			if err != nil {
+				glog.Errorf("receive %s: %+v", key, err)
+				continue
+			}
+			if key == "" {
+				// long poll received no messages
+				continue
+			}
+			changes <- change{key, m}
+		}
+	}()
+
+	for {
+		select {
+		case c := <-changes:
+			if !isUnderFilerDirectory(c.key, dir) {
+				continue
+			}
+			if err = applyFilerStoreChange(ctx, store, c.key, c.message); err != nil {
+				return changeCount, err
+			}
+			changeCount++
+		case <-time.After(idleTimeout):
+			return changeCount, nil
+		}
+	}
+}
+
+func applyFilerStoreChange(ctx context.Context, store filer2.FilerStore, key string, m *filer_pb.EventNotification) error {
+
+	var newPath filer2.FullPath
+	if m.NewEntry != nil {
+		newPath = filer2.NewFullPath(m.NewParentPath, m.NewEntry.Name)
+	}
+
+	if m.OldEntry != nil && string(newPath) != key {
+		if err := store.DeleteEntry(ctx, filer2.FullPath(key)); err != nil {
+			return fmt.Errorf("delete %s: %v", key, err)
+		}
+	}
+
+	if m.NewEntry != nil {
+		return saveFilerStoreEntry(ctx, store, &filer2.Entry{
+			FullPath: newPath,
+			Attr:     filer2.PbToEntryAttribute(m.NewEntry.Attributes),
+			Chunks:   m.NewEntry.Chunks,
+			Extended: m.NewEntry.Extended,
+			Version:  m.NewEntry.Version,
+		})
+	}
+
+	return nil
+}
+
+func isUnderFilerDirectory(key string, dir filer2.FullPath) bool {
+	if dir == "/" {
+		return true
+	}
+	return len(key) > len(dir) && key[:len(dir)] == string(dir) && key[len(dir)] == '/'
+}
+
+type filerStoreSummary struct {
+	DirCount  int64
+	FileCount int64
+	Checksum  uint64
+}
+
+func (s *filerStoreSummary) String() string {
+	return fmt.Sprintf("%d directories, %d files, checksum %016x", s.DirCount, s.FileCount, s.Checksum)
+}
+
+// summarizeFilerStore counts all entries, and adds up the hash of each entry into an order independent checksum
+func summarizeFilerStore(ctx context.Context, store filer2.FilerStore, dir filer2.FullPath, concurrency int) (*filerStoreSummary, error) {
+	summary := &filerStoreSummary{}
+	err := traverseFilerStore(ctx, store, dir, concurrency, func(entry *filer2.Entry) error {
+		buf := proto.NewBuffer(nil)
+		buf.SetDeterministic(true)
+		if err := buf.Marshal(entry.ToProtoEntry()); err != nil {
+			return fmt.Errorf("marshal %s: %v", entry.FullPath, err)
+		}
+		h := md5.New()
+		h.Write([]byte(entry.FullPath))
+		h.Write(buf.Bytes())
+		atomic.AddUint64(&summary.Checksum, binary.BigEndian.Uint64(h.Sum(nil)))
+		if entry.IsDirectory() {
+			atomic.AddInt64(&summary.DirCount, 1)
+		} else {
+			atomic.AddInt64(&summary.FileCount, 1)
+		}
+		return nil
+	})
+	return summary, err
+}
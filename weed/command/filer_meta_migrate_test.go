@@ -0,0 +1,71 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/filer2/memdb"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func TestMigrateFilerStore(t *testing.T) {
+
+	ctx := context.Background()
+
+	source, destination := &memdb.MemDbStore{}, &memdb.MemDbStore{}
+	source.Initialize(nil)
+	destination.Initialize(nil)
+
+	filer := filer2.NewFiler(nil, nil)
+	filer.SetStore(source)
+	filer.DisableDirectoryCache()
+
+	for _, p := range []string{"/a/b/c.txt", "/a/d.txt", "/e/f/g/h.txt", "/i.txt"} {
+		if err := filer.CreateEntry(ctx, &filer2.Entry{
+			FullPath: filer2.FullPath(p),
+			Attr:     filer2.Attr{Mode: 0644},
+			Chunks:   []*filer_pb.FileChunk{{FileId: "1,2345", Size: 100}},
+		}); err != nil {
+			t.Fatalf("create entry %v: %v", p, err)
+		}
+	}
+
+	err := traverseFilerStore(ctx, source, "/", 2, func(entry *filer2.Entry) error {
+		return saveFilerStoreEntry(ctx, destination, entry)
+	})
+	if err != nil {
+		t.Fatalf("copy: %v", err)
+	}
+
+	sourceSummary, _ := summarizeFilerStore(ctx, source, "/", 2)
+	destinationSummary, _ := summarizeFilerStore(ctx, destination, "/", 2)
+	if sourceSummary.DirCount != 5 || sourceSummary.FileCount != 4 {
+		t.Errorf("unexpected source summary: %s", sourceSummary)
+	}
+	if *sourceSummary != *destinationSummary {
+		t.Errorf("summary %s != %s", sourceSummary, destinationSummary)
+	}
+
+	// a rename event moves the entry
+	err = applyFilerStoreChange(ctx, destination, "/a/d.txt", &filer_pb.EventNotification{
+		OldEntry:      &filer_pb.Entry{Name: "d.txt", Attributes: &filer_pb.FuseAttributes{FileMode: 0644}},
+		NewEntry:      &filer_pb.Entry{Name: "d2.txt", Attributes: &filer_pb.FuseAttributes{FileMode: 0644}},
+		NewParentPath: "/e",
+	})
+	if err != nil {
+		t.Fatalf("apply change: %v", err)
+	}
+	if _, err = destination.FindEntry(ctx, "/a/d.txt"); err != filer2.ErrNotFound {
+		t.Errorf("old entry still exists: %v", err)
+	}
+	if _, err = destination.FindEntry(ctx, "/e/d2.txt"); err != nil {
+		t.Errorf("new entry not found: %v", err)
+	}
+
+	destinationSummary, _ = summarizeFilerStore(ctx, destination, "/", 2)
+	if *sourceSummary == *destinationSummary {
+		t.Errorf("summary should be different after changes")
+	}
+
+}
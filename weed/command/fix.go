@@ -9,6 +9,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/storage"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/storage/volume_info"
 )
 
 func init() {
@@ -72,7 +73,12 @@ func runFix(cmd *Command, args []string) bool {
 	}
 	defer indexFile.Close()
 
-	nm := storage.NewBtreeNeedleMap(indexFile)
+	offsetSize, err := volume_info.LoadOffsetSize(path.Join(*fixVolumePath, baseFileName+".vif"))
+	if err != nil {
+		glog.Fatalf("Read Volume Info [ERROR] %s\n", err)
+	}
+
+	nm := storage.NewBtreeNeedleMap(indexFile, offsetSize)
 	defer nm.Close()
 
 	vid := needle.VolumeId(*fixVolumeId)
@@ -0,0 +1,175 @@
+package command
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/idx"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/storage/volume_info"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func init() {
+	cmdFixOffset.Run = runFixOffset // break init cycle
+}
+
+var cmdFixOffset = &Command{
+	UsageLine: "fix.offset -dir=/tmp -volumeId=234 -offsetSize=5",
+	Short:     "convert the index files of volumes between 4 bytes and 5 bytes offsets",
+	Long: `Convert the .idx and .ecx files of the volumes into the offset size, and record it in the .vif file.
+
+  With 4 bytes offsets, a volume can be up to 32GB. With 5 bytes offsets, a volume can be up to 8TB.
+  The offset size of new volumes is chosen by the "-index.offsetSize" option of the volume server,
+  and the existing volumes keep their offset size until converted with this command.
+
+  The volume server should be stopped, or at least the volumes should not be loaded, during the conversion.
+  If -volumeId is not set, all the volumes in the dir, or all the volumes of the -collection, are converted.
+  If the conversion is interrupted, run the command again with the same options to complete it.
+
+  `,
+}
+
+var (
+	fixOffsetVolumePath       = cmdFixOffset.Flag.String("dir", ".", "data directory to store files")
+	fixOffsetVolumeCollection = cmdFixOffset.Flag.String("collection", "", "the volume collection name")
+	fixOffsetVolumeId         = cmdFixOffset.Flag.Int("volumeId", -1, "a volume id, or all volumes in the dir if not set")
+	fixOffsetSize             = cmdFixOffset.Flag.Int("offsetSize", 5, "the target offset size, 4 or 5")
+)
+
+func runFixOffset(cmd *Command, args []string) bool {
+
+	if !types.IsValidOffsetSize(*fixOffsetSize) {
+		glog.Fatalf("invalid offset size %d, expecting 4 or 5", *fixOffsetSize)
+	}
+
+	baseFileNames, err := listIndexedVolumes(*fixOffsetVolumePath, *fixOffsetVolumeCollection, *fixOffsetVolumeId)
+	if err != nil {
+		glog.Fatalf("list volumes in %s: %v", *fixOffsetVolumePath, err)
+	}
+
+	for _, baseFileName := range baseFileNames {
+		if err := convertVolumeOffsetSize(baseFileName, *fixOffsetSize); err != nil {
+			glog.Fatalf("convert volume %s: %v", baseFileName, err)
+		}
+	}
+
+	return true
+}
+
+// listIndexedVolumes returns the base file names of the volumes or ec volumes having .idx or .ecx files
+func listIndexedVolumes(dir, collection string, volumeId int) (baseFileNames []string, err error) {
+	fileInfos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	for _, fileInfo := range fileInfos {
+		name := fileInfo.Name()
+		ext := path.Ext(name)
+		if ext != ".idx" && ext != ".ecx" {
+			continue
+		}
+		base := name[:len(name)-len(ext)]
+		volumeCollection, volumeIdString := "", base
+		if i := strings.LastIndex(base, "_"); i >= 0 {
+			volumeCollection, volumeIdString = base[:i], base[i+1:]
+		}
+		id, parseErr := strconv.Atoi(volumeIdString)
+		if parseErr != nil {
+			continue
+		}
+		if volumeId != -1 && (id != volumeId || volumeCollection != collection) {
+			continue
+		}
+		if volumeId == -1 && collection != "" && volumeCollection != collection {
+			continue
+		}
+		if !seen[base] {
+			seen[base] = true
+			baseFileNames = append(baseFileNames, path.Join(dir, base))
+		}
+	}
+	if volumeId != -1 && len(baseFileNames) == 0 {
+		return nil, fmt.Errorf("volume %d not found", volumeId)
+	}
+	return
+}
+
+// convertVolumeOffsetSize converts the index files into side files, records the new offset size in the .vif file,
+// and then moves the side files over the index files.
+// If interrupted after the .vif file is saved, running it again moves the remaining side files.
+func convertVolumeOffsetSize(baseFileName string, offsetSize int) error {
+
+	volumeInfo, found, err := volume_info.MaybeLoadVolumeInfo(baseFileName + ".vif")
+	if err != nil {
+		return err
+	}
+	if !found {
+		volumeInfo = &volume_server_pb.VolumeInfo{}
+	}
+	fromOffsetSize := int(volumeInfo.BytesOffset)
+	if fromOffsetSize == 0 {
+		fromOffsetSize = types.OffsetSize
+	}
+
+	if fromOffsetSize == offsetSize {
+		// the side files are complete once the .vif file is saved
+		if err = moveConvertedIndexFiles(baseFileName); err != nil {
+			return err
+		}
+		glog.V(0).Infof("volume %s has %d bytes offsets", baseFileName, offsetSize)
+		return nil
+	}
+
+	for _, ext := range indexFileExts {
+		if !util.FileExists(baseFileName + ext) {
+			os.Remove(baseFileName + ext + convertedFileSuffix)
+			continue
+		}
+		if err = idx.ConvertIndexFile(baseFileName+ext, baseFileName+ext+convertedFileSuffix, fromOffsetSize, offsetSize); err != nil {
+			return err
+		}
+		glog.V(0).Infof("converted %s from %d bytes offsets to %d bytes offsets", baseFileName+ext, fromOffsetSize, offsetSize)
+	}
+
+	volumeInfo.BytesOffset = uint32(offsetSize)
+	if err = volume_info.SaveVolumeInfo(baseFileName+".vif", volumeInfo); err != nil {
+		return err
+	}
+
+	return moveConvertedIndexFiles(baseFileName)
+}
+
+const convertedFileSuffix = ".converted"
+
+var indexFileExts = []string{".idx", ".ecx"}
+
+func moveConvertedIndexFiles(baseFileName string) error {
+	var convertedExts []string
+	for _, ext := range indexFileExts {
+		if util.FileExists(baseFileName + ext + convertedFileSuffix) {
+			convertedExts = append(convertedExts, ext)
+		}
+	}
+	if len(convertedExts) == 0 {
+		return nil
+	}
+	// generated again from the converted .idx file
+	if err := os.Remove(baseFileName + ".sdx"); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove %s.sdx: %v", baseFileName, err)
+	}
+	for _, ext := range convertedExts {
+		convertedFileName := baseFileName + ext + convertedFileSuffix
+		if err := os.Rename(convertedFileName, baseFileName+ext); err != nil {
+			return fmt.Errorf("rename %s: %v", convertedFileName, err)
+		}
+	}
+	return nil
+}
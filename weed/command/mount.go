@@ -1,7 +1,10 @@
 package command
 
 import (
+	"crypto/md5"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 )
@@ -16,8 +19,20 @@ type MountOptions struct {
 	ttlSec             *int
 	chunkSizeLimitMB   *int
 	dataCenter         *string
+	durability         *string
 	allowOthers        *bool
 	umaskString        *string
+	cacheDir           *string
+	cacheSizeMB        *int64
+	cacheMemorySizeMB  *int64
+	readAheadMB        *int64
+	dirtyBufferMB      *int64
+	swapDir            *string
+	metaCache          *bool
+	writebackDir       *string
+	writebackWorkers   *int
+	mapIdentity        *bool
+	identityMapFile    *string
 }
 
 var (
@@ -37,8 +52,20 @@ func init() {
 	mountOptions.ttlSec = cmdMount.Flag.Int("ttl", 0, "file ttl in seconds")
 	mountOptions.chunkSizeLimitMB = cmdMount.Flag.Int("chunkSizeLimitMB", 4, "local write buffer size, also chunk large files")
 	mountOptions.dataCenter = cmdMount.Flag.String("dataCenter", "", "prefer to write to the data center")
+	mountOptions.durability = cmdMount.Flag.String("durability", "", "sync the written chunks on the volume servers: none, group[:<interval>], or fsync. If empty, let filer decide.")
 	mountOptions.allowOthers = cmdMount.Flag.Bool("allowOthers", true, "allows other users to access the file system")
 	mountOptions.umaskString = cmdMount.Flag.String("umask", "022", "octal umask, e.g., 022, 0111")
+	mountOptions.cacheDir = cmdMount.Flag.String("cacheDir", os.TempDir(), "local directory to cache the file chunks read from volume servers")
+	mountOptions.cacheSizeMB = cmdMount.Flag.Int64("cacheCapacityMB", 1000, "local disk space to cache the file chunks, 0 to disable the disk cache")
+	mountOptions.cacheMemorySizeMB = cmdMount.Flag.Int64("cacheMemoryMB", 64, "memory to cache the most recently read file chunks, 0 to disable the memory cache")
+	mountOptions.readAheadMB = cmdMount.Flag.Int64("readAheadMB", 8, "after sequential reads, prefetch this many MB into the chunk cache, 0 to disable")
+	mountOptions.dirtyBufferMB = cmdMount.Flag.Int64("dirtyBufferMB", 32, "memory to hold the written data of each open file before uploading it or moving it to the swap file")
+	mountOptions.swapDir = cmdMount.Flag.String("swapDir", "", "local directory to hold the written data beyond -dirtyBufferMB until the file is flushed, empty to upload it instead")
+	mountOptions.metaCache = cmdMount.Flag.Bool("metaCache", true, "keep the metadata in -cacheDir, kept up to date by the filer, instead of expiring it after a few seconds")
+	mountOptions.writebackDir = cmdMount.Flag.String("writebackDir", "", "local directory to stage the closed files and upload them in the background, empty to upload them when closing")
+	mountOptions.writebackWorkers = cmdMount.Flag.Int("writebackConcurrency", 8, "number of files uploaded concurrently in writeback mode")
+	mountOptions.mapIdentity = cmdMount.Flag.Bool("mapIdentity", false, "save the user and group names along with the uid and gid, and map them to the local uid and gid when reading")
+	mountOptions.identityMapFile = cmdMount.Flag.String("identityMapFile", "", "with -mapIdentity, a file of \"user <name> <uid>\" and \"group <name> <gid>\" lines to use before the local user database")
 	mountCpuProfile = cmdMount.Flag.String("cpuprofile", "", "cpu profile output file")
 	mountMemProfile = cmdMount.Flag.String("memprofile", "", "memory profile output file")
 }
@@ -76,3 +103,20 @@ func parseFilerGrpcAddress(filer string) (filerGrpcAddress string, err error) {
 
 	return fmt.Sprintf("%s:%d", hostnameAndPort[0], filerGrpcPort), nil
 }
+
+// chunkCacheDir separates the chunk caches of different mounts sharing the same cache directory
+func chunkCacheDir(cacheDir, filer, filerMountRootPath string) string {
+	return filepath.Join(cacheDir, fmt.Sprintf("seaweedfs_chunks_%x", md5.Sum([]byte(filer+":"+filerMountRootPath))))
+}
+
+func metaCacheDir(cacheDir, filer, filerMountRootPath string) string {
+	return filepath.Join(cacheDir, fmt.Sprintf("seaweedfs_meta_%x", md5.Sum([]byte(filer+":"+filerMountRootPath))))
+}
+
+// writebackStagingDir is kept for the same filer path across mount restarts, to resume the pending uploads
+func writebackStagingDir(writebackDir, filer, filerMountRootPath string) string {
+	if writebackDir == "" {
+		return ""
+	}
+	return filepath.Join(writebackDir, fmt.Sprintf("seaweedfs_writeback_%x", md5.Sum([]byte(filer+":"+filerMountRootPath))))
+}
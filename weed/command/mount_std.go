@@ -32,23 +32,15 @@ func runMount(cmd *Command, args []string) bool {
 		return false
 	}
 
-	return RunMount(
-		*mountOptions.filer,
-		*mountOptions.filerMountRootPath,
-		*mountOptions.dir,
-		*mountOptions.collection,
-		*mountOptions.replication,
-		*mountOptions.dataCenter,
-		*mountOptions.chunkSizeLimitMB,
-		*mountOptions.allowOthers,
-		*mountOptions.ttlSec,
-		*mountOptions.dirListingLimit,
-		os.FileMode(umask),
-	)
+	return RunMount(&mountOptions, os.FileMode(umask))
 }
 
-func RunMount(filer, filerMountRootPath, dir, collection, replication, dataCenter string, chunkSizeLimitMB int,
-	allowOthers bool, ttlSec int, dirListingLimit int, umask os.FileMode) bool {
+func RunMount(option *MountOptions, umask os.FileMode) bool {
+
+	filer := *option.filer
+	filerMountRootPath := *option.filerMountRootPath
+	dir := *option.dir
+	chunkSizeLimitMB := *option.chunkSizeLimitMB
 
 	util.LoadConfiguration("security", false)
 
@@ -62,6 +54,15 @@ func RunMount(filer, filerMountRootPath, dir, collection, replication, dataCente
 		return false
 	}
 
+	var identityMapper *util.IdentityMapper
+	if *option.mapIdentity {
+		var err error
+		if identityMapper, err = util.LoadIdentityMapper(*option.identityMapFile); err != nil {
+			fmt.Printf("can not load identity map: %v\n", err)
+			return false
+		}
+	}
+
 	fuse.Unmount(dir)
 
 	uid, gid := uint32(0), uint32(0)
@@ -115,7 +116,7 @@ func RunMount(filer, filerMountRootPath, dir, collection, replication, dataCente
 
 	options = append(options, osSpecificMountOptions()...)
 
-	if allowOthers {
+	if *option.allowOthers {
 		options = append(options, fuse.AllowOther())
 	}
 
@@ -145,23 +146,39 @@ func RunMount(filer, filerMountRootPath, dir, collection, replication, dataCente
 
 	daemonize.SignalOutcome(nil)
 
+	var metaCacheDirectory string
+	if *option.metaCache {
+		metaCacheDirectory = metaCacheDir(*option.cacheDir, filer, mountRoot)
+	}
+
 	err = fs.Serve(c, filesys.NewSeaweedFileSystem(&filesys.Option{
-		FilerGrpcAddress:   filerGrpcAddress,
-		GrpcDialOption:     security.LoadClientTLS(viper.Sub("grpc"), "client"),
-		FilerMountRootPath: mountRoot,
-		Collection:         collection,
-		Replication:        replication,
-		TtlSec:             int32(ttlSec),
-		ChunkSizeLimit:     int64(chunkSizeLimitMB) * 1024 * 1024,
-		DataCenter:         dataCenter,
-		DirListingLimit:    dirListingLimit,
-		EntryCacheTtl:      3 * time.Second,
-		MountUid:           uid,
-		MountGid:           gid,
-		MountMode:          mountMode,
-		MountCtime:         fileInfo.ModTime(),
-		MountMtime:         time.Now(),
-		Umask:              umask,
+		FilerGrpcAddress:          filerGrpcAddress,
+		GrpcDialOption:            security.LoadClientTLS(viper.Sub("grpc"), "client"),
+		FilerMountRootPath:        mountRoot,
+		Collection:                *option.collection,
+		Replication:               *option.replication,
+		TtlSec:                    int32(*option.ttlSec),
+		ChunkSizeLimit:            int64(chunkSizeLimitMB) * 1024 * 1024,
+		DataCenter:                *option.dataCenter,
+		Durability:                *option.durability,
+		DirListingLimit:           *option.dirListingLimit,
+		EntryCacheTtl:             3 * time.Second,
+		IdentityMapper:            identityMapper,
+		MountUid:                  uid,
+		MountGid:                  gid,
+		MountMode:                 mountMode,
+		MountCtime:                fileInfo.ModTime(),
+		MountMtime:                time.Now(),
+		Umask:                     umask,
+		ChunkCacheDir:             chunkCacheDir(*option.cacheDir, filer, mountRoot),
+		ChunkCacheSizeLimit:       *option.cacheSizeMB * 1024 * 1024,
+		ChunkCacheMemorySizeLimit: *option.cacheMemorySizeMB * 1024 * 1024,
+		ReadAheadSize:             *option.readAheadMB * 1024 * 1024,
+		DirtyPagesMemoryLimit:     *option.dirtyBufferMB * 1024 * 1024,
+		SwapDir:                   *option.swapDir,
+		MetaCacheDir:              metaCacheDirectory,
+		WritebackDir:              writebackStagingDir(*option.writebackDir, filer, mountRoot),
+		WritebackConcurrency:      *option.writebackWorkers,
 	}))
 	if err != nil {
 		fuse.Unmount(dir)
@@ -74,6 +74,11 @@ dir = "."					# directory to store level db files
 enabled = true
 dir = "."					# directory to store level db files
 
+[badger]
+# local on disk, supports consistent online metadata backup via "fs.meta.backup" in "weed shell"
+enabled = false
+dir = "."					# directory to store badger db files
+
 ####################################################
 # multiple filers on shared storage, fairly scalable
 ####################################################
@@ -127,12 +132,16 @@ keyspace="seaweedfs"
 hosts=[
 	"localhost:9042",
 ]
+# if positive, cassandra also expires the entries with a ttl, this many seconds after the filer should have purged them
+ttl_grace_seconds = 0
 
 [redis]
 enabled = false
 address  = "localhost:6379"
 password = ""
 database = 0
+# if positive, redis also expires the entries with a ttl, this many seconds after the filer should have purged them
+ttl_grace_seconds = 0
 
 [redis_cluster]
 enabled = false
@@ -145,6 +154,8 @@ addresses = [
     "localhost:30006",
 ]
 password = ""
+# if positive, redis also expires the entries with a ttl, this many seconds after the filer should have purged them
+ttl_grace_seconds = 0
 
 [etcd]
 enabled = false
@@ -155,6 +166,13 @@ timeout = "3s"
 enabled = false
 pdAddress = "192.168.199.113:2379"
 
+[quota]
+# the space limit in MB of the files owned by each user, unlimited if not listed
+# the files saved with the user name, by "weed mount" or "weed webdav" with -mapIdentity, are counted by the name,
+# and the other files by the uid
+# alice = 10240
+# 1000 = 10240
+
 
 `
 
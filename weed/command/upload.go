@@ -25,6 +25,7 @@ type UploadOptions struct {
 	collection  *string
 	dataCenter  *string
 	ttl         *string
+	durability  *string
 	maxMB       *int
 }
 
@@ -38,6 +39,7 @@ func init() {
 	upload.collection = cmdUpload.Flag.String("collection", "", "optional collection name")
 	upload.dataCenter = cmdUpload.Flag.String("dataCenter", "", "optional data center name")
 	upload.ttl = cmdUpload.Flag.String("ttl", "", "time to live, e.g.: 1m, 1h, 1d, 1M, 1y")
+	upload.durability = cmdUpload.Flag.String("durability", "", "sync the files on the volume servers: none, group[:<interval>], or fsync")
 	upload.maxMB = cmdUpload.Flag.Int("maxMB", 32, "split files larger than the limit")
 }
 
@@ -83,7 +85,7 @@ func runUpload(cmd *Command, args []string) bool {
 					}
 					results, e := operation.SubmitFiles(*upload.master, grpcDialOption, parts,
 						*upload.replication, *upload.collection, *upload.dataCenter,
-						*upload.ttl, *upload.maxMB)
+						*upload.ttl, *upload.durability, *upload.maxMB)
 					bytes, _ := json.Marshal(results)
 					fmt.Println(string(bytes))
 					if e != nil {
@@ -102,7 +104,7 @@ func runUpload(cmd *Command, args []string) bool {
 		}
 		results, _ := operation.SubmitFiles(*upload.master, grpcDialOption, parts,
 			*upload.replication, *upload.collection, *upload.dataCenter,
-			*upload.ttl, *upload.maxMB)
+			*upload.ttl, *upload.durability, *upload.maxMB)
 		bytes, _ := json.Marshal(results)
 		fmt.Println(string(bytes))
 	}
@@ -19,6 +19,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
 	"github.com/chrislusf/seaweedfs/weed/server"
 	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"google.golang.org/grpc/reflection"
 )
@@ -42,11 +43,13 @@ type VolumeServerOptions struct {
 	rack                  *string
 	whiteList             []string
 	indexType             *string
+	indexOffsetSize       *int
 	fixJpgOrientation     *bool
 	readRedirect          *bool
 	cpuProfile            *string
 	memProfile            *string
 	compactionMBPerSecond *int
+	compression           *string
 }
 
 func init() {
@@ -61,12 +64,14 @@ func init() {
 	v.idleConnectionTimeout = cmdVolume.Flag.Int("idleTimeout", 30, "connection idle seconds")
 	v.dataCenter = cmdVolume.Flag.String("dataCenter", "", "current volume server's data center name")
 	v.rack = cmdVolume.Flag.String("rack", "", "current volume server's rack name")
-	v.indexType = cmdVolume.Flag.String("index", "memory", "Choose [memory|leveldb|leveldbMedium|leveldbLarge] mode for memory~performance balance.")
+	v.indexType = cmdVolume.Flag.String("index", "memory", "Choose [memory|leveldb|leveldbMedium|leveldbLarge|sorted] mode for memory~performance balance.")
+	v.indexOffsetSize = cmdVolume.Flag.Int("index.offsetSize", types.OffsetSize, "the offset size in bytes of the index of new volumes, 4 for volumes up to 32GB or 5 for up to 8TB")
 	v.fixJpgOrientation = cmdVolume.Flag.Bool("images.fix.orientation", false, "Adjust jpg orientation when uploading.")
 	v.readRedirect = cmdVolume.Flag.Bool("read.redirect", true, "Redirect moved or non-local volumes.")
 	v.cpuProfile = cmdVolume.Flag.String("cpuprofile", "", "cpu profile output file")
 	v.memProfile = cmdVolume.Flag.String("memprofile", "", "memory profile output file")
 	v.compactionMBPerSecond = cmdVolume.Flag.Int("compactionMBps", 0, "limit background compaction or copying speed in mega bytes per second")
+	v.compression = cmdVolume.Flag.String("compression", util.GzipCompression, "the codec to compress the compressible uploads, gzip or zstd")
 }
 
 var cmdVolume = &Command{
@@ -146,8 +151,17 @@ func (v VolumeServerOptions) startVolumeServer(volumeFolders, maxVolumeCounts, v
 		volumeNeedleMapKind = storage.NeedleMapLevelDbMedium
 	case "leveldbLarge":
 		volumeNeedleMapKind = storage.NeedleMapLevelDbLarge
+	case "sorted":
+		volumeNeedleMapKind = storage.NeedleMapSortedFile
 	}
 
+	if !types.IsValidOffsetSize(*v.indexOffsetSize) {
+		glog.Fatalf("invalid index offset size %d, expecting 4 or 5", *v.indexOffsetSize)
+	}
+	storage.NewVolumeOffsetSize = *v.indexOffsetSize
+
+	setDefaultCompression(*v.compression)
+
 	masters := *v.masters
 
 	volumeServer := weed_server.NewVolumeServer(volumeMux, publicVolumeMux,
@@ -288,3 +302,10 @@ func (v VolumeServerOptions) startClusterHttpService(handler http.Handler) httpd
 	}()
 	return clusterHttpServer
 }
+
+func setDefaultCompression(codec string) {
+	if !util.IsCompressionSupported(codec) {
+		glog.Fatalf("unsupported compression %s, expecting %s or %s", codec, util.GzipCompression, util.ZstdCompression)
+	}
+	util.DefaultCompression = codec
+}
@@ -24,6 +24,8 @@ type WebDavOption struct {
 	collection     *string
 	tlsPrivateKey  *string
 	tlsCertificate *string
+	mapIdentity    *bool
+	identityMap    *string
 }
 
 func init() {
@@ -33,6 +35,8 @@ func init() {
 	webDavStandaloneOptions.collection = cmdWebDav.Flag.String("collection", "", "collection to create the files")
 	webDavStandaloneOptions.tlsPrivateKey = cmdWebDav.Flag.String("key.file", "", "path to the TLS private key file")
 	webDavStandaloneOptions.tlsCertificate = cmdWebDav.Flag.String("cert.file", "", "path to the TLS certificate file")
+	webDavStandaloneOptions.mapIdentity = cmdWebDav.Flag.Bool("mapIdentity", false, "save the user and group names along with the uid and gid of the created files")
+	webDavStandaloneOptions.identityMap = cmdWebDav.Flag.String("identityMapFile", "", "with -mapIdentity, a file of \"user <name> <uid>\" and \"group <name> <gid>\" lines to use before the local user database")
 }
 
 var cmdWebDav = &Command{
@@ -72,6 +76,13 @@ func (wo *WebDavOption) startWebDav() bool {
 		}
 	}
 
+	var identityMapper *util.IdentityMapper
+	if *wo.mapIdentity {
+		if identityMapper, err = util.LoadIdentityMapper(*wo.identityMap); err != nil {
+			glog.Fatalf("load identity map: %v", err)
+		}
+	}
+
 	ws, webdavServer_err := weed_server.NewWebDavServer(&weed_server.WebDavOption{
 		Filer:            *wo.filer,
 		FilerGrpcAddress: filerGrpcAddress,
@@ -79,6 +90,7 @@ func (wo *WebDavOption) startWebDav() bool {
 		Collection:       *wo.collection,
 		Uid:              uid,
 		Gid:              gid,
+		IdentityMapper:   identityMapper,
 	})
 	if webdavServer_err != nil {
 		glog.Fatalf("WebDav Server startup error: %v", webdavServer_err)
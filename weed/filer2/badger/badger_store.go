@@ -0,0 +1,251 @@
+package badger
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	weed_util "github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/dgraph-io/badger"
+)
+
+const (
+	DIR_FILE_SEPARATOR = byte(0x00)
+)
+
+func init() {
+	filer2.Stores = append(filer2.Stores, &BadgerStore{})
+}
+
+type BadgerStore struct {
+	db *badger.DB
+}
+
+func (store *BadgerStore) GetName() string {
+	return "badger"
+}
+
+func (store *BadgerStore) Initialize(configuration weed_util.Configuration) (err error) {
+	dir := configuration.GetString("dir")
+	return store.initialize(dir)
+}
+
+func (store *BadgerStore) initialize(dir string) (err error) {
+	glog.Infof("filer store badger dir: %s", dir)
+	if err := weed_util.TestFolderWritable(dir); err != nil {
+		return fmt.Errorf("Check Badger Folder %s Writable: %s", dir, err)
+	}
+
+	opts := badger.DefaultOptions(dir).WithTruncate(true)
+
+	if store.db, err = badger.Open(opts); err != nil {
+		glog.Infof("filer store open dir %s: %v", dir, err)
+		return
+	}
+
+	go store.loopValueLogGC()
+
+	return
+}
+
+// loopValueLogGC reclaims space held by overwritten or deleted values
+func (store *BadgerStore) loopValueLogGC() {
+	ticker := time.NewTicker(10 * time.Minute)
+	defer ticker.Stop()
+	for range ticker.C {
+		for store.db.RunValueLogGC(0.5) == nil {
+		}
+	}
+}
+
+func (store *BadgerStore) BeginTransaction(ctx context.Context) (context.Context, error) {
+	return ctx, nil
+}
+func (store *BadgerStore) CommitTransaction(ctx context.Context) error {
+	return nil
+}
+func (store *BadgerStore) RollbackTransaction(ctx context.Context) error {
+	return nil
+}
+
+func (store *BadgerStore) InsertEntry(ctx context.Context, entry *filer2.Entry) (err error) {
+	key := genKey(entry.DirAndName())
+
+	value, err := entry.EncodeAttributesAndChunks()
+	if err != nil {
+		return fmt.Errorf("encoding %s %+v: %v", entry.FullPath, entry.Attr, err)
+	}
+
+	err = store.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(key, value)
+	})
+
+	if err != nil {
+		return fmt.Errorf("persisting %s : %v", entry.FullPath, err)
+	}
+
+	return nil
+}
+
+func (store *BadgerStore) UpdateEntry(ctx context.Context, entry *filer2.Entry) (err error) {
+
+	return store.InsertEntry(ctx, entry)
+}
+
+func (store *BadgerStore) FindEntry(ctx context.Context, fullpath filer2.FullPath) (entry *filer2.Entry, err error) {
+	key := genKey(fullpath.DirAndName())
+
+	var data []byte
+	err = store.db.View(func(txn *badger.Txn) error {
+		item, getErr := txn.Get(key)
+		if getErr != nil {
+			return getErr
+		}
+		data, getErr = item.ValueCopy(nil)
+		return getErr
+	})
+
+	if err == badger.ErrKeyNotFound {
+		return nil, filer2.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get %s : %v", fullpath, err)
+	}
+
+	entry = &filer2.Entry{
+		FullPath: fullpath,
+	}
+	err = entry.DecodeAttributesAndChunks(data)
+	if err != nil {
+		return entry, fmt.Errorf("decode %s : %v", entry.FullPath, err)
+	}
+
+	return entry, nil
+}
+
+func (store *BadgerStore) DeleteEntry(ctx context.Context, fullpath filer2.FullPath) (err error) {
+	key := genKey(fullpath.DirAndName())
+
+	err = store.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(key)
+	})
+	if err != nil {
+		return fmt.Errorf("delete %s : %v", fullpath, err)
+	}
+
+	return nil
+}
+
+func (store *BadgerStore) ListDirectoryEntries(ctx context.Context, fullpath filer2.FullPath, startFileName string, inclusive bool,
+	limit int) (entries []*filer2.Entry, err error) {
+
+	directoryPrefix := genDirectoryKeyPrefix(fullpath, "")
+
+	err = store.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = directoryPrefix
+		iter := txn.NewIterator(opts)
+		defer iter.Close()
+
+		for iter.Seek(genDirectoryKeyPrefix(fullpath, startFileName)); iter.ValidForPrefix(directoryPrefix); iter.Next() {
+			item := iter.Item()
+			fileName := getNameFromKey(item.Key())
+			if fileName == "" {
+				continue
+			}
+			if fileName == startFileName && !inclusive {
+				continue
+			}
+			limit--
+			if limit < 0 {
+				break
+			}
+			entry := &filer2.Entry{
+				FullPath: filer2.NewFullPath(string(fullpath), fileName),
+			}
+			if decodeErr := item.Value(entry.DecodeAttributesAndChunks); decodeErr != nil {
+				glog.V(0).Infof("list %s : %v", entry.FullPath, decodeErr)
+				return decodeErr
+			}
+			entries = append(entries, entry)
+		}
+		return nil
+	})
+
+	return entries, err
+}
+
+// TraverseSnapshot visits all entries under the directory from one consistent read snapshot.
+// Since keys are sorted by "<dir>\x00<name>", a directory is always visited before its children.
+func (store *BadgerStore) TraverseSnapshot(ctx context.Context, dirPath filer2.FullPath, eachEntryFunc func(entry *filer2.Entry) error) (err error) {
+
+	return store.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = []byte(string(dirPath))
+		iter := txn.NewIterator(opts)
+		defer iter.Close()
+
+		for iter.Rewind(); iter.ValidForPrefix(opts.Prefix); iter.Next() {
+			item := iter.Item()
+			dir, name := getDirAndNameFromKey(item.Key())
+			if !isUnderDirectory(dir, string(dirPath)) {
+				continue
+			}
+			entry := &filer2.Entry{
+				FullPath: filer2.NewFullPath(dir, name),
+			}
+			if decodeErr := item.Value(entry.DecodeAttributesAndChunks); decodeErr != nil {
+				return fmt.Errorf("decode %s : %v", entry.FullPath, decodeErr)
+			}
+			if err := eachEntryFunc(entry); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func genKey(dirPath, fileName string) (key []byte) {
+	key = []byte(dirPath)
+	key = append(key, DIR_FILE_SEPARATOR)
+	key = append(key, []byte(fileName)...)
+	return key
+}
+
+func genDirectoryKeyPrefix(fullpath filer2.FullPath, startFileName string) (keyPrefix []byte) {
+	keyPrefix = []byte(string(fullpath))
+	keyPrefix = append(keyPrefix, DIR_FILE_SEPARATOR)
+	if len(startFileName) > 0 {
+		keyPrefix = append(keyPrefix, []byte(startFileName)...)
+	}
+	return keyPrefix
+}
+
+func getNameFromKey(key []byte) string {
+
+	_, name := getDirAndNameFromKey(key)
+
+	return name
+
+}
+
+func getDirAndNameFromKey(key []byte) (dir, name string) {
+
+	sepIndex := bytes.LastIndexByte(key, DIR_FILE_SEPARATOR)
+	if sepIndex < 0 {
+		return "", string(key)
+	}
+
+	return string(key[:sepIndex]), string(key[sepIndex+1:])
+
+}
+
+func isUnderDirectory(dir, parent string) bool {
+	if parent == "/" || dir == parent {
+		return true
+	}
+	return len(dir) > len(parent) && dir[:len(parent)] == parent && dir[len(parent)] == '/'
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/gocql/gocql"
+	"time"
 )
 
 func init() {
@@ -16,6 +17,8 @@ func init() {
 type CassandraStore struct {
 	cluster *gocql.ClusterConfig
 	session *gocql.Session
+	// ttlGrace, if positive, lets cassandra expire the entries this long after their ttl, in case the filer does not purge them
+	ttlGrace time.Duration
 }
 
 func (store *CassandraStore) GetName() string {
@@ -26,10 +29,12 @@ func (store *CassandraStore) Initialize(configuration util.Configuration) (err e
 	return store.initialize(
 		configuration.GetString("keyspace"),
 		configuration.GetStringSlice("hosts"),
+		configuration.GetInt("ttl_grace_seconds"),
 	)
 }
 
-func (store *CassandraStore) initialize(keyspace string, hosts []string) (err error) {
+func (store *CassandraStore) initialize(keyspace string, hosts []string, ttlGraceSeconds int) (err error) {
+	store.ttlGrace = time.Duration(ttlGraceSeconds) * time.Second
 	store.cluster = gocql.NewCluster(hosts...)
 	store.cluster.Keyspace = keyspace
 	store.cluster.Consistency = gocql.LocalQuorum
@@ -58,9 +63,14 @@ func (store *CassandraStore) InsertEntry(ctx context.Context, entry *filer2.Entr
 		return fmt.Errorf("encode %s: %s", entry.FullPath, err)
 	}
 
+	// expired by cassandra only after the grace, since the filer expires the entries with the notifications and the chunk deletion
+	ttl := entry.StoreTtl(store.ttlGrace)
+	if ttl > 0 && ttl < time.Second {
+		ttl = time.Second
+	}
 	if err := store.session.Query(
 		"INSERT INTO filemeta (directory,name,meta) VALUES(?,?,?) USING TTL ? ",
-		dir, name, meta, entry.TtlSec).Exec(); err != nil {
+		dir, name, meta, int(ttl/time.Second)).Exec(); err != nil {
 		return fmt.Errorf("insert %s: %s", entry.FullPath, err)
 	}
 
@@ -0,0 +1,50 @@
+package filer2
+
+import (
+	"bytes"
+	"crypto/md5"
+	"fmt"
+	"hash"
+)
+
+// ChunkViewVerifier checks the data of a full chunk against the md5 of the chunk, as the data is read.
+// The data of a partial chunk, or of a chunk without md5, is not checked.
+type ChunkViewVerifier struct {
+	chunkView *ChunkView
+	hash      hash.Hash
+}
+
+func NewChunkViewVerifier(chunkView *ChunkView) *ChunkViewVerifier {
+	v := &ChunkViewVerifier{
+		chunkView: chunkView,
+	}
+	if chunkView.IsFullChunk && len(chunkView.Md5) > 0 {
+		v.hash = md5.New()
+	}
+	return v
+}
+
+func (v *ChunkViewVerifier) Write(p []byte) (int, error) {
+	if v.hash != nil {
+		v.hash.Write(p)
+	}
+	return len(p), nil
+}
+
+// Verify should be called after all the data of the chunk view is written
+func (v *ChunkViewVerifier) Verify() error {
+	if v.hash == nil {
+		return nil
+	}
+	if actual := v.hash.Sum(nil); !bytes.Equal(actual, v.chunkView.Md5) {
+		return fmt.Errorf("chunk %s is corrupted: md5 %x, expected %x", v.chunkView.FileId, actual, v.chunkView.Md5)
+	}
+	return nil
+}
+
+// VerifyChunkView checks the data of the chunk view, if it is a full chunk with md5
+func VerifyChunkView(chunkView *ChunkView, data []byte) error {
+	v := NewChunkViewVerifier(chunkView)
+	v.Write(data)
+	return v.Verify()
+}
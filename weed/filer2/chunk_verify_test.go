@@ -0,0 +1,74 @@
+package filer2
+
+import (
+	"crypto/md5"
+	"fmt"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func TestVerifyChunkView(t *testing.T) {
+	data := []byte("0123456789")
+	dataMd5 := md5.Sum(data)
+
+	chunks := []*filer_pb.FileChunk{
+		{FileId: "1,abc", Offset: 0, Size: 10, Mtime: 100, ETag: "crc", Md5: dataMd5[:]},
+	}
+
+	fullViews := ViewFromChunks(chunks, 0, 10)
+	if len(fullViews) != 1 || !fullViews[0].IsFullChunk {
+		t.Fatalf("unexpected views %+v", fullViews)
+	}
+	if err := VerifyChunkView(fullViews[0], data); err != nil {
+		t.Errorf("verify full chunk: %v", err)
+	}
+	if err := VerifyChunkView(fullViews[0], []byte("0123456780")); err == nil {
+		t.Errorf("corrupted chunk is not detected")
+	}
+
+	// a partial chunk can not be verified
+	partialViews := ViewFromChunks(chunks, 2, 5)
+	if len(partialViews) != 1 || partialViews[0].IsFullChunk {
+		t.Fatalf("unexpected views %+v", partialViews)
+	}
+	if err := VerifyChunkView(partialViews[0], data[2:7]); err != nil {
+		t.Errorf("verify partial chunk: %v", err)
+	}
+
+	if etag := ETagChunks(chunks); etag != fmt.Sprintf("%x", dataMd5) {
+		t.Errorf("unexpected chunk etag %s", etag)
+	}
+	entry := &filer_pb.Entry{
+		Chunks:     chunks,
+		Attributes: &filer_pb.FuseAttributes{Md5: []byte{1, 2, 3}},
+	}
+	if etag := ETag(entry); etag != "010203" {
+		t.Errorf("unexpected entry etag %s", etag)
+	}
+}
+
+func TestDropCarriedOverMd5(t *testing.T) {
+	oldEntry := &Entry{
+		Attr:   Attr{Md5: []byte{1, 2, 3}},
+		Chunks: []*filer_pb.FileChunk{{FileId: "1,abc", Size: 10}},
+	}
+
+	sameChunks := &Entry{Attr: Attr{Md5: []byte{1, 2, 3}}, Chunks: []*filer_pb.FileChunk{{FileId: "1,abc", Size: 10}}}
+	DropCarriedOverMd5(oldEntry, sameChunks)
+	if sameChunks.Md5 == nil {
+		t.Errorf("the md5 of the same chunks is dropped")
+	}
+
+	carriedOver := &Entry{Attr: Attr{Md5: []byte{1, 2, 3}}, Chunks: []*filer_pb.FileChunk{{FileId: "2,def", Size: 12}}}
+	DropCarriedOverMd5(oldEntry, carriedOver)
+	if carriedOver.Md5 != nil {
+		t.Errorf("the md5 of the old content is kept")
+	}
+
+	newDigest := &Entry{Attr: Attr{Md5: []byte{4, 5, 6}}, Chunks: []*filer_pb.FileChunk{{FileId: "2,def", Size: 12}}}
+	DropCarriedOverMd5(oldEntry, newDigest)
+	if newDigest.Md5 == nil {
+		t.Errorf("the md5 of the new content is dropped")
+	}
+}
@@ -1,6 +1,7 @@
 package filer2
 
 import (
+	"context"
 	"os"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
@@ -24,6 +25,8 @@ func (f *Filer) LoadConfiguration(config *viper.Viper) {
 			}
 			f.SetStore(store)
 			glog.V(0).Infof("Configure filer for %s", store.GetName())
+			f.loadQuotas(config.Sub("quota"))
+			f.loadStoragePolicies(context.Background())
 			return
 		}
 	}
@@ -49,3 +52,16 @@ func validateOneEnabledStore(config *viper.Viper) {
 		}
 	}
 }
+
+func (f *Filer) loadQuotas(config *viper.Viper) {
+	q, err := loadQuotas(config)
+	if err != nil {
+		glog.Fatalf("Failed to load quotas: %v", err)
+	}
+	if q == nil {
+		return
+	}
+	f.quotas = q
+	glog.V(0).Infof("Configure quotas for %d owners", len(q.limits))
+	go q.keepCounting(f)
+}
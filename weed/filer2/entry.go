@@ -20,6 +20,7 @@ type Attr struct {
 	UserName      string
 	GroupNames    []string
 	SymlinkTarget string
+	Md5           []byte // md5 of the whole file content, if known
 }
 
 func (attr Attr) IsDirectory() bool {
@@ -33,6 +34,11 @@ type Entry struct {
 
 	// the following is for files
 	Chunks []*filer_pb.FileChunk `json:"chunks,omitempty"`
+
+	Extended map[string][]byte `json:"extended,omitempty"`
+
+	// increased by the filer on each change
+	Version uint64 `json:"version,omitempty"`
 }
 
 func (entry *Entry) Size() uint64 {
@@ -47,6 +53,32 @@ func (entry *Entry) Timestamp() time.Time {
 	}
 }
 
+// ExpireAt is when the file expires by its ttl since it was created or its content last changed, or zero if it never expires
+func (entry *Entry) ExpireAt() time.Time {
+	if entry.TtlSec <= 0 || entry.IsDirectory() || entry.Crtime.IsZero() {
+		return time.Time{}
+	}
+	return entry.Crtime.Add(time.Duration(entry.TtlSec) * time.Second)
+}
+
+func (entry *Entry) IsExpired(now time.Time) bool {
+	expireAt := entry.ExpireAt()
+	return !expireAt.IsZero() && !now.Before(expireAt)
+}
+
+// StoreTtl is how long a store with native ttl may keep the entry: the time left before it expires plus the grace,
+// so that the filer purges it and sends the notification first. It is zero if the entry never expires or there is no grace.
+func (entry *Entry) StoreTtl(grace time.Duration) time.Duration {
+	expireAt := entry.ExpireAt()
+	if expireAt.IsZero() || grace <= 0 {
+		return 0
+	}
+	if remaining := time.Until(expireAt); remaining > 0 {
+		return remaining + grace
+	}
+	return grace
+}
+
 func (entry *Entry) ToProtoEntry() *filer_pb.Entry {
 	if entry == nil {
 		return nil
@@ -56,6 +88,8 @@ func (entry *Entry) ToProtoEntry() *filer_pb.Entry {
 		IsDirectory: entry.IsDirectory(),
 		Attributes:  EntryAttributeToPb(entry),
 		Chunks:      entry.Chunks,
+		Extended:    entry.Extended,
+		Version:     entry.Version,
 	}
 }
 
@@ -1,6 +1,7 @@
 package filer2
 
 import (
+	"bytes"
 	"os"
 	"time"
 
@@ -13,6 +14,8 @@ func (entry *Entry) EncodeAttributesAndChunks() ([]byte, error) {
 	message := &filer_pb.Entry{
 		Attributes: EntryAttributeToPb(entry),
 		Chunks:     entry.Chunks,
+		Extended:   entry.Extended,
+		Version:    entry.Version,
 	}
 	return proto.Marshal(message)
 }
@@ -29,6 +32,10 @@ func (entry *Entry) DecodeAttributesAndChunks(blob []byte) error {
 
 	entry.Chunks = message.Chunks
 
+	entry.Extended = message.Extended
+
+	entry.Version = message.Version
+
 	return nil
 }
 
@@ -47,6 +54,7 @@ func EntryAttributeToPb(entry *Entry) *filer_pb.FuseAttributes {
 		UserName:      entry.Attr.UserName,
 		GroupName:     entry.Attr.GroupNames,
 		SymlinkTarget: entry.Attr.SymlinkTarget,
+		Md5:           entry.Attr.Md5,
 	}
 }
 
@@ -66,6 +74,7 @@ func PbToEntryAttribute(attr *filer_pb.FuseAttributes) Attr {
 	t.UserName = attr.UserName
 	t.GroupNames = attr.GroupName
 	t.SymlinkTarget = attr.SymlinkTarget
+	t.Md5 = attr.Md5
 
 	return t
 }
@@ -80,12 +89,27 @@ func EqualEntry(a, b *Entry) bool {
 	if !proto.Equal(EntryAttributeToPb(a), EntryAttributeToPb(b)) {
 		return false
 	}
-	if len(a.Chunks) != len(b.Chunks) {
+	if !EqualChunks(a.Chunks, b.Chunks) {
+		return false
+	}
+	if len(a.Extended) != len(b.Extended) {
 		return false
 	}
+	for k, v := range a.Extended {
+		if bv, found := b.Extended[k]; !found || !bytes.Equal(v, bv) {
+			return false
+		}
+	}
+	return true
+}
 
-	for i := 0; i < len(a.Chunks); i++ {
-		if !proto.Equal(a.Chunks[i], b.Chunks[i]) {
+// EqualChunks tells whether the two chunk lists are the same, in the same order
+func EqualChunks(a, b []*filer_pb.FileChunk) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !proto.Equal(a[i], b[i]) {
 			return false
 		}
 	}
@@ -0,0 +1,25 @@
+package filer2
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStoreTtl(t *testing.T) {
+	now := time.Now()
+	grace := time.Hour
+
+	if ttl := (&Entry{Attr: Attr{Crtime: now}}).StoreTtl(grace); ttl != 0 {
+		t.Errorf("entry without ttl kept for %v", ttl)
+	}
+	if ttl := (&Entry{Attr: Attr{Crtime: now, TtlSec: 60}}).StoreTtl(0); ttl != 0 {
+		t.Errorf("entry kept for %v without grace", ttl)
+	}
+	if ttl := (&Entry{Attr: Attr{Crtime: now, TtlSec: 60}}).StoreTtl(grace); ttl <= grace || ttl > grace+time.Minute {
+		t.Errorf("entry expiring in a minute kept for %v", ttl)
+	}
+	// the filer still gets the grace to purge an entry which is already due
+	if ttl := (&Entry{Attr: Attr{Crtime: now.Add(-time.Hour), TtlSec: 60}}).StoreTtl(grace); ttl != grace {
+		t.Errorf("expired entry kept for %v", ttl)
+	}
+}
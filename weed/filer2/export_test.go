@@ -0,0 +1,13 @@
+package filer2
+
+// SetQuotaLimits sets the quotas in bytes by owner, with the usage not counted yet
+func (f *Filer) SetQuotaLimits(limits map[string]int64) {
+	f.quotas = &quotas{
+		limits: limits,
+		usage:  make(map[string]int64),
+	}
+}
+
+func (f *Filer) CountQuotaUsage() error {
+	return f.quotas.count(f)
+}
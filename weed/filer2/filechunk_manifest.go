@@ -0,0 +1,184 @@
+package filer2
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/golang/protobuf/proto"
+)
+
+// ManifestBatch is the number of chunks folded into one manifest chunk
+const ManifestBatch = 1000
+
+type LookupFileIdFunctionType func(fileId string) (targetUrl string, err error)
+
+// SaveDataAsChunkFunctionType saves the data on a volume server, and returns the chunk with the file id set
+type SaveDataAsChunkFunctionType func(data []byte) (chunk *filer_pb.FileChunk, err error)
+
+func HasChunkManifest(chunks []*filer_pb.FileChunk) bool {
+	for _, chunk := range chunks {
+		if chunk.IsChunkManifest {
+			return true
+		}
+	}
+	return false
+}
+
+func SeparateManifestChunks(chunks []*filer_pb.FileChunk) (manifestChunks, nonManifestChunks []*filer_pb.FileChunk) {
+	for _, c := range chunks {
+		if c.IsChunkManifest {
+			manifestChunks = append(manifestChunks, c)
+		} else {
+			nonManifestChunks = append(nonManifestChunks, c)
+		}
+	}
+	return
+}
+
+// ResolveChunkManifest replaces the manifest chunks with the chunks stored in them, recursively.
+// Only the manifest chunks overlapping with [startOffset, stopOffset) are fetched, and the others are skipped,
+// since all the chunks in a manifest chunk are within its range.
+// The resolved manifest chunks are also returned.
+func ResolveChunkManifest(lookupFileIdFn LookupFileIdFunctionType, chunks []*filer_pb.FileChunk, startOffset, stopOffset int64) (dataChunks, manifestChunks []*filer_pb.FileChunk, err error) {
+
+	for _, chunk := range chunks {
+
+		if !chunk.IsChunkManifest {
+			dataChunks = append(dataChunks, chunk)
+			continue
+		}
+
+		if chunk.Offset+int64(chunk.Size) <= startOffset || stopOffset <= chunk.Offset {
+			continue
+		}
+
+		resolvedChunks, fetchErr := fetchChunkManifest(lookupFileIdFn, chunk)
+		if fetchErr != nil {
+			return nil, nil, fetchErr
+		}
+
+		manifestChunks = append(manifestChunks, chunk)
+
+		subDataChunks, subManifestChunks, subErr := ResolveChunkManifest(lookupFileIdFn, resolvedChunks, startOffset, stopOffset)
+		if subErr != nil {
+			return nil, nil, subErr
+		}
+		dataChunks = append(dataChunks, subDataChunks...)
+		manifestChunks = append(manifestChunks, subManifestChunks...)
+	}
+
+	return
+}
+
+// ResolveAllChunks lists all the chunks referenced by the chunk list, including the manifest chunks themselves.
+func ResolveAllChunks(lookupFileIdFn LookupFileIdFunctionType, chunks []*filer_pb.FileChunk) ([]*filer_pb.FileChunk, error) {
+	if !HasChunkManifest(chunks) {
+		return chunks, nil
+	}
+	dataChunks, manifestChunks, err := ResolveChunkManifest(lookupFileIdFn, chunks, 0, math.MaxInt64)
+	if err != nil {
+		return nil, err
+	}
+	return append(dataChunks, manifestChunks...), nil
+}
+
+func fetchChunkManifest(lookupFileIdFn LookupFileIdFunctionType, chunk *filer_pb.FileChunk) ([]*filer_pb.FileChunk, error) {
+
+	fileId := chunk.GetFileIdString()
+	urlString, err := lookupFileIdFn(fileId)
+	if err != nil {
+		return nil, fmt.Errorf("lookup manifest chunk %s: %v", fileId, err)
+	}
+
+	data, err := util.Get(urlString)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest chunk %s: %v", fileId, err)
+	}
+
+	manifest := &filer_pb.FileChunkManifest{}
+	if err := proto.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest chunk %s: %v", fileId, err)
+	}
+	filer_pb.AfterEntryDeserialization(manifest.Chunks)
+
+	return manifest.Chunks, nil
+}
+
+// MaybeManifestize folds the data chunks into manifest chunks of ManifestBatch chunks each,
+// if there are at least ManifestBatch data chunks. The existing manifest chunks are kept as is.
+func MaybeManifestize(saveFunc SaveDataAsChunkFunctionType, inputChunks []*filer_pb.FileChunk) (chunks []*filer_pb.FileChunk, err error) {
+	return doMaybeManifestize(saveFunc, inputChunks, ManifestBatch)
+}
+
+func doMaybeManifestize(saveFunc SaveDataAsChunkFunctionType, inputChunks []*filer_pb.FileChunk, batch int) (chunks []*filer_pb.FileChunk, err error) {
+
+	manifestChunks, dataChunks := SeparateManifestChunks(inputChunks)
+	if len(dataChunks) < batch {
+		return inputChunks, nil
+	}
+
+	// fold the chunks in the order of offsets, so that the manifest chunks do not overlap much,
+	// and reading a range only needs to fetch a few of them
+	sort.Slice(dataChunks, func(i, j int) bool {
+		if dataChunks[i].Offset == dataChunks[j].Offset {
+			return dataChunks[i].Mtime < dataChunks[j].Mtime
+		}
+		return dataChunks[i].Offset < dataChunks[j].Offset
+	})
+
+	chunks = manifestChunks
+	for len(dataChunks) >= batch {
+		manifestChunk, saveErr := saveChunkManifest(saveFunc, dataChunks[:batch])
+		if saveErr != nil {
+			return nil, saveErr
+		}
+		chunks = append(chunks, manifestChunk)
+		dataChunks = dataChunks[batch:]
+	}
+	chunks = append(chunks, dataChunks...)
+
+	return chunks, nil
+}
+
+func saveChunkManifest(saveFunc SaveDataAsChunkFunctionType, dataChunks []*filer_pb.FileChunk) (*filer_pb.FileChunk, error) {
+
+	var minOffset, maxStop, maxMtime int64 = math.MaxInt64, 0, 0
+	for _, chunk := range dataChunks {
+		minOffset = min(minOffset, chunk.Offset)
+		maxStop = max(maxStop, chunk.Offset+int64(chunk.Size))
+		maxMtime = max(maxMtime, chunk.Mtime)
+	}
+
+	// serialize copies, so that the chunks stay usable by the caller
+	manifest := &filer_pb.FileChunkManifest{}
+	for _, chunk := range dataChunks {
+		manifest.Chunks = append(manifest.Chunks, proto.Clone(chunk).(*filer_pb.FileChunk))
+	}
+	filer_pb.BeforeEntrySerialization(manifest.Chunks)
+
+	data, err := proto.Marshal(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("marshal manifest: %v", err)
+	}
+
+	manifestChunk, err := saveFunc(data)
+	if err != nil {
+		return nil, fmt.Errorf("save manifest: %v", err)
+	}
+	manifestChunk.Offset = minOffset
+	manifestChunk.Size = uint64(maxStop - minOffset)
+	manifestChunk.Mtime = maxMtime
+	manifestChunk.IsChunkManifest = true
+
+	return manifestChunk, nil
+}
+
+func max(x, y int64) int64 {
+	if x > y {
+		return x
+	}
+	return y
+}
@@ -0,0 +1,111 @@
+package filer2
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func TestChunkManifest(t *testing.T) {
+
+	var lock sync.Mutex
+	blobs := make(map[string][]byte)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		data, found := blobs[strings.TrimPrefix(r.URL.Path, "/")]
+		lock.Unlock()
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(data)
+	}))
+	defer server.Close()
+
+	saveFunc := func(data []byte) (*filer_pb.FileChunk, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		fileId := fmt.Sprintf("9,%x", len(blobs)+1)
+		blobs[fileId] = data
+		return &filer_pb.FileChunk{FileId: fileId}, nil
+	}
+	var lookupCount int
+	lookupFn := func(fileId string) (string, error) {
+		lookupCount++
+		return server.URL + "/" + fileId, nil
+	}
+
+	var inputChunks []*filer_pb.FileChunk
+	for i := 0; i < 7; i++ {
+		inputChunks = append(inputChunks, &filer_pb.FileChunk{
+			FileId: fmt.Sprintf("1,%x", i+1),
+			Offset: int64(i * 100),
+			Size:   100,
+			Mtime:  int64(i),
+		})
+	}
+
+	// 7 chunks in batches of 3: 2 manifest chunks, and 1 chunk left as is
+	chunks, err := doMaybeManifestize(saveFunc, inputChunks, 3)
+	if err != nil {
+		t.Fatalf("manifestize: %v", err)
+	}
+	manifestChunks, dataChunks := SeparateManifestChunks(chunks)
+	if len(manifestChunks) != 2 || len(dataChunks) != 1 {
+		t.Fatalf("unexpected chunks: %d manifest chunks, %d data chunks", len(manifestChunks), len(dataChunks))
+	}
+	if manifestChunks[0].Offset != 0 || manifestChunks[0].Size != 300 || manifestChunks[1].Offset != 300 || manifestChunks[1].Size != 300 {
+		t.Errorf("unexpected manifest chunk ranges: %v", manifestChunks)
+	}
+	if TotalSize(chunks) != 700 {
+		t.Errorf("unexpected total size %d", TotalSize(chunks))
+	}
+
+	// the existing manifest chunks are kept
+	if again, _ := doMaybeManifestize(saveFunc, chunks, 3); len(again) != len(chunks) {
+		t.Errorf("manifest chunks are folded again: %v", again)
+	}
+
+	resolved, resolvedManifestChunks, err := ResolveChunkManifest(lookupFn, chunks, 0, math.MaxInt64)
+	if err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+	if len(resolvedManifestChunks) != 2 {
+		t.Errorf("unexpected resolved manifest chunks %v", resolvedManifestChunks)
+	}
+	sort.Slice(resolved, func(i, j int) bool {
+		return resolved[i].Offset < resolved[j].Offset
+	})
+	if len(resolved) != len(inputChunks) {
+		t.Fatalf("resolved %d chunks, expected %d", len(resolved), len(inputChunks))
+	}
+	for i, chunk := range resolved {
+		if chunk.GetFileIdString() != inputChunks[i].GetFileIdString() || chunk.Offset != inputChunks[i].Offset || chunk.Mtime != inputChunks[i].Mtime {
+			t.Errorf("resolved chunk %d: %v, expected %v", i, chunk, inputChunks[i])
+		}
+	}
+
+	// only the manifest chunks overlapping with the range are fetched
+	lookupCount = 0
+	resolved, _, err = ResolveChunkManifest(lookupFn, chunks, 350, 450)
+	if err != nil {
+		t.Fatalf("resolve range: %v", err)
+	}
+	if lookupCount != 1 || len(resolved) != 4 {
+		t.Errorf("resolve range: %d lookups, %d chunks", lookupCount, len(resolved))
+	}
+
+	// the manifest chunks are not compacted away
+	compacted, garbage := CompactFileChunks(chunks)
+	if len(compacted) != len(chunks) || len(garbage) != 0 {
+		t.Errorf("unexpected compaction: %v, garbage %v", compacted, garbage)
+	}
+
+}
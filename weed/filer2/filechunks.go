@@ -1,6 +1,7 @@
 package filer2
 
 import (
+	"bytes"
 	"fmt"
 	"hash/fnv"
 	"sort"
@@ -19,20 +20,54 @@ func TotalSize(chunks []*filer_pb.FileChunk) (size uint64) {
 	return
 }
 
-func ETag(chunks []*filer_pb.FileChunk) (etag string) {
+// ETag is the hex md5 of the file content if known, the same as the ETag of S3 objects not uploaded in parts.
+// Otherwise, it is derived from the etags of the chunks.
+func ETag(entry *filer_pb.Entry) (etag string) {
+	if entry.Attributes != nil && len(entry.Attributes.Md5) > 0 {
+		return fmt.Sprintf("%x", entry.Attributes.Md5)
+	}
+	return ETagChunks(entry.Chunks)
+}
+
+func ETagEntry(entry *Entry) (etag string) {
+	if len(entry.Attr.Md5) > 0 {
+		return fmt.Sprintf("%x", entry.Attr.Md5)
+	}
+	return ETagChunks(entry.Chunks)
+}
+
+// DropCarriedOverMd5 drops the md5 of the whole file if it is the one of the old entry, but the chunks are changed.
+// The clients updating the chunks, e.g., weed mount, send back the attributes of the old content.
+func DropCarriedOverMd5(oldEntry, entry *Entry) {
+	if oldEntry != nil && len(entry.Md5) > 0 && bytes.Equal(entry.Md5, oldEntry.Md5) && !EqualChunks(entry.Chunks, oldEntry.Chunks) {
+		entry.Md5 = nil
+	}
+}
+
+func ETagChunks(chunks []*filer_pb.FileChunk) (etag string) {
 	if len(chunks) == 1 {
-		return chunks[0].ETag
+		return etagChunk(chunks[0])
 	}
 
 	h := fnv.New32a()
 	for _, c := range chunks {
-		h.Write([]byte(c.ETag))
+		h.Write([]byte(etagChunk(c)))
 	}
 	return fmt.Sprintf("%x", h.Sum32())
 }
 
+func etagChunk(chunk *filer_pb.FileChunk) string {
+	if len(chunk.Md5) > 0 {
+		return fmt.Sprintf("%x", chunk.Md5)
+	}
+	return chunk.ETag
+}
+
 func CompactFileChunks(chunks []*filer_pb.FileChunk) (compacted, garbage []*filer_pb.FileChunk) {
 
+	// the manifest chunks are kept as is, and only the other chunks are compacted
+	compacted, chunks = SeparateManifestChunks(chunks)
+
 	visibles := NonOverlappingVisibleIntervals(chunks)
 
 	fileIds := make(map[string]bool)
@@ -71,6 +106,7 @@ type ChunkView struct {
 	Size        uint64
 	LogicOffset int64
 	IsFullChunk bool
+	Md5         []byte // the md5 of the chunk content, set if IsFullChunk and known
 }
 
 func ViewFromChunks(chunks []*filer_pb.FileChunk, offset int64, size int) (views []*ChunkView) {
@@ -88,13 +124,17 @@ func ViewFromVisibleIntervals(visibles []VisibleInterval, offset int64, size int
 	for _, chunk := range visibles {
 		if chunk.start <= offset && offset < chunk.stop && offset < stop {
 			isFullChunk := chunk.isFullChunk && chunk.start == offset && chunk.stop <= stop
-			views = append(views, &ChunkView{
+			view := &ChunkView{
 				FileId:      chunk.fileId,
 				Offset:      offset - chunk.start, // offset is the data starting location in this file id
 				Size:        uint64(min(chunk.stop, stop) - offset),
 				LogicOffset: offset,
 				IsFullChunk: isFullChunk,
-			})
+			}
+			if isFullChunk {
+				view.Md5 = chunk.md5
+			}
+			views = append(views, view)
 			offset = min(chunk.stop, stop)
 		}
 	}
@@ -127,6 +167,7 @@ func MergeIntoVisibles(visibles, newVisibles []VisibleInterval, chunk *filer_pb.
 		chunk.Mtime,
 		true,
 	)
+	newV.md5 = chunk.Md5
 
 	length := len(visibles)
 	if length == 0 {
@@ -208,6 +249,7 @@ type VisibleInterval struct {
 	modifiedTime int64
 	fileId       string
 	isFullChunk  bool
+	md5          []byte
 }
 
 func newVisibleInterval(start, stop int64, fileId string, modifiedTime int64, isFullChunk bool) VisibleInterval {
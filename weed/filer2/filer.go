@@ -1,16 +1,21 @@
 package filer2
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"google.golang.org/grpc"
 	"math"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 	"github.com/chrislusf/seaweedfs/weed/wdclient"
 	"github.com/karlseguin/ccache"
 )
@@ -26,6 +31,11 @@ type Filer struct {
 	MasterClient       *wdclient.MasterClient
 	fileIdDeletionChan chan string
 	GrpcDialOption     grpc.DialOption
+	entryLocks         entryLocks
+	metaEvents         *metaEventLog
+	quotas             *quotas
+	storagePolicies    storagePolicyHolder
+	expiringEntries    sync.Map
 }
 
 func NewFiler(masters []string, grpcDialOption grpc.DialOption) *Filer {
@@ -34,6 +44,7 @@ func NewFiler(masters []string, grpcDialOption grpc.DialOption) *Filer {
 		MasterClient:       wdclient.NewMasterClient(context.Background(), grpcDialOption, "filer", masters),
 		fileIdDeletionChan: make(chan string, 4096),
 		GrpcDialOption:     grpcDialOption,
+		metaEvents:         newMetaEventLog(MetaEventsKept),
 	}
 
 	go f.loopProcessingDeletion()
@@ -45,6 +56,15 @@ func (f *Filer) SetStore(store FilerStore) {
 	f.store = NewFilerStoreWrapper(store)
 }
 
+// ReplicateStore routes all mutations of the current store through a raft group of filers.
+// Each applied mutation also invalidates the local directory cache.
+func (f *Filer) ReplicateStore() *ReplicatedFilerStore {
+	store := NewReplicatedFilerStore(f.store.actualStore, f.GrpcDialOption)
+	store.onChange = f.cacheDelDirectory
+	f.SetStore(store)
+	return store
+}
+
 func (f *Filer) DisableDirectoryCache() {
 	f.directoryCache = nil
 }
@@ -70,6 +90,11 @@ func (f *Filer) RollbackTransaction(ctx context.Context) error {
 }
 
 func (f *Filer) CreateEntry(ctx context.Context, entry *Entry) error {
+	return f.CreateEntryWithPrecondition(ctx, entry, nil)
+}
+
+// CreateEntryWithPrecondition creates or overwrites the entry, if the existing entry meets the precondition.
+func (f *Filer) CreateEntryWithPrecondition(ctx context.Context, entry *Entry, precondition *filer_pb.EntryPrecondition) error {
 
 	if string(entry.FullPath) == "/" {
 		return nil
@@ -111,6 +136,7 @@ func (f *Filer) CreateEntry(ctx context.Context, entry *Entry) error {
 					Uid:    entry.Uid,
 					Gid:    entry.Gid,
 				},
+				Version: 1,
 			}
 
 			glog.V(2).Infof("create directory: %s %v", dirPath, dirEntry.Mode)
@@ -149,23 +175,41 @@ func (f *Filer) CreateEntry(ctx context.Context, entry *Entry) error {
 		}
 	*/
 
-	oldEntry, _ := f.FindEntry(ctx, entry.FullPath)
+	unlock := f.LockEntry(entry.FullPath)
+	defer unlock()
+
+	oldEntry, _ := f.FindEntryLocked(ctx, entry.FullPath)
+
+	if err := CheckPrecondition(entry.FullPath, precondition, oldEntry); err != nil {
+		return err
+	}
+
+	f.applyStoragePolicy(entry)
 
 	if oldEntry == nil {
+		entry.Version = 1
+		f.maybeManifestize(entry)
+		if err := f.quotas.change(nil, entry, isQuotaEnforced(ctx)); err != nil {
+			return err
+		}
 		if err := f.store.InsertEntry(ctx, entry); err != nil {
+			f.quotas.change(entry, nil, false)
 			glog.Errorf("insert entry %s: %v", entry.FullPath, err)
 			return fmt.Errorf("insert entry %s: %v", entry.FullPath, err)
 		}
 	} else {
 		if err := f.UpdateEntry(ctx, oldEntry, entry); err != nil {
 			glog.Errorf("update entry %s: %v", entry.FullPath, err)
+			if _, ok := err.(*QuotaExceededError); ok {
+				return err
+			}
 			return fmt.Errorf("update entry %s: %v", entry.FullPath, err)
 		}
 	}
 
 	f.NotifyUpdateEvent(oldEntry, entry, true)
 
-	f.deleteChunksIfNotNew(oldEntry, entry)
+	f.DeleteChunksIfNotNew(oldEntry, entry)
 
 	return nil
 }
@@ -180,15 +224,103 @@ func (f *Filer) UpdateEntry(ctx context.Context, oldEntry, entry *Entry) (err er
 			glog.Errorf("existing %s is a file", entry.FullPath)
 			return fmt.Errorf("existing %s is a file", entry.FullPath)
 		}
+		entry.Version = oldEntry.Version + 1
+		// the ttl counts from the last change of the content, so an overwrite does not inherit an old expiry
+		if entry.TtlSec > 0 && !entry.IsDirectory() && (entry.TtlSec != oldEntry.TtlSec || !EqualChunks(entry.Chunks, oldEntry.Chunks)) {
+			entry.Crtime = time.Now()
+		}
+	}
+	f.maybeManifestize(entry)
+	if err = f.quotas.change(oldEntry, entry, isQuotaEnforced(ctx)); err != nil {
+		return err
+	}
+	if err = f.store.UpdateEntry(ctx, entry); err != nil {
+		f.quotas.change(entry, oldEntry, false)
+	}
+	return err
+}
+
+// maybeManifestize folds a long chunk list into manifest chunks, to keep the entry small
+func (f *Filer) maybeManifestize(entry *Entry) {
+	if entry.IsDirectory() || len(entry.Chunks) < ManifestBatch {
+		return
+	}
+	chunks, err := MaybeManifestize(f.saveAsChunkFn(entry), entry.Chunks)
+	if err != nil {
+		glog.Warningf("fold %d chunks of %s into manifest chunks: %v", len(entry.Chunks), entry.FullPath, err)
+		return
+	}
+	entry.Chunks = chunks
+}
+
+// saveAsChunkFn saves the data with the same replication, collection and ttl as the entry
+func (f *Filer) saveAsChunkFn(entry *Entry) SaveDataAsChunkFunctionType {
+	return func(data []byte) (*filer_pb.FileChunk, error) {
+
+		ttl := ""
+		if entry.TtlSec > 0 {
+			ttl = strconv.Itoa(int(entry.TtlSec))
+		}
+		assignResult, err := operation.Assign(f.GetMaster(), f.GrpcDialOption, &operation.VolumeAssignRequest{
+			Count:       1,
+			Replication: entry.Replication,
+			Collection:  entry.Collection,
+			Ttl:         ttl,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("assign volume: %v", err)
+		}
+		if assignResult.Error != "" {
+			return nil, fmt.Errorf("assign volume: %v", assignResult.Error)
+		}
+
+		fileUrl := fmt.Sprintf("http://%s/%s", assignResult.Url, assignResult.Fid)
+		uploadResult, err := operation.Upload(fileUrl, "", bytes.NewReader(data), "", "application/octet-stream", nil, assignResult.Auth)
+		if err != nil {
+			return nil, fmt.Errorf("upload to %s: %v", fileUrl, err)
+		}
+		if uploadResult.Error != "" {
+			return nil, fmt.Errorf("upload to %s: %v", fileUrl, uploadResult.Error)
+		}
+
+		return &filer_pb.FileChunk{
+			FileId: assignResult.Fid,
+			Size:   uint64(len(data)),
+			Mtime:  time.Now().UnixNano(),
+			ETag:   uploadResult.ETag,
+			Md5:    uploadResult.ContentMd5,
+		}, nil
 	}
-	return f.store.UpdateEntry(ctx, entry)
 }
 
+func (f *Filer) lookupFileId(fileId string) (string, error) {
+	return f.MasterClient.LookupFileId(fileId)
+}
+
+// FindEntry hides an expired file, which is purged in the background
 func (f *Filer) FindEntry(ctx context.Context, p FullPath) (entry *Entry, err error) {
+	entry, err = f.findEntry(ctx, p)
+	if err == nil && entry.IsExpired(time.Now()) {
+		go f.expireEntry(entry)
+		return nil, ErrNotFound
+	}
+	return entry, err
+}
 
-	now := time.Now()
+// FindEntryLocked is FindEntry for the callers holding LockEntry(p), and purges an expired file right away
+func (f *Filer) FindEntryLocked(ctx context.Context, p FullPath) (entry *Entry, err error) {
+	entry, err = f.findEntry(ctx, p)
+	if err == nil && entry.IsExpired(time.Now()) {
+		f.purgeExpiredEntry(ctx, entry)
+		return nil, ErrNotFound
+	}
+	return entry, err
+}
+
+func (f *Filer) findEntry(ctx context.Context, p FullPath) (entry *Entry, err error) {
 
 	if string(p) == "/" {
+		now := time.Now()
 		return &Entry{
 			FullPath: p,
 			Attr: Attr{
@@ -203,6 +335,43 @@ func (f *Filer) FindEntry(ctx context.Context, p FullPath) (entry *Entry, err er
 	return f.store.FindEntry(ctx, p)
 }
 
+// expireEntry purges an expired file, which is hidden as soon as it expires,
+// unless the file is changed or purged since it is read
+func (f *Filer) expireEntry(entry *Entry) {
+	if _, expiring := f.expiringEntries.LoadOrStore(entry.FullPath, true); expiring {
+		return
+	}
+	defer f.expiringEntries.Delete(entry.FullPath)
+
+	unlock := f.LockEntry(entry.FullPath)
+	defer unlock()
+
+	ctx := context.Background()
+	existing, err := f.store.FindEntry(ctx, entry.FullPath)
+	if err != nil {
+		if err != ErrNotFound {
+			glog.Errorf("expire %s: %v", entry.FullPath, err)
+		}
+		return
+	}
+	if existing.Version != entry.Version || !existing.IsExpired(time.Now()) {
+		return
+	}
+	f.purgeExpiredEntry(ctx, existing)
+}
+
+// purgeExpiredEntry deletes the expired file, with the caller holding LockEntry for it
+func (f *Filer) purgeExpiredEntry(ctx context.Context, entry *Entry) {
+	glog.V(3).Infof("expire %s: ttl %ds since %v", entry.FullPath, entry.TtlSec, entry.Crtime)
+	if err := f.store.DeleteEntry(ctx, entry.FullPath); err != nil {
+		glog.Errorf("expire %s: %v", entry.FullPath, err)
+		return
+	}
+	f.quotas.change(entry, nil, false)
+	f.NotifyUpdateEvent(entry, nil, true)
+	f.DeleteChunks(entry.FullPath, entry.Chunks)
+}
+
 func (f *Filer) DeleteEntryMetaAndData(ctx context.Context, p FullPath, isRecursive bool, ignoreRecursiveError, shouldDeleteChunks bool) (err error) {
 	entry, err := f.FindEntry(ctx, p)
 	if err != nil {
@@ -261,14 +430,85 @@ func (f *Filer) DeleteEntryMetaAndData(ctx context.Context, p FullPath, isRecurs
 
 	f.NotifyUpdateEvent(entry, nil, shouldDeleteChunks)
 
-	return f.store.DeleteEntry(ctx, p)
+	if err = f.store.DeleteEntry(ctx, p); err != nil {
+		return err
+	}
+	f.quotas.change(entry, nil, false)
+	return nil
 }
 
-func (f *Filer) ListDirectoryEntries(ctx context.Context, p FullPath, startFileName string, inclusive bool, limit int) ([]*Entry, error) {
+func (f *Filer) ListDirectoryEntries(ctx context.Context, p FullPath, startFileName string, inclusive bool, limit int) (entries []*Entry, err error) {
 	if strings.HasSuffix(string(p), "/") && len(p) > 1 {
 		p = p[0 : len(p)-1]
 	}
-	return f.store.ListDirectoryEntries(ctx, p, startFileName, inclusive, limit)
+
+	// the expired files are skipped, and more entries are listed in their place
+	now := time.Now()
+	for len(entries) < limit {
+		requested := limit - len(entries)
+		listed, listErr := f.store.ListDirectoryEntries(ctx, p, startFileName, inclusive, requested)
+		if listErr != nil {
+			return nil, listErr
+		}
+		expired := 0
+		for _, entry := range listed {
+			if entry.IsExpired(now) {
+				go f.expireEntry(entry)
+				expired++
+				continue
+			}
+			entries = append(entries, entry)
+		}
+		if expired == 0 || len(listed) < requested {
+			break
+		}
+		startFileName, inclusive = listed[len(listed)-1].Name(), false
+	}
+
+	return entries, nil
+}
+
+// TraverseSnapshot visits all entries under the directory from a consistent point-in-time view,
+// if the filer store supports it.
+func (f *Filer) TraverseSnapshot(ctx context.Context, p FullPath, eachEntryFunc func(entry *Entry) error) error {
+	if strings.HasSuffix(string(p), "/") && len(p) > 1 {
+		p = p[0 : len(p)-1]
+	}
+	return f.store.TraverseSnapshot(ctx, p, eachEntryFunc)
+}
+
+// traverseEntries visits all entries under the directory, from a snapshot if the filer store supports it,
+// or else by listing the directories page by page, which misses or repeats the entries moved meanwhile
+func (f *Filer) traverseEntries(ctx context.Context, p FullPath, eachEntryFunc func(entry *Entry) error) error {
+	err := f.TraverseSnapshot(ctx, p, eachEntryFunc)
+	if err != ErrSnapshotNotSupported {
+		return err
+	}
+	return f.listEntriesRecursively(ctx, p, eachEntryFunc)
+}
+
+func (f *Filer) listEntriesRecursively(ctx context.Context, dirPath FullPath, eachEntryFunc func(entry *Entry) error) error {
+	lastFileName := ""
+	for {
+		entries, err := f.store.ListDirectoryEntries(ctx, dirPath, lastFileName, false, 1024)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if err = eachEntryFunc(entry); err != nil {
+				return err
+			}
+			if entry.IsDirectory() {
+				if err = f.listEntriesRecursively(ctx, entry.FullPath, eachEntryFunc); err != nil {
+					return err
+				}
+			}
+			lastFileName = entry.Name()
+		}
+		if len(entries) < 1024 {
+			return nil
+		}
+	}
 }
 
 func (f *Filer) cacheDelDirectory(dirpath string) {
@@ -7,7 +7,9 @@ import (
 	"sync"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
 	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
@@ -50,7 +52,86 @@ func ReadIntoBuffer(ctx context.Context, filerClient FilerClient, fullFilePath s
 		return 0, fmt.Errorf("failed to lookup volume ids %v: %v", vids, err)
 	}
 
+	// read the chunk views on the same volume server in one batch
+	serverChunkViews := make(map[string][]*ChunkView)
+	for _, chunkView := range chunkViews {
+		locations := vid2Locations[VolumeId(chunkView.FileId)]
+		if locations == nil || len(locations.Locations) == 0 {
+			glog.V(0).Infof("failed to locate %s", chunkView.FileId)
+			return 0, fmt.Errorf("failed to locate %s", chunkView.FileId)
+		}
+		serverUrl := locations.Locations[0].Url
+		serverChunkViews[serverUrl] = append(serverChunkViews[serverUrl], chunkView)
+	}
+
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	for serverUrl, views := range serverChunkViews {
+		wg.Add(1)
+		go func(serverUrl string, views []*ChunkView) {
+			defer wg.Done()
+			n, readErr := readChunkViews(fullFilePath, serverUrl, views, buff, baseOffset)
+			lock.Lock()
+			totalRead += n
+			if readErr != nil {
+				err = readErr
+			}
+			lock.Unlock()
+		}(serverUrl, views)
+	}
+	wg.Wait()
+	return
+}
+
+// readChunkViews reads the chunk views from the volume server in one gRPC call,
+// or falls back to one http request per chunk view.
+func readChunkViews(fullFilePath string, serverUrl string, chunkViews []*ChunkView, buff []byte, baseOffset int64) (totalRead int64, err error) {
+
+	var requests []*volume_server_pb.ReadNeedleRequest
+	for _, chunkView := range chunkViews {
+		requests = append(requests, &volume_server_pb.ReadNeedleRequest{
+			FileId: chunkView.FileId,
+			Offset: chunkView.Offset,
+			Size:   int64(chunkView.Size),
+		})
+	}
+
+	received := make([]int64, len(chunkViews))
+	errs, err := operation.ReadNeedles(serverUrl, requests, func(i int, resp *volume_server_pb.ReadNeedleResponse) {
+		chunkView := chunkViews[i]
+		start := chunkView.LogicOffset - baseOffset + received[i]
+		stop := chunkView.LogicOffset - baseOffset + int64(chunkView.Size)
+		received[i] += int64(copy(buff[start:stop], resp.Data))
+	})
+	if err == operation.ErrGrpcDataPlaneUnavailable {
+		return readChunkViewsViaHttp(fullFilePath, serverUrl, chunkViews, buff, baseOffset)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read from %s: %v", serverUrl, err)
+	}
+
+	for i, chunkView := range chunkViews {
+		if errs[i] != nil {
+			glog.V(0).Infof("%v read %s/%v %v bytes: %v", fullFilePath, serverUrl, chunkView.FileId, received[i], errs[i])
+			err = fmt.Errorf("failed to read %s/%s: %v", serverUrl, chunkView.FileId, errs[i])
+			continue
+		}
+		start := chunkView.LogicOffset - baseOffset
+		if verifyErr := VerifyChunkView(chunkView, buff[start:start+received[i]]); verifyErr != nil {
+			glog.Errorf("%v read %s/%v: %v", fullFilePath, serverUrl, chunkView.FileId, verifyErr)
+			err = verifyErr
+			continue
+		}
+		glog.V(4).Infof("read fh read %d bytes: %+v", received[i], chunkView)
+		totalRead += received[i]
+	}
+	return
+}
+
+func readChunkViewsViaHttp(fullFilePath string, serverUrl string, chunkViews []*ChunkView, buff []byte, baseOffset int64) (totalRead int64, err error) {
+
 	var wg sync.WaitGroup
+	var lock sync.Mutex
 	for _, chunkView := range chunkViews {
 		wg.Add(1)
 		go func(chunkView *ChunkView) {
@@ -58,27 +139,26 @@ func ReadIntoBuffer(ctx context.Context, filerClient FilerClient, fullFilePath s
 
 			glog.V(4).Infof("read fh reading chunk: %+v", chunkView)
 
-			locations := vid2Locations[VolumeId(chunkView.FileId)]
-			if locations == nil || len(locations.Locations) == 0 {
-				glog.V(0).Infof("failed to locate %s", chunkView.FileId)
-				err = fmt.Errorf("failed to locate %s", chunkView.FileId)
-				return
-			}
-
-			var n int64
-			n, err = util.ReadUrl(
-				fmt.Sprintf("http://%s/%s", locations.Locations[0].Url, chunkView.FileId),
+			data := buff[chunkView.LogicOffset-baseOffset : chunkView.LogicOffset-baseOffset+int64(chunkView.Size)]
+			n, readErr := util.ReadUrl(
+				fmt.Sprintf("http://%s/%s", serverUrl, chunkView.FileId),
 				chunkView.Offset,
 				int(chunkView.Size),
-				buff[chunkView.LogicOffset-baseOffset:chunkView.LogicOffset-baseOffset+int64(chunkView.Size)],
+				data,
 				!chunkView.IsFullChunk)
+			if readErr == nil {
+				readErr = VerifyChunkView(chunkView, data[:n])
+			}
 
-			if err != nil {
+			lock.Lock()
+			defer lock.Unlock()
+
+			if readErr != nil {
 
-				glog.V(0).Infof("%v read http://%s/%v %v bytes: %v", fullFilePath, locations.Locations[0].Url, chunkView.FileId, n, err)
+				glog.V(0).Infof("%v read http://%s/%v %v bytes: %v", fullFilePath, serverUrl, chunkView.FileId, n, readErr)
 
 				err = fmt.Errorf("failed to read http://%s/%s: %v",
-					locations.Locations[0].Url, chunkView.FileId, err)
+					serverUrl, chunkView.FileId, readErr)
 				return
 			}
 
@@ -91,6 +171,34 @@ func ReadIntoBuffer(ctx context.Context, filerClient FilerClient, fullFilePath s
 	return
 }
 
+// LookupFn looks up the volume locations through the filer, to read the file ids
+func LookupFn(ctx context.Context, filerClient FilerClient) LookupFileIdFunctionType {
+	return func(fileId string) (targetUrl string, err error) {
+
+		vid := VolumeId(fileId)
+
+		err = filerClient.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+
+			resp, err := client.LookupVolume(ctx, &filer_pb.LookupVolumeRequest{
+				VolumeIds: []string{vid},
+			})
+			if err != nil {
+				return err
+			}
+
+			locations := resp.LocationsMap[vid]
+			if locations == nil || len(locations.Locations) == 0 {
+				return fmt.Errorf("failed to locate %s", fileId)
+			}
+			targetUrl = fmt.Sprintf("http://%s/%s", locations.Locations[0].Url, fileId)
+
+			return nil
+		})
+
+		return
+	}
+}
+
 func GetEntry(ctx context.Context, filerClient FilerClient, fullFilePath string) (entry *filer_pb.Entry, err error) {
 
 	dir, name := FullPath(fullFilePath).DirAndName()
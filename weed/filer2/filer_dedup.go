@@ -0,0 +1,260 @@
+package filer2
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	// the dedup index keeps one entry per chunk content, under a sub directory of the first 2 hex digits of the hash
+	DedupDirectory = "/etc/seaweedfs/dedup"
+	// the shared chunk, its placement and reference count are saved in the extended attributes of the index entry,
+	// instead of its chunks, so that deleting the index entry never deletes the chunk data
+	dedupChunkExtendedKey       = "chunk"
+	dedupRefCountExtendedKey    = "refcount"
+	dedupCollectionExtendedKey  = "collection"
+	dedupReplicationExtendedKey = "replication"
+	dedupTtlExtendedKey         = "ttl"
+	dedupDurabilityExtendedKey  = "durability"
+	// the index entries are updated with a version precondition, and retried if changed by another filer in the meantime
+	dedupIndexRetries = 8
+)
+
+// ContentHash is the key of the chunk content in the dedup index
+func ContentHash(data []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+func dedupIndexPath(contentHash string) FullPath {
+	return NewFullPath(DedupDirectory+"/"+contentHash[:2], contentHash)
+}
+
+// DedupPlacement is how the chunk is stored.
+// A chunk is shared only by the uploads of the same placement,
+// so that a file never relies on a chunk less replicated, less durable, or expiring earlier than asked.
+type DedupPlacement struct {
+	Collection  string
+	Replication string
+	Ttl         string
+	Durability  string
+}
+
+type dedupIndexEntry struct {
+	entry     *Entry
+	chunk     *filer_pb.FileChunk
+	placement DedupPlacement
+	refCount  int64
+}
+
+func (f *Filer) findDedupIndexEntry(ctx context.Context, contentHash string) (*dedupIndexEntry, error) {
+	entry, err := f.FindEntry(ctx, dedupIndexPath(contentHash))
+	if err != nil {
+		return nil, err
+	}
+	chunk := &filer_pb.FileChunk{}
+	if err = proto.Unmarshal(entry.Extended[dedupChunkExtendedKey], chunk); err != nil {
+		return nil, fmt.Errorf("unmarshal dedup chunk %s: %v", contentHash, err)
+	}
+	refCount, err := strconv.ParseInt(string(entry.Extended[dedupRefCountExtendedKey]), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("dedup chunk %s reference count: %v", contentHash, err)
+	}
+	return &dedupIndexEntry{
+		entry: entry,
+		chunk: chunk,
+		placement: DedupPlacement{
+			Collection:  string(entry.Extended[dedupCollectionExtendedKey]),
+			Replication: string(entry.Extended[dedupReplicationExtendedKey]),
+			Ttl:         string(entry.Extended[dedupTtlExtendedKey]),
+			Durability:  string(entry.Extended[dedupDurabilityExtendedKey]),
+		},
+		refCount: refCount,
+	}, nil
+}
+
+// saveDedupIndexEntry saves the index entry, if it is not changed since it is read, or not created yet if it is new
+func (f *Filer) saveDedupIndexEntry(ctx context.Context, e *dedupIndexEntry) error {
+	data, err := proto.Marshal(e.chunk)
+	if err != nil {
+		return fmt.Errorf("marshal dedup chunk %s: %v", e.chunk.ContentHash, err)
+	}
+	// a copy, since the entry read from the store can be shared with other readers
+	entry := *e.entry
+	entry.Extended = map[string][]byte{
+		dedupChunkExtendedKey:       data,
+		dedupCollectionExtendedKey:  []byte(e.placement.Collection),
+		dedupReplicationExtendedKey: []byte(e.placement.Replication),
+		dedupTtlExtendedKey:         []byte(e.placement.Ttl),
+		dedupDurabilityExtendedKey:  []byte(e.placement.Durability),
+		dedupRefCountExtendedKey:    []byte(strconv.FormatInt(e.refCount, 10)),
+	}
+	entry.Mtime = time.Now()
+	precondition := &filer_pb.EntryPrecondition{MustNotExist: true}
+	if entry.Version > 0 {
+		precondition = &filer_pb.EntryPrecondition{ExpectedVersion: entry.Version}
+	}
+	return f.CreateEntryWithPrecondition(ctx, &entry, precondition)
+}
+
+// deleteDedupIndexEntry deletes the index entry, if it is not changed since it is read
+func (f *Filer) deleteDedupIndexEntry(ctx context.Context, e *dedupIndexEntry) error {
+	unlock := f.LockEntry(e.entry.FullPath)
+	defer unlock()
+
+	existing, err := f.FindEntryLocked(ctx, e.entry.FullPath)
+	if err != nil {
+		return err
+	}
+	if err = CheckPrecondition(e.entry.FullPath, &filer_pb.EntryPrecondition{ExpectedVersion: e.entry.Version}, existing); err != nil {
+		return err
+	}
+	return f.DeleteEntryMetaAndData(ctx, e.entry.FullPath, false, false, false)
+}
+
+func isPreconditionFailed(err error) bool {
+	_, ok := err.(*PreconditionFailedError)
+	return ok
+}
+
+// ReferenceDedupChunk looks up the chunk with the same content in the dedup index, and takes a reference to it.
+// It returns nil if there is no such chunk of the same placement, and the content should be uploaded as a new chunk.
+func (f *Filer) ReferenceDedupChunk(ctx context.Context, placement DedupPlacement, contentHash string) *filer_pb.FileChunk {
+
+	var e *dedupIndexEntry
+	var err error
+	for i := 0; i < dedupIndexRetries; i++ {
+		e, err = f.findDedupIndexEntry(ctx, contentHash)
+		if err != nil {
+			if err != ErrNotFound {
+				glog.Errorf("find dedup chunk %s: %v", contentHash, err)
+			}
+			return nil
+		}
+		// the chunk is deleted together with the volumes of its collection, and is kept as replicated and durable as stored
+		if e.placement != placement {
+			return nil
+		}
+		e.refCount++
+		if err = f.saveDedupIndexEntry(ctx, e); !isPreconditionFailed(err) {
+			break
+		}
+	}
+	if err != nil {
+		glog.Errorf("reference dedup chunk %s: %v", contentHash, err)
+		return nil
+	}
+	glog.V(3).Infof("reference dedup chunk %s %s: %d", contentHash, e.chunk.GetFileIdString(), e.refCount)
+
+	return &filer_pb.FileChunk{
+		FileId:      e.chunk.GetFileIdString(),
+		Size:        e.chunk.Size,
+		ETag:        e.chunk.ETag,
+		Md5:         e.chunk.Md5,
+		ContentHash: contentHash,
+	}
+}
+
+// AddDedupChunk records the newly uploaded chunk in the dedup index, with one reference.
+// If the same content is already added, e.g., by a concurrent upload, the chunk is kept out of the index.
+func (f *Filer) AddDedupChunk(ctx context.Context, placement DedupPlacement, chunk *filer_pb.FileChunk) {
+
+	if _, err := f.findDedupIndexEntry(ctx, chunk.ContentHash); err != ErrNotFound {
+		chunk.ContentHash = ""
+		return
+	}
+
+	now := time.Now()
+	e := &dedupIndexEntry{
+		entry: &Entry{
+			FullPath: dedupIndexPath(chunk.ContentHash),
+			Attr: Attr{
+				Crtime: now,
+				Mode:   os.FileMode(0600),
+				Uid:    OS_UID,
+				Gid:    OS_GID,
+			},
+		},
+		placement: placement,
+		chunk: &filer_pb.FileChunk{
+			FileId:      chunk.GetFileIdString(),
+			Size:        chunk.Size,
+			ETag:        chunk.ETag,
+			Md5:         chunk.Md5,
+			ContentHash: chunk.ContentHash,
+		},
+		refCount: 1,
+	}
+	if err := f.saveDedupIndexEntry(ctx, e); err != nil {
+		if !isPreconditionFailed(err) {
+			glog.Errorf("add dedup chunk %s: %v", chunk.ContentHash, err)
+		}
+		chunk.ContentHash = ""
+	}
+}
+
+// releaseDedupChunk drops a reference to the shared chunk, and returns true if the chunk data should be deleted.
+// On failures, the chunk is kept, since a leaked chunk is better than a lost one.
+func (f *Filer) releaseDedupChunk(ctx context.Context, chunk *filer_pb.FileChunk) bool {
+
+	for i := 0; i < dedupIndexRetries; i++ {
+		e, err := f.findDedupIndexEntry(ctx, chunk.ContentHash)
+		if err != nil {
+			glog.Errorf("release dedup chunk %s %s: %v", chunk.ContentHash, chunk.GetFileIdString(), err)
+			return false
+		}
+		// not the chunk in the index, which no longer tracks its references, so it is kept
+		if e.chunk.GetFileIdString() != chunk.GetFileIdString() {
+			glog.Warningf("release dedup chunk %s: %s is not indexed, indexed %s", chunk.ContentHash, chunk.GetFileIdString(), e.chunk.GetFileIdString())
+			return false
+		}
+
+		e.refCount--
+		if e.refCount > 0 {
+			err = f.saveDedupIndexEntry(ctx, e)
+		} else {
+			err = f.deleteDedupIndexEntry(ctx, e)
+		}
+		if isPreconditionFailed(err) {
+			continue
+		}
+		if err != nil {
+			glog.Errorf("release dedup chunk %s: %v", chunk.ContentHash, err)
+			return false
+		}
+		glog.V(3).Infof("release dedup chunk %s %s: %d", chunk.ContentHash, chunk.GetFileIdString(), e.refCount)
+		return e.refCount <= 0
+	}
+
+	glog.Errorf("release dedup chunk %s: changed concurrently %d times", chunk.ContentHash, dedupIndexRetries)
+	return false
+}
+
+// minusChunkReferences returns the chunks of as not in bs.
+// A shared chunk is referenced once by each upload, which sets its own chunk mtime,
+// so that overwriting a file with the same content still releases the reference of the old file.
+func minusChunkReferences(as, bs []*filer_pb.FileChunk) (delta []*filer_pb.FileChunk) {
+	chunkReference := func(chunk *filer_pb.FileChunk) string {
+		if chunk.ContentHash == "" {
+			return chunk.GetFileIdString()
+		}
+		return fmt.Sprintf("%s@%d", chunk.GetFileIdString(), chunk.Mtime)
+	}
+	references := make(map[string]bool)
+	for _, chunk := range bs {
+		references[chunkReference(chunk)] = true
+	}
+	for _, chunk := range as {
+		if !references[chunkReference(chunk)] {
+			delta = append(delta, chunk)
+		}
+	}
+	return
+}
@@ -0,0 +1,147 @@
+package filer2_test
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/filer2/memdb"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func TestDedupChunkReferences(t *testing.T) {
+	f := filer2.NewFiler(nil, nil)
+	store := &memdb.MemDbStore{}
+	store.Initialize(nil)
+	f.SetStore(store)
+	f.DisableDirectoryCache()
+
+	ctx := context.Background()
+	backup := filer2.DedupPlacement{Collection: "backup"}
+	contentHash := filer2.ContentHash([]byte("the same artifact"))
+	indexPath := filer2.NewFullPath(filer2.DedupDirectory+"/"+contentHash[:2], contentHash)
+
+	refCount := func() string {
+		entry, err := f.FindEntry(ctx, indexPath)
+		if err != nil {
+			return err.Error()
+		}
+		return string(entry.Extended["refcount"])
+	}
+	// the references are released in the background
+	waitRefCount := func(expected string) string {
+		for i := 0; i < 100 && refCount() != expected; i++ {
+			time.Sleep(10 * time.Millisecond)
+		}
+		return refCount()
+	}
+	createFile := func(path string, chunk *filer_pb.FileChunk, mtime int64) {
+		chunk.Mtime = mtime
+		if err := f.CreateEntry(ctx, &filer2.Entry{
+			FullPath: filer2.FullPath(path),
+			Attr:     filer2.Attr{Mode: 0644},
+			Chunks:   []*filer_pb.FileChunk{chunk},
+		}); err != nil {
+			t.Fatalf("create %s: %v", path, err)
+		}
+	}
+
+	if chunk := f.ReferenceDedupChunk(ctx, backup, contentHash); chunk != nil {
+		t.Fatalf("unexpected chunk %v in the empty index", chunk)
+	}
+
+	uploaded := &filer_pb.FileChunk{FileId: "3,01637037d6", Size: 17, ContentHash: contentHash}
+	f.AddDedupChunk(ctx, backup, uploaded)
+	if uploaded.ContentHash == "" || refCount() != "1" {
+		t.Fatalf("add the uploaded chunk: %s", refCount())
+	}
+	createFile("/buckets/a/artifact", uploaded, 1)
+
+	if chunk := f.ReferenceDedupChunk(ctx, filer2.DedupPlacement{Collection: "other"}, contentHash); chunk != nil {
+		t.Errorf("unexpected chunk %v of another collection", chunk)
+	}
+	// a chunk is not shared by the files asking for more replicas or durability
+	if chunk := f.ReferenceDedupChunk(ctx, filer2.DedupPlacement{Collection: "backup", Replication: "010"}, contentHash); chunk != nil {
+		t.Errorf("unexpected chunk %v of another replication", chunk)
+	}
+	if chunk := f.ReferenceDedupChunk(ctx, filer2.DedupPlacement{Collection: "backup", Durability: "fsync"}, contentHash); chunk != nil {
+		t.Errorf("unexpected chunk %v of another durability", chunk)
+	}
+
+	reused := f.ReferenceDedupChunk(ctx, backup, contentHash)
+	if reused == nil || reused.GetFileIdString() != "3,01637037d6" || refCount() != "2" {
+		t.Fatalf("reuse the chunk %v: %s", reused, refCount())
+	}
+	createFile("/buckets/b/artifact", reused, 2)
+
+	// the same content is added again, e.g. by a concurrent upload
+	concurrent := &filer_pb.FileChunk{FileId: "4,02637037d6", Size: 17, ContentHash: contentHash}
+	f.AddDedupChunk(ctx, backup, concurrent)
+	if concurrent.ContentHash != "" || refCount() != "2" {
+		t.Errorf("the concurrent chunk should not be indexed: %s", refCount())
+	}
+
+	// overwriting with the same content takes a new reference, and releases the old one
+	createFile("/buckets/b/artifact", f.ReferenceDedupChunk(ctx, backup, contentHash), 3)
+	if waitRefCount("2") != "2" {
+		t.Errorf("overwrite with the same content: %s", refCount())
+	}
+
+	// updating other attributes keeps the reference
+	entry, _ := f.FindEntry(ctx, "/buckets/b/artifact")
+	updated := *entry
+	updated.Mode = 0600
+	if err := f.UpdateEntry(ctx, entry, &updated); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	f.DeleteChunksIfNotNew(entry, &updated)
+	if waitRefCount("2") != "2" {
+		t.Errorf("update the attributes: %s", refCount())
+	}
+
+	if err := f.DeleteEntryMetaAndData(ctx, "/buckets/a/artifact", false, false, true); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if waitRefCount("1") != "1" {
+		t.Errorf("delete one reference: %s", refCount())
+	}
+
+	// overwriting a file sharing the lock of the index entry, which releases the reference while holding the lock
+	collidingPath := ""
+	for i := 0; collidingPath == ""; i++ {
+		p := fmt.Sprintf("/buckets/c/artifact%d", i)
+		if crc32.ChecksumIEEE([]byte(p))%1024 == crc32.ChecksumIEEE([]byte(indexPath))%1024 {
+			collidingPath = p
+		}
+	}
+	createFile(collidingPath, f.ReferenceDedupChunk(ctx, backup, contentHash), 4)
+	if waitRefCount("2") != "2" {
+		t.Fatalf("reference from %s: %s", collidingPath, refCount())
+	}
+	overwritten := make(chan struct{})
+	go func() {
+		createFile(collidingPath, &filer_pb.FileChunk{FileId: "5,03637037d6", Size: 17}, 5)
+		close(overwritten)
+	}()
+	select {
+	case <-overwritten:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("overwriting %s is blocked on the lock of the index entry", collidingPath)
+	}
+	if waitRefCount("1") != "1" {
+		t.Errorf("overwrite %s: %s", collidingPath, refCount())
+	}
+
+	if err := f.DeleteEntryMetaAndData(ctx, "/buckets/b/artifact", false, false, true); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	for i := 0; i < 100 && refCount() != filer2.ErrNotFound.Error(); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if _, err := f.FindEntry(ctx, indexPath); err != filer2.ErrNotFound {
+		t.Errorf("the index entry should be deleted with the last reference: %v", err)
+	}
+}
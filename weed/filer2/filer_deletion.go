@@ -1,6 +1,7 @@
 package filer2
 
 import (
+	"context"
 	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
@@ -51,8 +52,40 @@ func (f *Filer) loopProcessingDeletion() {
 	}
 }
 
+// DeleteChunks deletes the chunks, including the chunks referenced by the manifest chunks
 func (f *Filer) DeleteChunks(fullpath FullPath, chunks []*filer_pb.FileChunk) {
+	allChunks, err := ResolveAllChunks(f.lookupFileId, chunks)
+	if err != nil {
+		glog.Errorf("resolve manifest chunks of %s: %v", fullpath, err)
+		_, allChunks = SeparateManifestChunks(chunks)
+	}
+	f.deleteChunks(fullpath, allChunks)
+}
+
+func (f *Filer) deleteChunks(fullpath FullPath, chunks []*filer_pb.FileChunk) {
+	var sharedChunks []*filer_pb.FileChunk
+	for _, chunk := range chunks {
+		if chunk.ContentHash != "" {
+			sharedChunks = append(sharedChunks, chunk)
+			continue
+		}
+		glog.V(3).Infof("deleting %s chunk %s", fullpath, chunk.String())
+		f.fileIdDeletionChan <- chunk.GetFileIdString()
+	}
+	if len(sharedChunks) > 0 {
+		// released in the background, since the caller can hold LockEntry of the file,
+		// and releasing takes LockEntry of the dedup index entries, which can share the same lock
+		go f.releaseDedupChunks(fullpath, sharedChunks)
+	}
+}
+
+// releaseDedupChunks drops the references to the shared chunks, and deletes the chunks no longer referenced
+func (f *Filer) releaseDedupChunks(fullpath FullPath, chunks []*filer_pb.FileChunk) {
 	for _, chunk := range chunks {
+		if !f.releaseDedupChunk(context.Background(), chunk) {
+			glog.V(3).Infof("keeping %s shared chunk %s", fullpath, chunk.String())
+			continue
+		}
 		glog.V(3).Infof("deleting %s chunk %s", fullpath, chunk.String())
 		f.fileIdDeletionChan <- chunk.GetFileIdString()
 	}
@@ -64,25 +97,28 @@ func (f *Filer) DeleteFileByFileId(fileId string) {
 	f.fileIdDeletionChan <- fileId
 }
 
-func (f *Filer) deleteChunksIfNotNew(oldEntry, newEntry *Entry) {
+// DeleteChunksIfNotNew deletes the chunks of the old entry not used by the new entry.
+// The manifest chunks on both sides are resolved, since the same chunk can be moved in or out of a manifest chunk.
+func (f *Filer) DeleteChunksIfNotNew(oldEntry, newEntry *Entry) {
 
 	if oldEntry == nil {
 		return
 	}
 	if newEntry == nil {
 		f.DeleteChunks(oldEntry.FullPath, oldEntry.Chunks)
+		return
 	}
 
-	var toDelete []*filer_pb.FileChunk
-	newChunkIds := make(map[string]bool)
-	for _, newChunk := range newEntry.Chunks {
-		newChunkIds[newChunk.GetFileIdString()] = true
+	oldChunks, err := ResolveAllChunks(f.lookupFileId, oldEntry.Chunks)
+	if err != nil {
+		glog.Errorf("resolve old manifest chunks of %s: %v", oldEntry.FullPath, err)
+		return
 	}
-
-	for _, oldChunk := range oldEntry.Chunks {
-		if _, found := newChunkIds[oldChunk.GetFileIdString()]; !found {
-			toDelete = append(toDelete, oldChunk)
-		}
+	newChunks, err := ResolveAllChunks(f.lookupFileId, newEntry.Chunks)
+	if err != nil {
+		glog.Errorf("resolve new manifest chunks of %s: %v", newEntry.FullPath, err)
+		return
 	}
-	f.DeleteChunks(oldEntry.FullPath, toDelete)
+
+	f.deleteChunks(oldEntry.FullPath, minusChunkReferences(oldChunks, newChunks))
 }
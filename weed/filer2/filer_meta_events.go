@@ -0,0 +1,142 @@
+package filer2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+// MetaEventsKept is the number of recent metadata events kept in memory for the subscribers to catch up
+const MetaEventsKept = 64 * 1024
+
+// ErrMetaEventsLost means some events since the requested time are no longer kept, e.g., after the filer restarts.
+// The subscriber should drop what it derived from the earlier events, and subscribe from now on.
+var ErrMetaEventsLost = errors.New("metadata events are lost")
+
+// metaEventLog keeps the recent metadata events in the order they happen, as a ring buffer
+type metaEventLog struct {
+	sync.Mutex
+	events  []*filer_pb.SubscribeMetadataResponse
+	start   int   // the index of the oldest event
+	sinceNs int64 // no event after this time is dropped
+	lastNs  int64
+	notify  chan struct{} // closed when a new event is added
+}
+
+func newMetaEventLog(capacity int) *metaEventLog {
+	return &metaEventLog{
+		events:  make([]*filer_pb.SubscribeMetadataResponse, 0, capacity),
+		sinceNs: time.Now().UnixNano(),
+		notify:  make(chan struct{}),
+	}
+}
+
+func (log *metaEventLog) add(directory string, eventNotification *filer_pb.EventNotification) {
+
+	log.Lock()
+	defer log.Unlock()
+
+	// the events are ordered by time, even if the clock goes back
+	tsNs := time.Now().UnixNano()
+	if tsNs <= log.lastNs {
+		tsNs = log.lastNs + 1
+	}
+	log.lastNs = tsNs
+
+	event := &filer_pb.SubscribeMetadataResponse{
+		Directory:         directory,
+		EventNotification: eventNotification,
+		TsNs:              tsNs,
+	}
+
+	if len(log.events) < cap(log.events) {
+		log.events = append(log.events, event)
+	} else {
+		log.sinceNs = log.events[log.start].TsNs
+		log.events[log.start] = event
+		log.start = (log.start + 1) % len(log.events)
+	}
+
+	close(log.notify)
+	log.notify = make(chan struct{})
+}
+
+// readSince returns the events after sinceNs, and a channel closed when there are newer events
+func (log *metaEventLog) readSince(sinceNs int64) (events []*filer_pb.SubscribeMetadataResponse, notify chan struct{}, err error) {
+
+	log.Lock()
+	defer log.Unlock()
+
+	if sinceNs < log.sinceNs {
+		return nil, nil, ErrMetaEventsLost
+	}
+
+	for i := range log.events {
+		event := log.events[(log.start+i)%len(log.events)]
+		if event.TsNs > sinceNs {
+			events = append(events, event)
+		}
+	}
+
+	return events, log.notify, nil
+}
+
+// SubscribeMetadata calls eachEventFn with the events after sinceNs under the path prefix,
+// and then with the new events as they happen, until the context is done or eachEventFn fails.
+// A zero sinceNs means from now on. The first event has no notification, and confirms the subscription.
+func (f *Filer) SubscribeMetadata(ctx context.Context, pathPrefix string, sinceNs int64, eachEventFn func(event *filer_pb.SubscribeMetadataResponse) error) error {
+
+	if sinceNs == 0 {
+		sinceNs = time.Now().UnixNano()
+	}
+
+	for confirmed := false; ; confirmed = true {
+		events, notify, err := f.metaEvents.readSince(sinceNs)
+		if err != nil {
+			return fmt.Errorf("subscribe since %d: %v", sinceNs, err)
+		}
+		if !confirmed {
+			if err := eachEventFn(&filer_pb.SubscribeMetadataResponse{TsNs: sinceNs}); err != nil {
+				return err
+			}
+		}
+		for _, event := range events {
+			if IsUnderPathPrefix(event, pathPrefix) {
+				if err := eachEventFn(event); err != nil {
+					return err
+				}
+			}
+			sinceNs = event.TsNs
+		}
+		select {
+		case <-notify:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+
+}
+
+// IsUnderPathPrefix checks whether the old or the new entry of the event is under the path prefix
+func IsUnderPathPrefix(event *filer_pb.SubscribeMetadataResponse, pathPrefix string) bool {
+	if pathPrefix == "" || pathPrefix == "/" {
+		return true
+	}
+	pathPrefix = strings.TrimSuffix(pathPrefix, "/")
+	under := func(fullpath string) bool {
+		return fullpath == pathPrefix || strings.HasPrefix(fullpath, pathPrefix+"/")
+	}
+	notification := event.EventNotification
+	if notification.OldEntry != nil && under(string(NewFullPath(event.Directory, notification.OldEntry.Name))) {
+		return true
+	}
+	if notification.NewEntry != nil && under(string(NewFullPath(notification.NewParentPath, notification.NewEntry.Name))) {
+		return true
+	}
+	return false
+}
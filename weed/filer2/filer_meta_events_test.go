@@ -0,0 +1,85 @@
+package filer2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func TestMetaEventLog(t *testing.T) {
+
+	log := newMetaEventLog(3)
+	startNs := log.sinceNs
+
+	for _, name := range []string{"a", "b", "c"} {
+		log.add("/dir", &filer_pb.EventNotification{NewEntry: &filer_pb.Entry{Name: name}, NewParentPath: "/dir"})
+	}
+
+	events, _, err := log.readSince(startNs)
+	if err != nil || len(events) != 3 {
+		t.Fatalf("read since start: %d events, %v", len(events), err)
+	}
+	if !(events[0].TsNs < events[1].TsNs && events[1].TsNs < events[2].TsNs) {
+		t.Errorf("events are not ordered by time")
+	}
+
+	// the oldest event is dropped
+	log.add("/dir", &filer_pb.EventNotification{NewEntry: &filer_pb.Entry{Name: "d"}, NewParentPath: "/dir"})
+	if _, _, err = log.readSince(startNs); err != ErrMetaEventsLost {
+		t.Errorf("expected lost events, got %v", err)
+	}
+	events, _, err = log.readSince(events[0].TsNs)
+	if err != nil || len(events) != 3 || events[0].EventNotification.NewEntry.Name != "b" || events[2].EventNotification.NewEntry.Name != "d" {
+		t.Errorf("read since the first event: %v, %v", events, err)
+	}
+
+}
+
+func TestSubscribeMetadata(t *testing.T) {
+
+	f := &Filer{metaEvents: newMetaEventLog(MetaEventsKept)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	received := make(chan *filer_pb.SubscribeMetadataResponse, 10)
+	done := make(chan error)
+	go func() {
+		done <- f.SubscribeMetadata(ctx, "/mnt", 0, func(event *filer_pb.SubscribeMetadataResponse) error {
+			received <- event
+			return nil
+		})
+	}()
+
+	if confirm := <-received; confirm.EventNotification != nil {
+		t.Fatalf("the first event does not confirm the subscription: %v", confirm)
+	}
+
+	f.NotifyUpdateEvent(&Entry{FullPath: "/other/file"}, nil, false)
+	f.NotifyUpdateEvent(nil, &Entry{FullPath: "/mnt/dir/file"}, false)
+	f.NotifyUpdateEvent(&Entry{FullPath: "/mnt2/file"}, nil, false)
+	// moved into the subscribed path
+	f.NotifyUpdateEvent(nil, &Entry{FullPath: "/mnt/file"}, false)
+
+	for _, expected := range []string{"/mnt/dir", "/mnt"} {
+		select {
+		case event := <-received:
+			if event.Directory != expected || event.EventNotification.NewParentPath != expected {
+				t.Errorf("unexpected event %v, expected under %s", event, expected)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("no event under %s", expected)
+		}
+	}
+	select {
+	case event := <-received:
+		t.Errorf("unexpected event %v", event)
+	default:
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("subscribe: %v", err)
+	}
+
+}
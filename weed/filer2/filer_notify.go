@@ -16,23 +16,36 @@ func (f *Filer) NotifyUpdateEvent(oldEntry, newEntry *Entry, deleteChunks bool)
 		return
 	}
 
+	newParentPath := ""
+	if newEntry != nil {
+		newParentPath, _ = newEntry.FullPath.DirAndName()
+	}
+	eventNotification := &filer_pb.EventNotification{
+		OldEntry:      oldEntry.ToProtoEntry(),
+		NewEntry:      newEntry.ToProtoEntry(),
+		DeleteChunks:  deleteChunks,
+		NewParentPath: newParentPath,
+	}
+
+	if newEntry != nil && newEntry.FullPath == StoragePolicyPath {
+		f.setStoragePolicies(newEntry)
+	} else if oldEntry != nil && oldEntry.FullPath == StoragePolicyPath {
+		f.setStoragePolicies(nil)
+	}
+
+	// for the metadata subscribers
+	if f.metaEvents != nil {
+		directory, _ := FullPath(key).DirAndName()
+		f.metaEvents.add(directory, eventNotification)
+	}
+
 	if notification.Queue != nil {
 
 		glog.V(3).Infof("notifying entry update %v", key)
 
-		newParentPath := ""
-		if newEntry != nil {
-			newParentPath, _ = newEntry.FullPath.DirAndName()
-		}
-
 		notification.Queue.SendMessage(
 			key,
-			&filer_pb.EventNotification{
-				OldEntry:      oldEntry.ToProtoEntry(),
-				NewEntry:      newEntry.ToProtoEntry(),
-				DeleteChunks:  deleteChunks,
-				NewParentPath: newParentPath,
-			},
+			eventNotification,
 		)
 
 	}
@@ -0,0 +1,75 @@
+package filer2
+
+import (
+	"bytes"
+	"fmt"
+	"hash/crc32"
+	"sync"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+const entryLockCount = 1024
+
+// entryLocks serializes the changes to the same entry within this filer,
+// so that a precondition stays valid until the change is saved.
+type entryLocks [entryLockCount]sync.Mutex
+
+// LockEntry locks the entry path against other changes through this filer.
+func (f *Filer) LockEntry(p FullPath) (unlock func()) {
+	lock := &f.entryLocks[crc32.ChecksumIEEE([]byte(p))%entryLockCount]
+	lock.Lock()
+	return lock.Unlock
+}
+
+type PreconditionFailedError struct {
+	FullPath FullPath
+	Reason   string
+}
+
+func (e *PreconditionFailedError) Error() string {
+	return fmt.Sprintf("precondition failed for %s: %s", e.FullPath, e.Reason)
+}
+
+// CheckPrecondition verifies the existing entry, which is nil if not found, against the precondition.
+func CheckPrecondition(p FullPath, precondition *filer_pb.EntryPrecondition, existing *Entry) error {
+	if precondition == nil {
+		return nil
+	}
+
+	if existing == nil {
+		if precondition.MustExist || precondition.ExpectedVersion != 0 || precondition.ExpectedMtime != 0 {
+			return &PreconditionFailedError{p, "not found"}
+		}
+		for k, v := range precondition.ExpectedExtended {
+			if len(v) > 0 {
+				return &PreconditionFailedError{p, fmt.Sprintf("not found with extended %s", k)}
+			}
+		}
+		return nil
+	}
+
+	if precondition.MustNotExist {
+		return &PreconditionFailedError{p, "already exists"}
+	}
+	if precondition.ExpectedVersion != 0 && precondition.ExpectedVersion != existing.Version {
+		return &PreconditionFailedError{p, fmt.Sprintf("version %d, expected %d", existing.Version, precondition.ExpectedVersion)}
+	}
+	if precondition.ExpectedMtime != 0 && precondition.ExpectedMtime != existing.Mtime.Unix() {
+		return &PreconditionFailedError{p, fmt.Sprintf("mtime %d, expected %d", existing.Mtime.Unix(), precondition.ExpectedMtime)}
+	}
+	for k, expected := range precondition.ExpectedExtended {
+		actual, found := existing.Extended[k]
+		if len(expected) == 0 {
+			if found {
+				return &PreconditionFailedError{p, fmt.Sprintf("extended %s exists", k)}
+			}
+			continue
+		}
+		if !found || !bytes.Equal(actual, expected) {
+			return &PreconditionFailedError{p, fmt.Sprintf("extended %s does not match", k)}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package filer2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func TestCheckPrecondition(t *testing.T) {
+
+	existing := &Entry{
+		FullPath: "/a/b.txt",
+		Attr: Attr{
+			Mtime: time.Unix(1000, 0),
+		},
+		Extended: map[string][]byte{"owner": []byte("alice")},
+		Version:  3,
+	}
+
+	testcases := []struct {
+		precondition *filer_pb.EntryPrecondition
+		existing     *Entry
+		expectFailed bool
+	}{
+		{nil, existing, false},
+		{nil, nil, false},
+		{&filer_pb.EntryPrecondition{MustNotExist: true}, nil, false},
+		{&filer_pb.EntryPrecondition{MustNotExist: true}, existing, true},
+		{&filer_pb.EntryPrecondition{MustExist: true}, nil, true},
+		{&filer_pb.EntryPrecondition{MustExist: true}, existing, false},
+		{&filer_pb.EntryPrecondition{ExpectedVersion: 3}, existing, false},
+		{&filer_pb.EntryPrecondition{ExpectedVersion: 2}, existing, true},
+		{&filer_pb.EntryPrecondition{ExpectedVersion: 1}, nil, true},
+		{&filer_pb.EntryPrecondition{ExpectedMtime: 1000}, existing, false},
+		{&filer_pb.EntryPrecondition{ExpectedMtime: 1001}, existing, true},
+		{&filer_pb.EntryPrecondition{ExpectedExtended: map[string][]byte{"owner": []byte("alice")}}, existing, false},
+		{&filer_pb.EntryPrecondition{ExpectedExtended: map[string][]byte{"owner": []byte("bob")}}, existing, true},
+		{&filer_pb.EntryPrecondition{ExpectedExtended: map[string][]byte{"lock": nil}}, existing, false},
+		{&filer_pb.EntryPrecondition{ExpectedExtended: map[string][]byte{"owner": nil}}, existing, true},
+		{&filer_pb.EntryPrecondition{ExpectedExtended: map[string][]byte{"owner": nil}}, nil, false},
+		{&filer_pb.EntryPrecondition{ExpectedExtended: map[string][]byte{"owner": []byte("alice")}}, nil, true},
+	}
+
+	for i, tc := range testcases {
+		err := CheckPrecondition("/a/b.txt", tc.precondition, tc.existing)
+		if tc.expectFailed != (err != nil) {
+			t.Errorf("case %d: expect failed %v, but got %v", i, tc.expectFailed, err)
+		}
+		if err != nil {
+			if _, ok := err.(*PreconditionFailedError); !ok {
+				t.Errorf("case %d: unexpected error type %v", i, err)
+			}
+		}
+	}
+
+}
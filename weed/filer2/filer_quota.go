@@ -0,0 +1,155 @@
+package filer2
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/spf13/viper"
+)
+
+// QuotaExceededError is returned when a write would take more space than the quota of the owner
+type QuotaExceededError struct {
+	FullPath FullPath
+	Owner    string
+	Usage    int64
+	Limit    int64
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("quota exceeded for %s writing %s: %d of %d bytes used", e.Owner, e.FullPath, e.Usage, e.Limit)
+}
+
+// quotas limits the total size of the files owned by each owner.
+// The owner is the user name saved along with the uid, e.g., by weed mount or WebDAV with -mapIdentity,
+// since the same user can have different uids on different hosts, or the uid if there is no user name.
+// The usage is counted from all the entries when the filer starts, and then tracked on each change.
+// It is approximate while counting, and the limits are enforced only after the counting is done.
+type quotas struct {
+	sync.Mutex
+	limits  map[string]int64
+	usage   map[string]int64
+	counted bool
+}
+
+// loadQuotas reads the limits in MB by user name or uid from the [quota] section of filer.toml
+func loadQuotas(config *viper.Viper) (*quotas, error) {
+	if config == nil {
+		return nil, nil
+	}
+	q := &quotas{
+		limits: make(map[string]int64),
+		usage:  make(map[string]int64),
+	}
+	for _, key := range config.AllKeys() {
+		// a user name with dots is read as a nested section
+		if strings.Contains(key, ".") {
+			return nil, fmt.Errorf("quota: invalid user name or uid %s", key)
+		}
+		q.limits[key] = config.GetInt64(key) * 1024 * 1024
+	}
+	if len(q.limits) == 0 {
+		return nil, nil
+	}
+	return q, nil
+}
+
+const quotaCountRetryInterval = time.Minute
+
+type skipQuotaKey struct{}
+
+// WithoutQuotaEnforcement marks the changes in the context as moves of existing files, e.g., renames,
+// which take no more space in total, and so are never refused by the quotas
+func WithoutQuotaEnforcement(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipQuotaKey{}, true)
+}
+
+func isQuotaEnforced(ctx context.Context) bool {
+	return ctx.Value(skipQuotaKey{}) == nil
+}
+
+// quotaOwner is the user name of the entry owner, or the uid if the user name is not saved.
+// The user name is in lower case, as the keys read from filer.toml.
+func quotaOwner(entry *Entry) string {
+	if entry.UserName != "" {
+		return strings.ToLower(entry.UserName)
+	}
+	return strconv.FormatUint(uint64(entry.Uid), 10)
+}
+
+func entrySpace(entry *Entry) int64 {
+	if entry == nil || entry.IsDirectory() {
+		return 0
+	}
+	return int64(TotalSize(entry.Chunks))
+}
+
+// change accounts for replacing the old entry with the new entry, either of which can be nil.
+// With enforce, it fails without any change if the new owner would go over the quota.
+func (q *quotas) change(oldEntry, newEntry *Entry, enforce bool) error {
+	if q == nil {
+		return nil
+	}
+
+	q.Lock()
+	defer q.Unlock()
+
+	deltas := make(map[string]int64)
+	if oldEntry != nil {
+		deltas[quotaOwner(oldEntry)] -= entrySpace(oldEntry)
+	}
+	if newEntry != nil {
+		deltas[quotaOwner(newEntry)] += entrySpace(newEntry)
+	}
+
+	if enforce && q.counted {
+		for owner, delta := range deltas {
+			limit, found := q.limits[owner]
+			if found && delta > 0 && q.usage[owner]+delta > limit {
+				return &QuotaExceededError{FullPath: newEntry.FullPath, Owner: owner, Usage: q.usage[owner], Limit: limit}
+			}
+		}
+	}
+
+	for owner, delta := range deltas {
+		q.usage[owner] += delta
+	}
+	return nil
+}
+
+// count adds up the space used by each owner, in addition to the changes during the counting
+func (q *quotas) count(f *Filer) error {
+	counted := make(map[string]int64)
+	err := f.traverseEntries(context.Background(), "/", func(entry *Entry) error {
+		counted[quotaOwner(entry)] += entrySpace(entry)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("count quota usage: %v", err)
+	}
+
+	q.Lock()
+	defer q.Unlock()
+	for owner, space := range counted {
+		q.usage[owner] += space
+	}
+	q.counted = true
+	glog.V(0).Infof("quota usage counted for %d owners", len(counted))
+	return nil
+}
+
+// keepCounting counts the usage, and retries on failures, since the quotas are not enforced until counted
+func (q *quotas) keepCounting(f *Filer) {
+	for {
+		err := q.count(f)
+		if err == nil {
+			return
+		}
+		glog.Errorf("%v, retrying in %v", err, quotaCountRetryInterval)
+		time.Sleep(quotaCountRetryInterval)
+	}
+}
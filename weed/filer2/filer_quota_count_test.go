@@ -0,0 +1,48 @@
+package filer2_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/filer2/memdb"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func TestQuotaCountWithoutSnapshot(t *testing.T) {
+	f := filer2.NewFiler(nil, nil)
+	store := &memdb.MemDbStore{}
+	store.Initialize(nil)
+	f.SetStore(store)
+	f.DisableDirectoryCache()
+
+	ctx := context.Background()
+	createFile := func(path string, size uint64) error {
+		return f.CreateEntry(ctx, &filer2.Entry{
+			FullPath: filer2.FullPath(path),
+			Attr:     filer2.Attr{Mode: 0644, Uid: 1000},
+			Chunks:   []*filer_pb.FileChunk{{FileId: "1,01637037d6", Size: size}},
+		})
+	}
+
+	// the files written before the filer starts, in nested directories
+	for _, path := range []string{"/home/a/1.txt", "/home/a/b/2.txt", "/home/3.txt"} {
+		if err := createFile(path, 30); err != nil {
+			t.Fatalf("create %s: %v", path, err)
+		}
+	}
+
+	// memdb can not traverse a snapshot, so the usage is counted by listing the directories
+	f.SetQuotaLimits(map[string]int64{"1000": 100})
+	if err := f.CountQuotaUsage(); err != nil {
+		t.Fatalf("count: %v", err)
+	}
+
+	if err := createFile("/home/a/4.txt", 10); err != nil {
+		t.Errorf("write within the quota: %v", err)
+	}
+	err := createFile("/home/a/5.txt", 1)
+	if _, ok := err.(*filer2.QuotaExceededError); !ok {
+		t.Errorf("expecting quota exceeded, got %v", err)
+	}
+}
@@ -0,0 +1,82 @@
+package filer2
+
+import (
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func TestQuotaChange(t *testing.T) {
+
+	q := &quotas{
+		limits:  map[string]int64{"1000": 100, "alice": 100},
+		usage:   make(map[string]int64),
+		counted: true,
+	}
+
+	newFile := func(uid uint32, size uint64) *Entry {
+		return &Entry{
+			FullPath: "/dir/file",
+			Attr:     Attr{Uid: uid, Mode: 0644},
+			Chunks:   []*filer_pb.FileChunk{{FileId: "1,1", Size: size}},
+		}
+	}
+
+	small, large := newFile(1000, 60), newFile(1000, 120)
+
+	if err := q.change(nil, small, true); err != nil {
+		t.Fatalf("create within the quota: %v", err)
+	}
+	if err := q.change(nil, small, true); err == nil {
+		t.Errorf("expecting quota exceeded")
+	} else if _, ok := err.(*QuotaExceededError); !ok {
+		t.Errorf("unexpected error %v", err)
+	}
+	if q.usage["1000"] != 60 {
+		t.Errorf("unexpected usage %d after a rejected change", q.usage["1000"])
+	}
+
+	if err := q.change(small, large, true); err == nil {
+		t.Errorf("expecting quota exceeded when growing the file")
+	}
+	// shrinking is always allowed, and undoing does not enforce
+	if err := q.change(large, small, false); err != nil {
+		t.Errorf("undo: %v", err)
+	}
+	if q.usage["1000"] != 0 {
+		t.Errorf("unexpected usage %d after undo", q.usage["1000"])
+	}
+
+	// the space moves to the new owner, who has no quota
+	if err := q.change(nil, small, true); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if err := q.change(small, newFile(2000, 60), true); err != nil {
+		t.Errorf("chown to an unlimited uid: %v", err)
+	}
+	if q.usage["1000"] != 0 || q.usage["2000"] != 60 {
+		t.Errorf("unexpected usage %v after chown", q.usage)
+	}
+
+	// the files saved with the user name are counted by the name, whatever the uid is on each host
+	byName := func(uid uint32, size uint64) *Entry {
+		entry := newFile(uid, size)
+		entry.UserName = "alice"
+		return entry
+	}
+	if err := q.change(nil, byName(1000, 60), true); err != nil {
+		t.Fatalf("create by name: %v", err)
+	}
+	if err := q.change(nil, byName(1001, 60), true); err == nil {
+		t.Errorf("expecting quota exceeded for the same user name with another uid")
+	}
+	if q.usage["alice"] != 60 || q.usage["1000"] != 0 {
+		t.Errorf("unexpected usage %v by name", q.usage)
+	}
+
+	var disabled *quotas
+	if err := disabled.change(nil, large, true); err != nil {
+		t.Errorf("unexpected error without quotas: %v", err)
+	}
+
+}
@@ -0,0 +1,216 @@
+package filer2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	StoragePolicyDirectory = "/etc/seaweedfs"
+	StoragePolicyName      = "storage_policies"
+	// the policies are saved in the extended attributes of the entry
+	storagePolicyExtendedKey = "storage_policies"
+	// the policies changed by other filers sharing the store are picked up by reloading them periodically
+	storagePolicyReloadInterval = time.Minute
+)
+
+// StoragePolicyPath is the entry keeping the storage policies, so that they are stored and replicated as other metadata
+var StoragePolicyPath = NewFullPath(StoragePolicyDirectory, StoragePolicyName)
+
+// StoragePolicies chooses the collection, replication, ttl, and chunk size of the files by their path prefix
+type StoragePolicies struct {
+	// sorted by the path prefix, so that the longer prefixes come after their parents
+	policies []*filer_pb.StoragePolicy
+}
+
+func NewStoragePolicies(pb *filer_pb.StoragePolicies) *StoragePolicies {
+	sp := &StoragePolicies{}
+	if pb == nil {
+		return sp
+	}
+	sp.policies = append(sp.policies, pb.Policies...)
+	sort.Slice(sp.policies, func(i, j int) bool {
+		return sp.policies[i].PathPrefix < sp.policies[j].PathPrefix
+	})
+	return sp
+}
+
+// Policies returns the policies sorted by the path prefix
+func (sp *StoragePolicies) Policies() []*filer_pb.StoragePolicy {
+	return sp.policies
+}
+
+// LoadStoragePolicies reads the policies from the extended attributes of the policy entry
+func LoadStoragePolicies(extended map[string][]byte) (*filer_pb.StoragePolicies, error) {
+	pb := &filer_pb.StoragePolicies{}
+	data, found := extended[storagePolicyExtendedKey]
+	if !found {
+		return pb, nil
+	}
+	if err := proto.Unmarshal(data, pb); err != nil {
+		return nil, fmt.Errorf("unmarshal storage policies: %v", err)
+	}
+	return pb, nil
+}
+
+// SaveStoragePolicies writes the policies into the extended attributes of the policy entry
+func SaveStoragePolicies(pb *filer_pb.StoragePolicies) (extended map[string][]byte, err error) {
+	data, err := proto.Marshal(pb)
+	if err != nil {
+		return nil, fmt.Errorf("marshal storage policies: %v", err)
+	}
+	return map[string][]byte{storagePolicyExtendedKey: data}, nil
+}
+
+// Match returns the policy of the full path, or nil if none matches.
+// A policy matches the path prefix itself and the paths under it, e.g., "/logs" matches "/logs/1.log" but not "/logs2".
+// The matching policies are merged, and the fields of a longer prefix override the ones of a shorter prefix.
+func (sp *StoragePolicies) Match(fullpath string) *filer_pb.StoragePolicy {
+	if sp == nil {
+		return nil
+	}
+	var matched *filer_pb.StoragePolicy
+	for _, p := range sp.policies {
+		if !isUnderPathPrefix(fullpath, p.PathPrefix) {
+			continue
+		}
+		if matched == nil {
+			matched = &filer_pb.StoragePolicy{}
+		}
+		matched.PathPrefix = p.PathPrefix
+		if p.Collection != "" {
+			matched.Collection = p.Collection
+		}
+		if p.Replication != "" {
+			matched.Replication = p.Replication
+		}
+		if p.Ttl != "" {
+			matched.Ttl = p.Ttl
+		}
+		if p.ChunkSizeMb > 0 {
+			matched.ChunkSizeMb = p.ChunkSizeMb
+		}
+		if p.Dedup {
+			matched.Dedup = true
+		}
+		if p.Durability != "" {
+			matched.Durability = p.Durability
+		}
+	}
+	return matched
+}
+
+func isUnderPathPrefix(fullpath, pathPrefix string) bool {
+	pathPrefix = strings.TrimSuffix(pathPrefix, "/")
+	return fullpath == pathPrefix || strings.HasPrefix(fullpath, pathPrefix+"/")
+}
+
+// StoragePolicyTtlSec converts the ttl of the policy to seconds, as saved in the entry attributes
+func StoragePolicyTtlSec(ttl string) int32 {
+	if ttl == "" {
+		return 0
+	}
+	t, err := needle.ReadTTL(ttl)
+	if err != nil {
+		glog.Warningf("storage policy ttl %s: %v", ttl, err)
+		return 0
+	}
+	return int32(t.Minutes()) * 60
+}
+
+// storagePolicyHolder keeps the policies in effect, replaced when the policy entry changes
+type storagePolicyHolder struct {
+	sync.RWMutex
+	policies *StoragePolicies
+	// of the loaded policy entry, to tell whether it is changed
+	version uint64
+	mtime   time.Time
+}
+
+// loadStoragePolicies reads the policies from the store, and keeps reloading them in the background
+func (f *Filer) loadStoragePolicies(ctx context.Context) {
+	f.reloadStoragePolicies(ctx)
+	go func() {
+		for range time.Tick(storagePolicyReloadInterval) {
+			f.reloadStoragePolicies(ctx)
+		}
+	}()
+}
+
+// reloadStoragePolicies replaces the policies in effect if the policy entry is changed in the store
+func (f *Filer) reloadStoragePolicies(ctx context.Context) {
+	entry, err := f.FindEntry(ctx, StoragePolicyPath)
+	if err != nil && err != ErrNotFound {
+		glog.Errorf("load storage policies: %v", err)
+		return
+	}
+
+	f.storagePolicies.RLock()
+	unchanged := f.storagePolicies.policies == nil
+	if entry != nil {
+		unchanged = f.storagePolicies.policies != nil && entry.Version == f.storagePolicies.version && entry.Mtime.Equal(f.storagePolicies.mtime)
+	}
+	f.storagePolicies.RUnlock()
+
+	if !unchanged {
+		f.setStoragePolicies(entry)
+	}
+}
+
+func (f *Filer) setStoragePolicies(entry *Entry) {
+	var policies *StoragePolicies
+	var version uint64
+	var mtime time.Time
+	if entry != nil {
+		pb, err := LoadStoragePolicies(entry.Extended)
+		if err != nil {
+			glog.Errorf("load storage policies: %v", err)
+			return
+		}
+		policies = NewStoragePolicies(pb)
+		version, mtime = entry.Version, entry.Mtime
+		glog.V(0).Infof("%d storage policies in effect", len(pb.Policies))
+	} else {
+		glog.V(0).Infof("no storage policies in effect")
+	}
+	f.storagePolicies.Lock()
+	f.storagePolicies.policies = policies
+	f.storagePolicies.version, f.storagePolicies.mtime = version, mtime
+	f.storagePolicies.Unlock()
+}
+
+// MatchStoragePolicy returns the storage policy of the full path, or nil if none applies
+func (f *Filer) MatchStoragePolicy(fullpath string) *filer_pb.StoragePolicy {
+	f.storagePolicies.RLock()
+	defer f.storagePolicies.RUnlock()
+	return f.storagePolicies.policies.Match(fullpath)
+}
+
+// applyStoragePolicy sets the collection, replication and ttl of a new or overwritten file by its storage policy
+func (f *Filer) applyStoragePolicy(entry *Entry) {
+	if entry.IsDirectory() || entry.FullPath == StoragePolicyPath {
+		return
+	}
+	p := f.MatchStoragePolicy(string(entry.FullPath))
+	if p == nil {
+		return
+	}
+	if p.Collection != "" {
+		entry.Collection = p.Collection
+	}
+	if p.Replication != "" {
+		entry.Replication = p.Replication
+	}
+	if ttlSec := StoragePolicyTtlSec(p.Ttl); ttlSec > 0 {
+		entry.TtlSec = ttlSec
+	}
+}
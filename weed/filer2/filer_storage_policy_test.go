@@ -0,0 +1,57 @@
+package filer2
+
+import (
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func TestStoragePolicyMatch(t *testing.T) {
+
+	extended, err := SaveStoragePolicies(&filer_pb.StoragePolicies{
+		Policies: []*filer_pb.StoragePolicy{
+			{PathPrefix: "/logs/app/", Ttl: "7d", Durability: "fsync"},
+			{PathPrefix: "/logs/", Collection: "logs", Replication: "010", Ttl: "30d", ChunkSizeMb: 8, Dedup: true},
+			{PathPrefix: "/", Replication: "001"},
+			{PathPrefix: "/data", Collection: "data"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	pb, err := LoadStoragePolicies(extended)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	sp := NewStoragePolicies(pb)
+
+	tests := []struct {
+		fullpath string
+		expected *filer_pb.StoragePolicy
+	}{
+		{"/logs/app/1.log", &filer_pb.StoragePolicy{PathPrefix: "/logs/app/", Collection: "logs", Replication: "010", Ttl: "7d", ChunkSizeMb: 8, Dedup: true, Durability: "fsync"}},
+		{"/logs/1.log", &filer_pb.StoragePolicy{PathPrefix: "/logs/", Collection: "logs", Replication: "010", Ttl: "30d", ChunkSizeMb: 8, Dedup: true}},
+		{"/logs2/1.log", &filer_pb.StoragePolicy{PathPrefix: "/", Replication: "001"}},
+		{"/data/1.txt", &filer_pb.StoragePolicy{PathPrefix: "/data", Collection: "data", Replication: "001"}},
+		{"/data", &filer_pb.StoragePolicy{PathPrefix: "/data", Collection: "data", Replication: "001"}},
+		{"/database/1.txt", &filer_pb.StoragePolicy{PathPrefix: "/", Replication: "001"}},
+	}
+	for _, test := range tests {
+		if matched := sp.Match(test.fullpath); !equalStoragePolicy(matched, test.expected) {
+			t.Errorf("%s: unexpected policy %+v", test.fullpath, matched)
+		}
+	}
+
+	if NewStoragePolicies(nil).Match("/logs/1.log") != nil {
+		t.Errorf("unexpected policy without any policy")
+	}
+	if ttlSec := StoragePolicyTtlSec("30d"); ttlSec != 30*24*3600 {
+		t.Errorf("unexpected ttl %d seconds", ttlSec)
+	}
+
+}
+
+func equalStoragePolicy(a, b *filer_pb.StoragePolicy) bool {
+	return a.PathPrefix == b.PathPrefix && a.Collection == b.Collection && a.Replication == b.Replication &&
+		a.Ttl == b.Ttl && a.ChunkSizeMb == b.ChunkSizeMb && a.Dedup == b.Dedup && a.Durability == b.Durability
+}
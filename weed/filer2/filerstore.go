@@ -27,7 +27,15 @@ type FilerStore interface {
 	RollbackTransaction(ctx context.Context) error
 }
 
+// SnapshotFilerStore is implemented by stores that can read a consistent
+// point-in-time view of all entries while writes continue, used for online metadata backup.
+type SnapshotFilerStore interface {
+	// TraverseSnapshot visits every entry under dirPath, parent directories before their children.
+	TraverseSnapshot(ctx context.Context, dirPath FullPath, eachEntryFunc func(entry *Entry) error) error
+}
+
 var ErrNotFound = errors.New("filer: no entry is found in filer store")
+var ErrSnapshotNotSupported = errors.New("filer: filer store does not support consistent snapshots")
 
 type FilerStoreWrapper struct {
 	actualStore FilerStore
@@ -125,3 +133,21 @@ func (fsw *FilerStoreWrapper) CommitTransaction(ctx context.Context) error {
 func (fsw *FilerStoreWrapper) RollbackTransaction(ctx context.Context) error {
 	return fsw.actualStore.RollbackTransaction(ctx)
 }
+
+func (fsw *FilerStoreWrapper) TraverseSnapshot(ctx context.Context, dirPath FullPath, eachEntryFunc func(entry *Entry) error) error {
+	snapshotStore, ok := fsw.actualStore.(SnapshotFilerStore)
+	if !ok {
+		return ErrSnapshotNotSupported
+	}
+
+	stats.FilerStoreCounter.WithLabelValues(fsw.actualStore.GetName(), "snapshot").Inc()
+	start := time.Now()
+	defer func() {
+		stats.FilerStoreHistogram.WithLabelValues(fsw.actualStore.GetName(), "snapshot").Observe(time.Since(start).Seconds())
+	}()
+
+	return snapshotStore.TraverseSnapshot(ctx, dirPath, func(entry *Entry) error {
+		filer_pb.AfterEntryDeserialization(entry.Chunks)
+		return eachEntryFunc(entry)
+	})
+}
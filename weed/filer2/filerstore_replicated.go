@@ -0,0 +1,218 @@
+package filer2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chrislusf/raft"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"google.golang.org/grpc"
+)
+
+const (
+	StoreMutationInsert = "insert"
+	StoreMutationUpdate = "update"
+	StoreMutationDelete = "delete"
+)
+
+func init() {
+	raft.RegisterCommand(&StoreMutationCommand{})
+}
+
+// ReplicatedFilerStore keeps identical copies of an embedded filer store on a group of filers.
+// All mutations go through the raft log, and are applied to the local store on every filer.
+// Reads are served by the local store.
+// It is also the raft state machine, saving the local store as raft snapshots.
+type ReplicatedFilerStore struct {
+	actualStore          FilerStore
+	raftServer           raft.Server
+	grpcDialOption       grpc.DialOption
+	onChange             func(dirpath string)
+	loadingLocalSnapshot bool
+}
+
+func NewReplicatedFilerStore(store FilerStore, grpcDialOption grpc.DialOption) *ReplicatedFilerStore {
+	return &ReplicatedFilerStore{
+		actualStore:    store,
+		grpcDialOption: grpcDialOption,
+	}
+}
+
+func (store *ReplicatedFilerStore) SetRaftServer(raftServer raft.Server) {
+	store.raftServer = raftServer
+}
+
+func (store *ReplicatedFilerStore) GetName() string {
+	return store.actualStore.GetName()
+}
+
+func (store *ReplicatedFilerStore) Initialize(configuration util.Configuration) error {
+	return store.actualStore.Initialize(configuration)
+}
+
+func (store *ReplicatedFilerStore) InsertEntry(ctx context.Context, entry *Entry) error {
+	return store.mutateEntry(ctx, StoreMutationInsert, entry)
+}
+
+func (store *ReplicatedFilerStore) UpdateEntry(ctx context.Context, entry *Entry) error {
+	return store.mutateEntry(ctx, StoreMutationUpdate, entry)
+}
+
+func (store *ReplicatedFilerStore) FindEntry(ctx context.Context, fp FullPath) (entry *Entry, err error) {
+	return store.actualStore.FindEntry(ctx, fp)
+}
+
+func (store *ReplicatedFilerStore) DeleteEntry(ctx context.Context, fp FullPath) (err error) {
+	return store.Mutate(ctx, &StoreMutationCommand{
+		Op:       StoreMutationDelete,
+		FullPath: string(fp),
+	})
+}
+
+func (store *ReplicatedFilerStore) ListDirectoryEntries(ctx context.Context, dirPath FullPath, startFileName string, includeStartFile bool, limit int) ([]*Entry, error) {
+	return store.actualStore.ListDirectoryEntries(ctx, dirPath, startFileName, includeStartFile, limit)
+}
+
+func (store *ReplicatedFilerStore) BeginTransaction(ctx context.Context) (context.Context, error) {
+	return ctx, nil
+}
+
+func (store *ReplicatedFilerStore) CommitTransaction(ctx context.Context) error {
+	return nil
+}
+
+func (store *ReplicatedFilerStore) RollbackTransaction(ctx context.Context) error {
+	return nil
+}
+
+func (store *ReplicatedFilerStore) TraverseSnapshot(ctx context.Context, dirPath FullPath, eachEntryFunc func(entry *Entry) error) error {
+	snapshotStore, ok := store.actualStore.(SnapshotFilerStore)
+	if !ok {
+		return ErrSnapshotNotSupported
+	}
+	return snapshotStore.TraverseSnapshot(ctx, dirPath, eachEntryFunc)
+}
+
+func (store *ReplicatedFilerStore) mutateEntry(ctx context.Context, op string, entry *Entry) error {
+	data, err := entry.EncodeAttributesAndChunks()
+	if err != nil {
+		return fmt.Errorf("encoding %s %+v: %v", entry.FullPath, entry.Attr, err)
+	}
+	return store.Mutate(ctx, &StoreMutationCommand{
+		Op:       op,
+		FullPath: string(entry.FullPath),
+		Data:     data,
+	})
+}
+
+// Mutate commits the mutation to the raft log via the current leader,
+// and waits until it is applied to the local store.
+func (store *ReplicatedFilerStore) Mutate(ctx context.Context, command *StoreMutationCommand) error {
+
+	if store.raftServer == nil {
+		return fmt.Errorf("filer store replication is not started")
+	}
+
+	if store.raftServer.State() == raft.Leader {
+		if _, err := store.raftServer.Do(command); err != nil {
+			return fmt.Errorf("%s %s: %v", command.Op, command.FullPath, err)
+		}
+		return nil
+	}
+
+	leader := store.raftServer.Leader()
+	if leader == "" {
+		return fmt.Errorf("%s %s: no filer store leader", command.Op, command.FullPath)
+	}
+
+	var commitIndex uint64
+	err := util.WithCachedGrpcClient(ctx, func(grpcConnection *grpc.ClientConn) error {
+		client := filer_pb.NewSeaweedFilerClient(grpcConnection)
+		resp, err := client.ApplyStoreMutation(ctx, &filer_pb.ApplyStoreMutationRequest{
+			Op:       command.Op,
+			FullPath: command.FullPath,
+			Data:     command.Data,
+		})
+		if err != nil {
+			return err
+		}
+		commitIndex = resp.CommitIndex
+		return nil
+	}, util.ServerToGrpcAddress(leader), store.grpcDialOption)
+	if err != nil {
+		return fmt.Errorf("forward %s %s to leader %s: %v", command.Op, command.FullPath, leader, err)
+	}
+
+	return store.waitForCommitIndex(ctx, commitIndex)
+}
+
+// CommitIndex returns the latest raft log index applied to the local store
+func (store *ReplicatedFilerStore) CommitIndex() uint64 {
+	return store.raftServer.CommitIndex()
+}
+
+// waitForCommitIndex makes the changes forwarded to the leader visible to reads on this filer
+func (store *ReplicatedFilerStore) waitForCommitIndex(ctx context.Context, commitIndex uint64) error {
+	for store.raftServer.CommitIndex() < commitIndex {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	return nil
+}
+
+func (store *ReplicatedFilerStore) applyMutation(command *StoreMutationCommand) (err error) {
+
+	ctx := context.Background()
+	fullpath := FullPath(command.FullPath)
+
+	switch command.Op {
+	case StoreMutationInsert, StoreMutationUpdate:
+		entry := &Entry{
+			FullPath: fullpath,
+		}
+		if err = entry.DecodeAttributesAndChunks(command.Data); err != nil {
+			return err
+		}
+		if command.Op == StoreMutationInsert {
+			err = store.actualStore.InsertEntry(ctx, entry)
+		} else {
+			err = store.actualStore.UpdateEntry(ctx, entry)
+		}
+	case StoreMutationDelete:
+		err = store.actualStore.DeleteEntry(ctx, fullpath)
+	default:
+		err = fmt.Errorf("unknown filer store mutation %s", command.Op)
+	}
+
+	if err != nil {
+		glog.Errorf("apply %s %s: %v", command.Op, command.FullPath, err)
+		return err
+	}
+
+	if store.onChange != nil {
+		store.onChange(command.FullPath)
+	}
+
+	return nil
+}
+
+type StoreMutationCommand struct {
+	Op       string `json:"op"`
+	FullPath string `json:"path"`
+	Data     []byte `json:"data,omitempty"`
+}
+
+func (c *StoreMutationCommand) CommandName() string {
+	return "FilerStoreMutation"
+}
+
+func (c *StoreMutationCommand) Apply(server raft.Server) (interface{}, error) {
+	store := server.Context().(*ReplicatedFilerStore)
+	return nil, store.applyMutation(c)
+}
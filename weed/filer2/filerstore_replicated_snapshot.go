@@ -0,0 +1,172 @@
+package filer2
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// The raft snapshot of the replicated store is a gzipped list of all entries,
+// each as the uvarint length prefixed full path and encoded attributes and chunks.
+// The snapshot lets the raft log be compacted, and brings a lagging peer up to date.
+
+// Save dumps the local store as the raft snapshot state.
+// The mutations applied during the dump are replayed from the raft log after the snapshot,
+// which is harmless since replaying a mutation again gives the same entry.
+func (store *ReplicatedFilerStore) Save() ([]byte, error) {
+
+	var buf bytes.Buffer
+	count := 0
+	err := store.traverse(context.Background(), "/", func(entry *Entry) error {
+		data, err := entry.EncodeAttributesAndChunks()
+		if err != nil {
+			return fmt.Errorf("encoding %s: %v", entry.FullPath, err)
+		}
+		writeSnapshotField(&buf, []byte(entry.FullPath))
+		writeSnapshotField(&buf, data)
+		count++
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("save filer store snapshot: %v", err)
+	}
+
+	state, err := util.GzipData(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("compress filer store snapshot: %v", err)
+	}
+	glog.V(0).Infof("saved filer store snapshot: %d entries, %d bytes", count, len(state))
+
+	return state, nil
+}
+
+// Recovery replaces the local store with the snapshot state sent by the leader.
+// When loading the local snapshot on start, the local store is already at least as new as the snapshot, and is kept as is.
+func (store *ReplicatedFilerStore) Recovery(state []byte) error {
+
+	if store.loadingLocalSnapshot {
+		return nil
+	}
+
+	data, err := util.UnGzipData(state)
+	if err != nil {
+		return fmt.Errorf("decompress filer store snapshot: %v", err)
+	}
+
+	ctx := context.Background()
+	snapshotPaths := make(map[FullPath]bool)
+	reader := bufio.NewReader(bytes.NewReader(data))
+	for {
+		fullpath, err := readSnapshotField(reader)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("read filer store snapshot: %v", err)
+		}
+		encoded, err := readSnapshotField(reader)
+		if err != nil {
+			return fmt.Errorf("read filer store snapshot %s: %v", fullpath, err)
+		}
+		entry := &Entry{FullPath: FullPath(fullpath)}
+		if err = entry.DecodeAttributesAndChunks(encoded); err != nil {
+			return fmt.Errorf("decode filer store snapshot %s: %v", fullpath, err)
+		}
+		if err = store.actualStore.InsertEntry(ctx, entry); err != nil {
+			return fmt.Errorf("recover %s: %v", fullpath, err)
+		}
+		snapshotPaths[entry.FullPath] = true
+		store.changed(entry.FullPath)
+	}
+
+	// delete the local entries not in the snapshot
+	var deletedPaths []FullPath
+	if err = store.traverse(ctx, "/", func(entry *Entry) error {
+		if !snapshotPaths[entry.FullPath] {
+			deletedPaths = append(deletedPaths, entry.FullPath)
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("list local filer store: %v", err)
+	}
+	for _, fullpath := range deletedPaths {
+		if err = store.actualStore.DeleteEntry(ctx, fullpath); err != nil {
+			return fmt.Errorf("recover deleted %s: %v", fullpath, err)
+		}
+		store.changed(fullpath)
+	}
+
+	glog.V(0).Infof("recovered filer store snapshot: %d entries, %d deleted", len(snapshotPaths), len(deletedPaths))
+
+	return nil
+}
+
+// LoadLocalSnapshot loads the latest local raft snapshot, before the raft server is started
+func (store *ReplicatedFilerStore) LoadLocalSnapshot(loadFn func() error) error {
+	store.loadingLocalSnapshot = true
+	defer func() {
+		store.loadingLocalSnapshot = false
+	}()
+	return loadFn()
+}
+
+// traverse visits all entries of the local store under the directory, from a consistent view if the store supports it
+func (store *ReplicatedFilerStore) traverse(ctx context.Context, dirPath FullPath, eachEntryFunc func(entry *Entry) error) error {
+
+	if snapshotStore, ok := store.actualStore.(SnapshotFilerStore); ok {
+		return snapshotStore.TraverseSnapshot(ctx, dirPath, eachEntryFunc)
+	}
+
+	lastFileName := ""
+	for {
+		entries, err := store.actualStore.ListDirectoryEntries(ctx, dirPath, lastFileName, false, 1024)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if err = eachEntryFunc(entry); err != nil {
+				return err
+			}
+			if entry.IsDirectory() {
+				if err = store.traverse(ctx, entry.FullPath, eachEntryFunc); err != nil {
+					return err
+				}
+			}
+			lastFileName = entry.Name()
+		}
+		if len(entries) < 1024 {
+			return nil
+		}
+	}
+}
+
+func (store *ReplicatedFilerStore) changed(fullpath FullPath) {
+	if store.onChange != nil {
+		store.onChange(string(fullpath))
+	}
+}
+
+func writeSnapshotField(buf *bytes.Buffer, data []byte) {
+	var lenBuf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(lenBuf[:], uint64(len(data)))
+	buf.Write(lenBuf[:n])
+	buf.Write(data)
+}
+
+func readSnapshotField(reader *bufio.Reader) ([]byte, error) {
+	length, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, length)
+	if _, err = io.ReadFull(reader, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
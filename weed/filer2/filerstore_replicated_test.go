@@ -0,0 +1,67 @@
+package filer2_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/filer2/memdb"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func TestReplicatedFilerStoreSnapshot(t *testing.T) {
+	ctx := context.Background()
+
+	newStore := func(paths ...string) (*memdb.MemDbStore, *filer2.ReplicatedFilerStore) {
+		actualStore := &memdb.MemDbStore{}
+		actualStore.Initialize(nil)
+		for _, p := range paths {
+			dir, _ := filer2.FullPath(p).DirAndName()
+			actualStore.InsertEntry(ctx, &filer2.Entry{
+				FullPath: filer2.FullPath(dir),
+				Attr:     filer2.Attr{Mode: os.ModeDir | 0755},
+			})
+			actualStore.InsertEntry(ctx, &filer2.Entry{
+				FullPath: filer2.FullPath(p),
+				Attr:     filer2.Attr{Mode: 0644},
+				Chunks:   []*filer_pb.FileChunk{{FileId: "1,abc" + p, Size: 10}},
+			})
+		}
+		return actualStore, filer2.NewReplicatedFilerStore(actualStore, nil)
+	}
+
+	_, leader := newStore("/a/1", "/a/2", "/b/3")
+	state, err := leader.Save()
+	if err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	// a lagging peer has an old file, and misses the new ones
+	peerStore, peer := newStore("/a/1", "/c/old")
+	if err = peer.Recovery(state); err != nil {
+		t.Fatalf("recovery: %v", err)
+	}
+	for _, p := range []string{"/a/1", "/a/2", "/b/3"} {
+		entry, err := peerStore.FindEntry(ctx, filer2.FullPath(p))
+		if err != nil {
+			t.Errorf("find %s: %v", p, err)
+			continue
+		}
+		if entry.Chunks[0].FileId != "1,abc"+p {
+			t.Errorf("unexpected %s chunks %v", p, entry.Chunks)
+		}
+	}
+	if _, err = peerStore.FindEntry(ctx, "/c/old"); err != filer2.ErrNotFound {
+		t.Errorf("the entry not in the snapshot is kept: %v", err)
+	}
+
+	// the local store is kept when loading the local snapshot on start
+	localStore, local := newStore("/d/newer")
+	if err = local.LoadLocalSnapshot(func() error { return local.Recovery(state) }); err != nil {
+		t.Fatalf("load local snapshot: %v", err)
+	}
+	if _, err = localStore.FindEntry(ctx, "/d/newer"); err != nil {
+		t.Errorf("the local store is changed: %v", err)
+	}
+}
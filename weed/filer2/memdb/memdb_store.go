@@ -65,6 +65,8 @@ func (store *MemDbStore) UpdateEntry(ctx context.Context, entry *filer2.Entry) (
 }
 
 func (store *MemDbStore) FindEntry(ctx context.Context, fullpath filer2.FullPath) (entry *filer2.Entry, err error) {
+	store.treeLock.Lock()
+	defer store.treeLock.Unlock()
 	item := store.tree.Get(entryItem{&filer2.Entry{FullPath: fullpath}})
 	if item == nil {
 		return nil, filer2.ErrNotFound
@@ -87,6 +89,9 @@ func (store *MemDbStore) ListDirectoryEntries(ctx context.Context, fullpath file
 		startFrom = startFrom + "/" + startFileName
 	}
 
+	store.treeLock.Lock()
+	defer store.treeLock.Unlock()
+
 	store.tree.AscendGreaterOrEqual(entryItem{&filer2.Entry{FullPath: filer2.FullPath(startFrom)}},
 		func(item btree.Item) bool {
 			if limit <= 0 {
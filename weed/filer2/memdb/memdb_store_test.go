@@ -2,8 +2,11 @@ package memdb
 
 import (
 	"context"
+	"fmt"
 	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 	"testing"
+	"time"
 )
 
 func TestCreateAndFind(t *testing.T) {
@@ -147,3 +150,110 @@ func TestCreateFileAndList(t *testing.T) {
 	}
 
 }
+
+func TestExpiredEntries(t *testing.T) {
+	filer := filer2.NewFiler(nil, nil)
+	store := &MemDbStore{}
+	store.Initialize(nil)
+	filer.SetStore(store)
+	filer.DisableDirectoryCache()
+
+	ctx := context.Background()
+
+	now := time.Now()
+	for i, crtime := range []time.Time{now.Add(-time.Hour), now, now.Add(-time.Hour), now} {
+		entry := &filer2.Entry{
+			FullPath: filer2.NewFullPath("/home/chris", fmt.Sprintf("file%d", i)),
+			Attr: filer2.Attr{
+				Mode:   0440,
+				Crtime: crtime,
+				TtlSec: 60,
+			},
+		}
+		if err := filer.CreateEntry(ctx, entry); err != nil {
+			t.Fatalf("create entry %v: %v", entry.FullPath, err)
+		}
+	}
+
+	if _, err := filer.FindEntry(ctx, "/home/chris/file0"); err != filer2.ErrNotFound {
+		t.Errorf("expired entry is found: %v", err)
+	}
+	if _, err := filer.FindEntry(ctx, "/home/chris/file1"); err != nil {
+		t.Errorf("find entry: %v", err)
+	}
+
+	// the expired entries are skipped, and the limit is still filled
+	entries, _ := filer.ListDirectoryEntries(ctx, "/home/chris", "", false, 2)
+	if len(entries) != 2 || entries[0].Name() != "file1" || entries[1].Name() != "file3" {
+		t.Errorf("unexpected entries %v", entries)
+	}
+
+	// purged from the store in the background
+	var err error
+	for i := 0; i < 100; i++ {
+		if _, err = store.FindEntry(ctx, "/home/chris/file2"); err == filer2.ErrNotFound {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err != filer2.ErrNotFound {
+		t.Errorf("expired entry is not purged: %v", err)
+	}
+
+	// an expired entry is replaced by a new one
+	entry := &filer2.Entry{
+		FullPath: "/home/chris/file4",
+		Attr:     filer2.Attr{Mode: 0440, Crtime: now.Add(-time.Hour), TtlSec: 60},
+	}
+	if err = filer.CreateEntry(ctx, entry); err != nil {
+		t.Fatalf("create entry %v: %v", entry.FullPath, err)
+	}
+	renewed := &filer2.Entry{
+		FullPath: entry.FullPath,
+		Attr:     filer2.Attr{Mode: 0440, Crtime: now, TtlSec: 60},
+	}
+	if err = filer.CreateEntry(ctx, renewed); err != nil {
+		t.Fatalf("renew entry %v: %v", entry.FullPath, err)
+	}
+	if _, err = filer.FindEntry(ctx, entry.FullPath); err != nil {
+		t.Errorf("renewed entry: %v", err)
+	}
+}
+
+func TestOverwriteUnderTtl(t *testing.T) {
+	filer := filer2.NewFiler(nil, nil)
+	store := &MemDbStore{}
+	store.Initialize(nil)
+	filer.SetStore(store)
+	filer.DisableDirectoryCache()
+
+	ctx := context.Background()
+
+	// a file written long ago, without a ttl
+	crtime := time.Now().Add(-2 * time.Hour)
+	entry := &filer2.Entry{
+		FullPath: "/home/chris/old.log",
+		Attr:     filer2.Attr{Mode: 0440, Crtime: crtime},
+		Chunks:   []*filer_pb.FileChunk{{FileId: "1,01", Size: 1}},
+	}
+	if err := filer.CreateEntry(ctx, entry); err != nil {
+		t.Fatalf("create entry %v: %v", entry.FullPath, err)
+	}
+
+	// overwritten under a ttl, keeping the original creation time
+	overwritten := &filer2.Entry{
+		FullPath: entry.FullPath,
+		Attr:     filer2.Attr{Mode: 0440, Crtime: crtime, TtlSec: 3600},
+		Chunks:   []*filer_pb.FileChunk{{FileId: "2,02", Size: 2}},
+	}
+	if err := filer.CreateEntry(ctx, overwritten); err != nil {
+		t.Fatalf("overwrite entry %v: %v", entry.FullPath, err)
+	}
+	found, err := filer.FindEntry(ctx, entry.FullPath)
+	if err != nil {
+		t.Fatalf("overwritten entry: %v", err)
+	}
+	if expireAt := found.ExpireAt(); expireAt.Before(time.Now().Add(59 * time.Minute)) {
+		t.Errorf("overwritten entry expires at %v", expireAt)
+	}
+}
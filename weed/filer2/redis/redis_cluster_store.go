@@ -4,6 +4,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/filer2"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/go-redis/redis"
+	"time"
 )
 
 func init() {
@@ -22,13 +23,15 @@ func (store *RedisClusterStore) Initialize(configuration util.Configuration) (er
 	return store.initialize(
 		configuration.GetStringSlice("addresses"),
 		configuration.GetString("password"),
+		configuration.GetInt("ttl_grace_seconds"),
 	)
 }
 
-func (store *RedisClusterStore) initialize(addresses []string, password string) (err error) {
+func (store *RedisClusterStore) initialize(addresses []string, password string, ttlGraceSeconds int) (err error) {
 	store.Client = redis.NewClusterClient(&redis.ClusterOptions{
 		Addrs:    addresses,
 		Password: password,
 	})
+	store.TtlGrace = time.Duration(ttlGraceSeconds) * time.Second
 	return
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/filer2"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/go-redis/redis"
+	"time"
 )
 
 func init() {
@@ -23,14 +24,16 @@ func (store *RedisStore) Initialize(configuration util.Configuration) (err error
 		configuration.GetString("address"),
 		configuration.GetString("password"),
 		configuration.GetInt("database"),
+		configuration.GetInt("ttl_grace_seconds"),
 	)
 }
 
-func (store *RedisStore) initialize(hostPort string, password string, database int) (err error) {
+func (store *RedisStore) initialize(hostPort string, password string, database int, ttlGraceSeconds int) (err error) {
 	store.Client = redis.NewClient(&redis.Options{
 		Addr:     hostPort,
 		Password: password,
 		DB:       database,
 	})
+	store.TtlGrace = time.Duration(ttlGraceSeconds) * time.Second
 	return
 }
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"github.com/chrislusf/seaweedfs/weed/filer2"
-	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/go-redis/redis"
 	"sort"
 	"strings"
@@ -17,6 +16,8 @@ const (
 
 type UniversalRedisStore struct {
 	Client redis.UniversalClient
+	// TtlGrace, if positive, lets redis expire the entries this long after their ttl, in case the filer does not purge them
+	TtlGrace time.Duration
 }
 
 func (store *UniversalRedisStore) BeginTransaction(ctx context.Context) (context.Context, error) {
@@ -36,7 +37,8 @@ func (store *UniversalRedisStore) InsertEntry(ctx context.Context, entry *filer2
 		return fmt.Errorf("encoding %s %+v: %v", entry.FullPath, entry.Attr, err)
 	}
 
-	_, err = store.Client.Set(string(entry.FullPath), value, time.Duration(entry.TtlSec)*time.Second).Result()
+	// expired by redis only after the grace, since the filer expires the entries with the notifications and the chunk deletion
+	_, err = store.Client.Set(string(entry.FullPath), value, entry.StoreTtl(store.TtlGrace)).Result()
 
 	if err != nil {
 		return fmt.Errorf("persisting %s : %v", entry.FullPath, err)
@@ -129,23 +131,24 @@ func (store *UniversalRedisStore) ListDirectoryEntries(ctx context.Context, full
 		return strings.Compare(members[i], members[j]) < 0
 	})
 
-	// limit
-	if limit < len(members) {
-		members = members[:limit]
-	}
-
-	// fetch entry meta
+	// fetch entry meta, until the limit is filled
 	for _, fileName := range members {
+		if len(entries) >= limit {
+			break
+		}
 		path := filer2.NewFullPath(string(fullpath), fileName)
 		entry, err := store.FindEntry(ctx, path)
-		if err != nil {
-			glog.V(0).Infof("list %s : %v", path, err)
+		if err == filer2.ErrNotFound {
+			// deleted since listed, or expired by redis
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("list %s : %v", path, err)
 		} else {
 			entries = append(entries, entry)
 		}
 	}
 
-	return entries, err
+	return entries, nil
 }
 
 func genDirectoryListKey(dir string) (dirList string) {
@@ -11,6 +11,12 @@ import (
 
 func StreamContent(masterClient *wdclient.MasterClient, w io.Writer, chunks []*filer_pb.FileChunk, offset int64, size int) error {
 
+	chunks, _, err := ResolveChunkManifest(masterClient.LookupFileId, chunks, offset, offset+int64(size))
+	if err != nil {
+		glog.V(1).Infof("resolve manifest chunks failed, err: %v", err)
+		return err
+	}
+
 	chunkViews := ViewFromChunks(chunks, offset, size)
 
 	fileId2Url := make(map[string]string)
@@ -27,9 +33,14 @@ func StreamContent(masterClient *wdclient.MasterClient, w io.Writer, chunks []*f
 
 	for _, chunkView := range chunkViews {
 		urlString := fileId2Url[chunkView.FileId]
+		verifier := NewChunkViewVerifier(chunkView)
 		_, err := util.ReadUrlAsStream(urlString, chunkView.Offset, int(chunkView.Size), func(data []byte) {
 			w.Write(data)
+			verifier.Write(data)
 		})
+		if err == nil {
+			err = verifier.Verify()
+		}
 		if err != nil {
 			glog.V(1).Infof("read %s failed, err: %v", chunkView.FileId, err)
 			return err
@@ -0,0 +1,300 @@
+package filesys
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/karlseguin/ccache"
+)
+
+// ChunkCache keeps recently read chunks in memory, and optionally on local disk.
+// Chunks are keyed by file id. The content of a file id never changes,
+// so the cached chunks only need to be removed when they are deleted.
+type ChunkCache struct {
+	memCache        *ccache.Cache
+	diskCache       *onDiskChunkCache
+	maxChunkSize    int64
+	maxMemChunkSize int64 // larger chunks are only cached on disk
+}
+
+type cachedChunk []byte
+
+func (c cachedChunk) Size() int64 {
+	return int64(len(c))
+}
+
+// NewChunkCache creates a chunk cache. A zero size limit disables the tier.
+func NewChunkCache(memorySizeLimit int64, dir string, diskSizeLimit int64) (*ChunkCache, error) {
+	c := &ChunkCache{}
+	if memorySizeLimit > 0 {
+		c.memCache = ccache.New(ccache.Configure().MaxSize(memorySizeLimit).ItemsToPrune(8))
+		c.maxChunkSize = memorySizeLimit / 8
+		c.maxMemChunkSize = c.maxChunkSize
+	}
+	if diskSizeLimit > 0 {
+		diskCache, err := newOnDiskChunkCache(dir, diskSizeLimit)
+		if err != nil {
+			return nil, err
+		}
+		c.diskCache = diskCache
+		if diskSizeLimit/8 > c.maxChunkSize {
+			c.maxChunkSize = diskSizeLimit / 8
+		}
+	}
+	return c, nil
+}
+
+// IsCacheable tells whether a chunk of this size is worth reading fully into the cache
+func (c *ChunkCache) IsCacheable(size uint64) bool {
+	return c != nil && int64(size) <= c.maxChunkSize
+}
+
+// GetChunk returns the chunk content, or nil if not cached
+func (c *ChunkCache) GetChunk(fileId string) []byte {
+	if c == nil {
+		return nil
+	}
+	if c.memCache != nil {
+		if item := c.memCache.Get(fileId); item != nil {
+			return item.Value().(cachedChunk)
+		}
+	}
+	if c.diskCache != nil {
+		if data := c.diskCache.get(fileId); data != nil {
+			c.setMemChunk(fileId, data)
+			return data
+		}
+	}
+	return nil
+}
+
+// SetChunk caches the content of a full chunk, which must not be changed afterwards
+func (c *ChunkCache) SetChunk(fileId string, data []byte) {
+	if c == nil {
+		return
+	}
+	c.setMemChunk(fileId, data)
+	if c.diskCache != nil {
+		if err := c.diskCache.set(fileId, data); err != nil {
+			glog.V(0).Infof("cache chunk %s on disk: %v", fileId, err)
+		}
+	}
+}
+
+func (c *ChunkCache) setMemChunk(fileId string, data []byte) {
+	if c.memCache != nil && int64(len(data)) <= c.maxMemChunkSize {
+		c.memCache.Set(fileId, cachedChunk(data), time.Hour)
+	}
+}
+
+// DeleteChunks removes the chunks, e.g., when they are deleted or replaced
+func (c *ChunkCache) DeleteChunks(fileIds []string) {
+	if c == nil {
+		return
+	}
+	for _, fileId := range fileIds {
+		if c.memCache != nil {
+			c.memCache.Delete(fileId)
+		}
+		if c.diskCache != nil {
+			c.diskCache.delete(fileId)
+		}
+	}
+}
+
+// fetchChunks reads the full chunks, and adds them to the chunk cache
+func (wfs *WFS) fetchChunks(ctx context.Context, fullpath string, chunks []*filer_pb.FileChunk) (fetched map[string][]byte, err error) {
+
+	var chunkViews []*filer2.ChunkView
+	var totalSize int64
+	for _, chunk := range chunks {
+		chunkViews = append(chunkViews, &filer2.ChunkView{
+			FileId:      chunk.GetFileIdString(),
+			Offset:      0,
+			Size:        chunk.Size,
+			LogicOffset: totalSize,
+			IsFullChunk: true,
+			Md5:         chunk.Md5,
+		})
+		totalSize += int64(chunk.Size)
+	}
+
+	buff := make([]byte, totalSize)
+	n, err := filer2.ReadIntoBuffer(ctx, wfs, fullpath, buff, chunkViews, 0)
+	if err != nil {
+		return nil, err
+	}
+	if n != totalSize {
+		return nil, fmt.Errorf("read %d bytes, expected %d", n, totalSize)
+	}
+
+	fetched = make(map[string][]byte)
+	for _, chunkView := range chunkViews {
+		// copy out each chunk, so that the cache does not hold on to the whole buffer
+		data := make([]byte, chunkView.Size)
+		copy(data, buff[chunkView.LogicOffset:])
+		fetched[chunkView.FileId] = data
+		wfs.chunkCache.SetChunk(chunkView.FileId, data)
+	}
+
+	return fetched, nil
+}
+
+// onDiskChunkCache stores each chunk as one file in a directory, and removes the least recently used ones beyond the size limit
+type onDiskChunkCache struct {
+	dir       string
+	sizeLimit int64
+
+	sync.Mutex
+	usedSize int64
+	lru      *list.List // of *onDiskChunk, most recently used in front
+	chunks   map[string]*list.Element
+}
+
+type onDiskChunk struct {
+	fileId string
+	size   int64
+}
+
+func newOnDiskChunkCache(dir string, sizeLimit int64) (*onDiskChunkCache, error) {
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("create chunk cache dir %s: %v", dir, err)
+	}
+
+	c := &onDiskChunkCache{
+		dir:       dir,
+		sizeLimit: sizeLimit,
+		lru:       list.New(),
+		chunks:    make(map[string]*list.Element),
+	}
+
+	// pick up the chunks cached by the previous mount, in the order of last access
+	fileInfos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read chunk cache dir %s: %v", dir, err)
+	}
+	sort.Slice(fileInfos, func(i, j int) bool {
+		return fileInfos[i].ModTime().After(fileInfos[j].ModTime())
+	})
+	for _, fileInfo := range fileInfos {
+		if fileInfo.IsDir() || strings.HasSuffix(fileInfo.Name(), ".tmp") {
+			os.Remove(filepath.Join(dir, fileInfo.Name()))
+			continue
+		}
+		fileId := strings.Replace(fileInfo.Name(), "_", ",", 1)
+		c.chunks[fileId] = c.lru.PushBack(&onDiskChunk{fileId: fileId, size: fileInfo.Size()})
+		c.usedSize += fileInfo.Size()
+	}
+	c.evict()
+
+	glog.V(0).Infof("chunk cache %s: %d chunks, %d bytes", dir, len(c.chunks), c.usedSize)
+
+	return c, nil
+}
+
+func (c *onDiskChunkCache) fileName(fileId string) string {
+	return filepath.Join(c.dir, strings.Replace(fileId, ",", "_", 1))
+}
+
+func (c *onDiskChunkCache) get(fileId string) []byte {
+
+	c.Lock()
+	element, found := c.chunks[fileId]
+	if found {
+		c.lru.MoveToFront(element)
+	}
+	c.Unlock()
+
+	if !found {
+		return nil
+	}
+
+	data, err := ioutil.ReadFile(c.fileName(fileId))
+	if err != nil || int64(len(data)) != element.Value.(*onDiskChunk).size {
+		glog.V(0).Infof("read cached chunk %s: %v", fileId, err)
+		c.delete(fileId)
+		return nil
+	}
+
+	// the modification time keeps the order of last access for the next mount
+	now := time.Now()
+	os.Chtimes(c.fileName(fileId), now, now)
+
+	return data
+}
+
+func (c *onDiskChunkCache) set(fileId string, data []byte) error {
+
+	if int64(len(data)) > c.sizeLimit {
+		return nil
+	}
+
+	c.Lock()
+	_, found := c.chunks[fileId]
+	c.Unlock()
+	if found {
+		return nil
+	}
+
+	// write to a temp file first, so that a crash does not leave a partial chunk behind
+	tmpFileName := c.fileName(fileId) + ".tmp"
+	if err := ioutil.WriteFile(tmpFileName, data, 0644); err != nil {
+		os.Remove(tmpFileName)
+		return err
+	}
+	if err := os.Rename(tmpFileName, c.fileName(fileId)); err != nil {
+		os.Remove(tmpFileName)
+		return err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	if _, found = c.chunks[fileId]; !found {
+		c.chunks[fileId] = c.lru.PushFront(&onDiskChunk{fileId: fileId, size: int64(len(data))})
+		c.usedSize += int64(len(data))
+	}
+	c.evict()
+
+	return nil
+}
+
+func (c *onDiskChunkCache) delete(fileId string) {
+	c.Lock()
+	defer c.Unlock()
+
+	if element, found := c.chunks[fileId]; found {
+		c.remove(element)
+	}
+}
+
+// evict removes the least recently used chunks until the cache fits in the size limit
+func (c *onDiskChunkCache) evict() {
+	for c.usedSize > c.sizeLimit {
+		element := c.lru.Back()
+		if element == nil {
+			return
+		}
+		c.remove(element)
+	}
+}
+
+func (c *onDiskChunkCache) remove(element *list.Element) {
+	chunk := c.lru.Remove(element).(*onDiskChunk)
+	delete(c.chunks, chunk.fileId)
+	c.usedSize -= chunk.size
+	if err := os.Remove(c.fileName(chunk.fileId)); err != nil && !os.IsNotExist(err) {
+		glog.V(0).Infof("remove cached chunk %s: %v", chunk.fileId, err)
+	}
+}
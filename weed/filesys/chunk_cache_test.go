@@ -0,0 +1,125 @@
+package filesys
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestOnDiskChunkCache(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "chunk_cache")
+	if err != nil {
+		t.Fatalf("temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	cache, err := NewChunkCache(0, dir, 1000)
+	if err != nil {
+		t.Fatalf("new chunk cache: %v", err)
+	}
+
+	for i := 0; i < 5; i++ {
+		cache.SetChunk(fmt.Sprintf("%d,0123", i), bytes.Repeat([]byte{byte(i)}, 300))
+	}
+
+	// only the 3 most recently added chunks fit
+	for i := 0; i < 5; i++ {
+		data := cache.GetChunk(fmt.Sprintf("%d,0123", i))
+		if i < 2 && data != nil {
+			t.Errorf("chunk %d should be evicted", i)
+		}
+		if i >= 2 && !bytes.Equal(data, bytes.Repeat([]byte{byte(i)}, 300)) {
+			t.Errorf("chunk %d: unexpected data %v", i, data)
+		}
+	}
+
+	cache.DeleteChunks([]string{"3,0123"})
+	if data := cache.GetChunk("3,0123"); data != nil {
+		t.Errorf("chunk 3 should be deleted")
+	}
+
+	// the chunks are still there after a restart
+	cache, err = NewChunkCache(0, dir, 1000)
+	if err != nil {
+		t.Fatalf("reopen chunk cache: %v", err)
+	}
+	if data := cache.GetChunk("4,0123"); !bytes.Equal(data, bytes.Repeat([]byte{4}, 300)) {
+		t.Errorf("chunk 4 is lost after reopening: %v", data)
+	}
+	if data := cache.GetChunk("3,0123"); data != nil {
+		t.Errorf("chunk 3 is back after reopening")
+	}
+
+}
+
+func TestLargeChunkOnlyOnDisk(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "chunk_cache")
+	if err != nil {
+		t.Fatalf("temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	cache, err := NewChunkCache(1024, dir, 8000)
+	if err != nil {
+		t.Fatalf("new chunk cache: %v", err)
+	}
+	if !cache.IsCacheable(1000) {
+		t.Errorf("chunks up to the disk limit should be cacheable")
+	}
+
+	cache.SetChunk("1,0123", bytes.Repeat([]byte{1}, 1000))
+	if cache.memCache.Get("1,0123") != nil {
+		t.Errorf("large chunk is cached in memory")
+	}
+	if data := cache.GetChunk("1,0123"); !bytes.Equal(data, bytes.Repeat([]byte{1}, 1000)) {
+		t.Errorf("large chunk is not cached on disk: %v", data)
+	}
+	if cache.memCache.Get("1,0123") != nil {
+		t.Errorf("large chunk read from disk is cached in memory")
+	}
+
+	cache.SetChunk("2,0123", []byte("abc"))
+	if cache.memCache.Get("2,0123") == nil {
+		t.Errorf("small chunk is not cached in memory")
+	}
+
+}
+
+func TestMemoryChunkCache(t *testing.T) {
+
+	cache, err := NewChunkCache(1024, "", 0)
+	if err != nil {
+		t.Fatalf("new chunk cache: %v", err)
+	}
+
+	if !cache.IsCacheable(128) || cache.IsCacheable(129) {
+		t.Errorf("unexpected max chunk size %d", cache.maxChunkSize)
+	}
+
+	cache.SetChunk("1,0123", []byte("abc"))
+	if data := cache.GetChunk("1,0123"); string(data) != "abc" {
+		t.Errorf("unexpected data %s", data)
+	}
+
+	cache.DeleteChunks([]string{"1,0123"})
+	if data := cache.GetChunk("1,0123"); data != nil {
+		t.Errorf("chunk should be deleted")
+	}
+
+	// a chunk too large for the memory is not cached
+	cache.SetChunk("2,0123", make([]byte, 129))
+	if data := cache.GetChunk("2,0123"); data != nil {
+		t.Errorf("large chunk is cached in memory")
+	}
+
+	var disabled *ChunkCache
+	disabled.SetChunk("1,0123", []byte("abc"))
+	if disabled.GetChunk("1,0123") != nil || disabled.IsCacheable(1) {
+		t.Errorf("nil chunk cache should cache nothing")
+	}
+
+}
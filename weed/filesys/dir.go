@@ -38,15 +38,20 @@ func (dir *Dir) Attr(ctx context.Context, attr *fuse.Attr) error {
 		return nil
 	}
 
+	parentDir, name := filer2.FullPath(dir.Path).DirAndName()
+	cachedEntry, _ := dir.wfs.metaCache.LookupEntry(parentDir, name)
+
 	item := dir.wfs.listDirectoryEntriesCache.Get(dir.Path)
-	if item != nil && !item.Expired() {
-		entry := item.Value().(*filer_pb.Entry)
+	if cachedEntry != nil || item != nil && !item.Expired() {
+		entry := cachedEntry
+		if entry == nil {
+			entry = item.Value().(*filer_pb.Entry)
+		}
 
 		attr.Mtime = time.Unix(entry.Attributes.Mtime, 0)
 		attr.Ctime = time.Unix(entry.Attributes.Crtime, 0)
 		attr.Mode = os.FileMode(entry.Attributes.FileMode)
-		attr.Gid = entry.Attributes.Gid
-		attr.Uid = entry.Attributes.Uid
+		attr.Uid, attr.Gid = dir.wfs.localOwner(entry.Attributes)
 
 		return nil
 	}
@@ -64,8 +69,7 @@ func (dir *Dir) Attr(ctx context.Context, attr *fuse.Attr) error {
 
 	attr.Mtime = time.Unix(dir.attributes.Mtime, 0)
 	attr.Ctime = time.Unix(dir.attributes.Crtime, 0)
-	attr.Gid = dir.attributes.Gid
-	attr.Uid = dir.attributes.Uid
+	attr.Uid, attr.Gid = dir.wfs.localOwner(dir.attributes)
 
 	return nil
 }
@@ -102,14 +106,14 @@ func (dir *Dir) Create(ctx context.Context, req *fuse.CreateRequest,
 				Mtime:       time.Now().Unix(),
 				Crtime:      time.Now().Unix(),
 				FileMode:    uint32(req.Mode &^ dir.wfs.option.Umask),
-				Uid:         req.Uid,
-				Gid:         req.Gid,
 				Collection:  dir.wfs.option.Collection,
 				Replication: dir.wfs.option.Replication,
 				TtlSec:      dir.wfs.option.TtlSec,
 			},
 		},
 	}
+	dir.wfs.setUid(request.Entry.Attributes, req.Uid)
+	dir.wfs.setGid(request.Entry.Attributes, req.Gid)
 	glog.V(1).Infof("create: %v", request)
 
 	if request.Entry.IsDirectory {
@@ -118,6 +122,7 @@ func (dir *Dir) Create(ctx context.Context, req *fuse.CreateRequest,
 				glog.V(0).Infof("create %s/%s: %v", dir.Path, req.Name, err)
 				return fuse.EIO
 			}
+			dir.wfs.metaCache.InsertEntry(dir.Path, request.Entry)
 			return nil
 		}); err != nil {
 			return nil, nil, err
@@ -147,17 +152,18 @@ func (dir *Dir) Mkdir(ctx context.Context, req *fuse.MkdirRequest) (fs.Node, err
 					Mtime:    time.Now().Unix(),
 					Crtime:   time.Now().Unix(),
 					FileMode: uint32(req.Mode &^ dir.wfs.option.Umask),
-					Uid:      req.Uid,
-					Gid:      req.Gid,
 				},
 			},
 		}
+		dir.wfs.setUid(request.Entry.Attributes, req.Uid)
+		dir.wfs.setGid(request.Entry.Attributes, req.Gid)
 
 		glog.V(1).Infof("mkdir: %v", request)
 		if _, err := client.CreateEntry(ctx, request); err != nil {
 			glog.V(0).Infof("mkdir %s/%s: %v", dir.Path, req.Name, err)
 			return fuse.EIO
 		}
+		dir.wfs.metaCache.InsertEntry(dir.Path, request.Entry)
 
 		return nil
 	})
@@ -176,15 +182,26 @@ func (dir *Dir) Lookup(ctx context.Context, req *fuse.LookupRequest, resp *fuse.
 	fullFilePath := path.Join(dir.Path, req.Name)
 
 	item := dir.wfs.listDirectoryEntriesCache.Get(fullFilePath)
-	if item != nil && !item.Expired() {
+	if entry = dir.wfs.writeback.stagedEntry(fullFilePath); entry != nil {
+		// staged in writeback mode, and maybe not on the filer yet
+	} else if cachedEntry, cached := dir.wfs.metaCache.LookupEntry(dir.Path, req.Name); cached {
+		if cachedEntry == nil {
+			return nil, fuse.ENOENT
+		}
+		entry = cachedEntry
+	} else if item != nil && !item.Expired() {
 		entry = item.Value().(*filer_pb.Entry)
 	}
 
 	if entry == nil {
+		changes := dir.wfs.metaCache.Changes()
 		entry, err = filer2.GetEntry(ctx, dir.wfs, fullFilePath)
 		if err != nil {
 			return nil, err
 		}
+		if entry != nil {
+			dir.wfs.metaCache.CacheEntry(dir.Path, entry, changes)
+		}
 	}
 
 	if entry != nil {
@@ -198,8 +215,7 @@ func (dir *Dir) Lookup(ctx context.Context, req *fuse.LookupRequest, resp *fuse.
 		resp.Attr.Mtime = time.Unix(entry.Attributes.Mtime, 0)
 		resp.Attr.Ctime = time.Unix(entry.Attributes.Crtime, 0)
 		resp.Attr.Mode = os.FileMode(entry.Attributes.FileMode)
-		resp.Attr.Gid = entry.Attributes.Gid
-		resp.Attr.Uid = entry.Attributes.Uid
+		resp.Attr.Uid, resp.Attr.Gid = dir.wfs.localOwner(entry.Attributes)
 
 		return node, nil
 	}
@@ -209,6 +225,34 @@ func (dir *Dir) Lookup(ctx context.Context, req *fuse.LookupRequest, resp *fuse.
 
 func (dir *Dir) ReadDirAll(ctx context.Context) (ret []fuse.Dirent, err error) {
 
+	// the files staged in writeback mode may not be on the filer yet
+	stagedNames := dir.wfs.writeback.stagedNames(dir.Path)
+
+	addEntry := func(entry *filer_pb.Entry) {
+		if entry.IsDirectory {
+			dirent := fuse.Dirent{Name: entry.Name, Type: fuse.DT_Dir}
+			ret = append(ret, dirent)
+		} else {
+			dirent := fuse.Dirent{Name: entry.Name, Type: fuse.DT_File}
+			ret = append(ret, dirent)
+		}
+		delete(stagedNames, entry.Name)
+	}
+
+	if cachedEntries, cached := dir.wfs.metaCache.ListDirectory(dir.Path); cached {
+		for _, entry := range cachedEntries {
+			addEntry(entry)
+		}
+		for name := range stagedNames {
+			ret = append(ret, fuse.Dirent{Name: name, Type: fuse.DT_File})
+		}
+		return ret, nil
+	}
+
+	changes := dir.wfs.metaCache.Changes()
+	var listedEntries []*filer_pb.Entry
+	isComplete := false
+
 	err = dir.wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
 
 		paginationLimit := 1024
@@ -234,20 +278,16 @@ func (dir *Dir) ReadDirAll(ctx context.Context) (ret []fuse.Dirent, err error) {
 			cacheTtl := estimatedCacheTtl(len(resp.Entries))
 
 			for _, entry := range resp.Entries {
-				if entry.IsDirectory {
-					dirent := fuse.Dirent{Name: entry.Name, Type: fuse.DT_Dir}
-					ret = append(ret, dirent)
-				} else {
-					dirent := fuse.Dirent{Name: entry.Name, Type: fuse.DT_File}
-					ret = append(ret, dirent)
-				}
+				addEntry(entry)
 				dir.wfs.listDirectoryEntriesCache.Set(path.Join(dir.Path, entry.Name), entry, cacheTtl)
+				listedEntries = append(listedEntries, entry)
 				lastEntryName = entry.Name
 			}
 
 			remaining -= len(resp.Entries)
 
 			if len(resp.Entries) < paginationLimit {
+				isComplete = true
 				break
 			}
 
@@ -256,6 +296,14 @@ func (dir *Dir) ReadDirAll(ctx context.Context) (ret []fuse.Dirent, err error) {
 		return nil
 	})
 
+	if err == nil && isComplete {
+		dir.wfs.metaCache.CacheDirectory(dir.Path, listedEntries, changes)
+	}
+
+	for name := range stagedNames {
+		ret = append(ret, fuse.Dirent{Name: name, Type: fuse.DT_File})
+	}
+
 	return ret, err
 }
 
@@ -271,19 +319,31 @@ func (dir *Dir) Remove(ctx context.Context, req *fuse.RemoveRequest) error {
 
 func (dir *Dir) removeOneFile(ctx context.Context, req *fuse.RemoveRequest) error {
 
+	// the pending upload is dropped, and the uploaded part is deleted below
+	staged := dir.wfs.writeback.discard(path.Join(dir.Path, req.Name))
+
 	entry, err := filer2.GetEntry(ctx, dir.wfs, path.Join(dir.Path, req.Name))
 	if err != nil {
 		return err
 	}
+	if entry == nil {
+		if staged {
+			// never uploaded to the filer
+			dir.wfs.metaCache.DeleteEntry(path.Join(dir.Path, req.Name))
+			return nil
+		}
+		return fuse.ENOENT
+	}
 
-	dir.wfs.deleteFileChunks(ctx, entry.Chunks)
+	// the filer deletes the data, and releases the deduplicated chunks shared with other files
+	dir.wfs.chunkCache.DeleteChunks(replacedFileIds(entry.Chunks, nil))
 
 	return dir.wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
 
 		request := &filer_pb.DeleteEntryRequest{
 			Directory:    dir.Path,
 			Name:         req.Name,
-			IsDeleteData: false,
+			IsDeleteData: true,
 		}
 
 		glog.V(3).Infof("remove file: %v", request)
@@ -294,6 +354,7 @@ func (dir *Dir) removeOneFile(ctx context.Context, req *fuse.RemoveRequest) erro
 		}
 
 		dir.wfs.listDirectoryEntriesCache.Delete(path.Join(dir.Path, req.Name))
+		dir.wfs.metaCache.DeleteEntry(path.Join(dir.Path, req.Name))
 
 		return nil
 	})
@@ -302,6 +363,9 @@ func (dir *Dir) removeOneFile(ctx context.Context, req *fuse.RemoveRequest) erro
 
 func (dir *Dir) removeFolder(ctx context.Context, req *fuse.RemoveRequest) error {
 
+	// the pending uploads under the folder would create it again
+	dir.wfs.writeback.discard(path.Join(dir.Path, req.Name))
+
 	return dir.wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
 
 		request := &filer_pb.DeleteEntryRequest{
@@ -318,6 +382,7 @@ func (dir *Dir) removeFolder(ctx context.Context, req *fuse.RemoveRequest) error
 		}
 
 		dir.wfs.listDirectoryEntriesCache.Delete(path.Join(dir.Path, req.Name))
+		dir.wfs.metaCache.DeleteEntry(path.Join(dir.Path, req.Name))
 
 		return nil
 	})
@@ -336,11 +401,11 @@ func (dir *Dir) Setattr(ctx context.Context, req *fuse.SetattrRequest, resp *fus
 	}
 
 	if req.Valid.Uid() {
-		dir.attributes.Uid = req.Uid
+		dir.wfs.setUid(dir.attributes, req.Uid)
 	}
 
 	if req.Valid.Gid() {
-		dir.attributes.Gid = req.Gid
+		dir.wfs.setGid(dir.attributes, req.Gid)
 	}
 
 	if req.Valid.Mtime() {
@@ -366,6 +431,7 @@ func (dir *Dir) Setattr(ctx context.Context, req *fuse.SetattrRequest, resp *fus
 		}
 
 		dir.wfs.listDirectoryEntriesCache.Delete(dir.Path)
+		dir.wfs.metaCache.InvalidateEntry(dir.Path)
 
 		return nil
 	})
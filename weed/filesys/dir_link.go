@@ -28,18 +28,19 @@ func (dir *Dir) Symlink(ctx context.Context, req *fuse.SymlinkRequest) (fs.Node,
 				Mtime:         time.Now().Unix(),
 				Crtime:        time.Now().Unix(),
 				FileMode:      uint32((os.FileMode(0777) | os.ModeSymlink) &^ dir.wfs.option.Umask),
-				Uid:           req.Uid,
-				Gid:           req.Gid,
 				SymlinkTarget: req.Target,
 			},
 		},
 	}
+	dir.wfs.setUid(request.Entry.Attributes, req.Uid)
+	dir.wfs.setGid(request.Entry.Attributes, req.Gid)
 
 	err := dir.wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
 		if _, err := client.CreateEntry(ctx, request); err != nil {
 			glog.V(0).Infof("symlink %s/%s: %v", dir.Path, req.NewName, err)
 			return fuse.EIO
 		}
+		dir.wfs.metaCache.InsertEntry(dir.Path, request.Entry)
 		return nil
 	})
 
@@ -3,6 +3,8 @@ package filesys
 import (
 	"context"
 	"fmt"
+	"path"
+
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 	"github.com/seaweedfs/fuse"
 	"github.com/seaweedfs/fuse/fs"
@@ -12,6 +14,13 @@ func (dir *Dir) Rename(ctx context.Context, req *fuse.RenameRequest, newDirector
 
 	newDir := newDirectory.(*Dir)
 
+	// the staged files, or the ones under the renamed directory, are renamed on the filer after they are uploaded,
+	// and the replaced ones are not uploaded
+	if err := dir.wfs.writeback.waitForUpload(ctx, path.Join(dir.Path, req.OldName)); err != nil {
+		return fmt.Errorf("renaming %s/%s: wait for writeback: %v", dir.Path, req.OldName, err)
+	}
+	dir.wfs.writeback.discard(path.Join(newDir.Path, req.NewName))
+
 	return dir.wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
 
 		request := &filer_pb.AtomicRenameEntryRequest{
@@ -26,6 +35,9 @@ func (dir *Dir) Rename(ctx context.Context, req *fuse.RenameRequest, newDirector
 			return fmt.Errorf("renaming %s/%s => %s/%s: %v", dir.Path, req.OldName, newDir.Path, req.NewName, err)
 		}
 
+		dir.wfs.metaCache.DeleteEntry(path.Join(dir.Path, req.OldName))
+		dir.wfs.metaCache.InvalidateEntry(path.Join(newDir.Path, req.NewName))
+
 		return nil
 
 	})
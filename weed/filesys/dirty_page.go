@@ -1,215 +1,479 @@
 package filesys
 
 import (
-	"bytes"
 	"context"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
 	"sync"
-	"sync/atomic"
-	"time"
 
+	"github.com/chrislusf/seaweedfs/weed/filer2"
 	"github.com/chrislusf/seaweedfs/weed/glog"
-	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
-	"github.com/chrislusf/seaweedfs/weed/security"
 )
 
-type ContinuousDirtyPages struct {
-	hasData bool
-	Offset  int64
-	Size    int64
-	Data    []byte
-	f       *File
-	lock    sync.Mutex
+// IntervalDirtyPages keeps the written but not yet uploaded data of a file handle,
+// as a sorted list of neither overlapping nor adjacent intervals.
+// Overlapping and adjacent writes are merged in place, so random writes do not force small uploads.
+// When the intervals use more memory than allowed, they are moved to a swap file if configured,
+// or else the largest one is uploaded.
+type IntervalDirtyPages struct {
+	f          *File
+	lock       sync.Mutex
+	intervals  []*dirtyInterval
+	memorySize int64
+	swap       *swapFile
+	// by the storage policy of the file
+	chunkSize int64
 }
 
-func newDirtyPages(file *File) *ContinuousDirtyPages {
-	return &ContinuousDirtyPages{
-		Data: nil,
-		f:    file,
+type dirtyInterval struct {
+	offset     int64
+	size       int64
+	data       []byte // nil if moved to the swap file
+	swapOffset int64
+}
+
+func (iv *dirtyInterval) stop() int64 {
+	return iv.offset + iv.size
+}
+
+// write copies the data into the interval, which must start at or before the offset
+func (iv *dirtyInterval) write(offset int64, data []byte) {
+	if stop := offset - iv.offset + int64(len(data)); stop > int64(len(iv.data)) {
+		iv.data = append(iv.data, make([]byte, stop-int64(len(iv.data)))...)
 	}
+	copy(iv.data[offset-iv.offset:], data)
+	iv.size = int64(len(iv.data))
 }
 
-func (pages *ContinuousDirtyPages) releaseResource() {
-	if pages.Data != nil {
-		pages.f.wfs.bufPool.Put(pages.Data)
-		pages.Data = nil
-		atomic.AddInt32(&counter, -1)
-		glog.V(3).Infof("%s/%s releasing resource %d", pages.f.dir.Path, pages.f.Name, counter)
+func newDirtyPages(file *File) *IntervalDirtyPages {
+	return &IntervalDirtyPages{
+		f:         file,
+		chunkSize: file.wfs.chunkSizeLimit(file.fullpath()),
 	}
 }
 
-var counter = int32(0)
+func (pages *IntervalDirtyPages) releaseResource() {
+	pages.lock.Lock()
+	defer pages.lock.Unlock()
+
+	if len(pages.intervals) > 0 {
+		glog.V(0).Infof("%s/%s discard %d dirty intervals", pages.f.dir.Path, pages.f.Name, len(pages.intervals))
+	}
+	pages.intervals = nil
+	pages.memorySize = 0
+	pages.closeSwapFile()
+}
 
-func (pages *ContinuousDirtyPages) AddPage(ctx context.Context, offset int64, data []byte) (chunks []*filer_pb.FileChunk, err error) {
+func (pages *IntervalDirtyPages) AddPage(ctx context.Context, offset int64, data []byte) (chunks []*filer_pb.FileChunk, err error) {
 
 	pages.lock.Lock()
 	defer pages.lock.Unlock()
 
-	var chunk *filer_pb.FileChunk
+	if err = pages.addInterval(offset, data); err != nil {
+		return nil, fmt.Errorf("add dirty page [%d,%d): %v", offset, offset+int64(len(data)), err)
+	}
 
-	if len(data) > int(pages.f.wfs.option.ChunkSizeLimit) {
-		// this is more than what buffer can hold.
-		return pages.flushAndSave(ctx, offset, data)
+	// upload the full chunks as soon as possible, e.g., for sequential writes
+	if chunks, err = pages.saveFullChunks(ctx); err != nil {
+		return
 	}
 
-	if pages.Data == nil {
-		pages.Data = pages.f.wfs.bufPool.Get().([]byte)
-		atomic.AddInt32(&counter, 1)
-		glog.V(3).Infof("%s/%s acquire resource %d", pages.f.dir.Path, pages.f.Name, counter)
+	var moreChunks []*filer_pb.FileChunk
+	moreChunks, err = pages.enforceMemoryLimit(ctx)
+	chunks = append(chunks, moreChunks...)
+
+	return
+}
+
+func (pages *IntervalDirtyPages) addInterval(offset int64, data []byte) error {
+
+	if len(data) == 0 {
+		return nil
 	}
 
-	if offset < pages.Offset || offset >= pages.Offset+int64(len(pages.Data)) ||
-		pages.Offset+int64(len(pages.Data)) < offset+int64(len(data)) {
-		// if the data is out of range,
-		// or buffer is full if adding new data,
-		// flush current buffer and add new data
+	start, stop := offset, offset+int64(len(data))
 
-		// println("offset", offset, "size", len(data), "existing offset", pages.Offset, "size", pages.Size)
+	// the intervals in [i, j) overlap or are adjacent to the new data
+	i := sort.Search(len(pages.intervals), func(k int) bool {
+		return pages.intervals[k].stop() >= start
+	})
+	j := i
+	for j < len(pages.intervals) && pages.intervals[j].offset <= stop {
+		j++
+	}
 
-		if chunk, err = pages.saveExistingPagesToStorage(ctx); err == nil {
-			if chunk != nil {
-				glog.V(4).Infof("%s/%s add save [%d,%d)", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size))
-				chunks = append(chunks, chunk)
-			}
-		} else {
-			glog.V(0).Infof("%s/%s add save [%d,%d): %v", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size), err)
-			return
+	if i == j {
+		iv := &dirtyInterval{offset: start, size: int64(len(data)), data: make([]byte, len(data))}
+		copy(iv.data, data)
+		pages.intervals = append(pages.intervals, nil)
+		copy(pages.intervals[i+1:], pages.intervals[i:])
+		pages.intervals[i] = iv
+		pages.memorySize += iv.size
+		return nil
+	}
+
+	base := pages.intervals[i]
+	if err := pages.load(base); err != nil {
+		return err
+	}
+	if base.offset > start {
+		extended := &dirtyInterval{offset: start}
+		extended.write(base.offset, base.data)
+		pages.memorySize += extended.size - base.size
+		base = extended
+	}
+	for _, iv := range pages.intervals[i+1 : j] {
+		if err := pages.load(iv); err != nil {
+			return err
 		}
-		pages.Offset = offset
-		copy(pages.Data, data)
-		pages.Size = int64(len(data))
+		oldSize := base.size
+		base.write(iv.offset, iv.data)
+		pages.memorySize += base.size - oldSize - iv.size
+	}
+	oldSize := base.size
+	base.write(start, data)
+	pages.memorySize += base.size - oldSize
+
+	pages.intervals[i] = base
+	pages.intervals = append(pages.intervals[:i+1], pages.intervals[j:]...)
+
+	return nil
+}
+
+// saveFullChunks uploads the chunk sized parts of the intervals
+func (pages *IntervalDirtyPages) saveFullChunks(ctx context.Context) (chunks []*filer_pb.FileChunk, err error) {
+
+	if pages.f.wfs.writeback != nil {
+		// the data is staged when flushed, instead of being uploaded while writing
 		return
 	}
 
-	if offset != pages.Offset+pages.Size {
-		// when this happens, debug shows the data overlapping with existing data is empty
-		// the data is not just append
-		if offset == pages.Offset && int(pages.Size) < len(data) {
-			// glog.V(2).Infof("pages[%d,%d) pages.Data len=%v, data len=%d, pages.Size=%d", pages.Offset, pages.Offset+pages.Size, len(pages.Data), len(data), pages.Size)
-			copy(pages.Data[pages.Size:], data[pages.Size:])
-		} else {
-			if pages.Size != 0 {
-				glog.V(1).Infof("%s/%s add page: pages [%d, %d) write [%d, %d)", pages.f.dir.Path, pages.f.Name, pages.Offset, pages.Offset+pages.Size, offset, offset+int64(len(data)))
+	chunkSize := pages.chunkSize
+
+	for _, iv := range pages.intervals {
+		if iv.size < chunkSize {
+			continue
+		}
+		if err = pages.load(iv); err != nil {
+			return
+		}
+		for iv.size >= chunkSize {
+			var chunk *filer_pb.FileChunk
+			if chunk, err = pages.saveToStorage(ctx, iv.data[:chunkSize], iv.offset); err != nil {
+				return
 			}
-			return pages.flushAndSave(ctx, offset, data)
+			chunks = append(chunks, chunk)
+			glog.V(4).Infof("%s/%s save full chunk [%d,%d) to %s", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size), chunk.FileId)
+			rest := make([]byte, iv.size-chunkSize)
+			copy(rest, iv.data[chunkSize:])
+			pages.memorySize -= chunkSize
+			iv.data, iv.offset, iv.size = rest, iv.offset+chunkSize, iv.size-chunkSize
 		}
-	} else {
-		copy(pages.Data[offset-pages.Offset:], data)
 	}
 
-	pages.Size = max(pages.Size, offset+int64(len(data))-pages.Offset)
+	pages.removeEmptyIntervals()
 
 	return
 }
 
-func (pages *ContinuousDirtyPages) flushAndSave(ctx context.Context, offset int64, data []byte) (chunks []*filer_pb.FileChunk, err error) {
+// enforceMemoryLimit moves the largest intervals to the swap file, or uploads them if there is no swap file
+func (pages *IntervalDirtyPages) enforceMemoryLimit(ctx context.Context) (chunks []*filer_pb.FileChunk, err error) {
 
-	var chunk *filer_pb.FileChunk
+	for pages.memorySize > pages.f.wfs.option.DirtyPagesMemoryLimit {
 
-	// flush existing
-	if chunk, err = pages.saveExistingPagesToStorage(ctx); err == nil {
-		if chunk != nil {
-			glog.V(4).Infof("%s/%s flush existing [%d,%d) to %s", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size), chunk.FileId)
-			chunks = append(chunks, chunk)
+		var largest *dirtyInterval
+		for _, iv := range pages.intervals {
+			if iv.data != nil && (largest == nil || iv.size > largest.size) {
+				largest = iv
+			}
 		}
-	} else {
-		glog.V(0).Infof("%s/%s failed to flush1 [%d,%d): %v", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size), err)
-		return
+		if largest == nil {
+			return
+		}
+
+		if pages.swapDir() != "" {
+			if err = pages.swapOut(largest); err == nil {
+				continue
+			}
+			glog.V(0).Infof("%s/%s swap out [%d,%d): %v", pages.f.dir.Path, pages.f.Name, largest.offset, largest.stop(), err)
+		}
+
+		var saved []*filer_pb.FileChunk
+		saved, err = pages.saveInterval(ctx, largest)
+		chunks = append(chunks, saved...)
+		if err != nil {
+			return
+		}
+		pages.memorySize -= largest.size
+		largest.size = 0
+		pages.removeEmptyIntervals()
 	}
-	pages.Size = 0
-	pages.Offset = 0
 
-	// flush the new page
-	if chunk, err = pages.saveToStorage(ctx, data, offset); err == nil {
-		if chunk != nil {
-			glog.V(4).Infof("%s/%s flush big request [%d,%d) to %s", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size), chunk.FileId)
-			chunks = append(chunks, chunk)
+	return
+}
+
+func (pages *IntervalDirtyPages) FlushToStorage(ctx context.Context) (chunks []*filer_pb.FileChunk, err error) {
+
+	pages.lock.Lock()
+	defer pages.lock.Unlock()
+
+	chunkSize := pages.chunkSize
+
+	for len(pages.intervals) > 0 {
+
+		// the intervals fitting into one chunk are uploaded together, with the gaps read from the existing chunks
+		j := 1
+		for j < len(pages.intervals) && pages.intervals[j].stop()-pages.intervals[0].offset <= chunkSize {
+			j++
 		}
-	} else {
-		glog.V(0).Infof("%s/%s failed to flush2 [%d,%d): %v", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size), err)
-		return
+
+		var saved []*filer_pb.FileChunk
+		if j > 1 {
+			saved, err = pages.saveMergedIntervals(ctx, pages.intervals[:j])
+		} else {
+			saved, err = pages.saveInterval(ctx, pages.intervals[0])
+		}
+		chunks = append(chunks, saved...)
+		if err != nil {
+			return
+		}
+
+		for _, iv := range pages.intervals[:j] {
+			if iv.data != nil {
+				pages.memorySize -= iv.size
+			}
+		}
+		pages.intervals = pages.intervals[j:]
+	}
+
+	pages.intervals = nil
+	pages.memorySize = 0
+	pages.closeSwapFile()
+
+	for _, chunk := range chunks {
+		glog.V(4).Infof("%s/%s flush [%d,%d) to %s", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size), chunk.FileId)
 	}
 
 	return
 }
 
-func (pages *ContinuousDirtyPages) FlushToStorage(ctx context.Context) (chunk *filer_pb.FileChunk, err error) {
+// StageTo hands the dirty intervals over to the writeback staging, instead of uploading them
+func (pages *IntervalDirtyPages) StageTo(stage func(offset int64, data []byte) error) error {
 
 	pages.lock.Lock()
 	defer pages.lock.Unlock()
 
-	if pages.Size == 0 {
-		return nil, nil
+	for len(pages.intervals) > 0 {
+		iv := pages.intervals[0]
+		if err := pages.load(iv); err != nil {
+			return err
+		}
+		if err := stage(iv.offset, iv.data); err != nil {
+			return err
+		}
+		pages.memorySize -= iv.size
+		pages.intervals = pages.intervals[1:]
 	}
 
-	if chunk, err = pages.saveExistingPagesToStorage(ctx); err == nil {
-		pages.Size = 0
-		pages.Offset = 0
-		if chunk != nil {
-			glog.V(4).Infof("%s/%s flush [%d,%d)", pages.f.dir.Path, pages.f.Name, chunk.Offset, chunk.Offset+int64(chunk.Size))
+	pages.intervals = nil
+	pages.memorySize = 0
+	pages.closeSwapFile()
+
+	return nil
+}
+
+func (pages *IntervalDirtyPages) hasData() bool {
+	pages.lock.Lock()
+	defer pages.lock.Unlock()
+	return len(pages.intervals) > 0
+}
+
+// ReadDirtyData copies the dirty data overlapping the buffer, and returns the stop offset of the copied data
+func (pages *IntervalDirtyPages) ReadDirtyData(buf []byte, offset int64) (maxStop int64) {
+
+	pages.lock.Lock()
+	defer pages.lock.Unlock()
+
+	stop := offset + int64(len(buf))
+	for _, iv := range pages.intervals {
+		if iv.stop() <= offset || iv.offset >= stop {
+			continue
 		}
+		start, end := max(iv.offset, offset), min(iv.stop(), stop)
+		if iv.data != nil {
+			copy(buf[start-offset:end-offset], iv.data[start-iv.offset:])
+		} else if err := pages.swap.readAt(buf[start-offset:end-offset], iv.swapOffset+start-iv.offset); err != nil {
+			glog.Errorf("%s/%s read swap file: %v", pages.f.dir.Path, pages.f.Name, err)
+			continue
+		}
+		maxStop = max(maxStop, end)
 	}
+
 	return
 }
 
-func (pages *ContinuousDirtyPages) saveExistingPagesToStorage(ctx context.Context) (*filer_pb.FileChunk, error) {
+func (pages *IntervalDirtyPages) saveInterval(ctx context.Context, iv *dirtyInterval) (chunks []*filer_pb.FileChunk, err error) {
 
-	if pages.Size == 0 {
-		return nil, nil
+	if err = pages.load(iv); err != nil {
+		return
 	}
 
-	return pages.saveToStorage(ctx, pages.Data[:pages.Size], pages.Offset)
+	chunkSize := pages.chunkSize
+	for start := int64(0); start < iv.size; start += chunkSize {
+		var chunk *filer_pb.FileChunk
+		if chunk, err = pages.saveToStorage(ctx, iv.data[start:min(start+chunkSize, iv.size)], iv.offset+start); err != nil {
+			return
+		}
+		chunks = append(chunks, chunk)
+	}
+
+	return
 }
 
-func (pages *ContinuousDirtyPages) saveToStorage(ctx context.Context, buf []byte, offset int64) (*filer_pb.FileChunk, error) {
+// saveMergedIntervals uploads the intervals as one chunk, filling the gaps with the existing file content
+func (pages *IntervalDirtyPages) saveMergedIntervals(ctx context.Context, intervals []*dirtyInterval) (chunks []*filer_pb.FileChunk, err error) {
+
+	first, last := intervals[0], intervals[len(intervals)-1]
+	buf := make([]byte, last.stop()-first.offset)
+
+	for i, iv := range intervals {
+		if err = pages.load(iv); err != nil {
+			return
+		}
+		copy(buf[iv.offset-first.offset:], iv.data)
+		if i > 0 {
+			gapStart, gapStop := intervals[i-1].stop(), iv.offset
+			if err = pages.readExistingData(ctx, buf[gapStart-first.offset:gapStop-first.offset], gapStart); err != nil {
+				glog.V(0).Infof("%s/%s read gap [%d,%d): %v", pages.f.dir.Path, pages.f.Name, gapStart, gapStop, err)
+				// upload the intervals separately
+				for _, iv := range intervals {
+					var saved []*filer_pb.FileChunk
+					saved, err = pages.saveInterval(ctx, iv)
+					chunks = append(chunks, saved...)
+					if err != nil {
+						return
+					}
+				}
+				return
+			}
+		}
+	}
+
+	chunk, err := pages.saveToStorage(ctx, buf, first.offset)
+	if err != nil {
+		return nil, err
+	}
 
-	var fileId, host string
-	var auth security.EncodedJwt
+	return []*filer_pb.FileChunk{chunk}, nil
+}
 
-	if err := pages.f.wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+func (pages *IntervalDirtyPages) readExistingData(ctx context.Context, buf []byte, offset int64) error {
 
-		request := &filer_pb.AssignVolumeRequest{
-			Count:       1,
-			Replication: pages.f.wfs.option.Replication,
-			Collection:  pages.f.wfs.option.Collection,
-			TtlSec:      pages.f.wfs.option.TtlSec,
-			DataCenter:  pages.f.wfs.option.DataCenter,
+	if err := pages.f.maybeBuildEntryViewCache(ctx); err != nil {
+		return err
+	}
+	chunkViews := filer2.ViewFromVisibleIntervals(pages.f.entryViewCache, offset, len(buf))
+	if len(chunkViews) == 0 {
+		// a hole in the file
+		return nil
+	}
+
+	_, err := filer2.ReadIntoBuffer(ctx, pages.f.wfs, pages.f.fullpath(), buf, chunkViews, offset)
+	return err
+}
+
+func (pages *IntervalDirtyPages) removeEmptyIntervals() {
+	intervals := pages.intervals[:0]
+	for _, iv := range pages.intervals {
+		if iv.size > 0 {
+			intervals = append(intervals, iv)
 		}
+	}
+	pages.intervals = intervals
+}
 
-		resp, err := client.AssignVolume(ctx, request)
-		if err != nil {
-			glog.V(0).Infof("assign volume failure %v: %v", request, err)
-			return err
+// load reads the interval back into memory if it is swapped out
+func (pages *IntervalDirtyPages) load(iv *dirtyInterval) error {
+	if iv.data != nil {
+		return nil
+	}
+	data := make([]byte, iv.size)
+	if err := pages.swap.readAt(data, iv.swapOffset); err != nil {
+		return fmt.Errorf("read swap file: %v", err)
+	}
+	iv.data = data
+	pages.memorySize += iv.size
+	return nil
+}
+
+func (pages *IntervalDirtyPages) swapOut(iv *dirtyInterval) (err error) {
+	if pages.swap == nil {
+		if pages.swap, err = newSwapFile(pages.swapDir()); err != nil {
+			return
 		}
+	}
+	if iv.swapOffset, err = pages.swap.append(iv.data); err != nil {
+		return
+	}
+	glog.V(4).Infof("%s/%s swap out [%d,%d)", pages.f.dir.Path, pages.f.Name, iv.offset, iv.stop())
+	iv.data = nil
+	pages.memorySize -= iv.size
+	return nil
+}
 
-		fileId, host, auth = resp.FileId, resp.Url, security.EncodedJwt(resp.Auth)
+// swapDir is where the dirty intervals are moved out of memory, which is also the staging directory in writeback mode
+func (pages *IntervalDirtyPages) swapDir() string {
+	if pages.f.wfs.option.SwapDir == "" && pages.f.wfs.writeback != nil {
+		return pages.f.wfs.option.WritebackDir
+	}
+	return pages.f.wfs.option.SwapDir
+}
 
-		return nil
-	}); err != nil {
-		return nil, fmt.Errorf("filerGrpcAddress assign volume: %v", err)
+func (pages *IntervalDirtyPages) closeSwapFile() {
+	if pages.swap != nil {
+		pages.swap.close()
+		pages.swap = nil
 	}
+}
+
+func (pages *IntervalDirtyPages) saveToStorage(ctx context.Context, buf []byte, offset int64) (*filer_pb.FileChunk, error) {
+	return pages.f.wfs.saveDataAsChunk(ctx, pages.f.fullpath(), buf, offset)
+}
 
-	fileUrl := fmt.Sprintf("http://%s/%s", host, fileId)
-	bufReader := bytes.NewReader(buf)
-	uploadResult, err := operation.Upload(fileUrl, pages.f.Name, bufReader, false, "application/octet-stream", nil, auth)
+// swapFile holds the dirty intervals moved out of memory. The space is reused only after all intervals are flushed.
+type swapFile struct {
+	file *os.File
+	size int64
+}
+
+func newSwapFile(dir string) (*swapFile, error) {
+	file, err := ioutil.TempFile(dir, "seaweedfs_swap_")
 	if err != nil {
-		glog.V(0).Infof("upload data %v to %s: %v", pages.f.Name, fileUrl, err)
-		return nil, fmt.Errorf("upload data: %v", err)
+		return nil, err
 	}
-	if uploadResult.Error != "" {
-		glog.V(0).Infof("upload failure %v to %s: %v", pages.f.Name, fileUrl, err)
-		return nil, fmt.Errorf("upload result: %v", uploadResult.Error)
+	return &swapFile{file: file}, nil
+}
+
+func (sf *swapFile) append(data []byte) (offset int64, err error) {
+	if _, err = sf.file.WriteAt(data, sf.size); err != nil {
+		return
 	}
+	offset = sf.size
+	sf.size += int64(len(data))
+	return
+}
 
-	return &filer_pb.FileChunk{
-		FileId: fileId,
-		Offset: offset,
-		Size:   uint64(len(buf)),
-		Mtime:  time.Now().UnixNano(),
-		ETag:   uploadResult.ETag,
-	}, nil
+func (sf *swapFile) readAt(buf []byte, offset int64) error {
+	_, err := sf.file.ReadAt(buf, offset)
+	return err
+}
 
+func (sf *swapFile) close() {
+	sf.file.Close()
+	os.Remove(sf.file.Name())
 }
 
 func max(x, y int64) int64 {
@@ -218,3 +482,10 @@ func max(x, y int64) int64 {
 	}
 	return y
 }
+
+func min(x, y int64) int64 {
+	if x < y {
+		return x
+	}
+	return y
+}
@@ -0,0 +1,119 @@
+package filesys
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func newTestDirtyPages(memoryLimit int64, swapDir string) *IntervalDirtyPages {
+	wfs := &WFS{option: &Option{
+		ChunkSizeLimit:        1024 * 1024,
+		DirtyPagesMemoryLimit: memoryLimit,
+		SwapDir:               swapDir,
+	}}
+	return newDirtyPages(&File{Name: "test", dir: &Dir{Path: "/", wfs: wfs}, wfs: wfs})
+}
+
+func TestDirtyPagesMergeIntervals(t *testing.T) {
+
+	pages := newTestDirtyPages(1024*1024, "")
+	ctx := context.Background()
+
+	writes := []struct {
+		offset int64
+		data   string
+	}{
+		{10, "aaaa"},
+		{20, "bbbb"},
+		{30, "cccc"},
+		{14, "dd"},     // adjacent to the first interval
+		{22, "eeeeee"}, // overlaps the second interval, and is adjacent to nothing
+		{5, "ffff"},    // before the first interval, with a gap
+		{8, "gggggg"},  // overlaps the first interval
+	}
+	for _, w := range writes {
+		if chunks, err := pages.AddPage(ctx, w.offset, []byte(w.data)); err != nil || len(chunks) != 0 {
+			t.Fatalf("add page %d: %v %v", w.offset, chunks, err)
+		}
+	}
+
+	// [5,16) [20,28) [30,34)
+	if len(pages.intervals) != 3 {
+		t.Fatalf("expected 3 intervals, got %d", len(pages.intervals))
+	}
+	if pages.memorySize != 11+8+4 {
+		t.Errorf("unexpected memory size %d", pages.memorySize)
+	}
+
+	buf := make([]byte, 40)
+	if stop := pages.ReadDirtyData(buf, 0); stop != 34 {
+		t.Errorf("unexpected dirty data stop %d", stop)
+	}
+	expected := []byte("\x00\x00\x00\x00\x00fffggggggdd\x00\x00\x00\x00bbeeeeee\x00\x00cccc\x00\x00\x00\x00\x00\x00")
+	if !bytes.Equal(buf, expected) {
+		t.Errorf("unexpected dirty data %q", buf)
+	}
+
+	// bridge all intervals
+	if _, err := pages.AddPage(ctx, 16, []byte("hhhhhhhhhhhhhh")); err != nil {
+		t.Fatalf("add page: %v", err)
+	}
+	if len(pages.intervals) != 1 || pages.intervals[0].offset != 5 || pages.intervals[0].size != 29 {
+		t.Errorf("unexpected intervals after bridging: %d", len(pages.intervals))
+	}
+	if pages.memorySize != 29 {
+		t.Errorf("unexpected memory size %d", pages.memorySize)
+	}
+
+}
+
+func TestDirtyPagesSwap(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "swap")
+	if err != nil {
+		t.Fatalf("temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	pages := newTestDirtyPages(100, dir)
+	ctx := context.Background()
+
+	for i := int64(0); i < 10; i++ {
+		if chunks, err := pages.AddPage(ctx, i*100, bytes.Repeat([]byte{byte('a' + i)}, 50)); err != nil || len(chunks) != 0 {
+			t.Fatalf("add page %d: %v %v", i, chunks, err)
+		}
+	}
+	if pages.memorySize > 100 {
+		t.Errorf("memory size %d is over the limit", pages.memorySize)
+	}
+	if pages.swap == nil || pages.swap.size == 0 {
+		t.Fatalf("nothing is swapped out")
+	}
+
+	// the swapped out data is still readable, and can be merged
+	if _, err := pages.AddPage(ctx, 40, []byte("zzzzzzzzzzzzzzzzzzzz")); err != nil {
+		t.Fatalf("add page: %v", err)
+	}
+	buf := make([]byte, 1000)
+	if stop := pages.ReadDirtyData(buf, 0); stop != 950 {
+		t.Errorf("unexpected dirty data stop %d", stop)
+	}
+	if !bytes.Equal(buf[:40], bytes.Repeat([]byte("a"), 40)) || string(buf[40:60]) != "zzzzzzzzzzzzzzzzzzzz" {
+		t.Errorf("unexpected data %q", buf[:60])
+	}
+	for i := int64(1); i < 10; i++ {
+		if !bytes.Equal(buf[i*100:i*100+50], bytes.Repeat([]byte{byte('a' + i)}, 50)) {
+			t.Errorf("unexpected data at %d: %q", i*100, buf[i*100:i*100+50])
+		}
+	}
+
+	swapFileName := pages.swap.file.Name()
+	pages.releaseResource()
+	if _, err := os.Stat(swapFileName); !os.IsNotExist(err) {
+		t.Errorf("swap file %s is not removed: %v", swapFileName, err)
+	}
+
+}
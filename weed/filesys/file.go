@@ -2,6 +2,8 @@ package filesys
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"os"
 	"path/filepath"
 	"sort"
@@ -10,6 +12,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/filer2"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/golang/protobuf/proto"
 	"github.com/seaweedfs/fuse"
 	"github.com/seaweedfs/fuse/fs"
 )
@@ -27,6 +30,7 @@ type File struct {
 	wfs            *WFS
 	entry          *filer_pb.Entry
 	entryViewCache []filer2.VisibleInterval
+	resolvedChunks []*filer_pb.FileChunk // the data chunks, with the manifest chunks resolved
 	isOpen         bool
 }
 
@@ -42,9 +46,11 @@ func (file *File) Attr(ctx context.Context, attr *fuse.Attr) error {
 
 	attr.Mode = os.FileMode(file.entry.Attributes.FileMode)
 	attr.Size = filer2.TotalSize(file.entry.Chunks)
+	if stagedSize := uint64(file.wfs.writeback.stagedSize(file.fullpath())); stagedSize > attr.Size {
+		attr.Size = stagedSize
+	}
 	attr.Mtime = time.Unix(file.entry.Attributes.Mtime, 0)
-	attr.Gid = file.entry.Attributes.Gid
-	attr.Uid = file.entry.Attributes.Uid
+	attr.Uid, attr.Gid = file.wfs.localOwner(file.entry.Attributes)
 	attr.Blocks = attr.Size/blockSize + 1
 	attr.BlockSize = uint32(file.wfs.option.ChunkSizeLimit)
 
@@ -56,9 +62,16 @@ func (file *File) Open(ctx context.Context, req *fuse.OpenRequest, resp *fuse.Op
 
 	glog.V(3).Infof("%v file open %+v", file.fullpath(), req)
 
+	// the staged uploads are applied to the file once it has a handle
+	staged, unlock := file.wfs.writeback.lockStaged(file.fullpath())
+	if staged != nil && !file.isOpen {
+		file.setEntry(proto.Clone(staged.entry).(*filer_pb.Entry))
+	}
+
 	file.isOpen = true
 
 	handle := file.wfs.AcquireHandle(file, req.Uid, req.Gid)
+	unlock()
 
 	resp.Handle = fuse.HandleID(handle.handle)
 
@@ -80,8 +93,14 @@ func (file *File) Setattr(ctx context.Context, req *fuse.SetattrRequest, resp *f
 		glog.V(3).Infof("%v file setattr set size=%v", file.fullpath(), req.Size)
 		if req.Size == 0 {
 			// fmt.Printf("truncate %v \n", fullPath)
+			file.wfs.chunkCache.DeleteChunks(replacedFileIds(file.entry.Chunks, nil))
 			file.entry.Chunks = nil
 			file.entryViewCache = nil
+			file.resolvedChunks = nil
+			if err := file.wfs.writeback.truncate(file.fullpath()); err != nil {
+				glog.Errorf("truncate staged %s: %v", file.fullpath(), err)
+				return fuse.EIO
+			}
 		}
 		file.entry.Attributes.FileSize = req.Size
 	}
@@ -90,11 +109,11 @@ func (file *File) Setattr(ctx context.Context, req *fuse.SetattrRequest, resp *f
 	}
 
 	if req.Valid.Uid() {
-		file.entry.Attributes.Uid = req.Uid
+		file.wfs.setUid(file.entry.Attributes, req.Uid)
 	}
 
 	if req.Valid.Gid() {
-		file.entry.Attributes.Gid = req.Gid
+		file.wfs.setGid(file.entry.Attributes, req.Gid)
 	}
 
 	if req.Valid.Crtime() {
@@ -109,6 +128,15 @@ func (file *File) Setattr(ctx context.Context, req *fuse.SetattrRequest, resp *f
 		return nil
 	}
 
+	// the staged entry is uploaded later with the new attributes
+	if staged, err := file.wfs.writeback.updateEntry(file.fullpath(), file.entry.Attributes); staged {
+		if err != nil {
+			glog.Errorf("setattr staged %s: %v", file.fullpath(), err)
+			return fuse.EIO
+		}
+		return nil
+	}
+
 	return file.wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
 
 		request := &filer_pb.UpdateEntryRequest{
@@ -122,6 +150,7 @@ func (file *File) Setattr(ctx context.Context, req *fuse.SetattrRequest, resp *f
 			glog.V(0).Infof("UpdateEntry file %s/%s: %v", file.dir.Path, file.Name, err)
 			return fuse.EIO
 		}
+		file.wfs.metaCache.InsertEntry(file.dir.Path, file.entry)
 
 		return nil
 	})
@@ -139,7 +168,15 @@ func (file *File) Fsync(ctx context.Context, req *fuse.FsyncRequest) error {
 func (file *File) maybeLoadAttributes(ctx context.Context) error {
 	if file.entry == nil || !file.isOpen {
 		item := file.wfs.listDirectoryEntriesCache.Get(file.fullpath())
-		if item != nil && !item.Expired() {
+		cachedEntry, cached := file.wfs.metaCache.LookupEntry(file.dir.Path, file.Name)
+		if entry := file.wfs.writeback.stagedEntry(file.fullpath()); entry != nil {
+			file.setEntry(entry)
+		} else if cached {
+			if cachedEntry == nil {
+				return fuse.ENOENT
+			}
+			file.setEntry(cachedEntry)
+		} else if item != nil && !item.Expired() {
 			entry := item.Value().(*filer_pb.Entry)
 			file.setEntry(entry)
 			// glog.V(1).Infof("file attr read cached %v attributes", file.Name)
@@ -182,6 +219,13 @@ func (file *File) addChunk(chunk *filer_pb.FileChunk) {
 
 func (file *File) addChunks(chunks []*filer_pb.FileChunk) {
 
+	if file.entryViewCache == nil {
+		// the visible intervals are computed with all the chunks when needed
+		file.entry.Chunks = append(file.entry.Chunks, chunks...)
+		file.resolvedChunks = nil
+		return
+	}
+
 	sort.Slice(chunks, func(i, j int) bool {
 		return chunks[i].Mtime < chunks[j].Mtime
 	})
@@ -197,9 +241,54 @@ func (file *File) addChunks(chunks []*filer_pb.FileChunk) {
 	glog.V(3).Infof("%s existing %d chunks adds %d more", file.fullpath(), len(file.entry.Chunks), len(chunks))
 
 	file.entry.Chunks = append(file.entry.Chunks, chunks...)
+	file.resolvedChunks = append(file.resolvedChunks, chunks...)
 }
 
 func (file *File) setEntry(entry *filer_pb.Entry) {
+	if file.entry != nil && file.entry != entry {
+		file.wfs.chunkCache.DeleteChunks(replacedFileIds(file.entry.Chunks, entry.Chunks))
+	}
 	file.entry = entry
-	file.entryViewCache = filer2.NonOverlappingVisibleIntervals(file.entry.Chunks)
+	file.entryViewCache = nil
+	file.resolvedChunks = nil
+	if err := file.maybeBuildEntryViewCache(context.Background()); err != nil {
+		glog.V(0).Infof("%s: %v", file.fullpath(), err)
+	}
+}
+
+// maybeBuildEntryViewCache computes the visible intervals if not yet,
+// after resolving the manifest chunks of the entry
+func (file *File) maybeBuildEntryViewCache(ctx context.Context) error {
+	if file.entryViewCache != nil {
+		return nil
+	}
+	chunks, _, err := filer2.ResolveChunkManifest(filer2.LookupFn(ctx, file.wfs), file.entry.Chunks, 0, math.MaxInt64)
+	if err != nil {
+		return fmt.Errorf("resolve manifest chunks: %v", err)
+	}
+	file.resolvedChunks = chunks
+	file.entryViewCache = filer2.NonOverlappingVisibleIntervals(chunks)
+	return nil
+}
+
+// dataChunks lists the data chunks of the entry, including those in the manifest chunks once resolved
+func (file *File) dataChunks() []*filer_pb.FileChunk {
+	if file.resolvedChunks != nil {
+		return file.resolvedChunks
+	}
+	return file.entry.Chunks
+}
+
+// replacedFileIds lists the file ids in the old chunks but not in the new chunks
+func replacedFileIds(oldChunks, newChunks []*filer_pb.FileChunk) (fileIds []string) {
+	newFileIds := make(map[string]bool)
+	for _, chunk := range newChunks {
+		newFileIds[chunk.GetFileIdString()] = true
+	}
+	for _, chunk := range oldChunks {
+		if fileId := chunk.GetFileIdString(); !newFileIds[fileId] {
+			fileIds = append(fileIds, fileId)
+		}
+	}
+	return
 }
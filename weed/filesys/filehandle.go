@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"mime"
 	"path"
+	"syscall"
 	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/filer2"
@@ -13,14 +14,17 @@ import (
 	"github.com/gabriel-vasile/mimetype"
 	"github.com/seaweedfs/fuse"
 	"github.com/seaweedfs/fuse/fs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type FileHandle struct {
 	// cache file has been written to
-	dirtyPages    *ContinuousDirtyPages
+	dirtyPages    *IntervalDirtyPages
 	contentType   string
 	dirtyMetadata bool
 	handle        uint64
+	readAhead     readAheadState
 
 	f         *File
 	RequestId fuse.RequestID // unique ID for request
@@ -50,31 +54,116 @@ func (fh *FileHandle) Read(ctx context.Context, req *fuse.ReadRequest, resp *fus
 
 	glog.V(4).Infof("%s read fh %d: [%d,%d)", fh.f.fullpath(), fh.handle, req.Offset, req.Offset+int64(req.Size))
 
+	buff := make([]byte, req.Size)
+
+	var totalRead int64
+	var err error
+
+	// the staged uploads are not applied to the file while reading
+	staged, unlock := fh.f.wfs.writeback.lockStaged(fh.f.fullpath())
+	defer unlock()
+
 	// this value should come from the filer instead of the old f
 	if len(fh.f.entry.Chunks) == 0 {
 		glog.V(1).Infof("empty fh %v/%v", fh.f.dir.Path, fh.f.Name)
-		return nil
-	}
+	} else if err = fh.f.maybeBuildEntryViewCache(ctx); err == nil {
 
-	buff := make([]byte, req.Size)
+		chunkViews := filer2.ViewFromVisibleIntervals(fh.f.entryViewCache, req.Offset, req.Size)
 
-	if fh.f.entryViewCache == nil {
-		fh.f.entryViewCache = filer2.NonOverlappingVisibleIntervals(fh.f.entry.Chunks)
+		totalRead, err = fh.readFromChunks(ctx, buff, chunkViews, req.Offset)
 	}
 
-	chunkViews := filer2.ViewFromVisibleIntervals(fh.f.entryViewCache, req.Offset, req.Size)
+	// the data staged in writeback mode but not uploaded yet
+	if staged != nil {
+		if stagedStop := staged.readAt(buff, req.Offset); stagedStop-req.Offset > totalRead {
+			totalRead = stagedStop - req.Offset
+		}
+	}
 
-	totalRead, err := filer2.ReadIntoBuffer(ctx, fh.f.wfs, fh.f.fullpath(), buff, chunkViews, req.Offset)
+	// the data written but not uploaded yet
+	if dirtyStop := fh.dirtyPages.ReadDirtyData(buff, req.Offset); dirtyStop-req.Offset > totalRead {
+		totalRead = dirtyStop - req.Offset
+	}
 
 	resp.Data = buff[:totalRead]
 
 	if err != nil {
 		glog.Errorf("file handle read %s: %v", fh.f.fullpath(), err)
+	} else {
+		fh.maybeReadAhead(req.Offset, req.Size)
 	}
 
 	return err
 }
 
+// readFromChunks serves the chunk views from the chunk cache if possible.
+// On a cache miss, the whole chunk is read and cached if it is not too big.
+func (fh *FileHandle) readFromChunks(ctx context.Context, buff []byte, chunkViews []*filer2.ChunkView, baseOffset int64) (totalRead int64, err error) {
+
+	chunkCache := fh.f.wfs.chunkCache
+	if chunkCache == nil {
+		return filer2.ReadIntoBuffer(ctx, fh.f.wfs, fh.f.fullpath(), buff, chunkViews, baseOffset)
+	}
+
+	chunks := make(map[string]*filer_pb.FileChunk)
+	for _, chunk := range fh.f.dataChunks() {
+		chunks[chunk.GetFileIdString()] = chunk
+	}
+
+	var missedViews []*filer2.ChunkView
+	var chunksToFetch []*filer_pb.FileChunk
+	for _, chunkView := range chunkViews {
+		fh.f.wfs.waitForPrefetch(chunkView.FileId)
+		if data := chunkCache.GetChunk(chunkView.FileId); data != nil {
+			totalRead += copyChunkView(buff, baseOffset, chunkView, data)
+			continue
+		}
+		missedViews = append(missedViews, chunkView)
+		if chunk, found := chunks[chunkView.FileId]; found && chunkCache.IsCacheable(chunk.Size) {
+			chunksToFetch = append(chunksToFetch, chunk)
+		}
+	}
+
+	if len(missedViews) == 0 {
+		return totalRead, nil
+	}
+
+	if len(chunksToFetch) > 0 {
+		fetched, fetchErr := fh.f.wfs.fetchChunks(ctx, fh.f.fullpath(), chunksToFetch)
+		if fetchErr != nil {
+			glog.V(0).Infof("fetch %d chunks of %s: %v", len(chunksToFetch), fh.f.fullpath(), fetchErr)
+		}
+		views := missedViews[:0]
+		for _, chunkView := range missedViews {
+			if data, found := fetched[chunkView.FileId]; found {
+				totalRead += copyChunkView(buff, baseOffset, chunkView, data)
+			} else {
+				views = append(views, chunkView)
+			}
+		}
+		missedViews = views
+	}
+
+	if len(missedViews) > 0 {
+		n, readErr := filer2.ReadIntoBuffer(ctx, fh.f.wfs, fh.f.fullpath(), buff, missedViews, baseOffset)
+		totalRead += n
+		err = readErr
+	}
+
+	return totalRead, err
+}
+
+func copyChunkView(buff []byte, baseOffset int64, chunkView *filer2.ChunkView, data []byte) int64 {
+	stop := chunkView.Offset + int64(chunkView.Size)
+	if stop > int64(len(data)) {
+		stop = int64(len(data))
+	}
+	if chunkView.Offset >= stop {
+		return 0
+	}
+	return int64(copy(buff[chunkView.LogicOffset-baseOffset:], data[chunkView.Offset:stop]))
+}
+
 // Write to the file handle
 func (fh *FileHandle) Write(ctx context.Context, req *fuse.WriteRequest, resp *fuse.WriteResponse) error {
 
@@ -128,28 +217,34 @@ func (fh *FileHandle) Flush(ctx context.Context, req *fuse.FlushRequest) error {
 	// send the data to the OS
 	glog.V(4).Infof("%s fh %d flush %v", fh.f.fullpath(), fh.handle, req)
 
-	chunk, err := fh.dirtyPages.FlushToStorage(ctx)
+	if fh.f.wfs.writeback != nil {
+		if !fh.dirtyMetadata && !fh.dirtyPages.hasData() {
+			return nil
+		}
+		if err := fh.f.wfs.writeback.stage(fh, req); err != nil {
+			glog.Errorf("stage %s/%s: %v", fh.f.dir.Path, fh.f.Name, err)
+			return fmt.Errorf("stage %s/%s: %v", fh.f.dir.Path, fh.f.Name, err)
+		}
+		return nil
+	}
+
+	chunks, err := fh.dirtyPages.FlushToStorage(ctx)
+	fh.f.addChunks(chunks)
+	if len(chunks) > 0 {
+		fh.dirtyMetadata = true
+	}
 	if err != nil {
 		glog.Errorf("flush %s/%s: %v", fh.f.dir.Path, fh.f.Name, err)
 		return fmt.Errorf("flush %s/%s: %v", fh.f.dir.Path, fh.f.Name, err)
 	}
 
-	fh.f.addChunk(chunk)
-
 	if !fh.dirtyMetadata {
 		return nil
 	}
 
 	return fh.f.wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
 
-		if fh.f.entry.Attributes != nil {
-			fh.f.entry.Attributes.Mime = fh.contentType
-			fh.f.entry.Attributes.Uid = req.Uid
-			fh.f.entry.Attributes.Gid = req.Gid
-			fh.f.entry.Attributes.Mtime = time.Now().Unix()
-			fh.f.entry.Attributes.Crtime = time.Now().Unix()
-			fh.f.entry.Attributes.FileMode = uint32(0777 &^ fh.f.wfs.option.Umask)
-		}
+		fh.setFlushAttributes(req)
 
 		request := &filer_pb.CreateEntryRequest{
 			Directory: fh.f.dir.Path,
@@ -167,8 +262,12 @@ func (fh *FileHandle) Flush(ctx context.Context, req *fuse.FlushRequest) error {
 
 		if _, err := client.CreateEntry(ctx, request); err != nil {
 			glog.Errorf("update fh: %v", err)
+			if status.Code(err) == codes.ResourceExhausted {
+				return fuse.Errno(syscall.EDQUOT)
+			}
 			return fmt.Errorf("update fh: %v", err)
 		}
+		fh.f.wfs.metaCache.InsertEntry(fh.f.dir.Path, fh.f.entry)
 
 		fh.f.wfs.deleteFileChunks(ctx, garbages)
 		for i, chunk := range garbages {
@@ -178,3 +277,16 @@ func (fh *FileHandle) Flush(ctx context.Context, req *fuse.FlushRequest) error {
 		return nil
 	})
 }
+
+func (fh *FileHandle) setFlushAttributes(req *fuse.FlushRequest) {
+	if fh.f.entry.Attributes != nil {
+		fh.f.entry.Attributes.Mime = fh.contentType
+		fh.f.wfs.setUid(fh.f.entry.Attributes, req.Uid)
+		fh.f.wfs.setGid(fh.f.entry.Attributes, req.Gid)
+		fh.f.entry.Attributes.Mtime = time.Now().Unix()
+		fh.f.entry.Attributes.Crtime = time.Now().Unix()
+		fh.f.entry.Attributes.FileMode = uint32(0777 &^ fh.f.wfs.option.Umask)
+		// the content is changed, and its md5 is not known
+		fh.f.entry.Attributes.Md5 = nil
+	}
+}
@@ -0,0 +1,422 @@
+package filesys
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/golang/protobuf/proto"
+	"github.com/syndtr/goleveldb/leveldb"
+	leveldb_util "github.com/syndtr/goleveldb/leveldb/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	metaCacheEntryPrefix  = "e:" // e:<dir>\x00<name> => entry
+	metaCacheListedPrefix = "d:" // d:<dir> => all entries of the directory are cached
+	metaCacheTsKey        = "ts" // the time of the last applied event
+)
+
+// MetaCache keeps the entries under the mounted path in a local leveldb,
+// patched with the metadata events subscribed from the filer, so that lookups and listings are served locally.
+// A directory is listed locally only after all its entries are cached.
+// The cache is kept across mount restarts, and resumes from the last applied event if the filer still has the events after it.
+type MetaCache struct {
+	db   *leveldb.DB
+	lock sync.Mutex
+	// the cached entries are used only while subscribed
+	active bool
+	// changed on each event, to detect the events during a lookup or a listing from the filer
+	changes int64
+}
+
+func NewMetaCache(dir string) (*MetaCache, error) {
+	db, err := leveldb.OpenFile(dir, nil)
+	if err != nil {
+		return nil, fmt.Errorf("open meta cache %s: %v", dir, err)
+	}
+	return &MetaCache{db: db}, nil
+}
+
+func entryKey(dir, name string) []byte {
+	return []byte(metaCacheEntryPrefix + dir + "\x00" + name)
+}
+
+func listedKey(dir string) []byte {
+	return []byte(metaCacheListedPrefix + dir)
+}
+
+// LookupEntry returns the cached entry. If cached is true and the entry is nil, the entry does not exist.
+func (mc *MetaCache) LookupEntry(dir, name string) (entry *filer_pb.Entry, cached bool) {
+	if mc == nil {
+		return nil, false
+	}
+
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	if !mc.active {
+		return nil, false
+	}
+
+	data, err := mc.db.Get(entryKey(dir, name), nil)
+	if err == nil {
+		entry = &filer_pb.Entry{}
+		if err = proto.Unmarshal(data, entry); err != nil {
+			glog.Errorf("meta cache %s/%s: %v", dir, name, err)
+			return nil, false
+		}
+		if isExpired(entry) {
+			// the filer removes it when looked up
+			return nil, false
+		}
+		return entry, true
+	}
+
+	if listed, _ := mc.db.Has(listedKey(dir), nil); listed {
+		return nil, true
+	}
+
+	return nil, false
+}
+
+// ListDirectory returns the cached entries of the directory, if all of them are cached
+func (mc *MetaCache) ListDirectory(dir string) (entries []*filer_pb.Entry, cached bool) {
+	if mc == nil {
+		return nil, false
+	}
+
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	if !mc.active {
+		return nil, false
+	}
+	if listed, _ := mc.db.Has(listedKey(dir), nil); !listed {
+		return nil, false
+	}
+
+	iter := mc.db.NewIterator(leveldb_util.BytesPrefix([]byte(metaCacheEntryPrefix+dir+"\x00")), nil)
+	defer iter.Release()
+	for iter.Next() {
+		entry := &filer_pb.Entry{}
+		if err := proto.Unmarshal(iter.Value(), entry); err != nil {
+			glog.Errorf("meta cache list %s: %v", dir, err)
+			return nil, false
+		}
+		if isExpired(entry) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if err := iter.Error(); err != nil {
+		glog.Errorf("meta cache list %s: %v", dir, err)
+		return nil, false
+	}
+
+	return entries, true
+}
+
+// Changes returns a token to tell whether any event is applied since
+func (mc *MetaCache) Changes() int64 {
+	if mc == nil {
+		return 0
+	}
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+	return mc.changes
+}
+
+// CacheDirectory saves all the entries of the directory read from the filer,
+// unless any event is applied after Changes() returned the token
+func (mc *MetaCache) CacheDirectory(dir string, entries []*filer_pb.Entry, changes int64) {
+	if mc == nil {
+		return
+	}
+
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	if !mc.active || mc.changes != changes {
+		return
+	}
+
+	batch := new(leveldb.Batch)
+	iter := mc.db.NewIterator(leveldb_util.BytesPrefix([]byte(metaCacheEntryPrefix+dir+"\x00")), nil)
+	for iter.Next() {
+		batch.Delete(append([]byte(nil), iter.Key()...))
+	}
+	iter.Release()
+	for _, entry := range entries {
+		if err := batchPutEntry(batch, dir, entry); err != nil {
+			glog.Errorf("meta cache %s/%s: %v", dir, entry.Name, err)
+			return
+		}
+	}
+	batch.Put(listedKey(dir), nil)
+
+	if err := mc.db.Write(batch, nil); err != nil {
+		glog.Errorf("meta cache directory %s: %v", dir, err)
+	}
+}
+
+// CacheEntry saves an entry read from the filer, unless any event is applied after Changes() returned the token
+func (mc *MetaCache) CacheEntry(dir string, entry *filer_pb.Entry, changes int64) {
+	if mc == nil {
+		return
+	}
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+	if !mc.active || mc.changes != changes {
+		return
+	}
+	mc.putEntry(dir, entry)
+}
+
+// InsertEntry saves an entry just changed by this mount
+func (mc *MetaCache) InsertEntry(dir string, entry *filer_pb.Entry) {
+	if mc == nil {
+		return
+	}
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+	if !mc.active {
+		return
+	}
+	mc.putEntry(dir, entry)
+}
+
+func (mc *MetaCache) putEntry(dir string, entry *filer_pb.Entry) {
+	batch := new(leveldb.Batch)
+	if err := batchPutEntry(batch, dir, entry); err != nil {
+		glog.Errorf("meta cache %s/%s: %v", dir, entry.Name, err)
+		return
+	}
+	if err := mc.db.Write(batch, nil); err != nil {
+		glog.Errorf("meta cache %s/%s: %v", dir, entry.Name, err)
+	}
+}
+
+// InvalidateEntry drops an entry changed by this mount, which is read from the filer again when needed
+func (mc *MetaCache) InvalidateEntry(fullpath string) {
+	if mc == nil {
+		return
+	}
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+	dir, name := filer2.FullPath(fullpath).DirAndName()
+	batch := new(leveldb.Batch)
+	batch.Delete(entryKey(dir, name))
+	batch.Delete(listedKey(dir))
+	if err := mc.db.Write(batch, nil); err != nil {
+		glog.Errorf("meta cache invalidate %s: %v", fullpath, err)
+	}
+}
+
+// DeleteEntry drops an entry deleted by this mount, with all the entries under it
+func (mc *MetaCache) DeleteEntry(fullpath string) {
+	if mc == nil {
+		return
+	}
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+	batch := new(leveldb.Batch)
+	mc.batchDeleteEntry(batch, fullpath)
+	if err := mc.db.Write(batch, nil); err != nil {
+		glog.Errorf("meta cache delete %s: %v", fullpath, err)
+	}
+}
+
+// ApplyEvent patches the cache with a metadata event from the filer
+func (mc *MetaCache) ApplyEvent(event *filer_pb.SubscribeMetadataResponse) error {
+
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	batch := new(leveldb.Batch)
+
+	notification := event.EventNotification
+	if notification.OldEntry != nil {
+		mc.batchDeleteEntry(batch, string(filer2.NewFullPath(event.Directory, notification.OldEntry.Name)))
+	}
+	if notification.NewEntry != nil {
+		if err := batchPutEntry(batch, notification.NewParentPath, notification.NewEntry); err != nil {
+			return err
+		}
+	}
+	batchPutTsNs(batch, event.TsNs)
+
+	mc.changes++
+
+	return mc.db.Write(batch, nil)
+}
+
+// confirmSubscription saves the time the subscription starts from, so that a resubscription resumes from it
+// even before any event is applied
+func (mc *MetaCache) confirmSubscription(tsNs int64) error {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	if tsNs <= mc.LastTsNs() {
+		return nil
+	}
+	batch := new(leveldb.Batch)
+	batchPutTsNs(batch, tsNs)
+	return mc.db.Write(batch, nil)
+}
+
+// LastTsNs is the time of the last applied event or the confirmed subscription, or 0 if none
+func (mc *MetaCache) LastTsNs() int64 {
+	data, err := mc.db.Get([]byte(metaCacheTsKey), nil)
+	if err != nil || len(data) != 8 {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(data))
+}
+
+func (mc *MetaCache) setActive(active bool) {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+	mc.active = active
+}
+
+// reset drops everything, after the events since the last applied one are lost
+func (mc *MetaCache) reset() error {
+	mc.lock.Lock()
+	defer mc.lock.Unlock()
+
+	mc.changes++
+
+	batch := new(leveldb.Batch)
+	iter := mc.db.NewIterator(nil, nil)
+	for iter.Next() {
+		batch.Delete(append([]byte(nil), iter.Key()...))
+	}
+	iter.Release()
+	return mc.db.Write(batch, nil)
+}
+
+func (mc *MetaCache) Shutdown() {
+	mc.db.Close()
+}
+
+// isExpired tells whether the ttl of the file has passed since it was created, as the filer hides it
+func isExpired(entry *filer_pb.Entry) bool {
+	attributes := entry.Attributes
+	if entry.IsDirectory || attributes == nil || attributes.TtlSec <= 0 || attributes.Crtime <= 0 {
+		return false
+	}
+	return attributes.Crtime+int64(attributes.TtlSec) <= time.Now().Unix()
+}
+
+func batchPutTsNs(batch *leveldb.Batch, tsNs int64) {
+	tsBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(tsBytes, uint64(tsNs))
+	batch.Put([]byte(metaCacheTsKey), tsBytes)
+}
+
+func batchPutEntry(batch *leveldb.Batch, dir string, entry *filer_pb.Entry) error {
+	data, err := proto.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	batch.Put(entryKey(dir, entry.Name), data)
+	return nil
+}
+
+// batchDeleteEntry deletes the entry, and the entries and listings under it if it is a directory
+func (mc *MetaCache) batchDeleteEntry(batch *leveldb.Batch, fullpath string) {
+	dir, name := filer2.FullPath(fullpath).DirAndName()
+	batch.Delete(entryKey(dir, name))
+
+	subPrefix := strings.TrimSuffix(fullpath, "/")
+	for _, prefix := range []string{
+		metaCacheEntryPrefix + subPrefix + "\x00",
+		metaCacheEntryPrefix + subPrefix + "/",
+		metaCacheListedPrefix + subPrefix,
+	} {
+		iter := mc.db.NewIterator(leveldb_util.BytesPrefix([]byte(prefix)), nil)
+		for iter.Next() {
+			key := string(iter.Key())
+			if strings.HasPrefix(key, metaCacheListedPrefix) && key != prefix && !strings.HasPrefix(key, prefix+"/") {
+				// another directory sharing the name prefix
+				continue
+			}
+			batch.Delete(append([]byte(nil), iter.Key()...))
+		}
+		iter.Release()
+	}
+}
+
+// subscribeMetaEvents keeps the meta cache up to date with the metadata events under the mounted path
+func (wfs *WFS) subscribeMetaEvents(mc *MetaCache) {
+
+	ctx := context.Background()
+
+	for {
+		if mc.LastTsNs() == 0 {
+			// the filer subscribes from now, missing any change since the cached entries were saved
+			if err := mc.reset(); err != nil {
+				glog.Errorf("reset meta cache: %v", err)
+				time.Sleep(3 * time.Second)
+				continue
+			}
+		}
+
+		err := wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+
+			stream, err := client.SubscribeMetadata(ctx, &filer_pb.SubscribeMetadataRequest{
+				ClientName: "mount",
+				PathPrefix: wfs.option.FilerMountRootPath,
+				SinceNs:    mc.LastTsNs(),
+			})
+			if err != nil {
+				return err
+			}
+
+			for {
+				resp, err := stream.Recv()
+				if err != nil {
+					return err
+				}
+				if resp.EventNotification == nil {
+					glog.V(0).Infof("meta cache subscribed to %s since %d", wfs.option.FilerMountRootPath, resp.TsNs)
+					if err := mc.confirmSubscription(resp.TsNs); err != nil {
+						return fmt.Errorf("save subscription time: %v", err)
+					}
+					mc.setActive(true)
+					continue
+				}
+				if err := mc.ApplyEvent(resp); err != nil {
+					return fmt.Errorf("apply event: %v", err)
+				}
+			}
+
+		})
+
+		mc.setActive(false)
+
+		if status.Code(err) == codes.Unimplemented {
+			glog.V(0).Infof("meta cache is disabled: the filer does not push metadata events")
+			return
+		}
+		if err != nil && strings.Contains(err.Error(), filer2.ErrMetaEventsLost.Error()) {
+			glog.V(0).Infof("meta cache is out of date: %v", err)
+			if resetErr := mc.reset(); resetErr != nil {
+				glog.Errorf("reset meta cache: %v", resetErr)
+			}
+			continue
+		}
+
+		glog.V(0).Infof("subscribe metadata events: %v", err)
+		time.Sleep(3 * time.Second)
+	}
+
+}
@@ -0,0 +1,120 @@
+package filesys
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func TestMetaCache(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "meta")
+	if err != nil {
+		t.Fatalf("temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	mc, err := NewMetaCache(dir)
+	if err != nil {
+		t.Fatalf("open meta cache: %v", err)
+	}
+
+	// not used until subscribed
+	mc.CacheDirectory("/a", []*filer_pb.Entry{{Name: "x"}}, mc.Changes())
+	if _, cached := mc.ListDirectory("/a"); cached {
+		t.Errorf("meta cache is used before subscribed")
+	}
+	mc.setActive(true)
+
+	mc.CacheDirectory("/a", []*filer_pb.Entry{{Name: "b", IsDirectory: true}, {Name: "f1"}}, mc.Changes())
+	mc.CacheDirectory("/a/b", []*filer_pb.Entry{{Name: "f2"}}, mc.Changes())
+	mc.CacheDirectory("/a/bc", []*filer_pb.Entry{{Name: "f3"}}, mc.Changes())
+
+	if entries, cached := mc.ListDirectory("/a"); !cached || len(entries) != 2 || entries[0].Name != "b" || entries[1].Name != "f1" {
+		t.Errorf("unexpected listing of /a: %v %v", entries, cached)
+	}
+	if entry, cached := mc.LookupEntry("/a", "f1"); !cached || entry == nil {
+		t.Errorf("f1 is not cached")
+	}
+	if entry, cached := mc.LookupEntry("/a", "missing"); !cached || entry != nil {
+		t.Errorf("missing entry in a listed directory: %v %v", entry, cached)
+	}
+	if _, cached := mc.LookupEntry("/other", "f1"); cached {
+		t.Errorf("entry in a directory not listed is cached")
+	}
+
+	// a listing overlapping with an event is not cached
+	changes := mc.Changes()
+	if err = mc.ApplyEvent(&filer_pb.SubscribeMetadataResponse{
+		Directory: "/a",
+		EventNotification: &filer_pb.EventNotification{
+			NewEntry:      &filer_pb.Entry{Name: "f4"},
+			NewParentPath: "/a",
+		},
+		TsNs: 100,
+	}); err != nil {
+		t.Fatalf("apply event: %v", err)
+	}
+	mc.CacheDirectory("/d", []*filer_pb.Entry{{Name: "f5"}}, changes)
+	if _, cached := mc.ListDirectory("/d"); cached {
+		t.Errorf("stale listing is cached")
+	}
+	if entries, _ := mc.ListDirectory("/a"); len(entries) != 3 {
+		t.Errorf("created entry is not in the listing: %v", entries)
+	}
+
+	// moving a directory drops everything under it
+	if err = mc.ApplyEvent(&filer_pb.SubscribeMetadataResponse{
+		Directory: "/a",
+		EventNotification: &filer_pb.EventNotification{
+			OldEntry: &filer_pb.Entry{Name: "b", IsDirectory: true},
+		},
+		TsNs: 200,
+	}); err != nil {
+		t.Fatalf("apply event: %v", err)
+	}
+	if _, cached := mc.ListDirectory("/a/b"); cached {
+		t.Errorf("deleted directory is still listed")
+	}
+	if entry, cached := mc.LookupEntry("/a", "b"); !cached || entry != nil {
+		t.Errorf("deleted directory is still cached")
+	}
+	if entries, cached := mc.ListDirectory("/a/bc"); !cached || len(entries) != 1 {
+		t.Errorf("directory sharing the name prefix is dropped")
+	}
+
+	// kept across restarts
+	mc.Shutdown()
+	if mc, err = NewMetaCache(dir); err != nil {
+		t.Fatalf("reopen meta cache: %v", err)
+	}
+	defer mc.Shutdown()
+	if mc.LastTsNs() != 200 {
+		t.Errorf("unexpected last event time %d", mc.LastTsNs())
+	}
+	mc.setActive(true)
+	if entries, cached := mc.ListDirectory("/a"); !cached || len(entries) != 2 {
+		t.Errorf("unexpected listing of /a after reopen: %v", entries)
+	}
+
+	if err = mc.reset(); err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+	if _, cached := mc.ListDirectory("/a"); cached || mc.LastTsNs() != 0 {
+		t.Errorf("meta cache is not reset")
+	}
+
+	// the subscription time is kept before any event, but never goes back
+	if err = mc.confirmSubscription(300); err != nil {
+		t.Fatalf("confirm subscription: %v", err)
+	}
+	if err = mc.confirmSubscription(250); err != nil {
+		t.Fatalf("confirm subscription: %v", err)
+	}
+	if mc.LastTsNs() != 300 {
+		t.Errorf("unexpected subscription time %d", mc.LastTsNs())
+	}
+
+}
@@ -0,0 +1,144 @@
+package filesys
+
+import (
+	"context"
+	"sync"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+const (
+	// the number of consecutive sequential reads to start reading ahead
+	sequentialReadThreshold = 2
+	// asynchronous reads may arrive slightly out of order, and still count as sequential
+	sequentialReadSlack = 1024 * 1024
+)
+
+// readAheadState detects sequential reads on a file handle
+type readAheadState struct {
+	sync.Mutex
+	lastStop        int64
+	sequentialReads int
+	prefetchedStop  int64
+}
+
+// isSequential records the read, and tells whether the recent reads are sequential
+func (ra *readAheadState) isSequential(offset int64, size int) bool {
+	ra.Lock()
+	defer ra.Unlock()
+
+	if ra.lastStop-sequentialReadSlack <= offset && offset <= ra.lastStop+sequentialReadSlack {
+		ra.sequentialReads++
+	} else {
+		ra.sequentialReads = 0
+		ra.prefetchedStop = 0
+	}
+
+	if stop := offset + int64(size); stop > ra.lastStop || ra.sequentialReads == 0 {
+		ra.lastStop = stop
+	}
+
+	return ra.sequentialReads >= sequentialReadThreshold
+}
+
+// nextWindow returns the range not prefetched yet within the window after the last read
+func (ra *readAheadState) nextWindow(window int64) (start, stop int64) {
+	ra.Lock()
+	defer ra.Unlock()
+
+	start, stop = ra.lastStop, ra.lastStop+window
+	if ra.prefetchedStop > start {
+		start = ra.prefetchedStop
+	}
+	if start < stop {
+		ra.prefetchedStop = stop
+	}
+	return
+}
+
+// maybeReadAhead prefetches the chunks after a sequential read into the chunk cache.
+// Random reads are not affected.
+func (fh *FileHandle) maybeReadAhead(offset int64, size int) {
+
+	wfs := fh.f.wfs
+	if wfs.option.ReadAheadSize <= 0 || wfs.chunkCache == nil {
+		return
+	}
+
+	if !fh.readAhead.isSequential(offset, size) {
+		return
+	}
+
+	start, stop := fh.readAhead.nextWindow(wfs.option.ReadAheadSize)
+	if start >= stop {
+		return
+	}
+
+	chunks := make(map[string]*filer_pb.FileChunk)
+	for _, chunk := range fh.f.dataChunks() {
+		chunks[chunk.GetFileIdString()] = chunk
+	}
+
+	var chunksToFetch []*filer_pb.FileChunk
+	for _, chunkView := range filer2.ViewFromVisibleIntervals(fh.f.entryViewCache, start, int(stop-start)) {
+		if chunk, found := chunks[chunkView.FileId]; found && wfs.chunkCache.IsCacheable(chunk.Size) {
+			chunksToFetch = append(chunksToFetch, chunk)
+		}
+	}
+
+	wfs.prefetchChunks(fh.f.fullpath(), chunksToFetch)
+}
+
+// prefetchChunks fetches the chunks in the background, skipping the cached chunks and those already being fetched
+func (wfs *WFS) prefetchChunks(fullpath string, chunks []*filer_pb.FileChunk) {
+
+	var toFetch []*filer_pb.FileChunk
+	var done []chan struct{}
+
+	wfs.prefetchLock.Lock()
+	for _, chunk := range chunks {
+		fileId := chunk.GetFileIdString()
+		if _, found := wfs.prefetching[fileId]; found {
+			continue
+		}
+		if wfs.chunkCache.GetChunk(fileId) != nil {
+			continue
+		}
+		ch := make(chan struct{})
+		wfs.prefetching[fileId] = ch
+		toFetch = append(toFetch, chunk)
+		done = append(done, ch)
+	}
+	wfs.prefetchLock.Unlock()
+
+	if len(toFetch) == 0 {
+		return
+	}
+
+	glog.V(4).Infof("%s read ahead %d chunks", fullpath, len(toFetch))
+
+	go func() {
+		if _, err := wfs.fetchChunks(context.Background(), fullpath, toFetch); err != nil {
+			glog.V(1).Infof("%s read ahead: %v", fullpath, err)
+		}
+		wfs.prefetchLock.Lock()
+		for i, chunk := range toFetch {
+			delete(wfs.prefetching, chunk.GetFileIdString())
+			close(done[i])
+		}
+		wfs.prefetchLock.Unlock()
+	}()
+}
+
+// waitForPrefetch waits until the chunk is fetched, if it is being prefetched
+func (wfs *WFS) waitForPrefetch(fileId string) {
+	wfs.prefetchLock.Lock()
+	ch, found := wfs.prefetching[fileId]
+	wfs.prefetchLock.Unlock()
+
+	if found {
+		<-ch
+	}
+}
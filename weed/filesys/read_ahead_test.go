@@ -0,0 +1,41 @@
+package filesys
+
+import "testing"
+
+func TestSequentialReadDetection(t *testing.T) {
+
+	ra := &readAheadState{}
+	const size = 128 * 1024
+
+	if ra.isSequential(0, size) {
+		t.Errorf("the first read should not trigger read ahead")
+	}
+	if !ra.isSequential(size, size) {
+		t.Errorf("the second sequential read should trigger read ahead")
+	}
+
+	start, stop := ra.nextWindow(1024 * 1024)
+	if start != 2*size || stop != 2*size+1024*1024 {
+		t.Errorf("unexpected window [%d,%d)", start, stop)
+	}
+
+	// out of order asynchronous reads still count as sequential
+	if !ra.isSequential(3*size, size) || !ra.isSequential(2*size, size) {
+		t.Errorf("slightly out of order reads should be sequential")
+	}
+
+	// only the part after the previous window is prefetched
+	start, stop = ra.nextWindow(1024 * 1024)
+	if start != 2*size+1024*1024 || stop != 4*size+1024*1024 {
+		t.Errorf("unexpected window [%d,%d)", start, stop)
+	}
+
+	// a random read resets the detection
+	if ra.isSequential(100*1024*1024, size) {
+		t.Errorf("random read should not trigger read ahead")
+	}
+	if ra.isSequential(10*1024*1024, size) {
+		t.Errorf("random read should not trigger read ahead")
+	}
+
+}
@@ -0,0 +1,70 @@
+package filesys
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+// the storage policies are reloaded from the filer after this long
+const storagePolicyCacheTtl = time.Minute
+
+// storagePolicyCache keeps the storage policies of the filer, for the chunk size of the files.
+// The collection, replication and ttl are applied by the filer when assigning the volumes and saving the entries.
+type storagePolicyCache struct {
+	sync.Mutex
+	policies *filer2.StoragePolicies
+	loadedAt time.Time
+}
+
+func (wfs *WFS) storagePolicy(fullpath string) *filer_pb.StoragePolicy {
+
+	if wfs.storagePolicies == nil {
+		return nil
+	}
+
+	c := wfs.storagePolicies
+	c.Lock()
+	defer c.Unlock()
+
+	if time.Since(c.loadedAt) > storagePolicyCacheTtl {
+		c.loadedAt = time.Now()
+		err := wfs.WithFilerClient(context.Background(), func(client filer_pb.SeaweedFilerClient) error {
+			resp, err := client.LookupDirectoryEntry(context.Background(), &filer_pb.LookupDirectoryEntryRequest{
+				Directory: filer2.StoragePolicyDirectory,
+				Name:      filer2.StoragePolicyName,
+			})
+			if err != nil {
+				if strings.Contains(err.Error(), filer2.ErrNotFound.Error()) {
+					c.policies = nil
+					return nil
+				}
+				return err
+			}
+			pb, err := filer2.LoadStoragePolicies(resp.Entry.Extended)
+			if err != nil {
+				return err
+			}
+			c.policies = filer2.NewStoragePolicies(pb)
+			return nil
+		})
+		if err != nil {
+			glog.V(1).Infof("load storage policies: %v", err)
+		}
+	}
+
+	return c.policies.Match(fullpath)
+}
+
+// chunkSizeLimit is the chunk size of the storage policy of the file, or the default chunk size
+func (wfs *WFS) chunkSizeLimit(fullpath string) int64 {
+	if p := wfs.storagePolicy(fullpath); p != nil && p.ChunkSizeMb > 0 {
+		return int64(p.ChunkSizeMb) * 1024 * 1024
+	}
+	return wfs.option.ChunkSizeLimit
+}
@@ -1,6 +1,7 @@
 package filesys
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math"
@@ -8,8 +9,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/chrislusf/seaweedfs/weed/filer2"
 	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/karlseguin/ccache"
 	"github.com/seaweedfs/fuse"
@@ -26,10 +30,32 @@ type Option struct {
 	TtlSec             int32
 	ChunkSizeLimit     int64
 	DataCenter         string
+	Durability         string
 	DirListingLimit    int
 	EntryCacheTtl      time.Duration
 	Umask              os.FileMode
 
+	// chunk cache, disabled if both size limits are zero
+	ChunkCacheDir             string
+	ChunkCacheSizeLimit       int64
+	ChunkCacheMemorySizeLimit int64
+	// prefetch this many bytes into the chunk cache after sequential reads, 0 to disable
+	ReadAheadSize int64
+
+	// memory for the dirty pages of each open file, beyond which they are moved to a swap file in SwapDir, or uploaded
+	DirtyPagesMemoryLimit int64
+	SwapDir               string
+
+	// keep the metadata in a local cache kept up to date by the filer, disabled if empty
+	MetaCacheDir string
+
+	// stage the flushed files in WritebackDir and upload them in the background, disabled if empty
+	WritebackDir         string
+	WritebackConcurrency int
+
+	// translates the owners by user and group names, nil to keep the numeric ids
+	IdentityMapper *util.IdentityMapper
+
 	MountUid   uint32
 	MountGid   uint32
 	MountMode  os.FileMode
@@ -48,7 +74,21 @@ type WFS struct {
 	handles           []*FileHandle
 	pathToHandleIndex map[string]int
 	pathToHandleLock  sync.Mutex
-	bufPool           sync.Pool
+
+	chunkCache *ChunkCache
+
+	// nil if disabled, and not used until subscribed to the metadata events
+	metaCache *MetaCache
+
+	// nil unless in writeback mode
+	writeback *writeback
+
+	// nil to use the default chunk size only
+	storagePolicies *storagePolicyCache
+
+	// chunks being read ahead
+	prefetching  map[string]chan struct{}
+	prefetchLock sync.Mutex
 
 	stats statsCache
 }
@@ -62,11 +102,36 @@ func NewSeaweedFileSystem(option *Option) *WFS {
 		option:                    option,
 		listDirectoryEntriesCache: ccache.New(ccache.Configure().MaxSize(1024 * 8).ItemsToPrune(100)),
 		pathToHandleIndex:         make(map[string]int),
-		bufPool: sync.Pool{
-			New: func() interface{} {
-				return make([]byte, option.ChunkSizeLimit)
-			},
-		},
+		prefetching:               make(map[string]chan struct{}),
+		storagePolicies:           &storagePolicyCache{},
+	}
+	operation.EnableGrpcDataPlane(option.GrpcDialOption)
+
+	if option.ChunkCacheSizeLimit > 0 || option.ChunkCacheMemorySizeLimit > 0 {
+		chunkCache, err := NewChunkCache(option.ChunkCacheMemorySizeLimit, option.ChunkCacheDir, option.ChunkCacheSizeLimit)
+		if err != nil {
+			glog.Errorf("chunk cache is disabled: %v", err)
+		} else {
+			wfs.chunkCache = chunkCache
+		}
+	}
+
+	if option.MetaCacheDir != "" {
+		metaCache, err := NewMetaCache(option.MetaCacheDir)
+		if err != nil {
+			glog.Errorf("meta cache is disabled: %v", err)
+		} else {
+			wfs.metaCache = metaCache
+			go wfs.subscribeMetaEvents(metaCache)
+		}
+	}
+
+	if option.WritebackDir != "" {
+		writeback, err := newWriteback(wfs, option.WritebackDir, option.WritebackConcurrency)
+		if err != nil {
+			glog.Fatalf("writeback mode: %v", err)
+		}
+		wfs.writeback = writeback
 	}
 
 	return wfs
@@ -190,3 +255,85 @@ func (wfs *WFS) Statfs(ctx context.Context, req *fuse.StatfsRequest, resp *fuse.
 
 	return nil
 }
+
+// saveDataAsChunk uploads the data to a volume server assigned by the filer, as a chunk at the offset
+func (wfs *WFS) saveDataAsChunk(ctx context.Context, fullpath string, buf []byte, offset int64) (*filer_pb.FileChunk, error) {
+
+	dir, name := filer2.FullPath(fullpath).DirAndName()
+
+	var fileId, host, durability string
+	var auth security.EncodedJwt
+
+	if err := wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+
+		request := &filer_pb.AssignVolumeRequest{
+			Count:       1,
+			Replication: wfs.option.Replication,
+			Collection:  wfs.option.Collection,
+			TtlSec:      wfs.option.TtlSec,
+			DataCenter:  wfs.option.DataCenter,
+			ParentPath:  dir,
+			Durability:  wfs.option.Durability,
+		}
+
+		resp, err := client.AssignVolume(ctx, request)
+		if err != nil {
+			glog.V(0).Infof("assign volume failure %v: %v", request, err)
+			return err
+		}
+
+		fileId, host, durability, auth = resp.FileId, resp.Url, resp.Durability, security.EncodedJwt(resp.Auth)
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("filerGrpcAddress assign volume: %v", err)
+	}
+
+	fileUrl := operation.WithDurability(fmt.Sprintf("http://%s/%s", host, fileId), durability)
+	bufReader := bytes.NewReader(buf)
+	uploadResult, err := operation.Upload(fileUrl, name, bufReader, "", "application/octet-stream", nil, auth)
+	if err != nil {
+		glog.V(0).Infof("upload data %v to %s: %v", name, fileUrl, err)
+		return nil, fmt.Errorf("upload data: %v", err)
+	}
+	if uploadResult.Error != "" {
+		glog.V(0).Infof("upload failure %v to %s: %v", name, fileUrl, err)
+		return nil, fmt.Errorf("upload result: %v", uploadResult.Error)
+	}
+
+	return &filer_pb.FileChunk{
+		FileId: fileId,
+		Offset: offset,
+		Size:   uint64(len(buf)),
+		Mtime:  time.Now().UnixNano(),
+		ETag:   uploadResult.ETag,
+		Md5:    uploadResult.ContentMd5,
+	}, nil
+
+}
+
+// setUid saves the local uid as the owner, with the user name if the identities are mapped
+func (wfs *WFS) setUid(attributes *filer_pb.FuseAttributes, uid uint32) {
+	attributes.Uid = uid
+	if wfs.option.IdentityMapper != nil {
+		attributes.UserName = wfs.option.IdentityMapper.UserName(uid)
+	}
+}
+
+// setGid saves the local gid as the group, with the group name if the identities are mapped
+func (wfs *WFS) setGid(attributes *filer_pb.FuseAttributes, gid uint32) {
+	attributes.Gid = gid
+	if wfs.option.IdentityMapper != nil {
+		if name := wfs.option.IdentityMapper.GroupName(gid); name != "" {
+			attributes.GroupName = []string{name}
+		} else {
+			attributes.GroupName = nil
+		}
+	}
+}
+
+// localOwner returns the local uid and gid of the saved owner and group
+func (wfs *WFS) localOwner(attributes *filer_pb.FuseAttributes) (uid, gid uint32) {
+	return wfs.option.IdentityMapper.Uid(attributes.UserName, attributes.Uid),
+		wfs.option.IdentityMapper.Gid(attributes.GroupName, attributes.Gid)
+}
@@ -10,16 +10,35 @@ import (
 	"google.golang.org/grpc"
 )
 
+// deleteFileChunks deletes the chunks the filer does not know about, e.g., the chunks replaced before the file is saved.
+// The deduplicated chunks are shared by other files, and are only released by the filer, when the entry is saved or deleted.
 func (wfs *WFS) deleteFileChunks(ctx context.Context, chunks []*filer_pb.FileChunk) {
 	if len(chunks) == 0 {
 		return
 	}
 
-	var fileIds []string
+	allChunks, err := filer2.ResolveAllChunks(filer2.LookupFn(ctx, wfs), chunks)
+	if err != nil {
+		glog.V(0).Infof("resolve manifest chunks: %v", err)
+		_, allChunks = filer2.SeparateManifestChunks(chunks)
+	}
+	chunks = allChunks
+
+	var fileIds, cachedFileIds []string
 	for _, chunk := range chunks {
+		cachedFileIds = append(cachedFileIds, chunk.GetFileIdString())
+		if chunk.ContentHash != "" {
+			continue
+		}
 		fileIds = append(fileIds, chunk.GetFileIdString())
 	}
 
+	wfs.chunkCache.DeleteChunks(cachedFileIds)
+
+	if len(fileIds) == 0 {
+		return
+	}
+
 	wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
 		deleteFileIds(ctx, wfs.option.GrpcDialOption, client, fileIds)
 		return nil
@@ -0,0 +1,672 @@
+package filesys
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/golang/protobuf/proto"
+	"github.com/seaweedfs/fuse"
+)
+
+const (
+	writebackMinRetryDelay = time.Second
+	writebackMaxRetryDelay = time.Minute
+)
+
+// writeback stages the data of the flushed files on local disk, and uploads them with background workers,
+// so that closing a file does not wait for the volume servers and the filer.
+// Each staged file has an append only data file and a journal in the staging directory.
+// The journal is replaced on each change, and the pending uploads are resumed after the mount restarts.
+type writeback struct {
+	wfs  *WFS
+	dir  string
+	lock sync.Mutex
+	// the staged files by full path
+	files  map[string]*stagedFile
+	queue  chan *stagedFile
+	nextId int64
+}
+
+type stagedFile struct {
+	sync.Mutex
+	id        string
+	fullpath  string
+	entry     *filer_pb.Entry
+	intervals []*stagedInterval // in the order of staging, so later ones overwrite earlier ones
+	dataFile  *os.File
+	dataSize  int64
+	version   int64 // changed on each change to the staged file
+	epoch     int64 // changed when the staged data is discarded, so that the uploads in progress are dropped
+	queued    bool
+	uploading bool
+	removed   bool
+	attempts  int
+	done      chan struct{} // closed after the file is uploaded or discarded
+	// closed after the entry is saved to the filer, and the changes to the staged file wait for it
+	committing chan struct{}
+}
+
+type stagedInterval struct {
+	Offset     int64
+	Size       int64
+	DataOffset int64 // the offset in the data file
+	Mtime      int64 // when it is staged, used as the chunk mtime to keep the write order
+}
+
+type stagedJournal struct {
+	FullPath  string
+	Entry     []byte
+	Intervals []*stagedInterval
+}
+
+func newWriteback(wfs *WFS, dir string, concurrency int) (*writeback, error) {
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("create writeback directory %s: %v", dir, err)
+	}
+
+	wb := &writeback{
+		wfs:    wfs,
+		dir:    dir,
+		files:  make(map[string]*stagedFile),
+		queue:  make(chan *stagedFile),
+		nextId: time.Now().UnixNano(),
+	}
+
+	if err := wb.loadJournals(); err != nil {
+		return nil, err
+	}
+
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	for i := 0; i < concurrency; i++ {
+		go wb.loopUploading()
+	}
+
+	for _, sf := range wb.files {
+		sf.Lock()
+		wb.enqueue(sf)
+		sf.Unlock()
+	}
+
+	return wb, nil
+}
+
+func (wb *writeback) loadJournals() error {
+
+	fileInfos, err := ioutil.ReadDir(wb.dir)
+	if err != nil {
+		return fmt.Errorf("list writeback directory %s: %v", wb.dir, err)
+	}
+
+	for _, fileInfo := range fileInfos {
+		if !strings.HasSuffix(fileInfo.Name(), ".meta") {
+			continue
+		}
+		id := strings.TrimSuffix(fileInfo.Name(), ".meta")
+		sf, err := wb.loadJournal(id)
+		if err != nil {
+			// keep the files for manual recovery
+			glog.Errorf("load writeback journal %s: %v", id, err)
+			continue
+		}
+		if existing, found := wb.files[sf.fullpath]; found {
+			// only the latest staging of a path is kept
+			if existing.id > sf.id {
+				existing, sf = sf, existing
+			}
+			glog.V(0).Infof("discard older writeback journal %s of %s", existing.id, existing.fullpath)
+			wb.removeStagingFiles(existing)
+		}
+		wb.files[sf.fullpath] = sf
+		glog.V(0).Infof("resume writeback of %s: %d intervals", sf.fullpath, len(sf.intervals))
+	}
+
+	return nil
+}
+
+func (wb *writeback) loadJournal(id string) (*stagedFile, error) {
+
+	data, err := ioutil.ReadFile(filepath.Join(wb.dir, id+".meta"))
+	if err != nil {
+		return nil, err
+	}
+	journal := &stagedJournal{}
+	if err = json.Unmarshal(data, journal); err != nil {
+		return nil, fmt.Errorf("unmarshal: %v", err)
+	}
+	entry := &filer_pb.Entry{}
+	if err = proto.Unmarshal(journal.Entry, entry); err != nil {
+		return nil, fmt.Errorf("unmarshal entry: %v", err)
+	}
+
+	dataFile, err := os.OpenFile(filepath.Join(wb.dir, id+".dat"), os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	stat, err := dataFile.Stat()
+	if err != nil {
+		dataFile.Close()
+		return nil, err
+	}
+
+	sf := &stagedFile{
+		id:       id,
+		fullpath: journal.FullPath,
+		entry:    entry,
+		dataFile: dataFile,
+		dataSize: stat.Size(),
+		done:     make(chan struct{}),
+	}
+	for _, iv := range journal.Intervals {
+		if iv.DataOffset+iv.Size > sf.dataSize {
+			glog.Errorf("writeback %s of %s: interval [%d,%d) is not in the data file", id, sf.fullpath, iv.Offset, iv.Offset+iv.Size)
+			continue
+		}
+		sf.intervals = append(sf.intervals, iv)
+	}
+
+	return sf, nil
+}
+
+// stage moves the dirty pages and the entry of the file handle to the staging directory, and queues the upload
+func (wb *writeback) stage(fh *FileHandle, req *fuse.FlushRequest) error {
+
+	fullpath := fh.f.fullpath()
+
+	sf, err := wb.getOrCreate(fullpath)
+	if err != nil {
+		return err
+	}
+
+	sf.lockForChange()
+	defer sf.Unlock()
+
+	if err = fh.dirtyPages.StageTo(sf.appendInterval); err != nil {
+		if sf.entry == nil {
+			// nothing is staged for the path before
+			wb.remove(sf)
+		}
+		return fmt.Errorf("stage dirty pages: %v", err)
+	}
+
+	fh.setFlushAttributes(req)
+	sf.entry = proto.Clone(fh.f.entry).(*filer_pb.Entry)
+	sf.version++
+
+	if err = wb.saveJournal(sf); err != nil {
+		return err
+	}
+
+	glog.V(3).Infof("%s staged %d intervals, %d bytes", fullpath, len(sf.intervals), sf.dataSize)
+
+	wb.enqueue(sf)
+
+	return nil
+}
+
+func (wb *writeback) getOrCreate(fullpath string) (*stagedFile, error) {
+
+	wb.lock.Lock()
+	defer wb.lock.Unlock()
+
+	if sf, found := wb.files[fullpath]; found {
+		return sf, nil
+	}
+
+	id := fmt.Sprintf("%016x", atomic.AddInt64(&wb.nextId, 1))
+	dataFile, err := os.OpenFile(filepath.Join(wb.dir, id+".dat"), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("create writeback data file: %v", err)
+	}
+
+	sf := &stagedFile{
+		id:       id,
+		fullpath: fullpath,
+		dataFile: dataFile,
+		done:     make(chan struct{}),
+	}
+	wb.files[fullpath] = sf
+
+	return sf, nil
+}
+
+func (sf *stagedFile) appendInterval(offset int64, data []byte) error {
+	if _, err := sf.dataFile.WriteAt(data, sf.dataSize); err != nil {
+		return err
+	}
+	sf.intervals = append(sf.intervals, &stagedInterval{
+		Offset:     offset,
+		Size:       int64(len(data)),
+		DataOffset: sf.dataSize,
+		Mtime:      time.Now().UnixNano(),
+	})
+	sf.dataSize += int64(len(data))
+	return nil
+}
+
+// readAt copies the staged data overlapping the buffer, and returns the stop offset of the copied data
+func (sf *stagedFile) readAt(buf []byte, offset int64) (maxStop int64) {
+	stop := offset + int64(len(buf))
+	for _, iv := range sf.intervals {
+		if iv.Offset+iv.Size <= offset || iv.Offset >= stop {
+			continue
+		}
+		start, end := max(iv.Offset, offset), min(iv.Offset+iv.Size, stop)
+		if _, err := sf.dataFile.ReadAt(buf[start-offset:end-offset], iv.DataOffset+start-iv.Offset); err != nil {
+			glog.Errorf("read staged %s: %v", sf.fullpath, err)
+			continue
+		}
+		maxStop = max(maxStop, end)
+	}
+	return
+}
+
+func (sf *stagedFile) size() (size int64) {
+	for _, iv := range sf.intervals {
+		size = max(size, iv.Offset+iv.Size)
+	}
+	return
+}
+
+// saveJournal replaces the journal of the staged file, after the staged data is persisted
+func (wb *writeback) saveJournal(sf *stagedFile) error {
+
+	if err := sf.dataFile.Sync(); err != nil {
+		return fmt.Errorf("sync writeback data file: %v", err)
+	}
+
+	entryData, err := proto.Marshal(sf.entry)
+	if err != nil {
+		return fmt.Errorf("marshal entry: %v", err)
+	}
+	data, err := json.Marshal(&stagedJournal{
+		FullPath:  sf.fullpath,
+		Entry:     entryData,
+		Intervals: sf.intervals,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal journal: %v", err)
+	}
+
+	journalFile := filepath.Join(wb.dir, sf.id+".meta")
+	if err = ioutil.WriteFile(journalFile+".tmp", data, 0644); err != nil {
+		return fmt.Errorf("write writeback journal: %v", err)
+	}
+	if err = os.Rename(journalFile+".tmp", journalFile); err != nil {
+		return fmt.Errorf("write writeback journal: %v", err)
+	}
+
+	return nil
+}
+
+// enqueue schedules the upload of the staged file, which must be locked
+func (wb *writeback) enqueue(sf *stagedFile) {
+	if sf.queued || sf.removed {
+		return
+	}
+	sf.queued = true
+	go func() {
+		wb.queue <- sf
+	}()
+}
+
+func (wb *writeback) loopUploading() {
+	for sf := range wb.queue {
+		wb.upload(sf)
+	}
+}
+
+func (wb *writeback) upload(sf *stagedFile) {
+
+	sf.Lock()
+	sf.queued = false
+	if sf.removed || sf.uploading {
+		// the upload in progress queues the file again if it is changed
+		sf.Unlock()
+		return
+	}
+	sf.uploading = true
+	version, epoch := sf.version, sf.epoch
+	intervals := make([]*stagedInterval, len(sf.intervals))
+	copy(intervals, sf.intervals)
+	sf.Unlock()
+
+	ctx := context.Background()
+
+	chunks, err := wb.uploadIntervals(ctx, sf, intervals)
+
+	sf.Lock()
+	defer sf.Unlock()
+
+	if err == nil && sf.epoch != epoch {
+		// the staged data is discarded in the meantime
+		sf.uploading = false
+		go wb.wfs.deleteFileChunks(ctx, chunks)
+		wb.enqueue(sf)
+		return
+	}
+
+	if err == nil {
+		err = wb.commit(ctx, sf, chunks)
+	}
+	sf.uploading = false
+
+	if err != nil {
+		go wb.wfs.deleteFileChunks(ctx, chunks)
+		sf.attempts++
+		delay := writebackMinRetryDelay << uint(min(int64(sf.attempts-1), 6))
+		if delay > writebackMaxRetryDelay {
+			delay = writebackMaxRetryDelay
+		}
+		glog.Errorf("writeback %s, attempt %d, retry in %v: %v", sf.fullpath, sf.attempts, delay, err)
+		time.AfterFunc(delay, func() {
+			sf.Lock()
+			wb.enqueue(sf)
+			sf.Unlock()
+		})
+		return
+	}
+
+	sf.attempts = 0
+	sf.intervals = sf.intervals[len(intervals):]
+
+	if sf.version != version || len(sf.intervals) > 0 {
+		// staged again in the meantime
+		if err = wb.saveJournal(sf); err != nil {
+			glog.Errorf("writeback %s: %v", sf.fullpath, err)
+		}
+		wb.enqueue(sf)
+		return
+	}
+
+	glog.V(3).Infof("writeback %s: uploaded", sf.fullpath)
+	wb.remove(sf)
+}
+
+func (wb *writeback) uploadIntervals(ctx context.Context, sf *stagedFile, intervals []*stagedInterval) (chunks []*filer_pb.FileChunk, err error) {
+
+	chunkSize := wb.wfs.chunkSizeLimit(sf.fullpath)
+
+	for _, iv := range intervals {
+		for start := int64(0); start < iv.Size; start += chunkSize {
+			data := make([]byte, min(chunkSize, iv.Size-start))
+			if _, err = sf.dataFile.ReadAt(data, iv.DataOffset+start); err != nil {
+				return chunks, fmt.Errorf("read staged data: %v", err)
+			}
+			var chunk *filer_pb.FileChunk
+			if chunk, err = wb.wfs.saveDataAsChunk(ctx, sf.fullpath, data, iv.Offset+start); err != nil {
+				return chunks, err
+			}
+			chunk.Mtime = iv.Mtime
+			chunks = append(chunks, chunk)
+		}
+	}
+
+	return chunks, nil
+}
+
+// commit saves the staged entry with the uploaded chunks to the filer, and passes the chunks to the open file if any.
+// The staged file must be locked. It is unlocked while waiting for the filer, and the changes to it wait until the commit is done.
+func (wb *writeback) commit(ctx context.Context, sf *stagedFile, chunks []*filer_pb.FileChunk) error {
+
+	dir, name := filer2.FullPath(sf.fullpath).DirAndName()
+
+	entry := proto.Clone(sf.entry).(*filer_pb.Entry)
+	entry.Name = name
+	entry.Chunks = append(entry.Chunks, chunks...)
+	compactedChunks, garbages := filer2.CompactFileChunks(entry.Chunks)
+	entry.Chunks = compactedChunks
+	if entry.Attributes != nil {
+		// the content is changed, and its md5 is not known
+		entry.Attributes.Md5 = nil
+	}
+
+	committing := make(chan struct{})
+	sf.committing = committing
+	sf.Unlock()
+
+	err := wb.wfs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+		if _, err := client.CreateEntry(ctx, &filer_pb.CreateEntryRequest{
+			Directory: dir,
+			Entry:     entry,
+		}); err != nil {
+			return fmt.Errorf("create entry: %v", err)
+		}
+		return nil
+	})
+
+	sf.Lock()
+	sf.committing = nil
+	close(committing)
+	if err != nil {
+		return err
+	}
+
+	sf.entry.Chunks = entry.Chunks
+	go wb.wfs.deleteFileChunks(ctx, garbages)
+	wb.wfs.listDirectoryEntriesCache.Delete(sf.fullpath)
+	wb.wfs.metaCache.InsertEntry(dir, entry)
+
+	wb.wfs.pathToHandleLock.Lock()
+	if index, found := wb.wfs.pathToHandleIndex[sf.fullpath]; found && wb.wfs.handles[index] != nil {
+		wb.wfs.handles[index].f.addChunks(chunks)
+	}
+	wb.wfs.pathToHandleLock.Unlock()
+
+	return nil
+}
+
+// remove drops the staged file, which must be locked
+func (wb *writeback) remove(sf *stagedFile) {
+	sf.removed = true
+	sf.epoch++
+
+	wb.lock.Lock()
+	if wb.files[sf.fullpath] == sf {
+		delete(wb.files, sf.fullpath)
+	}
+	wb.lock.Unlock()
+
+	wb.removeStagingFiles(sf)
+	close(sf.done)
+}
+
+func (wb *writeback) removeStagingFiles(sf *stagedFile) {
+	sf.dataFile.Close()
+	os.Remove(filepath.Join(wb.dir, sf.id+".meta"))
+	os.Remove(sf.dataFile.Name())
+}
+
+func (wb *writeback) get(fullpath string) *stagedFile {
+	if wb == nil {
+		return nil
+	}
+	wb.lock.Lock()
+	defer wb.lock.Unlock()
+	return wb.files[fullpath]
+}
+
+// lockForChange locks the staged file, after the commit in progress if any
+func (sf *stagedFile) lockForChange() {
+	sf.Lock()
+	for sf.committing != nil {
+		committing := sf.committing
+		sf.Unlock()
+		<-committing
+		sf.Lock()
+	}
+}
+
+// lockStaged locks the staged file of the path if any, to read it
+func (wb *writeback) lockStaged(fullpath string) (sf *stagedFile, unlock func()) {
+	return wb.lockStagedWith(fullpath, func(sf *stagedFile) { sf.Lock() })
+}
+
+// lockStagedForChange locks the staged file of the path if any, so that its uploads are not applied until unlocked
+func (wb *writeback) lockStagedForChange(fullpath string) (sf *stagedFile, unlock func()) {
+	return wb.lockStagedWith(fullpath, (*stagedFile).lockForChange)
+}
+
+func (wb *writeback) lockStagedWith(fullpath string, lockFn func(sf *stagedFile)) (sf *stagedFile, unlock func()) {
+	if sf = wb.get(fullpath); sf == nil {
+		return nil, func() {}
+	}
+	lockFn(sf)
+	if sf.removed {
+		sf.Unlock()
+		return nil, func() {}
+	}
+	return sf, sf.Unlock
+}
+
+// stagedEntry returns a copy of the staged entry of the path, or nil if not staged
+func (wb *writeback) stagedEntry(fullpath string) *filer_pb.Entry {
+	sf, unlock := wb.lockStaged(fullpath)
+	defer unlock()
+	if sf == nil || sf.entry == nil {
+		return nil
+	}
+	return proto.Clone(sf.entry).(*filer_pb.Entry)
+}
+
+// stagedSize returns the file size covered by the staged data not uploaded yet
+func (wb *writeback) stagedSize(fullpath string) int64 {
+	sf, unlock := wb.lockStaged(fullpath)
+	defer unlock()
+	if sf == nil {
+		return 0
+	}
+	return sf.size()
+}
+
+// stagedPaths lists the path if it is staged, and the staged files under it if it is a directory
+func (wb *writeback) stagedPaths(fullpath string) (paths []string) {
+	if wb == nil {
+		return nil
+	}
+	wb.lock.Lock()
+	defer wb.lock.Unlock()
+	for p := range wb.files {
+		if p == fullpath || strings.HasPrefix(p, fullpath+"/") {
+			paths = append(paths, p)
+		}
+	}
+	return
+}
+
+// stagedNames lists the staged files directly under the directory
+func (wb *writeback) stagedNames(dir string) map[string]bool {
+	if wb == nil {
+		return nil
+	}
+	wb.lock.Lock()
+	defer wb.lock.Unlock()
+	names := make(map[string]bool)
+	for fullpath := range wb.files {
+		if parent, name := filer2.FullPath(fullpath).DirAndName(); parent == dir {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+// updateEntry replaces the staged entry, e.g., after its attributes are changed.
+// It returns false if the path is not staged.
+func (wb *writeback) updateEntry(fullpath string, attributes *filer_pb.FuseAttributes) (bool, error) {
+	sf, unlock := wb.lockStagedForChange(fullpath)
+	defer unlock()
+	if sf == nil || sf.entry == nil {
+		return false, nil
+	}
+	sf.entry.Attributes = proto.Clone(attributes).(*filer_pb.FuseAttributes)
+	sf.version++
+	if err := wb.saveJournal(sf); err != nil {
+		return true, err
+	}
+	wb.enqueue(sf)
+	return true, nil
+}
+
+// truncate discards the staged data and chunks of the path
+func (wb *writeback) truncate(fullpath string) error {
+	sf, unlock := wb.lockStagedForChange(fullpath)
+	defer unlock()
+	if sf == nil {
+		return nil
+	}
+	sf.intervals = nil
+	sf.epoch++
+	sf.version++
+	if sf.entry != nil {
+		sf.entry.Chunks = nil
+	}
+	if err := wb.saveJournal(sf); err != nil {
+		return err
+	}
+	wb.enqueue(sf)
+	return nil
+}
+
+// discard drops the staged file of the path, or the staged files under the directory, e.g., when it is deleted.
+// It returns false if nothing is staged.
+func (wb *writeback) discard(fullpath string) (discarded bool) {
+	for _, p := range wb.stagedPaths(fullpath) {
+		if wb.discardOne(p) {
+			discarded = true
+		}
+	}
+	return
+}
+
+func (wb *writeback) discardOne(fullpath string) bool {
+	sf, unlock := wb.lockStagedForChange(fullpath)
+	defer unlock()
+	if sf == nil {
+		return false
+	}
+	glog.V(3).Infof("writeback %s: discard %d intervals", fullpath, len(sf.intervals))
+	wb.remove(sf)
+	return true
+}
+
+// waitForUpload waits until the staged file of the path, or the staged files under the directory, are uploaded
+func (wb *writeback) waitForUpload(ctx context.Context, fullpath string) error {
+	for _, p := range wb.stagedPaths(fullpath) {
+		if err := wb.waitForUploadOne(ctx, p); err != nil {
+			return fmt.Errorf("%s: %v", p, err)
+		}
+	}
+	return nil
+}
+
+func (wb *writeback) waitForUploadOne(ctx context.Context, fullpath string) error {
+	sf, unlock := wb.lockStaged(fullpath)
+	if sf == nil {
+		unlock()
+		return nil
+	}
+	// retry now instead of waiting for the backoff
+	sf.attempts = 0
+	wb.enqueue(sf)
+	unlock()
+
+	select {
+	case <-sf.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,152 @@
+package filesys
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func newTestWriteback(dir string) *writeback {
+	return &writeback{
+		dir:   dir,
+		files: make(map[string]*stagedFile),
+		queue: make(chan *stagedFile),
+	}
+}
+
+func TestWritebackJournal(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "writeback")
+	if err != nil {
+		t.Fatalf("temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	pages := newTestDirtyPages(1024*1024, "")
+	ctx := context.Background()
+	for _, w := range []struct {
+		offset int64
+		data   string
+	}{
+		{0, "aaaaaaaaaa"},
+		{20, "bbbbb"},
+	} {
+		if _, err := pages.AddPage(ctx, w.offset, []byte(w.data)); err != nil {
+			t.Fatalf("add page %d: %v", w.offset, err)
+		}
+	}
+
+	wb := newTestWriteback(dir)
+	sf, err := wb.getOrCreate("/dir/file")
+	if err != nil {
+		t.Fatalf("create staged file: %v", err)
+	}
+	if err = pages.StageTo(sf.appendInterval); err != nil {
+		t.Fatalf("stage: %v", err)
+	}
+	if pages.hasData() {
+		t.Errorf("dirty pages are not cleared after staging")
+	}
+	// staged again later, overwriting a part of the first interval
+	if err = sf.appendInterval(5, []byte("ccc")); err != nil {
+		t.Fatalf("stage: %v", err)
+	}
+	sf.entry = &filer_pb.Entry{
+		Name:       "file",
+		Attributes: &filer_pb.FuseAttributes{FileMode: 0644},
+	}
+	if err = wb.saveJournal(sf); err != nil {
+		t.Fatalf("save journal: %v", err)
+	}
+
+	// reload as after the mount restarts
+	reloaded := newTestWriteback(dir)
+	if err = reloaded.loadJournals(); err != nil {
+		t.Fatalf("load journals: %v", err)
+	}
+	loaded := reloaded.get("/dir/file")
+	if loaded == nil {
+		t.Fatalf("staged file is not reloaded")
+	}
+	if len(loaded.intervals) != 3 || loaded.entry.Name != "file" || loaded.entry.Attributes.FileMode != 0644 {
+		t.Errorf("unexpected reloaded staged file: %+v", loaded)
+	}
+	if loaded.size() != 25 {
+		t.Errorf("unexpected staged size %d", loaded.size())
+	}
+
+	buf := make([]byte, 30)
+	if stop := loaded.readAt(buf, 0); stop != 25 {
+		t.Errorf("unexpected staged data stop %d", stop)
+	}
+	if expected := []byte("aaaaacccaa\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00bbbbb\x00\x00\x00\x00\x00"); !bytes.Equal(buf, expected) {
+		t.Errorf("unexpected staged data %q", buf)
+	}
+
+	// the staging files are removed once uploaded or discarded
+	if !reloaded.discard("/dir/file") {
+		t.Errorf("staged file is not discarded")
+	}
+	if fileInfos, _ := ioutil.ReadDir(dir); len(fileInfos) != 0 {
+		t.Errorf("%d staging files left", len(fileInfos))
+	}
+	if reloaded.get("/dir/file") != nil || reloaded.discard("/dir/file") {
+		t.Errorf("staged file is still listed")
+	}
+
+	// not in writeback mode
+	var disabled *writeback
+	if disabled.stagedEntry("/dir/file") != nil || disabled.stagedSize("/dir/file") != 0 || disabled.discard("/dir/file") {
+		t.Errorf("unexpected staged file without writeback")
+	}
+
+}
+
+func TestWritebackDiscardDirectory(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "writeback")
+	if err != nil {
+		t.Fatalf("temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	wb := newTestWriteback(dir)
+	for _, p := range []string{"/dir/a", "/dir/sub/b", "/dir2/c"} {
+		if _, err = wb.getOrCreate(p); err != nil {
+			t.Fatalf("create staged file %s: %v", p, err)
+		}
+	}
+
+	// a commit in progress holds back the discard
+	sf := wb.get("/dir/a")
+	committing := make(chan struct{})
+	sf.committing = committing
+	discarded := make(chan bool)
+	go func() {
+		discarded <- wb.discard("/dir")
+	}()
+	select {
+	case <-discarded:
+		t.Fatalf("discarded during the commit")
+	case <-time.After(50 * time.Millisecond):
+	}
+	sf.Lock()
+	sf.committing = nil
+	close(committing)
+	sf.Unlock()
+
+	if !<-discarded {
+		t.Errorf("the staged files under the directory are not discarded")
+	}
+	if wb.get("/dir/a") != nil || wb.get("/dir/sub/b") != nil {
+		t.Errorf("the staged files under the directory are kept")
+	}
+	if wb.get("/dir2/c") == nil {
+		t.Errorf("the staged file of another directory is discarded")
+	}
+}
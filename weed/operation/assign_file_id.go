@@ -7,6 +7,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"google.golang.org/grpc"
+	"net/url"
 	"strings"
 )
 
@@ -19,6 +20,7 @@ type VolumeAssignRequest struct {
 	Rack                string
 	DataNode            string
 	WritableVolumeCount uint32
+	Durability          string
 }
 
 type AssignResult struct {
@@ -55,6 +57,7 @@ func Assign(server string, grpcDialOption grpc.DialOption, primaryRequest *Volum
 				Rack:                primaryRequest.Rack,
 				DataNode:            primaryRequest.DataNode,
 				WritableVolumeCount: primaryRequest.WritableVolumeCount,
+				Durability:          primaryRequest.Durability,
 			}
 			resp, grpcErr := masterClient.Assign(context.Background(), req)
 			if grpcErr != nil {
@@ -99,3 +102,18 @@ func LookupJwt(master string, fileId string) security.EncodedJwt {
 
 	return security.EncodedJwt(tokenStr)
 }
+
+// WithDurability asks the volume server to sync the upload with the durability, e.g. "fsync", unless it is empty or "none"
+func WithDurability(uploadUrl, durability string) string {
+	if durability == "" || durability == "none" {
+		return uploadUrl
+	}
+	u, err := url.Parse(uploadUrl)
+	if err != nil {
+		return uploadUrl
+	}
+	q := u.Query()
+	q.Set("durability", durability)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
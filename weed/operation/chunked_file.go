@@ -53,10 +53,10 @@ func (s ChunkList) Len() int           { return len(s) }
 func (s ChunkList) Less(i, j int) bool { return s[i].Offset < s[j].Offset }
 func (s ChunkList) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
 
-func LoadChunkManifest(buffer []byte, isGzipped bool) (*ChunkManifest, error) {
-	if isGzipped {
+func LoadChunkManifest(buffer []byte, compression string) (*ChunkManifest, error) {
+	if compression != "" {
 		var err error
-		if buffer, err = util.UnGzipData(buffer); err != nil {
+		if buffer, err = util.DecompressData(compression, buffer); err != nil {
 			return nil, err
 		}
 	}
@@ -0,0 +1,302 @@
+package operation
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+const needlePieceSize = 2 * 1024 * 1024
+
+var ErrGrpcDataPlaneUnavailable = errors.New("volume server grpc data plane is not available")
+
+var (
+	dataPlaneEnabled        bool
+	dataPlaneGrpcDialOption grpc.DialOption
+	// volume servers to reach via http instead, until the time
+	dataPlaneSkipped     = make(map[string]time.Time)
+	dataPlaneSkippedLock sync.Mutex
+)
+
+// EnableGrpcDataPlane lets Upload and ReadNeedles read and write needles via the volume server gRPC,
+// falling back to http for the volume servers without the gRPC data plane.
+// It should be called when the process starts.
+func EnableGrpcDataPlane(grpcDialOption grpc.DialOption) {
+	dataPlaneGrpcDialOption = grpcDialOption
+	dataPlaneEnabled = true
+}
+
+func isGrpcDataPlaneAvailable(volumeServer string) bool {
+	if !dataPlaneEnabled {
+		return false
+	}
+	dataPlaneSkippedLock.Lock()
+	defer dataPlaneSkippedLock.Unlock()
+	if until, found := dataPlaneSkipped[volumeServer]; found {
+		if time.Now().Before(until) {
+			return false
+		}
+		delete(dataPlaneSkipped, volumeServer)
+	}
+	return true
+}
+
+// checkGrpcDataPlaneError skips the gRPC data plane of the volume server for a while,
+// if the volume server is too old to support it, or its gRPC port is not reachable.
+func checkGrpcDataPlaneError(volumeServer string, err error) error {
+	var skipDuration time.Duration
+	switch status.Code(err) {
+	case codes.Unimplemented:
+		skipDuration = 10 * time.Minute
+	case codes.Unavailable:
+		skipDuration = time.Minute
+	default:
+		return err
+	}
+	glog.V(0).Infof("use http for volume server %s in the next %v: %v", volumeServer, skipDuration, err)
+	dataPlaneSkippedLock.Lock()
+	dataPlaneSkipped[volumeServer] = time.Now().Add(skipDuration)
+	dataPlaneSkippedLock.Unlock()
+	return ErrGrpcDataPlaneUnavailable
+}
+
+// ReadNeedles reads several file ids from the volume server in one gRPC call.
+// The data of the i-th request is passed to fn piece by piece, and errs[i] is the error of the i-th request.
+// ErrGrpcDataPlaneUnavailable means the file ids should be read via http instead.
+func ReadNeedles(volumeServer string, requests []*volume_server_pb.ReadNeedleRequest, fn func(i int, resp *volume_server_pb.ReadNeedleResponse)) (errs []error, err error) {
+
+	if !isGrpcDataPlaneAvailable(volumeServer) {
+		return nil, ErrGrpcDataPlaneUnavailable
+	}
+
+	errs = make([]error, len(requests))
+
+	err = WithVolumeServerClient(volumeServer, dataPlaneGrpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		stream, err := client.ReadNeedle(ctx)
+		if err != nil {
+			return err
+		}
+
+		sendErrChan := make(chan error, 1)
+		go func() {
+			for _, req := range requests {
+				if sendErr := stream.Send(req); sendErr != nil {
+					sendErrChan <- sendErr
+					return
+				}
+			}
+			sendErrChan <- stream.CloseSend()
+		}()
+
+		for i := 0; i < len(requests); {
+			resp, recvErr := stream.Recv()
+			if recvErr == io.EOF {
+				return fmt.Errorf("received %d of %d file ids", i, len(requests))
+			}
+			if recvErr != nil {
+				return recvErr
+			}
+			if resp.FileId != requests[i].FileId {
+				return fmt.Errorf("expecting %s but received %s", requests[i].FileId, resp.FileId)
+			}
+			if resp.Error != "" {
+				errs[i] = errors.New(resp.Error)
+			} else {
+				fn(i, resp)
+			}
+			if resp.IsLast {
+				i++
+			}
+		}
+
+		return <-sendErrChan
+	})
+
+	if err != nil {
+		return nil, checkGrpcDataPlaneError(volumeServer, err)
+	}
+	return errs, nil
+}
+
+// WriteNeedles writes several file ids to the volume server in one gRPC call,
+// splitting the data of each request into pieces. The i-th response is for the i-th request.
+// ErrGrpcDataPlaneUnavailable means the file ids should be written via http instead.
+func WriteNeedles(volumeServer string, requests []*volume_server_pb.WriteNeedleRequest) (responses []*volume_server_pb.WriteNeedleResponse, err error) {
+
+	if !isGrpcDataPlaneAvailable(volumeServer) {
+		return nil, ErrGrpcDataPlaneUnavailable
+	}
+
+	err = WithVolumeServerClient(volumeServer, dataPlaneGrpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		stream, err := client.WriteNeedle(ctx)
+		if err != nil {
+			return err
+		}
+
+		sendErrChan := make(chan error, 1)
+		go func() {
+			for _, req := range requests {
+				if sendErr := sendNeedlePieces(stream, req); sendErr != nil {
+					sendErrChan <- sendErr
+					return
+				}
+			}
+			sendErrChan <- stream.CloseSend()
+		}()
+
+		responses = nil
+		for len(responses) < len(requests) {
+			resp, recvErr := stream.Recv()
+			if recvErr == io.EOF {
+				return fmt.Errorf("received %d of %d file ids", len(responses), len(requests))
+			}
+			if recvErr != nil {
+				return recvErr
+			}
+			responses = append(responses, resp)
+		}
+
+		return <-sendErrChan
+	})
+
+	if err != nil {
+		return nil, checkGrpcDataPlaneError(volumeServer, err)
+	}
+	return responses, nil
+}
+
+func sendNeedlePieces(stream volume_server_pb.VolumeServer_WriteNeedleClient, req *volume_server_pb.WriteNeedleRequest) error {
+	data := req.Data
+	piece := *req
+	for {
+		stop := len(data)
+		if stop > needlePieceSize {
+			stop = needlePieceSize
+		}
+		piece.Data, data = data[:stop], data[stop:]
+		piece.IsLast = len(data) == 0
+		if err := stream.Send(&piece); err != nil {
+			return err
+		}
+		if piece.IsLast {
+			return nil
+		}
+		piece = volume_server_pb.WriteNeedleRequest{
+			FileId: req.FileId,
+		}
+	}
+}
+
+// toWriteNeedleRequest converts the upload url, e.g., "http://localhost:8080/3,01637037d6?ttl=3m", into a gRPC request.
+// It is not ok if the url or the pairs have anything not supported by WriteNeedle.
+func toWriteNeedleRequest(uploadUrl string, pairMap map[string]string) (volumeServer string, req *volume_server_pb.WriteNeedleRequest, ok bool) {
+
+	u, err := url.Parse(uploadUrl)
+	if err != nil || u.Host == "" {
+		return "", nil, false
+	}
+	fileId := strings.TrimPrefix(u.Path, "/")
+	if strings.ContainsAny(fileId, "/.") {
+		return "", nil, false
+	}
+	if _, _, err = ParseFileId(fileId); err != nil {
+		return "", nil, false
+	}
+
+	req = &volume_server_pb.WriteNeedleRequest{
+		FileId: fileId,
+	}
+	for name, values := range u.Query() {
+		value := ""
+		if len(values) > 0 {
+			value = values[0]
+		}
+		switch name {
+		case "ttl":
+			req.Ttl = value
+		case "ts":
+			if req.LastModified, err = strconv.ParseUint(value, 10, 64); err != nil {
+				return "", nil, false
+			}
+		case "cm":
+			if req.IsChunkManifest, err = strconv.ParseBool(value); err != nil {
+				return "", nil, false
+			}
+		case "durability":
+			req.Durability = value
+		case "type":
+			if value != "replicate" {
+				return "", nil, false
+			}
+			req.IsReplicate = true
+		default:
+			return "", nil, false
+		}
+	}
+
+	for k, v := range pairMap {
+		// the same as the http headers received by the volume server
+		k = http.CanonicalHeaderKey(k)
+		if k == util.ContentMd5Header {
+			if req.ContentMd5, err = base64.StdEncoding.DecodeString(v); err != nil {
+				return "", nil, false
+			}
+			continue
+		}
+		if !strings.HasPrefix(k, needle.PairNamePrefix) {
+			return "", nil, false
+		}
+		if req.Pairs == nil {
+			req.Pairs = make(map[string]string)
+		}
+		req.Pairs[k[len(needle.PairNamePrefix):]] = v
+	}
+
+	return u.Host, req, true
+}
+
+func uploadWithGrpc(volumeServer string, req *volume_server_pb.WriteNeedleRequest) (*UploadResult, error) {
+	responses, err := WriteNeedles(volumeServer, []*volume_server_pb.WriteNeedleRequest{req})
+	if err != nil {
+		return nil, err
+	}
+	resp := responses[0]
+	if resp.Error != "" {
+		return nil, errors.New(resp.Error)
+	}
+	ret := &UploadResult{
+		Size:       resp.Size,
+		ETag:       resp.Etag,
+		ContentMd5: resp.ContentMd5,
+	}
+	if req.Name != "" {
+		ret.Name = path.Base(req.Name)
+	}
+	return ret, nil
+}
@@ -0,0 +1,40 @@
+package operation
+
+import (
+	"testing"
+)
+
+func TestToWriteNeedleRequest(t *testing.T) {
+
+	volumeServer, req, ok := toWriteNeedleRequest("http://localhost:8080/3,01637037d6?type=replicate&ttl=3m&ts=1578000000&cm=true",
+		map[string]string{"seaweed-color": "blue"})
+	if !ok {
+		t.Fatalf("expecting the url converted")
+	}
+	if volumeServer != "localhost:8080" || req.FileId != "3,01637037d6" {
+		t.Errorf("unexpected volume server %s file id %s", volumeServer, req.FileId)
+	}
+	if !req.IsReplicate || req.Ttl != "3m" || req.LastModified != 1578000000 || !req.IsChunkManifest {
+		t.Errorf("unexpected request %+v", req)
+	}
+	if req.Pairs["Color"] != "blue" {
+		t.Errorf("unexpected pairs %+v", req.Pairs)
+	}
+
+	for _, uploadUrl := range []string{
+		"http://localhost:8080/3/01637037d6",
+		"http://localhost:8080/3,01637037d6.jpg",
+		"http://localhost:8080/3,01637037d6?collection=pictures",
+		"http://localhost:8080/3,01637037d6?type=unknown",
+		"/3,01637037d6",
+	} {
+		if _, _, ok := toWriteNeedleRequest(uploadUrl, nil); ok {
+			t.Errorf("%s should be uploaded via http", uploadUrl)
+		}
+	}
+
+	if _, _, ok := toWriteNeedleRequest("http://localhost:8080/3,01637037d6", map[string]string{"Content-Type": "text/plain"}); ok {
+		t.Errorf("headers other than the pairs should be uploaded via http")
+	}
+
+}
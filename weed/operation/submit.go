@@ -25,6 +25,7 @@ type FilePart struct {
 	Collection  string
 	DataCenter  string
 	Ttl         string
+	Durability  string
 	Server      string //this comes from assign result
 	Fid         string //this comes from assign result, but customizable
 }
@@ -38,7 +39,7 @@ type SubmitResult struct {
 }
 
 func SubmitFiles(master string, grpcDialOption grpc.DialOption, files []FilePart,
-	replication string, collection string, dataCenter string, ttl string, maxMB int) ([]SubmitResult, error) {
+	replication string, collection string, dataCenter string, ttl string, durability string, maxMB int) ([]SubmitResult, error) {
 	results := make([]SubmitResult, len(files))
 	for index, file := range files {
 		results[index].FileName = file.FileName
@@ -49,6 +50,7 @@ func SubmitFiles(master string, grpcDialOption grpc.DialOption, files []FilePart
 		Collection:  collection,
 		DataCenter:  dataCenter,
 		Ttl:         ttl,
+		Durability:  durability,
 	}
 	ret, err := Assign(master, grpcDialOption, ar)
 	if err != nil {
@@ -66,6 +68,7 @@ func SubmitFiles(master string, grpcDialOption grpc.DialOption, files []FilePart
 		file.Replication = replication
 		file.Collection = collection
 		file.DataCenter = dataCenter
+		file.Durability = durability
 		results[index].Size, err = file.Upload(maxMB, master, ret.Auth, grpcDialOption)
 		if err != nil {
 			results[index].Error = err.Error()
@@ -114,6 +117,7 @@ func (fi FilePart) Upload(maxMB int, master string, jwt security.EncodedJwt, grp
 	if fi.ModTime != 0 {
 		fileUrl += "?ts=" + strconv.Itoa(int(fi.ModTime))
 	}
+	fileUrl = WithDurability(fileUrl, fi.Durability)
 	if closer, ok := fi.Reader.(io.Closer); ok {
 		defer closer.Close()
 	}
@@ -136,6 +140,7 @@ func (fi FilePart) Upload(maxMB int, master string, jwt security.EncodedJwt, grp
 				Replication: fi.Replication,
 				Collection:  fi.Collection,
 				Ttl:         fi.Ttl,
+				Durability:  fi.Durability,
 			}
 			ret, err = Assign(master, grpcDialOption, ar)
 			if err != nil {
@@ -149,6 +154,7 @@ func (fi FilePart) Upload(maxMB int, master string, jwt security.EncodedJwt, grp
 					Replication: fi.Replication,
 					Collection:  fi.Collection,
 					Ttl:         fi.Ttl,
+					Durability:  fi.Durability,
 				}
 				ret, err = Assign(master, grpcDialOption, ar)
 				if err != nil {
@@ -163,7 +169,7 @@ func (fi FilePart) Upload(maxMB int, master string, jwt security.EncodedJwt, grp
 					id += "_" + strconv.FormatInt(i, 10)
 				}
 			}
-			fileUrl := "http://" + ret.Url + "/" + id
+			fileUrl := WithDurability("http://"+ret.Url+"/"+id, fi.Durability)
 			count, e := upload_one_chunk(
 				baseName+"-"+strconv.FormatInt(i+1, 10),
 				io.LimitReader(fi.Reader, chunkSize),
@@ -189,7 +195,7 @@ func (fi FilePart) Upload(maxMB int, master string, jwt security.EncodedJwt, grp
 			cm.DeleteChunks(master, grpcDialOption)
 		}
 	} else {
-		ret, e := Upload(fileUrl, baseName, fi.Reader, false, fi.MimeType, nil, jwt)
+		ret, e := Upload(fileUrl, baseName, fi.Reader, "", fi.MimeType, nil, jwt)
 		if e != nil {
 			return 0, e
 		}
@@ -202,7 +208,7 @@ func upload_one_chunk(filename string, reader io.Reader, master,
 	fileUrl string, jwt security.EncodedJwt,
 ) (size uint32, e error) {
 	glog.V(4).Info("Uploading part ", filename, " to ", fileUrl, "...")
-	uploadResult, uploadError := Upload(fileUrl, filename, reader, false,
+	uploadResult, uploadError := Upload(fileUrl, filename, reader, "",
 		"application/octet-stream", nil, jwt)
 	if uploadError != nil {
 		return 0, uploadError
@@ -221,6 +227,6 @@ func upload_chunked_file_manifest(fileUrl string, manifest *ChunkManifest, jwt s
 	q := u.Query()
 	q.Set("cm", "true")
 	u.RawQuery = q.Encode()
-	_, e = Upload(u.String(), manifest.Name, bufReader, false, "application/json", nil, jwt)
+	_, e = Upload(u.String(), manifest.Name, bufReader, "", "application/json", nil, jwt)
 	return e
 }
@@ -22,10 +22,11 @@ import (
 )
 
 type UploadResult struct {
-	Name  string `json:"name,omitempty"`
-	Size  uint32 `json:"size,omitempty"`
-	Error string `json:"error,omitempty"`
-	ETag  string `json:"eTag,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Size       uint32 `json:"size,omitempty"`
+	Error      string `json:"error,omitempty"`
+	ETag       string `json:"eTag,omitempty"`
+	ContentMd5 []byte `json:"contentMd5,omitempty"` // the md5 of the uncompressed content, not set by older volume servers
 }
 
 var (
@@ -41,43 +42,73 @@ func init() {
 var fileNameEscaper = strings.NewReplacer("\\", "\\\\", "\"", "\\\"")
 
 // Upload sends a POST request to a volume server to upload the content with adjustable compression level
-func UploadWithLocalCompressionLevel(uploadUrl string, filename string, reader io.Reader, isGzipped bool, mtype string, pairMap map[string]string, jwt security.EncodedJwt, compressionLevel int) (*UploadResult, error) {
+// contentEncoding is the codec if the content is already compressed, or "" to compress it here if gzippable
+func UploadWithLocalCompressionLevel(uploadUrl string, filename string, reader io.Reader, contentEncoding string, mtype string, pairMap map[string]string, jwt security.EncodedJwt, compressionLevel int) (*UploadResult, error) {
 	if compressionLevel < 1 {
 		compressionLevel = 1
 	}
 	if compressionLevel > 9 {
 		compressionLevel = 9
 	}
-	return doUpload(uploadUrl, filename, reader, isGzipped, mtype, pairMap, compressionLevel, jwt)
+	return doUpload(uploadUrl, filename, reader, contentEncoding, mtype, pairMap, compressionLevel, jwt)
 }
 
 // Upload sends a POST request to a volume server to upload the content with fast compression
-func Upload(uploadUrl string, filename string, reader io.Reader, isGzipped bool, mtype string, pairMap map[string]string, jwt security.EncodedJwt) (*UploadResult, error) {
-	return doUpload(uploadUrl, filename, reader, isGzipped, mtype, pairMap, flate.BestSpeed, jwt)
+func Upload(uploadUrl string, filename string, reader io.Reader, contentEncoding string, mtype string, pairMap map[string]string, jwt security.EncodedJwt) (*UploadResult, error) {
+	return doUpload(uploadUrl, filename, reader, contentEncoding, mtype, pairMap, flate.BestSpeed, jwt)
 }
 
-func doUpload(uploadUrl string, filename string, reader io.Reader, isGzipped bool, mtype string, pairMap map[string]string, compression int, jwt security.EncodedJwt) (*UploadResult, error) {
-	contentIsGzipped := isGzipped
-	shouldGzipNow := false
-	if !isGzipped {
+func doUpload(uploadUrl string, filename string, reader io.Reader, contentEncoding string, mtype string, pairMap map[string]string, compressionLevel int, jwt security.EncodedJwt) (*UploadResult, error) {
+	shouldCompressNow := false
+	if contentEncoding == "" {
 		if shouldBeZipped, iAmSure := util.IsGzippableFileType(filepath.Base(filename), mtype); iAmSure && shouldBeZipped {
-			shouldGzipNow = true
-			contentIsGzipped = true
+			shouldCompressNow = true
+			contentEncoding = util.DefaultCompression
 		}
 	}
-	return upload_content(uploadUrl, func(w io.Writer) (err error) {
-		if shouldGzipNow {
-			gzWriter, _ := gzip.NewWriterLevel(w, compression)
+	fillBufferFunction := func(w io.Writer) (err error) {
+		if !shouldCompressNow {
+			_, err = io.Copy(w, reader)
+			return
+		}
+		if contentEncoding == util.GzipCompression {
+			gzWriter, _ := gzip.NewWriterLevel(w, compressionLevel)
 			_, err = io.Copy(gzWriter, reader)
 			gzWriter.Close()
-		} else {
-			_, err = io.Copy(w, reader)
+			return
 		}
+		data, err := ioutil.ReadAll(reader)
+		if err != nil {
+			return err
+		}
+		compressed, err := util.CompressData(contentEncoding, data)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(compressed)
 		return
-	}, filename, contentIsGzipped, mtype, pairMap, jwt)
+	}
+
+	if volumeServer, req, ok := toWriteNeedleRequest(uploadUrl, pairMap); ok && isGrpcDataPlaneAvailable(volumeServer) {
+		var buf bytes.Buffer
+		if err := fillBufferFunction(&buf); err != nil {
+			return nil, err
+		}
+		req.Data, req.Name, req.Mime, req.Compression, req.Jwt = buf.Bytes(), filename, mtype, contentEncoding, string(jwt)
+		ret, err := uploadWithGrpc(volumeServer, req)
+		if err != ErrGrpcDataPlaneUnavailable {
+			return ret, err
+		}
+		fillBufferFunction = func(w io.Writer) (err error) {
+			_, err = w.Write(buf.Bytes())
+			return
+		}
+	}
+
+	return upload_content(uploadUrl, fillBufferFunction, filename, contentEncoding, mtype, pairMap, jwt)
 }
 
-func upload_content(uploadUrl string, fillBufferFunction func(w io.Writer) error, filename string, isGzipped bool, mtype string, pairMap map[string]string, jwt security.EncodedJwt) (*UploadResult, error) {
+func upload_content(uploadUrl string, fillBufferFunction func(w io.Writer) error, filename string, contentEncoding string, mtype string, pairMap map[string]string, jwt security.EncodedJwt) (*UploadResult, error) {
 	body_buf := bytes.NewBufferString("")
 	body_writer := multipart.NewWriter(body_buf)
 	h := make(textproto.MIMEHeader)
@@ -88,8 +119,8 @@ func upload_content(uploadUrl string, fillBufferFunction func(w io.Writer) error
 	if mtype != "" {
 		h.Set("Content-Type", mtype)
 	}
-	if isGzipped {
-		h.Set("Content-Encoding", "gzip")
+	if contentEncoding != "" {
+		h.Set("Content-Encoding", contentEncoding)
 	}
 
 	file_writer, cp_err := body_writer.CreatePart(h)
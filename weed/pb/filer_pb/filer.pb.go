@@ -17,8 +17,10 @@ It has these top-level messages:
 	FullEntry
 	EventNotification
 	FileChunk
+	FileChunkManifest
 	FileId
 	FuseAttributes
+	EntryPrecondition
 	CreateEntryRequest
 	CreateEntryResponse
 	UpdateEntryRequest
@@ -39,6 +41,14 @@ It has these top-level messages:
 	StatisticsResponse
 	GetFilerConfigurationRequest
 	GetFilerConfigurationResponse
+	BackupMetadataRequest
+	BackupMetadataResponse
+	ApplyStoreMutationRequest
+	ApplyStoreMutationResponse
+	SubscribeMetadataRequest
+	SubscribeMetadataResponse
+	StoragePolicy
+	StoragePolicies
 */
 package filer_pb
 
@@ -172,6 +182,7 @@ type Entry struct {
 	Chunks      []*FileChunk      `protobuf:"bytes,3,rep,name=chunks" json:"chunks,omitempty"`
 	Attributes  *FuseAttributes   `protobuf:"bytes,4,opt,name=attributes" json:"attributes,omitempty"`
 	Extended    map[string][]byte `protobuf:"bytes,5,rep,name=extended" json:"extended,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Version     uint64            `protobuf:"varint,6,opt,name=version" json:"version,omitempty"`
 }
 
 func (m *Entry) Reset()                    { *m = Entry{} }
@@ -214,6 +225,13 @@ func (m *Entry) GetExtended() map[string][]byte {
 	return nil
 }
 
+func (m *Entry) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type FullEntry struct {
 	Dir   string `protobuf:"bytes,1,opt,name=dir" json:"dir,omitempty"`
 	Entry *Entry `protobuf:"bytes,2,opt,name=entry" json:"entry,omitempty"`
@@ -279,14 +297,19 @@ func (m *EventNotification) GetNewParentPath() string {
 }
 
 type FileChunk struct {
-	FileId       string  `protobuf:"bytes,1,opt,name=file_id,json=fileId" json:"file_id,omitempty"`
-	Offset       int64   `protobuf:"varint,2,opt,name=offset" json:"offset,omitempty"`
-	Size         uint64  `protobuf:"varint,3,opt,name=size" json:"size,omitempty"`
-	Mtime        int64   `protobuf:"varint,4,opt,name=mtime" json:"mtime,omitempty"`
-	ETag         string  `protobuf:"bytes,5,opt,name=e_tag,json=eTag" json:"e_tag,omitempty"`
-	SourceFileId string  `protobuf:"bytes,6,opt,name=source_file_id,json=sourceFileId" json:"source_file_id,omitempty"`
-	Fid          *FileId `protobuf:"bytes,7,opt,name=fid" json:"fid,omitempty"`
-	SourceFid    *FileId `protobuf:"bytes,8,opt,name=source_fid,json=sourceFid" json:"source_fid,omitempty"`
+	FileId          string  `protobuf:"bytes,1,opt,name=file_id,json=fileId" json:"file_id,omitempty"`
+	Offset          int64   `protobuf:"varint,2,opt,name=offset" json:"offset,omitempty"`
+	Size            uint64  `protobuf:"varint,3,opt,name=size" json:"size,omitempty"`
+	Mtime           int64   `protobuf:"varint,4,opt,name=mtime" json:"mtime,omitempty"`
+	ETag            string  `protobuf:"bytes,5,opt,name=e_tag,json=eTag" json:"e_tag,omitempty"`
+	SourceFileId    string  `protobuf:"bytes,6,opt,name=source_file_id,json=sourceFileId" json:"source_file_id,omitempty"`
+	Fid             *FileId `protobuf:"bytes,7,opt,name=fid" json:"fid,omitempty"`
+	SourceFid       *FileId `protobuf:"bytes,8,opt,name=source_fid,json=sourceFid" json:"source_fid,omitempty"`
+	IsChunkManifest bool    `protobuf:"varint,9,opt,name=is_chunk_manifest,json=isChunkManifest" json:"is_chunk_manifest,omitempty"`
+	ContentHash     string  `protobuf:"bytes,10,opt,name=content_hash,json=contentHash" json:"content_hash,omitempty"`
+	// the md5 of the uncompressed content, verified when the whole chunk is read or copied through the filer.
+	// It is not kept in the needle, so the volume copies, tailing and erasure coding only check the needle crc.
+	Md5 []byte `protobuf:"bytes,11,opt,name=md5,proto3" json:"md5,omitempty"`
 }
 
 func (m *FileChunk) Reset()                    { *m = FileChunk{} }
@@ -350,6 +373,44 @@ func (m *FileChunk) GetSourceFid() *FileId {
 	return nil
 }
 
+func (m *FileChunk) GetIsChunkManifest() bool {
+	if m != nil {
+		return m.IsChunkManifest
+	}
+	return false
+}
+
+func (m *FileChunk) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
+func (m *FileChunk) GetMd5() []byte {
+	if m != nil {
+		return m.Md5
+	}
+	return nil
+}
+
+// the chunk list stored as a blob on the volume servers, to keep large entries small
+type FileChunkManifest struct {
+	Chunks []*FileChunk `protobuf:"bytes,1,rep,name=chunks" json:"chunks,omitempty"`
+}
+
+func (m *FileChunkManifest) Reset()                    { *m = FileChunkManifest{} }
+func (m *FileChunkManifest) String() string            { return proto.CompactTextString(m) }
+func (*FileChunkManifest) ProtoMessage()               {}
+func (*FileChunkManifest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *FileChunkManifest) GetChunks() []*FileChunk {
+	if m != nil {
+		return m.Chunks
+	}
+	return nil
+}
+
 type FileId struct {
 	VolumeId uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
 	FileKey  uint64 `protobuf:"varint,2,opt,name=file_key,json=fileKey" json:"file_key,omitempty"`
@@ -359,7 +420,7 @@ type FileId struct {
 func (m *FileId) Reset()                    { *m = FileId{} }
 func (m *FileId) String() string            { return proto.CompactTextString(m) }
 func (*FileId) ProtoMessage()               {}
-func (*FileId) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*FileId) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *FileId) GetVolumeId() uint32 {
 	if m != nil {
@@ -396,12 +457,13 @@ type FuseAttributes struct {
 	UserName      string   `protobuf:"bytes,11,opt,name=user_name,json=userName" json:"user_name,omitempty"`
 	GroupName     []string `protobuf:"bytes,12,rep,name=group_name,json=groupName" json:"group_name,omitempty"`
 	SymlinkTarget string   `protobuf:"bytes,13,opt,name=symlink_target,json=symlinkTarget" json:"symlink_target,omitempty"`
+	Md5           []byte   `protobuf:"bytes,14,opt,name=md5,proto3" json:"md5,omitempty"`
 }
 
 func (m *FuseAttributes) Reset()                    { *m = FuseAttributes{} }
 func (m *FuseAttributes) String() string            { return proto.CompactTextString(m) }
 func (*FuseAttributes) ProtoMessage()               {}
-func (*FuseAttributes) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*FuseAttributes) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *FuseAttributes) GetFileSize() uint64 {
 	if m != nil {
@@ -494,15 +556,72 @@ func (m *FuseAttributes) GetSymlinkTarget() string {
 	return ""
 }
 
+func (m *FuseAttributes) GetMd5() []byte {
+	if m != nil {
+		return m.Md5
+	}
+	return nil
+}
+
+// the mutation is rejected with FailedPrecondition if any of the set conditions is not met
+type EntryPrecondition struct {
+	MustNotExist     bool              `protobuf:"varint,1,opt,name=must_not_exist,json=mustNotExist" json:"must_not_exist,omitempty"`
+	MustExist        bool              `protobuf:"varint,2,opt,name=must_exist,json=mustExist" json:"must_exist,omitempty"`
+	ExpectedVersion  uint64            `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion" json:"expected_version,omitempty"`
+	ExpectedMtime    int64             `protobuf:"varint,4,opt,name=expected_mtime,json=expectedMtime" json:"expected_mtime,omitempty"`
+	ExpectedExtended map[string][]byte `protobuf:"bytes,5,rep,name=expected_extended,json=expectedExtended" json:"expected_extended,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *EntryPrecondition) Reset()                    { *m = EntryPrecondition{} }
+func (m *EntryPrecondition) String() string            { return proto.CompactTextString(m) }
+func (*EntryPrecondition) ProtoMessage()               {}
+func (*EntryPrecondition) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *EntryPrecondition) GetMustNotExist() bool {
+	if m != nil {
+		return m.MustNotExist
+	}
+	return false
+}
+
+func (m *EntryPrecondition) GetMustExist() bool {
+	if m != nil {
+		return m.MustExist
+	}
+	return false
+}
+
+func (m *EntryPrecondition) GetExpectedVersion() uint64 {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return 0
+}
+
+func (m *EntryPrecondition) GetExpectedMtime() int64 {
+	if m != nil {
+		return m.ExpectedMtime
+	}
+	return 0
+}
+
+func (m *EntryPrecondition) GetExpectedExtended() map[string][]byte {
+	if m != nil {
+		return m.ExpectedExtended
+	}
+	return nil
+}
+
 type CreateEntryRequest struct {
-	Directory string `protobuf:"bytes,1,opt,name=directory" json:"directory,omitempty"`
-	Entry     *Entry `protobuf:"bytes,2,opt,name=entry" json:"entry,omitempty"`
+	Directory    string             `protobuf:"bytes,1,opt,name=directory" json:"directory,omitempty"`
+	Entry        *Entry             `protobuf:"bytes,2,opt,name=entry" json:"entry,omitempty"`
+	Precondition *EntryPrecondition `protobuf:"bytes,3,opt,name=precondition" json:"precondition,omitempty"`
 }
 
 func (m *CreateEntryRequest) Reset()                    { *m = CreateEntryRequest{} }
 func (m *CreateEntryRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateEntryRequest) ProtoMessage()               {}
-func (*CreateEntryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*CreateEntryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *CreateEntryRequest) GetDirectory() string {
 	if m != nil {
@@ -518,23 +637,31 @@ func (m *CreateEntryRequest) GetEntry() *Entry {
 	return nil
 }
 
+func (m *CreateEntryRequest) GetPrecondition() *EntryPrecondition {
+	if m != nil {
+		return m.Precondition
+	}
+	return nil
+}
+
 type CreateEntryResponse struct {
 }
 
 func (m *CreateEntryResponse) Reset()                    { *m = CreateEntryResponse{} }
 func (m *CreateEntryResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateEntryResponse) ProtoMessage()               {}
-func (*CreateEntryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*CreateEntryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 type UpdateEntryRequest struct {
-	Directory string `protobuf:"bytes,1,opt,name=directory" json:"directory,omitempty"`
-	Entry     *Entry `protobuf:"bytes,2,opt,name=entry" json:"entry,omitempty"`
+	Directory    string             `protobuf:"bytes,1,opt,name=directory" json:"directory,omitempty"`
+	Entry        *Entry             `protobuf:"bytes,2,opt,name=entry" json:"entry,omitempty"`
+	Precondition *EntryPrecondition `protobuf:"bytes,3,opt,name=precondition" json:"precondition,omitempty"`
 }
 
 func (m *UpdateEntryRequest) Reset()                    { *m = UpdateEntryRequest{} }
 func (m *UpdateEntryRequest) String() string            { return proto.CompactTextString(m) }
 func (*UpdateEntryRequest) ProtoMessage()               {}
-func (*UpdateEntryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*UpdateEntryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *UpdateEntryRequest) GetDirectory() string {
 	if m != nil {
@@ -550,27 +677,35 @@ func (m *UpdateEntryRequest) GetEntry() *Entry {
 	return nil
 }
 
+func (m *UpdateEntryRequest) GetPrecondition() *EntryPrecondition {
+	if m != nil {
+		return m.Precondition
+	}
+	return nil
+}
+
 type UpdateEntryResponse struct {
 }
 
 func (m *UpdateEntryResponse) Reset()                    { *m = UpdateEntryResponse{} }
 func (m *UpdateEntryResponse) String() string            { return proto.CompactTextString(m) }
 func (*UpdateEntryResponse) ProtoMessage()               {}
-func (*UpdateEntryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*UpdateEntryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 type DeleteEntryRequest struct {
 	Directory string `protobuf:"bytes,1,opt,name=directory" json:"directory,omitempty"`
 	Name      string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
 	// bool is_directory = 3;
-	IsDeleteData         bool `protobuf:"varint,4,opt,name=is_delete_data,json=isDeleteData" json:"is_delete_data,omitempty"`
-	IsRecursive          bool `protobuf:"varint,5,opt,name=is_recursive,json=isRecursive" json:"is_recursive,omitempty"`
-	IgnoreRecursiveError bool `protobuf:"varint,6,opt,name=ignore_recursive_error,json=ignoreRecursiveError" json:"ignore_recursive_error,omitempty"`
+	IsDeleteData         bool               `protobuf:"varint,4,opt,name=is_delete_data,json=isDeleteData" json:"is_delete_data,omitempty"`
+	IsRecursive          bool               `protobuf:"varint,5,opt,name=is_recursive,json=isRecursive" json:"is_recursive,omitempty"`
+	IgnoreRecursiveError bool               `protobuf:"varint,6,opt,name=ignore_recursive_error,json=ignoreRecursiveError" json:"ignore_recursive_error,omitempty"`
+	Precondition         *EntryPrecondition `protobuf:"bytes,7,opt,name=precondition" json:"precondition,omitempty"`
 }
 
 func (m *DeleteEntryRequest) Reset()                    { *m = DeleteEntryRequest{} }
 func (m *DeleteEntryRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteEntryRequest) ProtoMessage()               {}
-func (*DeleteEntryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*DeleteEntryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *DeleteEntryRequest) GetDirectory() string {
 	if m != nil {
@@ -607,13 +742,20 @@ func (m *DeleteEntryRequest) GetIgnoreRecursiveError() bool {
 	return false
 }
 
+func (m *DeleteEntryRequest) GetPrecondition() *EntryPrecondition {
+	if m != nil {
+		return m.Precondition
+	}
+	return nil
+}
+
 type DeleteEntryResponse struct {
 }
 
 func (m *DeleteEntryResponse) Reset()                    { *m = DeleteEntryResponse{} }
 func (m *DeleteEntryResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteEntryResponse) ProtoMessage()               {}
-func (*DeleteEntryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*DeleteEntryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 type AtomicRenameEntryRequest struct {
 	OldDirectory string `protobuf:"bytes,1,opt,name=old_directory,json=oldDirectory" json:"old_directory,omitempty"`
@@ -625,7 +767,7 @@ type AtomicRenameEntryRequest struct {
 func (m *AtomicRenameEntryRequest) Reset()                    { *m = AtomicRenameEntryRequest{} }
 func (m *AtomicRenameEntryRequest) String() string            { return proto.CompactTextString(m) }
 func (*AtomicRenameEntryRequest) ProtoMessage()               {}
-func (*AtomicRenameEntryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*AtomicRenameEntryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 func (m *AtomicRenameEntryRequest) GetOldDirectory() string {
 	if m != nil {
@@ -661,7 +803,7 @@ type AtomicRenameEntryResponse struct {
 func (m *AtomicRenameEntryResponse) Reset()                    { *m = AtomicRenameEntryResponse{} }
 func (m *AtomicRenameEntryResponse) String() string            { return proto.CompactTextString(m) }
 func (*AtomicRenameEntryResponse) ProtoMessage()               {}
-func (*AtomicRenameEntryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*AtomicRenameEntryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 type AssignVolumeRequest struct {
 	Count       int32  `protobuf:"varint,1,opt,name=count" json:"count,omitempty"`
@@ -669,12 +811,14 @@ type AssignVolumeRequest struct {
 	Replication string `protobuf:"bytes,3,opt,name=replication" json:"replication,omitempty"`
 	TtlSec      int32  `protobuf:"varint,4,opt,name=ttl_sec,json=ttlSec" json:"ttl_sec,omitempty"`
 	DataCenter  string `protobuf:"bytes,5,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+	ParentPath  string `protobuf:"bytes,6,opt,name=parent_path,json=parentPath" json:"parent_path,omitempty"`
+	Durability  string `protobuf:"bytes,7,opt,name=durability" json:"durability,omitempty"`
 }
 
 func (m *AssignVolumeRequest) Reset()                    { *m = AssignVolumeRequest{} }
 func (m *AssignVolumeRequest) String() string            { return proto.CompactTextString(m) }
 func (*AssignVolumeRequest) ProtoMessage()               {}
-func (*AssignVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*AssignVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 func (m *AssignVolumeRequest) GetCount() int32 {
 	if m != nil {
@@ -711,18 +855,33 @@ func (m *AssignVolumeRequest) GetDataCenter() string {
 	return ""
 }
 
+func (m *AssignVolumeRequest) GetParentPath() string {
+	if m != nil {
+		return m.ParentPath
+	}
+	return ""
+}
+
+func (m *AssignVolumeRequest) GetDurability() string {
+	if m != nil {
+		return m.Durability
+	}
+	return ""
+}
+
 type AssignVolumeResponse struct {
-	FileId    string `protobuf:"bytes,1,opt,name=file_id,json=fileId" json:"file_id,omitempty"`
-	Url       string `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
-	PublicUrl string `protobuf:"bytes,3,opt,name=public_url,json=publicUrl" json:"public_url,omitempty"`
-	Count     int32  `protobuf:"varint,4,opt,name=count" json:"count,omitempty"`
-	Auth      string `protobuf:"bytes,5,opt,name=auth" json:"auth,omitempty"`
+	FileId     string `protobuf:"bytes,1,opt,name=file_id,json=fileId" json:"file_id,omitempty"`
+	Url        string `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
+	PublicUrl  string `protobuf:"bytes,3,opt,name=public_url,json=publicUrl" json:"public_url,omitempty"`
+	Count      int32  `protobuf:"varint,4,opt,name=count" json:"count,omitempty"`
+	Auth       string `protobuf:"bytes,5,opt,name=auth" json:"auth,omitempty"`
+	Durability string `protobuf:"bytes,6,opt,name=durability" json:"durability,omitempty"`
 }
 
 func (m *AssignVolumeResponse) Reset()                    { *m = AssignVolumeResponse{} }
 func (m *AssignVolumeResponse) String() string            { return proto.CompactTextString(m) }
 func (*AssignVolumeResponse) ProtoMessage()               {}
-func (*AssignVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*AssignVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *AssignVolumeResponse) GetFileId() string {
 	if m != nil {
@@ -759,6 +918,13 @@ func (m *AssignVolumeResponse) GetAuth() string {
 	return ""
 }
 
+func (m *AssignVolumeResponse) GetDurability() string {
+	if m != nil {
+		return m.Durability
+	}
+	return ""
+}
+
 type LookupVolumeRequest struct {
 	VolumeIds []string `protobuf:"bytes,1,rep,name=volume_ids,json=volumeIds" json:"volume_ids,omitempty"`
 }
@@ -766,7 +932,7 @@ type LookupVolumeRequest struct {
 func (m *LookupVolumeRequest) Reset()                    { *m = LookupVolumeRequest{} }
 func (m *LookupVolumeRequest) String() string            { return proto.CompactTextString(m) }
 func (*LookupVolumeRequest) ProtoMessage()               {}
-func (*LookupVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*LookupVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *LookupVolumeRequest) GetVolumeIds() []string {
 	if m != nil {
@@ -782,7 +948,7 @@ type Locations struct {
 func (m *Locations) Reset()                    { *m = Locations{} }
 func (m *Locations) String() string            { return proto.CompactTextString(m) }
 func (*Locations) ProtoMessage()               {}
-func (*Locations) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*Locations) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *Locations) GetLocations() []*Location {
 	if m != nil {
@@ -799,7 +965,7 @@ type Location struct {
 func (m *Location) Reset()                    { *m = Location{} }
 func (m *Location) String() string            { return proto.CompactTextString(m) }
 func (*Location) ProtoMessage()               {}
-func (*Location) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*Location) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *Location) GetUrl() string {
 	if m != nil {
@@ -822,7 +988,7 @@ type LookupVolumeResponse struct {
 func (m *LookupVolumeResponse) Reset()                    { *m = LookupVolumeResponse{} }
 func (m *LookupVolumeResponse) String() string            { return proto.CompactTextString(m) }
 func (*LookupVolumeResponse) ProtoMessage()               {}
-func (*LookupVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*LookupVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *LookupVolumeResponse) GetLocationsMap() map[string]*Locations {
 	if m != nil {
@@ -838,7 +1004,7 @@ type DeleteCollectionRequest struct {
 func (m *DeleteCollectionRequest) Reset()                    { *m = DeleteCollectionRequest{} }
 func (m *DeleteCollectionRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteCollectionRequest) ProtoMessage()               {}
-func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *DeleteCollectionRequest) GetCollection() string {
 	if m != nil {
@@ -853,7 +1019,7 @@ type DeleteCollectionResponse struct {
 func (m *DeleteCollectionResponse) Reset()                    { *m = DeleteCollectionResponse{} }
 func (m *DeleteCollectionResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteCollectionResponse) ProtoMessage()               {}
-func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 type StatisticsRequest struct {
 	Replication string `protobuf:"bytes,1,opt,name=replication" json:"replication,omitempty"`
@@ -864,7 +1030,7 @@ type StatisticsRequest struct {
 func (m *StatisticsRequest) Reset()                    { *m = StatisticsRequest{} }
 func (m *StatisticsRequest) String() string            { return proto.CompactTextString(m) }
 func (*StatisticsRequest) ProtoMessage()               {}
-func (*StatisticsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*StatisticsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 func (m *StatisticsRequest) GetReplication() string {
 	if m != nil {
@@ -899,7 +1065,7 @@ type StatisticsResponse struct {
 func (m *StatisticsResponse) Reset()                    { *m = StatisticsResponse{} }
 func (m *StatisticsResponse) String() string            { return proto.CompactTextString(m) }
 func (*StatisticsResponse) ProtoMessage()               {}
-func (*StatisticsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*StatisticsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
 func (m *StatisticsResponse) GetReplication() string {
 	if m != nil {
@@ -949,7 +1115,7 @@ type GetFilerConfigurationRequest struct {
 func (m *GetFilerConfigurationRequest) Reset()                    { *m = GetFilerConfigurationRequest{} }
 func (m *GetFilerConfigurationRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetFilerConfigurationRequest) ProtoMessage()               {}
-func (*GetFilerConfigurationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*GetFilerConfigurationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
 type GetFilerConfigurationResponse struct {
 	Masters     []string `protobuf:"bytes,1,rep,name=masters" json:"masters,omitempty"`
@@ -961,7 +1127,7 @@ type GetFilerConfigurationResponse struct {
 func (m *GetFilerConfigurationResponse) Reset()                    { *m = GetFilerConfigurationResponse{} }
 func (m *GetFilerConfigurationResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetFilerConfigurationResponse) ProtoMessage()               {}
-func (*GetFilerConfigurationResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*GetFilerConfigurationResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
 func (m *GetFilerConfigurationResponse) GetMasters() []string {
 	if m != nil {
@@ -991,6 +1157,232 @@ func (m *GetFilerConfigurationResponse) GetMaxMb() uint32 {
 	return 0
 }
 
+type BackupMetadataRequest struct {
+	Directory string `protobuf:"bytes,1,opt,name=directory" json:"directory,omitempty"`
+}
+
+func (m *BackupMetadataRequest) Reset()                    { *m = BackupMetadataRequest{} }
+func (m *BackupMetadataRequest) String() string            { return proto.CompactTextString(m) }
+func (*BackupMetadataRequest) ProtoMessage()               {}
+func (*BackupMetadataRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *BackupMetadataRequest) GetDirectory() string {
+	if m != nil {
+		return m.Directory
+	}
+	return ""
+}
+
+type BackupMetadataResponse struct {
+	Entries []*FullEntry `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+}
+
+func (m *BackupMetadataResponse) Reset()                    { *m = BackupMetadataResponse{} }
+func (m *BackupMetadataResponse) String() string            { return proto.CompactTextString(m) }
+func (*BackupMetadataResponse) ProtoMessage()               {}
+func (*BackupMetadataResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *BackupMetadataResponse) GetEntries() []*FullEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type ApplyStoreMutationRequest struct {
+	Op       string `protobuf:"bytes,1,opt,name=op" json:"op,omitempty"`
+	FullPath string `protobuf:"bytes,2,opt,name=full_path,json=fullPath" json:"full_path,omitempty"`
+	Data     []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ApplyStoreMutationRequest) Reset()                    { *m = ApplyStoreMutationRequest{} }
+func (m *ApplyStoreMutationRequest) String() string            { return proto.CompactTextString(m) }
+func (*ApplyStoreMutationRequest) ProtoMessage()               {}
+func (*ApplyStoreMutationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *ApplyStoreMutationRequest) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *ApplyStoreMutationRequest) GetFullPath() string {
+	if m != nil {
+		return m.FullPath
+	}
+	return ""
+}
+
+func (m *ApplyStoreMutationRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ApplyStoreMutationResponse struct {
+	CommitIndex uint64 `protobuf:"varint,1,opt,name=commit_index,json=commitIndex" json:"commit_index,omitempty"`
+}
+
+func (m *ApplyStoreMutationResponse) Reset()                    { *m = ApplyStoreMutationResponse{} }
+func (m *ApplyStoreMutationResponse) String() string            { return proto.CompactTextString(m) }
+func (*ApplyStoreMutationResponse) ProtoMessage()               {}
+func (*ApplyStoreMutationResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *ApplyStoreMutationResponse) GetCommitIndex() uint64 {
+	if m != nil {
+		return m.CommitIndex
+	}
+	return 0
+}
+
+type SubscribeMetadataRequest struct {
+	ClientName string `protobuf:"bytes,1,opt,name=client_name,json=clientName" json:"client_name,omitempty"`
+	PathPrefix string `protobuf:"bytes,2,opt,name=path_prefix,json=pathPrefix" json:"path_prefix,omitempty"`
+	SinceNs    int64  `protobuf:"varint,3,opt,name=since_ns,json=sinceNs" json:"since_ns,omitempty"`
+}
+
+func (m *SubscribeMetadataRequest) Reset()                    { *m = SubscribeMetadataRequest{} }
+func (m *SubscribeMetadataRequest) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeMetadataRequest) ProtoMessage()               {}
+func (*SubscribeMetadataRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *SubscribeMetadataRequest) GetClientName() string {
+	if m != nil {
+		return m.ClientName
+	}
+	return ""
+}
+
+func (m *SubscribeMetadataRequest) GetPathPrefix() string {
+	if m != nil {
+		return m.PathPrefix
+	}
+	return ""
+}
+
+func (m *SubscribeMetadataRequest) GetSinceNs() int64 {
+	if m != nil {
+		return m.SinceNs
+	}
+	return 0
+}
+
+type SubscribeMetadataResponse struct {
+	Directory         string             `protobuf:"bytes,1,opt,name=directory" json:"directory,omitempty"`
+	EventNotification *EventNotification `protobuf:"bytes,2,opt,name=event_notification,json=eventNotification" json:"event_notification,omitempty"`
+	TsNs              int64              `protobuf:"varint,3,opt,name=ts_ns,json=tsNs" json:"ts_ns,omitempty"`
+}
+
+func (m *SubscribeMetadataResponse) Reset()                    { *m = SubscribeMetadataResponse{} }
+func (m *SubscribeMetadataResponse) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeMetadataResponse) ProtoMessage()               {}
+func (*SubscribeMetadataResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *SubscribeMetadataResponse) GetDirectory() string {
+	if m != nil {
+		return m.Directory
+	}
+	return ""
+}
+
+func (m *SubscribeMetadataResponse) GetEventNotification() *EventNotification {
+	if m != nil {
+		return m.EventNotification
+	}
+	return nil
+}
+
+func (m *SubscribeMetadataResponse) GetTsNs() int64 {
+	if m != nil {
+		return m.TsNs
+	}
+	return 0
+}
+
+// a storage policy applies to all the files whose full path starts with the path prefix,
+// and the longest matching prefix wins. Empty fields are not changed by the policy.
+type StoragePolicy struct {
+	PathPrefix  string `protobuf:"bytes,1,opt,name=path_prefix,json=pathPrefix" json:"path_prefix,omitempty"`
+	Collection  string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+	Replication string `protobuf:"bytes,3,opt,name=replication" json:"replication,omitempty"`
+	Ttl         string `protobuf:"bytes,4,opt,name=ttl" json:"ttl,omitempty"`
+	ChunkSizeMb int32  `protobuf:"varint,5,opt,name=chunk_size_mb,json=chunkSizeMb" json:"chunk_size_mb,omitempty"`
+	Dedup       bool   `protobuf:"varint,6,opt,name=dedup" json:"dedup,omitempty"`
+	Durability  string `protobuf:"bytes,7,opt,name=durability" json:"durability,omitempty"`
+}
+
+func (m *StoragePolicy) Reset()                    { *m = StoragePolicy{} }
+func (m *StoragePolicy) String() string            { return proto.CompactTextString(m) }
+func (*StoragePolicy) ProtoMessage()               {}
+func (*StoragePolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *StoragePolicy) GetPathPrefix() string {
+	if m != nil {
+		return m.PathPrefix
+	}
+	return ""
+}
+
+func (m *StoragePolicy) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *StoragePolicy) GetReplication() string {
+	if m != nil {
+		return m.Replication
+	}
+	return ""
+}
+
+func (m *StoragePolicy) GetTtl() string {
+	if m != nil {
+		return m.Ttl
+	}
+	return ""
+}
+
+func (m *StoragePolicy) GetChunkSizeMb() int32 {
+	if m != nil {
+		return m.ChunkSizeMb
+	}
+	return 0
+}
+
+func (m *StoragePolicy) GetDedup() bool {
+	if m != nil {
+		return m.Dedup
+	}
+	return false
+}
+
+func (m *StoragePolicy) GetDurability() string {
+	if m != nil {
+		return m.Durability
+	}
+	return ""
+}
+
+type StoragePolicies struct {
+	Policies []*StoragePolicy `protobuf:"bytes,1,rep,name=policies" json:"policies,omitempty"`
+}
+
+func (m *StoragePolicies) Reset()                    { *m = StoragePolicies{} }
+func (m *StoragePolicies) String() string            { return proto.CompactTextString(m) }
+func (*StoragePolicies) ProtoMessage()               {}
+func (*StoragePolicies) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *StoragePolicies) GetPolicies() []*StoragePolicy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*LookupDirectoryEntryRequest)(nil), "filer_pb.LookupDirectoryEntryRequest")
 	proto.RegisterType((*LookupDirectoryEntryResponse)(nil), "filer_pb.LookupDirectoryEntryResponse")
@@ -1000,8 +1392,10 @@ func init() {
 	proto.RegisterType((*FullEntry)(nil), "filer_pb.FullEntry")
 	proto.RegisterType((*EventNotification)(nil), "filer_pb.EventNotification")
 	proto.RegisterType((*FileChunk)(nil), "filer_pb.FileChunk")
+	proto.RegisterType((*FileChunkManifest)(nil), "filer_pb.FileChunkManifest")
 	proto.RegisterType((*FileId)(nil), "filer_pb.FileId")
 	proto.RegisterType((*FuseAttributes)(nil), "filer_pb.FuseAttributes")
+	proto.RegisterType((*EntryPrecondition)(nil), "filer_pb.EntryPrecondition")
 	proto.RegisterType((*CreateEntryRequest)(nil), "filer_pb.CreateEntryRequest")
 	proto.RegisterType((*CreateEntryResponse)(nil), "filer_pb.CreateEntryResponse")
 	proto.RegisterType((*UpdateEntryRequest)(nil), "filer_pb.UpdateEntryRequest")
@@ -1022,6 +1416,14 @@ func init() {
 	proto.RegisterType((*StatisticsResponse)(nil), "filer_pb.StatisticsResponse")
 	proto.RegisterType((*GetFilerConfigurationRequest)(nil), "filer_pb.GetFilerConfigurationRequest")
 	proto.RegisterType((*GetFilerConfigurationResponse)(nil), "filer_pb.GetFilerConfigurationResponse")
+	proto.RegisterType((*BackupMetadataRequest)(nil), "filer_pb.BackupMetadataRequest")
+	proto.RegisterType((*BackupMetadataResponse)(nil), "filer_pb.BackupMetadataResponse")
+	proto.RegisterType((*ApplyStoreMutationRequest)(nil), "filer_pb.ApplyStoreMutationRequest")
+	proto.RegisterType((*ApplyStoreMutationResponse)(nil), "filer_pb.ApplyStoreMutationResponse")
+	proto.RegisterType((*SubscribeMetadataRequest)(nil), "filer_pb.SubscribeMetadataRequest")
+	proto.RegisterType((*SubscribeMetadataResponse)(nil), "filer_pb.SubscribeMetadataResponse")
+	proto.RegisterType((*StoragePolicy)(nil), "filer_pb.StoragePolicy")
+	proto.RegisterType((*StoragePolicies)(nil), "filer_pb.StoragePolicies")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1046,6 +1448,9 @@ type SeaweedFilerClient interface {
 	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
 	Statistics(ctx context.Context, in *StatisticsRequest, opts ...grpc.CallOption) (*StatisticsResponse, error)
 	GetFilerConfiguration(ctx context.Context, in *GetFilerConfigurationRequest, opts ...grpc.CallOption) (*GetFilerConfigurationResponse, error)
+	BackupMetadata(ctx context.Context, in *BackupMetadataRequest, opts ...grpc.CallOption) (SeaweedFiler_BackupMetadataClient, error)
+	ApplyStoreMutation(ctx context.Context, in *ApplyStoreMutationRequest, opts ...grpc.CallOption) (*ApplyStoreMutationResponse, error)
+	SubscribeMetadata(ctx context.Context, in *SubscribeMetadataRequest, opts ...grpc.CallOption) (SeaweedFiler_SubscribeMetadataClient, error)
 }
 
 type seaweedFilerClient struct {
@@ -1155,6 +1560,79 @@ func (c *seaweedFilerClient) GetFilerConfiguration(ctx context.Context, in *GetF
 	return out, nil
 }
 
+func (c *seaweedFilerClient) BackupMetadata(ctx context.Context, in *BackupMetadataRequest, opts ...grpc.CallOption) (SeaweedFiler_BackupMetadataClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_SeaweedFiler_serviceDesc.Streams[0], c.cc, "/filer_pb.SeaweedFiler/BackupMetadata", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &seaweedFilerBackupMetadataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SeaweedFiler_BackupMetadataClient interface {
+	Recv() (*BackupMetadataResponse, error)
+	grpc.ClientStream
+}
+
+type seaweedFilerBackupMetadataClient struct {
+	grpc.ClientStream
+}
+
+func (x *seaweedFilerBackupMetadataClient) Recv() (*BackupMetadataResponse, error) {
+	m := new(BackupMetadataResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *seaweedFilerClient) ApplyStoreMutation(ctx context.Context, in *ApplyStoreMutationRequest, opts ...grpc.CallOption) (*ApplyStoreMutationResponse, error) {
+	out := new(ApplyStoreMutationResponse)
+	err := grpc.Invoke(ctx, "/filer_pb.SeaweedFiler/ApplyStoreMutation", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seaweedFilerClient) SubscribeMetadata(ctx context.Context, in *SubscribeMetadataRequest, opts ...grpc.CallOption) (SeaweedFiler_SubscribeMetadataClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_SeaweedFiler_serviceDesc.Streams[1], c.cc, "/filer_pb.SeaweedFiler/SubscribeMetadata", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &seaweedFilerSubscribeMetadataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SeaweedFiler_SubscribeMetadataClient interface {
+	Recv() (*SubscribeMetadataResponse, error)
+	grpc.ClientStream
+}
+
+type seaweedFilerSubscribeMetadataClient struct {
+	grpc.ClientStream
+}
+
+func (x *seaweedFilerSubscribeMetadataClient) Recv() (*SubscribeMetadataResponse, error) {
+	m := new(SubscribeMetadataResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for SeaweedFiler service
 
 type SeaweedFilerServer interface {
@@ -1169,6 +1647,9 @@ type SeaweedFilerServer interface {
 	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
 	Statistics(context.Context, *StatisticsRequest) (*StatisticsResponse, error)
 	GetFilerConfiguration(context.Context, *GetFilerConfigurationRequest) (*GetFilerConfigurationResponse, error)
+	BackupMetadata(*BackupMetadataRequest, SeaweedFiler_BackupMetadataServer) error
+	ApplyStoreMutation(context.Context, *ApplyStoreMutationRequest) (*ApplyStoreMutationResponse, error)
+	SubscribeMetadata(*SubscribeMetadataRequest, SeaweedFiler_SubscribeMetadataServer) error
 }
 
 func RegisterSeaweedFilerServer(s *grpc.Server, srv SeaweedFilerServer) {
@@ -1373,6 +1854,66 @@ func _SeaweedFiler_GetFilerConfiguration_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SeaweedFiler_BackupMetadata_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackupMetadataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SeaweedFilerServer).BackupMetadata(m, &seaweedFilerBackupMetadataServer{stream})
+}
+
+type SeaweedFiler_BackupMetadataServer interface {
+	Send(*BackupMetadataResponse) error
+	grpc.ServerStream
+}
+
+type seaweedFilerBackupMetadataServer struct {
+	grpc.ServerStream
+}
+
+func (x *seaweedFilerBackupMetadataServer) Send(m *BackupMetadataResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SeaweedFiler_ApplyStoreMutation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyStoreMutationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedFilerServer).ApplyStoreMutation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filer_pb.SeaweedFiler/ApplyStoreMutation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedFilerServer).ApplyStoreMutation(ctx, req.(*ApplyStoreMutationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeaweedFiler_SubscribeMetadata_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeMetadataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SeaweedFilerServer).SubscribeMetadata(m, &seaweedFilerSubscribeMetadataServer{stream})
+}
+
+type SeaweedFiler_SubscribeMetadataServer interface {
+	Send(*SubscribeMetadataResponse) error
+	grpc.ServerStream
+}
+
+type seaweedFilerSubscribeMetadataServer struct {
+	grpc.ServerStream
+}
+
+func (x *seaweedFilerSubscribeMetadataServer) Send(m *SubscribeMetadataResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _SeaweedFiler_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "filer_pb.SeaweedFiler",
 	HandlerType: (*SeaweedFilerServer)(nil),
@@ -1421,114 +1962,166 @@ var _SeaweedFiler_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetFilerConfiguration",
 			Handler:    _SeaweedFiler_GetFilerConfiguration_Handler,
 		},
+		{
+			MethodName: "ApplyStoreMutation",
+			Handler:    _SeaweedFiler_ApplyStoreMutation_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BackupMetadata",
+			Handler:       _SeaweedFiler_BackupMetadata_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeMetadata",
+			Handler:       _SeaweedFiler_SubscribeMetadata_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "filer.proto",
 }
 
 func init() { proto.RegisterFile("filer.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xb4, 0x58, 0x49, 0x6f, 0xdc, 0x46,
-	0x16, 0x36, 0x7b, 0xe7, 0xeb, 0x6e, 0x5b, 0x2a, 0xc9, 0x36, 0xdd, 0x5a, 0x46, 0xa6, 0xc6, 0x1e,
-	0x19, 0x63, 0x68, 0x0c, 0x8f, 0x0f, 0xf6, 0x18, 0x03, 0xc4, 0xd6, 0x12, 0x08, 0x91, 0x17, 0x50,
-	0x76, 0x90, 0x20, 0x40, 0x08, 0x8a, 0xac, 0x6e, 0x55, 0x44, 0xb2, 0x3a, 0xc5, 0xa2, 0x24, 0xe7,
-	0x27, 0xe4, 0x98, 0x63, 0x80, 0x9c, 0xf3, 0x27, 0x82, 0x5c, 0x02, 0xff, 0x9d, 0x1c, 0x73, 0x0e,
-	0xaa, 0x8a, 0x64, 0x17, 0x9b, 0x2d, 0xc9, 0x41, 0xe0, 0x1b, 0xeb, 0x2d, 0xdf, 0x5b, 0xea, 0x2d,
-	0xd5, 0x0d, 0xdd, 0x21, 0x09, 0x31, 0xdb, 0x1c, 0x33, 0xca, 0x29, 0xea, 0xc8, 0x83, 0x3b, 0x3e,
-	0xb4, 0x5f, 0xc1, 0xd2, 0x3e, 0xa5, 0xc7, 0xe9, 0x78, 0x9b, 0x30, 0xec, 0x73, 0xca, 0xde, 0xed,
-	0xc4, 0x9c, 0xbd, 0x73, 0xf0, 0xb7, 0x29, 0x4e, 0x38, 0x5a, 0x06, 0x33, 0xc8, 0x19, 0x96, 0xb1,
-	0x66, 0x6c, 0x98, 0xce, 0x84, 0x80, 0x10, 0x34, 0x62, 0x2f, 0xc2, 0x56, 0x4d, 0x32, 0xe4, 0xb7,
-	0xbd, 0x03, 0xcb, 0xb3, 0x01, 0x93, 0x31, 0x8d, 0x13, 0x8c, 0xee, 0x40, 0x13, 0x0b, 0x82, 0x44,
-	0xeb, 0x3e, 0xbc, 0xb6, 0x99, 0xbb, 0xb2, 0xa9, 0xe4, 0x14, 0xd7, 0xfe, 0xd5, 0x00, 0xb4, 0x4f,
-	0x12, 0x2e, 0x88, 0x04, 0x27, 0x1f, 0xe6, 0xcf, 0x0d, 0x68, 0x8d, 0x19, 0x1e, 0x92, 0xb3, 0xcc,
-	0xa3, 0xec, 0x84, 0xee, 0xc3, 0x7c, 0xc2, 0x3d, 0xc6, 0x77, 0x19, 0x8d, 0x76, 0x49, 0x88, 0x5f,
-	0x0a, 0xa7, 0xeb, 0x52, 0xa4, 0xca, 0x40, 0x9b, 0x80, 0x48, 0xec, 0x87, 0x69, 0x42, 0x4e, 0xf0,
-	0x41, 0xce, 0xb5, 0x1a, 0x6b, 0xc6, 0x46, 0xc7, 0x99, 0xc1, 0x41, 0x8b, 0xd0, 0x0c, 0x49, 0x44,
-	0xb8, 0xd5, 0x5c, 0x33, 0x36, 0xfa, 0x8e, 0x3a, 0xd8, 0x9f, 0xc0, 0x42, 0xc9, 0xff, 0x2c, 0xfc,
-	0x7b, 0xd0, 0xc6, 0x8a, 0x64, 0x19, 0x6b, 0xf5, 0x59, 0x09, 0xc8, 0xf9, 0xf6, 0x4f, 0x35, 0x68,
-	0x4a, 0x52, 0x91, 0x67, 0x63, 0x92, 0x67, 0x74, 0x1b, 0x7a, 0x24, 0x71, 0x27, 0xc9, 0xa8, 0x49,
-	0xff, 0xba, 0x24, 0x29, 0xf2, 0x8e, 0xfe, 0x0d, 0x2d, 0xff, 0x28, 0x8d, 0x8f, 0x13, 0xab, 0x2e,
-	0x4d, 0x2d, 0x4c, 0x4c, 0x89, 0x60, 0xb7, 0x04, 0xcf, 0xc9, 0x44, 0xd0, 0x63, 0x00, 0x8f, 0x73,
-	0x46, 0x0e, 0x53, 0x8e, 0x13, 0x19, 0x6d, 0xf7, 0xa1, 0xa5, 0x29, 0xa4, 0x09, 0x7e, 0x56, 0xf0,
-	0x1d, 0x4d, 0x16, 0x3d, 0x81, 0x0e, 0x3e, 0xe3, 0x38, 0x0e, 0x70, 0x60, 0x35, 0xa5, 0xa1, 0x95,
-	0xa9, 0x98, 0x36, 0x77, 0x32, 0xbe, 0x8a, 0xb0, 0x10, 0x1f, 0x3c, 0x85, 0x7e, 0x89, 0x85, 0xe6,
-	0xa0, 0x7e, 0x8c, 0xf3, 0x9b, 0x15, 0x9f, 0x22, 0xbb, 0x27, 0x5e, 0x98, 0xaa, 0x22, 0xeb, 0x39,
-	0xea, 0xf0, 0xbf, 0xda, 0x63, 0xc3, 0xde, 0x06, 0x73, 0x37, 0x0d, 0xc3, 0x42, 0x31, 0x20, 0x2c,
-	0x57, 0x0c, 0x08, 0x9b, 0x14, 0x5a, 0xed, 0xc2, 0x42, 0xfb, 0xc5, 0x80, 0xf9, 0x9d, 0x13, 0x1c,
-	0xf3, 0x97, 0x94, 0x93, 0x21, 0xf1, 0x3d, 0x4e, 0x68, 0x8c, 0xee, 0x83, 0x49, 0xc3, 0xc0, 0xbd,
-	0xb0, 0x52, 0x3b, 0x34, 0xcc, 0xbc, 0xbe, 0x0f, 0x66, 0x8c, 0x4f, 0xdd, 0x0b, 0xcd, 0x75, 0x62,
-	0x7c, 0xaa, 0xa4, 0xd7, 0xa1, 0x1f, 0xe0, 0x10, 0x73, 0xec, 0x16, 0xb7, 0x23, 0xae, 0xae, 0xa7,
-	0x88, 0x5b, 0xea, 0x3a, 0xee, 0xc2, 0x35, 0x01, 0x39, 0xf6, 0x18, 0x8e, 0xb9, 0x3b, 0xf6, 0xf8,
-	0x91, 0xbc, 0x13, 0xd3, 0xe9, 0xc7, 0xf8, 0xf4, 0xb5, 0xa4, 0xbe, 0xf6, 0xf8, 0x91, 0xfd, 0x87,
-	0x01, 0x66, 0x71, 0x99, 0xe8, 0x26, 0xb4, 0x85, 0x59, 0x97, 0x04, 0x59, 0x26, 0x5a, 0xe2, 0xb8,
-	0x17, 0x88, 0xce, 0xa0, 0xc3, 0x61, 0x82, 0xb9, 0x74, 0xaf, 0xee, 0x64, 0x27, 0x51, 0x59, 0x09,
-	0xf9, 0x4e, 0x35, 0x43, 0xc3, 0x91, 0xdf, 0x22, 0xe3, 0x11, 0x27, 0x11, 0x96, 0x06, 0xeb, 0x8e,
-	0x3a, 0xa0, 0x05, 0x68, 0x62, 0x97, 0x7b, 0x23, 0x59, 0xe5, 0xa6, 0xd3, 0xc0, 0x6f, 0xbc, 0x11,
-	0xfa, 0x27, 0x5c, 0x4d, 0x68, 0xca, 0x7c, 0xec, 0xe6, 0x66, 0x5b, 0x92, 0xdb, 0x53, 0xd4, 0x5d,
-	0x65, 0xdc, 0x86, 0xfa, 0x90, 0x04, 0x56, 0x5b, 0x26, 0x66, 0xae, 0x5c, 0x84, 0x7b, 0x81, 0x23,
-	0x98, 0xe8, 0x3f, 0x00, 0x05, 0x52, 0x60, 0x75, 0xce, 0x11, 0x35, 0x73, 0xdc, 0xc0, 0xfe, 0x02,
-	0x5a, 0x19, 0xfc, 0x12, 0x98, 0x27, 0x34, 0x4c, 0xa3, 0x22, 0xec, 0xbe, 0xd3, 0x51, 0x84, 0xbd,
-	0x00, 0xdd, 0x02, 0x39, 0xeb, 0x5c, 0x51, 0x55, 0x35, 0x19, 0xa4, 0xcc, 0xd0, 0x67, 0x58, 0x4e,
-	0x0b, 0x9f, 0xd2, 0x63, 0xa2, 0xa2, 0x6f, 0x3b, 0xd9, 0xc9, 0xfe, 0xbd, 0x06, 0x57, 0xcb, 0xe5,
-	0x2e, 0x4c, 0x48, 0x14, 0x99, 0x2b, 0x43, 0xc2, 0x48, 0xd8, 0x83, 0x52, 0xbe, 0x6a, 0x7a, 0xbe,
-	0x72, 0x95, 0x88, 0x06, 0xca, 0x40, 0x5f, 0xa9, 0xbc, 0xa0, 0x01, 0x16, 0xd5, 0x9a, 0x92, 0x40,
-	0x26, 0xb8, 0xef, 0x88, 0x4f, 0x41, 0x19, 0x91, 0x20, 0x1b, 0x21, 0xe2, 0x53, 0xba, 0xc7, 0x24,
-	0x6e, 0x4b, 0x5d, 0x99, 0x3a, 0x89, 0x2b, 0x8b, 0x04, 0xb5, 0xad, 0xee, 0x41, 0x7c, 0xa3, 0x35,
-	0xe8, 0x32, 0x3c, 0x0e, 0xb3, 0xea, 0x95, 0xe9, 0x33, 0x1d, 0x9d, 0x84, 0x56, 0x01, 0x7c, 0x1a,
-	0x86, 0xd8, 0x97, 0x02, 0xa6, 0x14, 0xd0, 0x28, 0xa2, 0x72, 0x38, 0x0f, 0xdd, 0x04, 0xfb, 0x16,
-	0xac, 0x19, 0x1b, 0x4d, 0xa7, 0xc5, 0x79, 0x78, 0x80, 0x7d, 0x11, 0x47, 0x9a, 0x60, 0xe6, 0xca,
-	0x01, 0xd4, 0x95, 0x7a, 0x1d, 0x41, 0x90, 0xa3, 0x72, 0x05, 0x60, 0xc4, 0x68, 0x3a, 0x56, 0xdc,
-	0xde, 0x5a, 0x5d, 0xcc, 0x63, 0x49, 0x91, 0xec, 0x3b, 0x70, 0x35, 0x79, 0x17, 0x85, 0x24, 0x3e,
-	0x76, 0xb9, 0xc7, 0x46, 0x98, 0x5b, 0x7d, 0x55, 0xc3, 0x19, 0xf5, 0x8d, 0x24, 0xda, 0x5f, 0x02,
-	0xda, 0x62, 0xd8, 0xe3, 0xf8, 0x2f, 0xac, 0x9e, 0x0f, 0xec, 0xee, 0xeb, 0xb0, 0x50, 0x82, 0x56,
-	0x53, 0x58, 0x58, 0x7c, 0x3b, 0x0e, 0x3e, 0x96, 0xc5, 0x12, 0x74, 0x66, 0xf1, 0xbd, 0x01, 0x68,
-	0x5b, 0x36, 0xf8, 0xdf, 0xdb, 0xaf, 0xa2, 0xe5, 0xc4, 0xdc, 0x57, 0x03, 0x24, 0xf0, 0xb8, 0x97,
-	0x6d, 0xa6, 0x1e, 0x49, 0x14, 0xfe, 0xb6, 0xc7, 0xbd, 0x6c, 0x3b, 0x30, 0xec, 0xa7, 0x4c, 0x2c,
-	0x2b, 0x59, 0x57, 0x72, 0x3b, 0x38, 0x39, 0x09, 0x3d, 0x82, 0x1b, 0x64, 0x14, 0x53, 0x86, 0x27,
-	0x62, 0x2e, 0x66, 0x8c, 0x32, 0x59, 0x6f, 0x1d, 0x67, 0x51, 0x71, 0x0b, 0x85, 0x1d, 0xc1, 0x13,
-	0xe1, 0x95, 0xc2, 0xc8, 0xc2, 0xfb, 0xd1, 0x00, 0xeb, 0x19, 0xa7, 0x11, 0xf1, 0x1d, 0x2c, 0xdc,
-	0x2c, 0x05, 0xb9, 0x0e, 0x7d, 0x31, 0x4c, 0xa7, 0x03, 0xed, 0xd1, 0x30, 0x98, 0x2c, 0xab, 0x5b,
-	0x20, 0xe6, 0xa9, 0xab, 0xc5, 0xdb, 0xa6, 0x61, 0x20, 0xcb, 0x68, 0x1d, 0xc4, 0xd0, 0xd3, 0xf4,
-	0xd5, 0xea, 0xee, 0xc5, 0xf8, 0xb4, 0xa4, 0x2f, 0x84, 0xa4, 0xbe, 0x9a, 0x94, 0xed, 0x18, 0x9f,
-	0x0a, 0x7d, 0x7b, 0x09, 0x6e, 0xcd, 0xf0, 0x2d, 0xf3, 0xfc, 0x67, 0x03, 0x16, 0x9e, 0x25, 0x09,
-	0x19, 0xc5, 0x9f, 0xcb, 0x99, 0x91, 0x3b, 0xbd, 0x08, 0x4d, 0x9f, 0xa6, 0x31, 0x97, 0xce, 0x36,
-	0x1d, 0x75, 0x98, 0x6a, 0xa3, 0x5a, 0xa5, 0x8d, 0xa6, 0x1a, 0xb1, 0x5e, 0x6d, 0x44, 0xad, 0xd1,
-	0x1a, 0xa5, 0x46, 0xfb, 0x07, 0x74, 0xc5, 0x75, 0xba, 0x3e, 0x8e, 0x39, 0x66, 0xd9, 0x98, 0x05,
-	0x41, 0xda, 0x92, 0x14, 0xfb, 0x7b, 0x03, 0x16, 0xcb, 0x9e, 0x66, 0x6f, 0x8a, 0x73, 0xa7, 0xbe,
-	0x18, 0x33, 0x2c, 0xcc, 0xdc, 0x14, 0x9f, 0xa2, 0x61, 0xc7, 0xe9, 0x61, 0x48, 0x7c, 0x57, 0x30,
-	0x94, 0x7b, 0xa6, 0xa2, 0xbc, 0x65, 0xe1, 0x24, 0xe8, 0x86, 0x1e, 0x34, 0x82, 0x86, 0x97, 0xf2,
-	0xa3, 0x7c, 0xf2, 0x8b, 0x6f, 0xfb, 0x11, 0x2c, 0xa8, 0x67, 0x5e, 0x39, 0x6b, 0x2b, 0x00, 0xc5,
-	0x2c, 0x56, 0x2f, 0x1c, 0xd3, 0x31, 0xf3, 0x61, 0x9c, 0xd8, 0xff, 0x07, 0x73, 0x9f, 0xaa, 0x44,
-	0x24, 0xe8, 0x01, 0x98, 0x61, 0x7e, 0xc8, 0x1e, 0x43, 0x68, 0xd2, 0x54, 0xb9, 0x9c, 0x33, 0x11,
-	0xb2, 0x9f, 0x42, 0x27, 0x27, 0xe7, 0xb1, 0x19, 0xe7, 0xc5, 0x56, 0x9b, 0x8a, 0xcd, 0xfe, 0xcd,
-	0x80, 0xc5, 0xb2, 0xcb, 0x59, 0xfa, 0xde, 0x42, 0xbf, 0x30, 0xe1, 0x46, 0xde, 0x38, 0xf3, 0xe5,
-	0x81, 0xee, 0x4b, 0x55, 0xad, 0x70, 0x30, 0x79, 0xe1, 0x8d, 0x55, 0x49, 0xf5, 0x42, 0x8d, 0x34,
-	0x78, 0x03, 0xf3, 0x15, 0x91, 0x19, 0xef, 0x9b, 0x7b, 0xfa, 0xfb, 0xa6, 0xf4, 0x46, 0x2b, 0xb4,
-	0xf5, 0x47, 0xcf, 0x13, 0xb8, 0xa9, 0xfa, 0x6f, 0xab, 0x28, 0xba, 0x3c, 0xf7, 0xe5, 0xda, 0x34,
-	0xa6, 0x6b, 0xd3, 0x1e, 0x80, 0x55, 0x55, 0xcd, 0xba, 0x60, 0x04, 0xf3, 0x07, 0xdc, 0xe3, 0x24,
-	0xe1, 0xc4, 0x2f, 0x1e, 0xdb, 0x53, 0xc5, 0x6c, 0x5c, 0xb6, 0x55, 0xaa, 0xed, 0x30, 0x07, 0x75,
-	0xce, 0xf3, 0x3a, 0x13, 0x9f, 0xe2, 0x16, 0x90, 0x6e, 0x29, 0xbb, 0x83, 0x8f, 0x60, 0x4a, 0xd4,
-	0x03, 0xa7, 0xdc, 0x0b, 0xd5, 0xd6, 0x6e, 0xc8, 0xad, 0x6d, 0x4a, 0x8a, 0x5c, 0xdb, 0x6a, 0xb1,
-	0x05, 0x8a, 0xdb, 0x54, 0x3b, 0x5d, 0x10, 0x24, 0x73, 0x05, 0x40, 0xb6, 0x94, 0xea, 0x86, 0x96,
-	0xd2, 0x15, 0x94, 0x2d, 0x41, 0xb0, 0x57, 0x61, 0xf9, 0x53, 0xcc, 0xc5, 0xfb, 0x83, 0x6d, 0xd1,
-	0x78, 0x48, 0x46, 0x29, 0xf3, 0xb4, 0xab, 0xb0, 0x7f, 0x30, 0x60, 0xe5, 0x1c, 0x81, 0x2c, 0x60,
-	0x0b, 0xda, 0x91, 0x97, 0x70, 0xcc, 0xf2, 0x2e, 0xc9, 0x8f, 0xd3, 0xa9, 0xa8, 0x5d, 0x96, 0x8a,
-	0x7a, 0x25, 0x15, 0xd7, 0xa1, 0x15, 0x79, 0x67, 0x6e, 0x74, 0x98, 0x3d, 0x30, 0x9a, 0x91, 0x77,
-	0xf6, 0xe2, 0xf0, 0xe1, 0xfb, 0x36, 0xf4, 0x0e, 0xb0, 0x77, 0x8a, 0x71, 0x20, 0x1d, 0x43, 0xa3,
-	0xbc, 0x21, 0xca, 0x3f, 0xd5, 0xd0, 0x9d, 0xe9, 0xca, 0x9f, 0xf9, 0xdb, 0x70, 0x70, 0xf7, 0x32,
-	0xb1, 0xac, 0xb6, 0xae, 0xa0, 0x7d, 0xe8, 0x6a, 0xbf, 0x85, 0xd0, 0xb2, 0xa6, 0x58, 0xf9, 0x89,
-	0x37, 0x58, 0x39, 0x87, 0xab, 0xa3, 0x69, 0x3b, 0x5d, 0x47, 0xab, 0xbe, 0x22, 0x74, 0xb4, 0x59,
-	0x0f, 0x01, 0x89, 0xa6, 0xed, 0x6b, 0x1d, 0xad, 0xfa, 0x42, 0xd0, 0xd1, 0x66, 0x2d, 0x79, 0x89,
-	0xa6, 0xad, 0x47, 0x1d, 0xad, 0xba, 0xfc, 0x75, 0xb4, 0x59, 0x3b, 0xf5, 0x0a, 0xfa, 0x1a, 0xe6,
-	0x2b, 0x8b, 0x0b, 0xd9, 0x13, 0xad, 0xf3, 0x36, 0xee, 0x60, 0xfd, 0x42, 0x99, 0x02, 0xff, 0x15,
-	0xf4, 0xf4, 0x85, 0x82, 0x34, 0x87, 0x66, 0xac, 0xc4, 0xc1, 0xea, 0x79, 0x6c, 0x1d, 0x50, 0x9f,
-	0x95, 0x3a, 0xe0, 0x8c, 0x6d, 0xa1, 0x03, 0xce, 0x1a, 0xb1, 0xf6, 0x15, 0xf4, 0x15, 0xcc, 0x4d,
-	0xcf, 0x2c, 0x74, 0x7b, 0x3a, 0x6d, 0x95, 0x51, 0x38, 0xb0, 0x2f, 0x12, 0x29, 0xc0, 0xf7, 0x00,
-	0x26, 0xa3, 0x08, 0x2d, 0x4d, 0x74, 0x2a, 0xa3, 0x70, 0xb0, 0x3c, 0x9b, 0x59, 0x40, 0x7d, 0x03,
-	0xd7, 0x67, 0xf6, 0x3b, 0xd2, 0x9a, 0xe4, 0xa2, 0x89, 0x31, 0xf8, 0xd7, 0xa5, 0x72, 0xb9, 0xad,
-	0xe7, 0xab, 0x30, 0x97, 0xa8, 0x36, 0x1e, 0x26, 0x9b, 0x7e, 0x48, 0x70, 0xcc, 0x9f, 0x83, 0xd4,
-	0x78, 0xcd, 0x28, 0xa7, 0x87, 0x2d, 0xf9, 0x1f, 0xcf, 0x7f, 0xff, 0x0c, 0x00, 0x00, 0xff, 0xff,
-	0x0e, 0xa9, 0xb5, 0x68, 0xf2, 0x11, 0x00, 0x00,
+	// 2207 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4f, 0x73, 0xdc, 0x48,
+	0x15, 0x47, 0xf3, 0x5f, 0x6f, 0x66, 0x1c, 0x4f, 0x3b, 0xd9, 0x28, 0x93, 0x38, 0xf1, 0x2a, 0xc9,
+	0x92, 0x40, 0x30, 0x21, 0xbb, 0x5b, 0xb5, 0xcb, 0x16, 0xb5, 0x9b, 0x38, 0xce, 0x12, 0x88, 0xbd,
+	0x2e, 0x39, 0x59, 0xa8, 0x82, 0x5a, 0x21, 0x4b, 0xed, 0x71, 0x63, 0x8d, 0x24, 0xd4, 0x2d, 0xff,
+	0xe1, 0xc4, 0x89, 0x0f, 0xc0, 0x91, 0x8f, 0xc0, 0x17, 0xe0, 0x42, 0x71, 0xe1, 0x03, 0x70, 0xe4,
+	0xc6, 0x85, 0x0b, 0x1f, 0x83, 0xea, 0x3f, 0x92, 0x5a, 0xd2, 0x8c, 0x9d, 0x65, 0x8b, 0x2a, 0x6e,
+	0xea, 0xf7, 0x5e, 0xbf, 0x7e, 0xef, 0xf5, 0xfb, 0xf3, 0xeb, 0x19, 0x18, 0x1e, 0x92, 0x10, 0xa7,
+	0x9b, 0x49, 0x1a, 0xb3, 0x18, 0x0d, 0xc4, 0xc2, 0x4d, 0x0e, 0xec, 0x2f, 0xe0, 0xe6, 0xab, 0x38,
+	0x3e, 0xce, 0x92, 0xe7, 0x24, 0xc5, 0x3e, 0x8b, 0xd3, 0xf3, 0xed, 0x88, 0xa5, 0xe7, 0x0e, 0xfe,
+	0x4d, 0x86, 0x29, 0x43, 0xb7, 0xc0, 0x0c, 0x72, 0x86, 0x65, 0x6c, 0x18, 0x0f, 0x4c, 0xa7, 0x24,
+	0x20, 0x04, 0x9d, 0xc8, 0x9b, 0x63, 0xab, 0x25, 0x18, 0xe2, 0xdb, 0xde, 0x86, 0x5b, 0x8b, 0x15,
+	0xd2, 0x24, 0x8e, 0x28, 0x46, 0xf7, 0xa1, 0x8b, 0x23, 0xa6, 0xb4, 0x0d, 0x9f, 0x5c, 0xd9, 0xcc,
+	0x4d, 0xd9, 0x94, 0x72, 0x92, 0x6b, 0xff, 0xd5, 0x00, 0xf4, 0x8a, 0x50, 0xc6, 0x89, 0x04, 0xd3,
+	0xb7, 0xb3, 0xe7, 0x1d, 0xe8, 0x25, 0x29, 0x3e, 0x24, 0x67, 0xca, 0x22, 0xb5, 0x42, 0x8f, 0x60,
+	0x42, 0x99, 0x97, 0xb2, 0x17, 0x69, 0x3c, 0x7f, 0x41, 0x42, 0xbc, 0xcb, 0x8d, 0x6e, 0x0b, 0x91,
+	0x26, 0x03, 0x6d, 0x02, 0x22, 0x91, 0x1f, 0x66, 0x94, 0x9c, 0xe0, 0xfd, 0x9c, 0x6b, 0x75, 0x36,
+	0x8c, 0x07, 0x03, 0x67, 0x01, 0x07, 0x5d, 0x85, 0x6e, 0x48, 0xe6, 0x84, 0x59, 0xdd, 0x0d, 0xe3,
+	0xc1, 0xd8, 0x91, 0x0b, 0xfb, 0x33, 0x58, 0xab, 0xd8, 0xaf, 0xdc, 0x7f, 0x08, 0x7d, 0x2c, 0x49,
+	0x96, 0xb1, 0xd1, 0x5e, 0x14, 0x80, 0x9c, 0x6f, 0xff, 0xb9, 0x05, 0x5d, 0x41, 0x2a, 0xe2, 0x6c,
+	0x94, 0x71, 0x46, 0xef, 0xc2, 0x88, 0x50, 0xb7, 0x0c, 0x46, 0x4b, 0xd8, 0x37, 0x24, 0xb4, 0x88,
+	0x3b, 0xfa, 0x2e, 0xf4, 0xfc, 0xa3, 0x2c, 0x3a, 0xa6, 0x56, 0x5b, 0x1c, 0xb5, 0x56, 0x1e, 0xc5,
+	0x9d, 0xdd, 0xe2, 0x3c, 0x47, 0x89, 0xa0, 0x8f, 0x00, 0x3c, 0xc6, 0x52, 0x72, 0x90, 0x31, 0x4c,
+	0x85, 0xb7, 0xc3, 0x27, 0x96, 0xb6, 0x21, 0xa3, 0xf8, 0x69, 0xc1, 0x77, 0x34, 0x59, 0xf4, 0x31,
+	0x0c, 0xf0, 0x19, 0xc3, 0x51, 0x80, 0x03, 0xab, 0x2b, 0x0e, 0x5a, 0xaf, 0xf9, 0xb4, 0xb9, 0xad,
+	0xf8, 0xd2, 0xc3, 0x42, 0x1c, 0x59, 0xd0, 0x3f, 0xc1, 0x29, 0x25, 0x71, 0x64, 0xf5, 0x36, 0x8c,
+	0x07, 0x1d, 0x27, 0x5f, 0x4e, 0x3f, 0x81, 0x71, 0x65, 0x13, 0x5a, 0x85, 0xf6, 0x31, 0xce, 0xef,
+	0x9c, 0x7f, 0xf2, 0xb8, 0x9f, 0x78, 0x61, 0x26, 0xd3, 0x6f, 0xe4, 0xc8, 0xc5, 0x0f, 0x5b, 0x1f,
+	0x19, 0xf6, 0x73, 0x30, 0x5f, 0x64, 0x61, 0x58, 0x6c, 0x0c, 0x48, 0x9a, 0x6f, 0x0c, 0x48, 0x5a,
+	0xa6, 0x60, 0xeb, 0xc2, 0x14, 0xfc, 0x8b, 0x01, 0x93, 0xed, 0x13, 0x1c, 0xb1, 0xdd, 0x98, 0x91,
+	0x43, 0xe2, 0x7b, 0x8c, 0xc4, 0x11, 0x7a, 0x04, 0x66, 0x1c, 0x06, 0xee, 0x85, 0x39, 0x3c, 0x88,
+	0x43, 0x65, 0xf5, 0x23, 0x30, 0x23, 0x7c, 0xea, 0x5e, 0x78, 0xdc, 0x20, 0xc2, 0xa7, 0x52, 0xfa,
+	0x2e, 0x8c, 0x03, 0x1c, 0x62, 0x86, 0xdd, 0xe2, 0xde, 0xf8, 0xa5, 0x8e, 0x24, 0x71, 0x4b, 0x5e,
+	0xd4, 0x7b, 0x70, 0x85, 0xab, 0x4c, 0xbc, 0x14, 0x47, 0xcc, 0x4d, 0x3c, 0x76, 0x24, 0x6e, 0xcb,
+	0x74, 0xc6, 0x11, 0x3e, 0xdd, 0x13, 0xd4, 0x3d, 0x8f, 0x1d, 0xd9, 0xff, 0x68, 0x81, 0x59, 0x5c,
+	0x33, 0xba, 0x0e, 0x7d, 0x7e, 0xac, 0x4b, 0x02, 0x15, 0x89, 0x1e, 0x5f, 0xbe, 0x0c, 0x78, 0xcd,
+	0xc4, 0x87, 0x87, 0x14, 0x33, 0x61, 0x5e, 0xdb, 0x51, 0x2b, 0x9e, 0x73, 0x94, 0xfc, 0x56, 0x96,
+	0x49, 0xc7, 0x11, 0xdf, 0x3c, 0xe2, 0x73, 0x46, 0xe6, 0x58, 0x1c, 0xd8, 0x76, 0xe4, 0x02, 0xad,
+	0x41, 0x17, 0xbb, 0xcc, 0x9b, 0x89, 0xfc, 0x37, 0x9d, 0x0e, 0x7e, 0xed, 0xcd, 0xd0, 0x3d, 0x58,
+	0xa1, 0x71, 0x96, 0xfa, 0xd8, 0xcd, 0x8f, 0xed, 0x09, 0xee, 0x48, 0x52, 0x5f, 0xc8, 0xc3, 0x6d,
+	0x68, 0x1f, 0x92, 0xc0, 0xea, 0x8b, 0xc0, 0xac, 0x56, 0xd3, 0xf3, 0x65, 0xe0, 0x70, 0x26, 0xfa,
+	0x3e, 0x40, 0xa1, 0x29, 0xb0, 0x06, 0x4b, 0x44, 0xcd, 0x5c, 0x6f, 0x80, 0xbe, 0x03, 0x13, 0x42,
+	0x65, 0x04, 0xdd, 0xb9, 0x17, 0x91, 0x43, 0x4c, 0x99, 0x65, 0x8a, 0x48, 0x5e, 0x21, 0x54, 0x84,
+	0x63, 0x47, 0x91, 0x79, 0x15, 0xf9, 0x71, 0xc4, 0x78, 0x24, 0x8f, 0x3c, 0x7a, 0x64, 0x81, 0x30,
+	0x72, 0xa8, 0x68, 0x3f, 0xf6, 0xe8, 0x11, 0xcf, 0x9f, 0x79, 0xf0, 0xa1, 0x35, 0x14, 0x49, 0xc6,
+	0x3f, 0xed, 0xcf, 0x60, 0x52, 0x04, 0xb6, 0xd0, 0x54, 0x16, 0x9b, 0x71, 0x69, 0xb1, 0xd9, 0x3f,
+	0x87, 0x9e, 0x8a, 0xc0, 0x4d, 0x30, 0x4f, 0xe2, 0x30, 0x9b, 0x17, 0x37, 0x33, 0x76, 0x06, 0x92,
+	0xf0, 0x32, 0x40, 0x37, 0x40, 0x34, 0x6a, 0x97, 0x27, 0x7e, 0x4b, 0xd6, 0x07, 0x5f, 0xff, 0x14,
+	0x8b, 0x56, 0xe7, 0xc7, 0xf1, 0x31, 0x91, 0x17, 0xd4, 0x77, 0xd4, 0xca, 0xfe, 0x5d, 0x1b, 0x56,
+	0xaa, 0xb5, 0xca, 0x8f, 0x10, 0x5a, 0xc4, 0x75, 0x1a, 0x42, 0x8d, 0x50, 0xbb, 0x5f, 0xb9, 0xd2,
+	0x96, 0x7e, 0xa5, 0xf9, 0x96, 0x79, 0x1c, 0xc8, 0x03, 0xc6, 0x72, 0xcb, 0x4e, 0x1c, 0x60, 0x1e,
+	0x90, 0x8c, 0x04, 0x22, 0x07, 0xc6, 0x0e, 0xff, 0xe4, 0x94, 0x19, 0x09, 0x54, 0xff, 0xe3, 0x9f,
+	0xc2, 0xbc, 0x54, 0xe8, 0xed, 0xc9, 0xac, 0x92, 0x2b, 0x9e, 0x55, 0x73, 0x4e, 0xed, 0xcb, 0x54,
+	0xe1, 0xdf, 0x68, 0x03, 0x86, 0x29, 0x4e, 0x42, 0x55, 0x60, 0xe2, 0x86, 0x4d, 0x47, 0x27, 0xa1,
+	0xdb, 0x00, 0x7e, 0x1c, 0x86, 0xd8, 0x17, 0x02, 0xa6, 0x10, 0xd0, 0x28, 0x3c, 0xb9, 0x19, 0x0b,
+	0x5d, 0x8a, 0x7d, 0x71, 0x81, 0x5d, 0xa7, 0xc7, 0x58, 0xb8, 0x8f, 0x7d, 0xee, 0x47, 0x46, 0x71,
+	0xea, 0x8a, 0xee, 0x39, 0x14, 0xfb, 0x06, 0x9c, 0x20, 0xfa, 0xfc, 0x3a, 0xc0, 0x2c, 0x8d, 0xb3,
+	0x44, 0x72, 0x47, 0x1b, 0x6d, 0x3e, 0x4c, 0x04, 0x45, 0xb0, 0xef, 0xc3, 0x0a, 0x3d, 0x9f, 0x87,
+	0x24, 0x3a, 0x76, 0x99, 0x97, 0xce, 0x30, 0xb3, 0xc6, 0xb2, 0xcc, 0x14, 0xf5, 0xb5, 0x20, 0xe6,
+	0xe9, 0xb1, 0x52, 0xa6, 0xc7, 0xdf, 0x5b, 0x30, 0x11, 0xf5, 0xbc, 0x97, 0x62, 0x3f, 0x8e, 0x02,
+	0x22, 0x6c, 0xbc, 0x07, 0x2b, 0xf3, 0x8c, 0x32, 0x37, 0x8a, 0x99, 0x8b, 0xcf, 0x08, 0x65, 0xe2,
+	0x2a, 0x06, 0xce, 0x88, 0x53, 0x77, 0x63, 0xb6, 0xcd, 0x69, 0xdc, 0x26, 0x21, 0x25, 0x25, 0x64,
+	0x4f, 0x37, 0x39, 0x45, 0xb2, 0x1f, 0xc2, 0x2a, 0x3e, 0x4b, 0xb0, 0xcf, 0x70, 0xe0, 0xe6, 0x8d,
+	0x53, 0x16, 0xe8, 0x95, 0x9c, 0xfe, 0xa5, 0x24, 0x73, 0xf3, 0x0b, 0x51, 0xbd, 0x68, 0xc7, 0x39,
+	0x75, 0x47, 0x5c, 0xc8, 0x57, 0x30, 0x29, 0xc4, 0x6a, 0x5d, 0xfc, 0x07, 0xb5, 0x46, 0xa5, 0xbb,
+	0xb3, 0xb9, 0xad, 0x36, 0x55, 0x3b, 0xfb, 0x2a, 0xae, 0x91, 0xa7, 0x5b, 0x70, 0x6d, 0xa1, 0xe8,
+	0xd7, 0xea, 0xe7, 0x7f, 0x34, 0x00, 0x6d, 0xa5, 0xd8, 0x63, 0xf8, 0x6b, 0x80, 0x93, 0xb7, 0xeb,
+	0xf2, 0xe8, 0x53, 0x18, 0x25, 0x9a, 0x63, 0x22, 0x9c, 0xc3, 0x27, 0x37, 0x2f, 0xf0, 0xdd, 0xa9,
+	0x6c, 0xb0, 0xaf, 0xc1, 0x5a, 0xc5, 0x36, 0x39, 0xe8, 0x85, 0xcd, 0x6f, 0x92, 0xe0, 0xff, 0xd6,
+	0xe6, 0x8a, 0x6d, 0xca, 0xe6, 0xdf, 0xb7, 0x00, 0x3d, 0x17, 0xb3, 0xe6, 0x9b, 0x81, 0x40, 0x9e,
+	0xec, 0x1c, 0x9c, 0xc8, 0x59, 0x16, 0x78, 0xcc, 0x53, 0xf0, 0x69, 0x44, 0xa8, 0xd4, 0xff, 0xdc,
+	0x63, 0x9e, 0x82, 0x30, 0x29, 0xf6, 0xb3, 0x94, 0x23, 0x2a, 0xab, 0x9b, 0x43, 0x18, 0x27, 0x27,
+	0xa1, 0x0f, 0xe0, 0x1d, 0x32, 0x8b, 0xe2, 0x14, 0x97, 0x62, 0x2e, 0x4e, 0xd3, 0x38, 0x15, 0x7d,
+	0x65, 0xe0, 0x5c, 0x95, 0xdc, 0x62, 0xc3, 0x36, 0xe7, 0x35, 0xe2, 0xd3, 0xff, 0x2f, 0xe2, 0x53,
+	0x89, 0x43, 0x79, 0xa7, 0xd6, 0x53, 0x16, 0xcf, 0x89, 0xef, 0x60, 0xee, 0x67, 0x25, 0x4a, 0x77,
+	0x61, 0xcc, 0x81, 0x41, 0x3d, 0x52, 0xa3, 0x38, 0x0c, 0x4a, 0x48, 0x76, 0x03, 0x38, 0x36, 0x70,
+	0xb5, 0x80, 0xf5, 0xe3, 0x30, 0x10, 0xfd, 0xe6, 0x2e, 0xf0, 0x01, 0xae, 0xed, 0x97, 0x00, 0x75,
+	0x14, 0xe1, 0xd3, 0xca, 0x7e, 0x2e, 0x24, 0xf6, 0xcb, 0xa9, 0xdf, 0x8f, 0xf0, 0x29, 0xdf, 0x6f,
+	0xdf, 0x84, 0x1b, 0x0b, 0x6c, 0x53, 0x96, 0xff, 0xdb, 0x80, 0xb5, 0xa7, 0x94, 0x92, 0x59, 0xf4,
+	0xa5, 0x18, 0x2e, 0xb9, 0xd1, 0x57, 0xa1, 0xeb, 0xc7, 0x59, 0x24, 0x9b, 0x51, 0xd7, 0x91, 0x8b,
+	0x5a, 0xbf, 0x6d, 0x35, 0xfa, 0x6d, 0xad, 0x63, 0xb7, 0x9b, 0x1d, 0x5b, 0xeb, 0xc8, 0x9d, 0x4a,
+	0x47, 0xbe, 0x03, 0x43, 0x9e, 0x0f, 0xae, 0x8f, 0x23, 0x86, 0x53, 0x05, 0x19, 0x80, 0x93, 0xb6,
+	0x04, 0x85, 0x0b, 0xe8, 0xd0, 0x46, 0xa2, 0x06, 0x48, 0x0a, 0x5c, 0xc3, 0x8d, 0x0b, 0xb2, 0xd4,
+	0x3b, 0x20, 0x21, 0x61, 0xe7, 0x6a, 0x90, 0x68, 0x14, 0xfb, 0x4f, 0x06, 0x5c, 0xad, 0xba, 0xaa,
+	0xa0, 0xf7, 0x52, 0x08, 0xc4, 0x07, 0x5a, 0x1a, 0x2a, 0x3f, 0xf9, 0x27, 0x6f, 0xc3, 0x49, 0x76,
+	0x10, 0x12, 0xdf, 0xe5, 0x0c, 0xe9, 0x9f, 0x29, 0x29, 0x6f, 0xd2, 0xb0, 0x8c, 0x5a, 0x47, 0x8f,
+	0x1a, 0x82, 0x8e, 0x97, 0xb1, 0xa3, 0x1c, 0x06, 0x79, 0x59, 0xc3, 0xd8, 0x5e, 0xc3, 0xd8, 0x0f,
+	0x60, 0x4d, 0xbe, 0x96, 0xaa, 0xd7, 0xb2, 0x0e, 0x50, 0xa0, 0x02, 0x09, 0x28, 0x4c, 0xc7, 0xcc,
+	0x61, 0x01, 0xb5, 0x7f, 0x04, 0xe6, 0xab, 0x58, 0x46, 0x9a, 0xa2, 0xc7, 0x60, 0x86, 0xf9, 0x42,
+	0x61, 0x0f, 0x54, 0x66, 0x7a, 0x2e, 0xe7, 0x94, 0x42, 0xf6, 0x27, 0x30, 0xc8, 0xc9, 0xb9, 0xef,
+	0xc6, 0x32, 0xdf, 0x5b, 0x35, 0xdf, 0xed, 0xbf, 0x19, 0x70, 0xb5, 0x6a, 0xb2, 0x0a, 0xef, 0x1b,
+	0x18, 0x17, 0x47, 0xb8, 0x73, 0x2f, 0x51, 0xb6, 0x3c, 0xd6, 0x6d, 0x69, 0x6e, 0x2b, 0x0c, 0xa4,
+	0x3b, 0x5e, 0x22, 0x73, 0x76, 0x14, 0x6a, 0xa4, 0xe9, 0x6b, 0x98, 0x34, 0x44, 0x16, 0x0c, 0x8f,
+	0x87, 0xfa, 0xf0, 0xa8, 0xa0, 0xaf, 0x62, 0xb7, 0x3e, 0x51, 0x3e, 0x86, 0xeb, 0xb2, 0xc0, 0xb7,
+	0x8a, 0xac, 0xce, 0x63, 0x5f, 0x4d, 0x7e, 0xa3, 0x9e, 0xfc, 0xf6, 0x14, 0xac, 0xe6, 0x56, 0x55,
+	0x66, 0x33, 0x98, 0xec, 0x33, 0x8f, 0x11, 0xca, 0x88, 0x5f, 0xbc, 0x59, 0x6b, 0xd5, 0x62, 0x5c,
+	0x86, 0x6f, 0x9a, 0xf5, 0xb6, 0x0a, 0x6d, 0xc6, 0xf2, 0x3c, 0xe4, 0x9f, 0xfc, 0x16, 0x90, 0x7e,
+	0x92, 0xba, 0x83, 0xff, 0xc1, 0x51, 0x3c, 0x1f, 0x58, 0xcc, 0xbc, 0x50, 0xe2, 0xc7, 0x8e, 0x40,
+	0x1b, 0xa6, 0xa0, 0x08, 0x00, 0x29, 0x21, 0x56, 0x20, 0xb9, 0x5d, 0x89, 0x2e, 0x39, 0x41, 0x30,
+	0xd7, 0x01, 0x44, 0xc9, 0xc9, 0x6a, 0x91, 0x4f, 0x3c, 0x81, 0x2c, 0xb7, 0x38, 0xc1, 0xbe, 0x0d,
+	0xb7, 0x3e, 0xc7, 0x8c, 0x23, 0xe1, 0x74, 0x2b, 0x8e, 0x0e, 0xc9, 0x2c, 0x4b, 0x3d, 0xed, 0x2a,
+	0xec, 0x3f, 0x18, 0xb0, 0xbe, 0x44, 0x40, 0x39, 0x6c, 0x41, 0x7f, 0xee, 0x51, 0x86, 0xd3, 0xbc,
+	0x4a, 0xf2, 0x65, 0x3d, 0x14, 0xad, 0xcb, 0x42, 0xd1, 0x6e, 0x84, 0xe2, 0x1a, 0xf4, 0xe6, 0xde,
+	0x99, 0x3b, 0x3f, 0x50, 0x50, 0xb7, 0x3b, 0xf7, 0xce, 0x76, 0x0e, 0xec, 0x0f, 0xe1, 0xda, 0x33,
+	0xcf, 0x3f, 0xce, 0x92, 0x1d, 0xcc, 0x3c, 0xde, 0xb8, 0xde, 0x6a, 0x4c, 0xda, 0x9f, 0xc3, 0x3b,
+	0xf5, 0x6d, 0xca, 0x87, 0xef, 0xd5, 0x7f, 0x12, 0xd0, 0x9f, 0x0e, 0xf9, 0x33, 0xb6, 0xfc, 0x59,
+	0xe0, 0x97, 0x70, 0xe3, 0x69, 0x92, 0x84, 0xe7, 0xfb, 0x2c, 0x4e, 0xf1, 0x4e, 0xc6, 0xf4, 0x88,
+	0xa1, 0x15, 0x68, 0xc5, 0x89, 0x3a, 0xbc, 0x15, 0x27, 0x02, 0xc8, 0x67, 0x61, 0x28, 0x7b, 0xa9,
+	0x8c, 0xc1, 0x80, 0x13, 0x44, 0x27, 0x45, 0xd0, 0x11, 0xb3, 0xb9, 0x2d, 0xf0, 0x96, 0xf8, 0xb6,
+	0x3f, 0x85, 0xe9, 0x22, 0xed, 0xca, 0x54, 0xf1, 0x5c, 0x9a, 0xcf, 0x09, 0x73, 0x49, 0x14, 0xe0,
+	0x33, 0xf5, 0x9a, 0x18, 0x4a, 0xda, 0x4b, 0x4e, 0xb2, 0x4f, 0xc1, 0xda, 0xcf, 0x0e, 0xa8, 0x9f,
+	0x92, 0x03, 0x5c, 0x8f, 0xd0, 0x1d, 0x18, 0xfa, 0x21, 0xe1, 0xbd, 0x5d, 0xfb, 0x39, 0x03, 0x24,
+	0x49, 0xcc, 0x40, 0xd1, 0xfc, 0xd9, 0x91, 0x5b, 0xf9, 0x15, 0x07, 0x38, 0x69, 0x4f, 0x50, 0xf8,
+	0xfc, 0xa3, 0x24, 0xf2, 0xb1, 0x1b, 0xc9, 0xc7, 0x71, 0xdb, 0xe9, 0x8b, 0xf5, 0x2e, 0xe5, 0xc3,
+	0xf9, 0xc6, 0x82, 0x93, 0x95, 0xe5, 0x17, 0x63, 0x98, 0x9f, 0x00, 0xc2, 0x27, 0xc2, 0x2e, 0xed,
+	0xa9, 0xaf, 0x5a, 0x89, 0x0e, 0x1b, 0xea, 0xbf, 0x06, 0x38, 0x13, 0x5c, 0x27, 0xf1, 0xe7, 0x30,
+	0xa3, 0xa5, 0x7d, 0x1d, 0x46, 0x77, 0xa9, 0xfd, 0x4f, 0x03, 0xc6, 0x3c, 0xa4, 0xde, 0x0c, 0xef,
+	0xc5, 0x21, 0xf1, 0xcf, 0xeb, 0xae, 0x1a, 0x0d, 0x57, 0xbf, 0xf9, 0x10, 0x56, 0xb5, 0xdc, 0x29,
+	0x6b, 0xd9, 0x86, 0xb1, 0x7c, 0x17, 0xf3, 0x6a, 0xe5, 0x99, 0xdd, 0x15, 0x03, 0x6c, 0x28, 0x88,
+	0xbc, 0x62, 0x77, 0x0e, 0xf8, 0x70, 0x0b, 0x70, 0x90, 0x25, 0x0a, 0x61, 0xc9, 0xc5, 0xa5, 0x53,
+	0xf7, 0x05, 0x5c, 0xd1, 0xfd, 0x23, 0x98, 0xa2, 0xf7, 0x61, 0x90, 0xa8, 0x6f, 0x95, 0xd8, 0xd7,
+	0xcb, 0x50, 0x56, 0x82, 0xe1, 0x14, 0x82, 0x4f, 0xfe, 0x65, 0xc2, 0x68, 0x1f, 0x7b, 0xa7, 0x18,
+	0x07, 0xa2, 0xec, 0xd1, 0x2c, 0x1f, 0x37, 0xd5, 0xdf, 0x13, 0xd1, 0xfd, 0xfa, 0x5c, 0x59, 0xf8,
+	0x03, 0xe6, 0xf4, 0xbd, 0xcb, 0xc4, 0x54, 0xe7, 0xfe, 0x16, 0x7a, 0x05, 0x43, 0xed, 0x07, 0x3b,
+	0x74, 0x4b, 0xdb, 0xd8, 0xf8, 0x1d, 0x72, 0xba, 0xbe, 0x84, 0xab, 0x6b, 0xd3, 0x5e, 0x05, 0xba,
+	0xb6, 0xe6, 0x43, 0x66, 0xba, 0xbe, 0x84, 0xab, 0x6b, 0xd3, 0xf0, 0xba, 0xae, 0xad, 0xf9, 0xc4,
+	0x98, 0xae, 0x2f, 0xe1, 0xea, 0xda, 0x34, 0x74, 0xab, 0x6b, 0x6b, 0x82, 0xff, 0xe9, 0xfa, 0x12,
+	0x6e, 0xa1, 0xed, 0x2b, 0x98, 0x34, 0x70, 0x27, 0xb2, 0xcb, 0x5d, 0xcb, 0x00, 0xf3, 0xf4, 0xee,
+	0x85, 0x32, 0x85, 0xfe, 0x2f, 0x60, 0xa4, 0xc3, 0x39, 0xa4, 0x19, 0xb4, 0x00, 0xd1, 0x4e, 0x6f,
+	0x2f, 0x63, 0xeb, 0x0a, 0x75, 0x24, 0xa2, 0x2b, 0x5c, 0x80, 0xc5, 0xa6, 0xb7, 0x97, 0xb1, 0x0b,
+	0x85, 0xbf, 0x80, 0xd5, 0x3a, 0x22, 0x40, 0xef, 0xd6, 0xc3, 0xd6, 0x00, 0x1a, 0x53, 0xfb, 0x22,
+	0x91, 0x42, 0xf9, 0x4b, 0x80, 0x72, 0xd0, 0xa3, 0x9b, 0x7a, 0x05, 0xd5, 0x80, 0xc6, 0xf4, 0xd6,
+	0x62, 0x66, 0xa1, 0xea, 0xd7, 0x70, 0x6d, 0xe1, 0x34, 0x45, 0x5a, 0x91, 0x5c, 0x34, 0x8f, 0xa7,
+	0xdf, 0xbe, 0x54, 0xae, 0x38, 0xeb, 0x67, 0xb0, 0x52, 0x1d, 0x77, 0xe8, 0x4e, 0xb9, 0x79, 0xe1,
+	0xfc, 0x9c, 0x6e, 0x2c, 0x17, 0xc8, 0xd5, 0x3e, 0x36, 0x90, 0x07, 0xa8, 0x39, 0xa0, 0x90, 0x9e,
+	0x4b, 0xcb, 0x86, 0xe3, 0xf4, 0xde, 0xc5, 0x42, 0x85, 0xed, 0xbf, 0x82, 0x49, 0x63, 0x90, 0xe8,
+	0x19, 0xbd, 0x6c, 0xbe, 0x4d, 0xef, 0x5e, 0x28, 0x53, 0x3a, 0xf1, 0xec, 0x36, 0xac, 0x52, 0xd9,
+	0xe4, 0x0e, 0xe9, 0xa6, 0x9c, 0x7f, 0xcf, 0x40, 0xc4, 0x73, 0x8f, 0xff, 0x3f, 0x73, 0xd0, 0x13,
+	0x7f, 0xd3, 0xbc, 0xff, 0x9f, 0x01, 0x00, 0xe2, 0x2c, 0x6e, 0xf7, 0xb5, 0x19, 0x00, 0x00,
 }
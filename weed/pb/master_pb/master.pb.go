@@ -12,6 +12,7 @@ It has these top-level messages:
 	Heartbeat
 	HeartbeatResponse
 	VolumeInformationMessage
+	DiskStatus
 	VolumeShortInformationMessage
 	VolumeEcShardInformationMessage
 	StorageBackend
@@ -42,6 +43,8 @@ It has these top-level messages:
 	LookupEcVolumeResponse
 	GetMasterConfigurationRequest
 	GetMasterConfigurationResponse
+	VolumeServerDrainRequest
+	VolumeServerDrainResponse
 */
 package master_pb
 
@@ -85,6 +88,8 @@ type Heartbeat struct {
 	NewEcShards     []*VolumeEcShardInformationMessage `protobuf:"bytes,17,rep,name=new_ec_shards,json=newEcShards" json:"new_ec_shards,omitempty"`
 	DeletedEcShards []*VolumeEcShardInformationMessage `protobuf:"bytes,18,rep,name=deleted_ec_shards,json=deletedEcShards" json:"deleted_ec_shards,omitempty"`
 	HasNoEcShards   bool                               `protobuf:"varint,19,opt,name=has_no_ec_shards,json=hasNoEcShards" json:"has_no_ec_shards,omitempty"`
+	// disk health
+	DiskStatuses []*DiskStatus `protobuf:"bytes,20,rep,name=disk_statuses,json=diskStatuses" json:"disk_statuses,omitempty"`
 }
 
 func (m *Heartbeat) Reset()                    { *m = Heartbeat{} }
@@ -204,6 +209,13 @@ func (m *Heartbeat) GetHasNoEcShards() bool {
 	return false
 }
 
+func (m *Heartbeat) GetDiskStatuses() []*DiskStatus {
+	if m != nil {
+		return m.DiskStatuses
+	}
+	return nil
+}
+
 type HeartbeatResponse struct {
 	VolumeSizeLimit        uint64            `protobuf:"varint,1,opt,name=volume_size_limit,json=volumeSizeLimit" json:"volume_size_limit,omitempty"`
 	Leader                 string            `protobuf:"bytes,2,opt,name=leader" json:"leader,omitempty"`
@@ -265,6 +277,8 @@ type VolumeInformationMessage struct {
 	Ttl              uint32 `protobuf:"varint,10,opt,name=ttl" json:"ttl,omitempty"`
 	CompactRevision  uint32 `protobuf:"varint,11,opt,name=compact_revision,json=compactRevision" json:"compact_revision,omitempty"`
 	ModifiedAtSecond int64  `protobuf:"varint,12,opt,name=modified_at_second,json=modifiedAtSecond" json:"modified_at_second,omitempty"`
+	Durability       string `protobuf:"bytes,13,opt,name=durability" json:"durability,omitempty"`
+	OffsetSize       uint32 `protobuf:"varint,14,opt,name=offset_size,json=offsetSize" json:"offset_size,omitempty"`
 }
 
 func (m *VolumeInformationMessage) Reset()                    { *m = VolumeInformationMessage{} }
@@ -356,6 +370,52 @@ func (m *VolumeInformationMessage) GetModifiedAtSecond() int64 {
 	return 0
 }
 
+func (m *VolumeInformationMessage) GetDurability() string {
+	if m != nil {
+		return m.Durability
+	}
+	return ""
+}
+
+func (m *VolumeInformationMessage) GetOffsetSize() uint32 {
+	if m != nil {
+		return m.OffsetSize
+	}
+	return 0
+}
+
+type DiskStatus struct {
+	Dir      string `protobuf:"bytes,1,opt,name=dir" json:"dir,omitempty"`
+	IsFailed bool   `protobuf:"varint,2,opt,name=is_failed,json=isFailed" json:"is_failed,omitempty"`
+	Error    string `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *DiskStatus) Reset()                    { *m = DiskStatus{} }
+func (m *DiskStatus) String() string            { return proto.CompactTextString(m) }
+func (*DiskStatus) ProtoMessage()               {}
+func (*DiskStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *DiskStatus) GetDir() string {
+	if m != nil {
+		return m.Dir
+	}
+	return ""
+}
+
+func (m *DiskStatus) GetIsFailed() bool {
+	if m != nil {
+		return m.IsFailed
+	}
+	return false
+}
+
+func (m *DiskStatus) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 type VolumeShortInformationMessage struct {
 	Id               uint32 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
 	Collection       string `protobuf:"bytes,3,opt,name=collection" json:"collection,omitempty"`
@@ -367,7 +427,7 @@ type VolumeShortInformationMessage struct {
 func (m *VolumeShortInformationMessage) Reset()                    { *m = VolumeShortInformationMessage{} }
 func (m *VolumeShortInformationMessage) String() string            { return proto.CompactTextString(m) }
 func (*VolumeShortInformationMessage) ProtoMessage()               {}
-func (*VolumeShortInformationMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*VolumeShortInformationMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 func (m *VolumeShortInformationMessage) GetId() uint32 {
 	if m != nil {
@@ -410,10 +470,12 @@ type VolumeEcShardInformationMessage struct {
 	EcIndexBits uint32 `protobuf:"varint,3,opt,name=ec_index_bits,json=ecIndexBits" json:"ec_index_bits,omitempty"`
 }
 
-func (m *VolumeEcShardInformationMessage) Reset()                    { *m = VolumeEcShardInformationMessage{} }
-func (m *VolumeEcShardInformationMessage) String() string            { return proto.CompactTextString(m) }
-func (*VolumeEcShardInformationMessage) ProtoMessage()               {}
-func (*VolumeEcShardInformationMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (m *VolumeEcShardInformationMessage) Reset()         { *m = VolumeEcShardInformationMessage{} }
+func (m *VolumeEcShardInformationMessage) String() string { return proto.CompactTextString(m) }
+func (*VolumeEcShardInformationMessage) ProtoMessage()    {}
+func (*VolumeEcShardInformationMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{5}
+}
 
 func (m *VolumeEcShardInformationMessage) GetId() uint32 {
 	if m != nil {
@@ -445,7 +507,7 @@ type StorageBackend struct {
 func (m *StorageBackend) Reset()                    { *m = StorageBackend{} }
 func (m *StorageBackend) String() string            { return proto.CompactTextString(m) }
 func (*StorageBackend) ProtoMessage()               {}
-func (*StorageBackend) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*StorageBackend) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
 func (m *StorageBackend) GetType() string {
 	if m != nil {
@@ -474,7 +536,7 @@ type Empty struct {
 func (m *Empty) Reset()                    { *m = Empty{} }
 func (m *Empty) String() string            { return proto.CompactTextString(m) }
 func (*Empty) ProtoMessage()               {}
-func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 type SuperBlockExtra struct {
 	ErasureCoding *SuperBlockExtra_ErasureCoding `protobuf:"bytes,1,opt,name=erasure_coding,json=erasureCoding" json:"erasure_coding,omitempty"`
@@ -483,7 +545,7 @@ type SuperBlockExtra struct {
 func (m *SuperBlockExtra) Reset()                    { *m = SuperBlockExtra{} }
 func (m *SuperBlockExtra) String() string            { return proto.CompactTextString(m) }
 func (*SuperBlockExtra) ProtoMessage()               {}
-func (*SuperBlockExtra) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*SuperBlockExtra) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 func (m *SuperBlockExtra) GetErasureCoding() *SuperBlockExtra_ErasureCoding {
 	if m != nil {
@@ -502,7 +564,7 @@ func (m *SuperBlockExtra_ErasureCoding) Reset()         { *m = SuperBlockExtra_E
 func (m *SuperBlockExtra_ErasureCoding) String() string { return proto.CompactTextString(m) }
 func (*SuperBlockExtra_ErasureCoding) ProtoMessage()    {}
 func (*SuperBlockExtra_ErasureCoding) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{7, 0}
+	return fileDescriptor0, []int{8, 0}
 }
 
 func (m *SuperBlockExtra_ErasureCoding) GetData() uint32 {
@@ -533,7 +595,7 @@ type KeepConnectedRequest struct {
 func (m *KeepConnectedRequest) Reset()                    { *m = KeepConnectedRequest{} }
 func (m *KeepConnectedRequest) String() string            { return proto.CompactTextString(m) }
 func (*KeepConnectedRequest) ProtoMessage()               {}
-func (*KeepConnectedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*KeepConnectedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *KeepConnectedRequest) GetName() string {
 	if m != nil {
@@ -553,7 +615,7 @@ type VolumeLocation struct {
 func (m *VolumeLocation) Reset()                    { *m = VolumeLocation{} }
 func (m *VolumeLocation) String() string            { return proto.CompactTextString(m) }
 func (*VolumeLocation) ProtoMessage()               {}
-func (*VolumeLocation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*VolumeLocation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *VolumeLocation) GetUrl() string {
 	if m != nil {
@@ -598,7 +660,7 @@ type LookupVolumeRequest struct {
 func (m *LookupVolumeRequest) Reset()                    { *m = LookupVolumeRequest{} }
 func (m *LookupVolumeRequest) String() string            { return proto.CompactTextString(m) }
 func (*LookupVolumeRequest) ProtoMessage()               {}
-func (*LookupVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*LookupVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 func (m *LookupVolumeRequest) GetVolumeIds() []string {
 	if m != nil {
@@ -621,7 +683,7 @@ type LookupVolumeResponse struct {
 func (m *LookupVolumeResponse) Reset()                    { *m = LookupVolumeResponse{} }
 func (m *LookupVolumeResponse) String() string            { return proto.CompactTextString(m) }
 func (*LookupVolumeResponse) ProtoMessage()               {}
-func (*LookupVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*LookupVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *LookupVolumeResponse) GetVolumeIdLocations() []*LookupVolumeResponse_VolumeIdLocation {
 	if m != nil {
@@ -640,7 +702,7 @@ func (m *LookupVolumeResponse_VolumeIdLocation) Reset()         { *m = LookupVol
 func (m *LookupVolumeResponse_VolumeIdLocation) String() string { return proto.CompactTextString(m) }
 func (*LookupVolumeResponse_VolumeIdLocation) ProtoMessage()    {}
 func (*LookupVolumeResponse_VolumeIdLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{11, 0}
+	return fileDescriptor0, []int{12, 0}
 }
 
 func (m *LookupVolumeResponse_VolumeIdLocation) GetVolumeId() string {
@@ -672,7 +734,7 @@ type Location struct {
 func (m *Location) Reset()                    { *m = Location{} }
 func (m *Location) String() string            { return proto.CompactTextString(m) }
 func (*Location) ProtoMessage()               {}
-func (*Location) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*Location) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 func (m *Location) GetUrl() string {
 	if m != nil {
@@ -698,12 +760,13 @@ type AssignRequest struct {
 	DataNode            string `protobuf:"bytes,7,opt,name=data_node,json=dataNode" json:"data_node,omitempty"`
 	MemoryMapMaxSizeMb  uint32 `protobuf:"varint,8,opt,name=memory_map_max_size_mb,json=memoryMapMaxSizeMb" json:"memory_map_max_size_mb,omitempty"`
 	WritableVolumeCount uint32 `protobuf:"varint,9,opt,name=Writable_volume_count,json=WritableVolumeCount" json:"Writable_volume_count,omitempty"`
+	Durability          string `protobuf:"bytes,10,opt,name=durability" json:"durability,omitempty"`
 }
 
 func (m *AssignRequest) Reset()                    { *m = AssignRequest{} }
 func (m *AssignRequest) String() string            { return proto.CompactTextString(m) }
 func (*AssignRequest) ProtoMessage()               {}
-func (*AssignRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*AssignRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *AssignRequest) GetCount() uint64 {
 	if m != nil {
@@ -768,6 +831,13 @@ func (m *AssignRequest) GetWritableVolumeCount() uint32 {
 	return 0
 }
 
+func (m *AssignRequest) GetDurability() string {
+	if m != nil {
+		return m.Durability
+	}
+	return ""
+}
+
 type AssignResponse struct {
 	Fid       string `protobuf:"bytes,1,opt,name=fid" json:"fid,omitempty"`
 	Url       string `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
@@ -780,7 +850,7 @@ type AssignResponse struct {
 func (m *AssignResponse) Reset()                    { *m = AssignResponse{} }
 func (m *AssignResponse) String() string            { return proto.CompactTextString(m) }
 func (*AssignResponse) ProtoMessage()               {}
-func (*AssignResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*AssignResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 func (m *AssignResponse) GetFid() string {
 	if m != nil {
@@ -833,7 +903,7 @@ type StatisticsRequest struct {
 func (m *StatisticsRequest) Reset()                    { *m = StatisticsRequest{} }
 func (m *StatisticsRequest) String() string            { return proto.CompactTextString(m) }
 func (*StatisticsRequest) ProtoMessage()               {}
-func (*StatisticsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*StatisticsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *StatisticsRequest) GetReplication() string {
 	if m != nil {
@@ -868,7 +938,7 @@ type StatisticsResponse struct {
 func (m *StatisticsResponse) Reset()                    { *m = StatisticsResponse{} }
 func (m *StatisticsResponse) String() string            { return proto.CompactTextString(m) }
 func (*StatisticsResponse) ProtoMessage()               {}
-func (*StatisticsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*StatisticsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *StatisticsResponse) GetReplication() string {
 	if m != nil {
@@ -920,7 +990,7 @@ type StorageType struct {
 func (m *StorageType) Reset()                    { *m = StorageType{} }
 func (m *StorageType) String() string            { return proto.CompactTextString(m) }
 func (*StorageType) ProtoMessage()               {}
-func (*StorageType) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*StorageType) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 func (m *StorageType) GetReplication() string {
 	if m != nil {
@@ -943,7 +1013,7 @@ type Collection struct {
 func (m *Collection) Reset()                    { *m = Collection{} }
 func (m *Collection) String() string            { return proto.CompactTextString(m) }
 func (*Collection) ProtoMessage()               {}
-func (*Collection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*Collection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 func (m *Collection) GetName() string {
 	if m != nil {
@@ -960,7 +1030,7 @@ type CollectionListRequest struct {
 func (m *CollectionListRequest) Reset()                    { *m = CollectionListRequest{} }
 func (m *CollectionListRequest) String() string            { return proto.CompactTextString(m) }
 func (*CollectionListRequest) ProtoMessage()               {}
-func (*CollectionListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*CollectionListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 func (m *CollectionListRequest) GetIncludeNormalVolumes() bool {
 	if m != nil {
@@ -983,7 +1053,7 @@ type CollectionListResponse struct {
 func (m *CollectionListResponse) Reset()                    { *m = CollectionListResponse{} }
 func (m *CollectionListResponse) String() string            { return proto.CompactTextString(m) }
 func (*CollectionListResponse) ProtoMessage()               {}
-func (*CollectionListResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*CollectionListResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *CollectionListResponse) GetCollections() []*Collection {
 	if m != nil {
@@ -999,7 +1069,7 @@ type CollectionDeleteRequest struct {
 func (m *CollectionDeleteRequest) Reset()                    { *m = CollectionDeleteRequest{} }
 func (m *CollectionDeleteRequest) String() string            { return proto.CompactTextString(m) }
 func (*CollectionDeleteRequest) ProtoMessage()               {}
-func (*CollectionDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*CollectionDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *CollectionDeleteRequest) GetName() string {
 	if m != nil {
@@ -1014,11 +1084,9 @@ type CollectionDeleteResponse struct {
 func (m *CollectionDeleteResponse) Reset()                    { *m = CollectionDeleteResponse{} }
 func (m *CollectionDeleteResponse) String() string            { return proto.CompactTextString(m) }
 func (*CollectionDeleteResponse) ProtoMessage()               {}
-func (*CollectionDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*CollectionDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
-//
 // volume related
-//
 type DataNodeInfo struct {
 	Id                string                             `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	VolumeCount       uint64                             `protobuf:"varint,2,opt,name=volume_count,json=volumeCount" json:"volume_count,omitempty"`
@@ -1027,12 +1095,14 @@ type DataNodeInfo struct {
 	ActiveVolumeCount uint64                             `protobuf:"varint,5,opt,name=active_volume_count,json=activeVolumeCount" json:"active_volume_count,omitempty"`
 	VolumeInfos       []*VolumeInformationMessage        `protobuf:"bytes,6,rep,name=volume_infos,json=volumeInfos" json:"volume_infos,omitempty"`
 	EcShardInfos      []*VolumeEcShardInformationMessage `protobuf:"bytes,7,rep,name=ec_shard_infos,json=ecShardInfos" json:"ec_shard_infos,omitempty"`
+	IsDraining        bool                               `protobuf:"varint,8,opt,name=is_draining,json=isDraining" json:"is_draining,omitempty"`
+	DiskStatuses      []*DiskStatus                      `protobuf:"bytes,9,rep,name=disk_statuses,json=diskStatuses" json:"disk_statuses,omitempty"`
 }
 
 func (m *DataNodeInfo) Reset()                    { *m = DataNodeInfo{} }
 func (m *DataNodeInfo) String() string            { return proto.CompactTextString(m) }
 func (*DataNodeInfo) ProtoMessage()               {}
-func (*DataNodeInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*DataNodeInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *DataNodeInfo) GetId() string {
 	if m != nil {
@@ -1083,6 +1153,20 @@ func (m *DataNodeInfo) GetEcShardInfos() []*VolumeEcShardInformationMessage {
 	return nil
 }
 
+func (m *DataNodeInfo) GetIsDraining() bool {
+	if m != nil {
+		return m.IsDraining
+	}
+	return false
+}
+
+func (m *DataNodeInfo) GetDiskStatuses() []*DiskStatus {
+	if m != nil {
+		return m.DiskStatuses
+	}
+	return nil
+}
+
 type RackInfo struct {
 	Id                string          `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	VolumeCount       uint64          `protobuf:"varint,2,opt,name=volume_count,json=volumeCount" json:"volume_count,omitempty"`
@@ -1095,7 +1179,7 @@ type RackInfo struct {
 func (m *RackInfo) Reset()                    { *m = RackInfo{} }
 func (m *RackInfo) String() string            { return proto.CompactTextString(m) }
 func (*RackInfo) ProtoMessage()               {}
-func (*RackInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*RackInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *RackInfo) GetId() string {
 	if m != nil {
@@ -1151,7 +1235,7 @@ type DataCenterInfo struct {
 func (m *DataCenterInfo) Reset()                    { *m = DataCenterInfo{} }
 func (m *DataCenterInfo) String() string            { return proto.CompactTextString(m) }
 func (*DataCenterInfo) ProtoMessage()               {}
-func (*DataCenterInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*DataCenterInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *DataCenterInfo) GetId() string {
 	if m != nil {
@@ -1207,7 +1291,7 @@ type TopologyInfo struct {
 func (m *TopologyInfo) Reset()                    { *m = TopologyInfo{} }
 func (m *TopologyInfo) String() string            { return proto.CompactTextString(m) }
 func (*TopologyInfo) ProtoMessage()               {}
-func (*TopologyInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*TopologyInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 func (m *TopologyInfo) GetId() string {
 	if m != nil {
@@ -1257,7 +1341,7 @@ type VolumeListRequest struct {
 func (m *VolumeListRequest) Reset()                    { *m = VolumeListRequest{} }
 func (m *VolumeListRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeListRequest) ProtoMessage()               {}
-func (*VolumeListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*VolumeListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 type VolumeListResponse struct {
 	TopologyInfo      *TopologyInfo `protobuf:"bytes,1,opt,name=topology_info,json=topologyInfo" json:"topology_info,omitempty"`
@@ -1267,7 +1351,7 @@ type VolumeListResponse struct {
 func (m *VolumeListResponse) Reset()                    { *m = VolumeListResponse{} }
 func (m *VolumeListResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeListResponse) ProtoMessage()               {}
-func (*VolumeListResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*VolumeListResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
 func (m *VolumeListResponse) GetTopologyInfo() *TopologyInfo {
 	if m != nil {
@@ -1290,7 +1374,7 @@ type LookupEcVolumeRequest struct {
 func (m *LookupEcVolumeRequest) Reset()                    { *m = LookupEcVolumeRequest{} }
 func (m *LookupEcVolumeRequest) String() string            { return proto.CompactTextString(m) }
 func (*LookupEcVolumeRequest) ProtoMessage()               {}
-func (*LookupEcVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*LookupEcVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
 func (m *LookupEcVolumeRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -1307,7 +1391,7 @@ type LookupEcVolumeResponse struct {
 func (m *LookupEcVolumeResponse) Reset()                    { *m = LookupEcVolumeResponse{} }
 func (m *LookupEcVolumeResponse) String() string            { return proto.CompactTextString(m) }
 func (*LookupEcVolumeResponse) ProtoMessage()               {}
-func (*LookupEcVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*LookupEcVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
 func (m *LookupEcVolumeResponse) GetVolumeId() uint32 {
 	if m != nil {
@@ -1334,7 +1418,7 @@ func (m *LookupEcVolumeResponse_EcShardIdLocation) Reset() {
 func (m *LookupEcVolumeResponse_EcShardIdLocation) String() string { return proto.CompactTextString(m) }
 func (*LookupEcVolumeResponse_EcShardIdLocation) ProtoMessage()    {}
 func (*LookupEcVolumeResponse_EcShardIdLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{30, 0}
+	return fileDescriptor0, []int{31, 0}
 }
 
 func (m *LookupEcVolumeResponse_EcShardIdLocation) GetShardId() uint32 {
@@ -1357,17 +1441,19 @@ type GetMasterConfigurationRequest struct {
 func (m *GetMasterConfigurationRequest) Reset()                    { *m = GetMasterConfigurationRequest{} }
 func (m *GetMasterConfigurationRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetMasterConfigurationRequest) ProtoMessage()               {}
-func (*GetMasterConfigurationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*GetMasterConfigurationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
 
 type GetMasterConfigurationResponse struct {
 	MetricsAddress         string `protobuf:"bytes,1,opt,name=metrics_address,json=metricsAddress" json:"metrics_address,omitempty"`
 	MetricsIntervalSeconds uint32 `protobuf:"varint,2,opt,name=metrics_interval_seconds,json=metricsIntervalSeconds" json:"metrics_interval_seconds,omitempty"`
 }
 
-func (m *GetMasterConfigurationResponse) Reset()                    { *m = GetMasterConfigurationResponse{} }
-func (m *GetMasterConfigurationResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetMasterConfigurationResponse) ProtoMessage()               {}
-func (*GetMasterConfigurationResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (m *GetMasterConfigurationResponse) Reset()         { *m = GetMasterConfigurationResponse{} }
+func (m *GetMasterConfigurationResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMasterConfigurationResponse) ProtoMessage()    {}
+func (*GetMasterConfigurationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{33}
+}
 
 func (m *GetMasterConfigurationResponse) GetMetricsAddress() string {
 	if m != nil {
@@ -1383,10 +1469,43 @@ func (m *GetMasterConfigurationResponse) GetMetricsIntervalSeconds() uint32 {
 	return 0
 }
 
+type VolumeServerDrainRequest struct {
+	Node     string `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
+	Draining bool   `protobuf:"varint,2,opt,name=draining" json:"draining,omitempty"`
+}
+
+func (m *VolumeServerDrainRequest) Reset()                    { *m = VolumeServerDrainRequest{} }
+func (m *VolumeServerDrainRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeServerDrainRequest) ProtoMessage()               {}
+func (*VolumeServerDrainRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *VolumeServerDrainRequest) GetNode() string {
+	if m != nil {
+		return m.Node
+	}
+	return ""
+}
+
+func (m *VolumeServerDrainRequest) GetDraining() bool {
+	if m != nil {
+		return m.Draining
+	}
+	return false
+}
+
+type VolumeServerDrainResponse struct {
+}
+
+func (m *VolumeServerDrainResponse) Reset()                    { *m = VolumeServerDrainResponse{} }
+func (m *VolumeServerDrainResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeServerDrainResponse) ProtoMessage()               {}
+func (*VolumeServerDrainResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
 func init() {
 	proto.RegisterType((*Heartbeat)(nil), "master_pb.Heartbeat")
 	proto.RegisterType((*HeartbeatResponse)(nil), "master_pb.HeartbeatResponse")
 	proto.RegisterType((*VolumeInformationMessage)(nil), "master_pb.VolumeInformationMessage")
+	proto.RegisterType((*DiskStatus)(nil), "master_pb.DiskStatus")
 	proto.RegisterType((*VolumeShortInformationMessage)(nil), "master_pb.VolumeShortInformationMessage")
 	proto.RegisterType((*VolumeEcShardInformationMessage)(nil), "master_pb.VolumeEcShardInformationMessage")
 	proto.RegisterType((*StorageBackend)(nil), "master_pb.StorageBackend")
@@ -1420,6 +1539,8 @@ func init() {
 	proto.RegisterType((*LookupEcVolumeResponse_EcShardIdLocation)(nil), "master_pb.LookupEcVolumeResponse.EcShardIdLocation")
 	proto.RegisterType((*GetMasterConfigurationRequest)(nil), "master_pb.GetMasterConfigurationRequest")
 	proto.RegisterType((*GetMasterConfigurationResponse)(nil), "master_pb.GetMasterConfigurationResponse")
+	proto.RegisterType((*VolumeServerDrainRequest)(nil), "master_pb.VolumeServerDrainRequest")
+	proto.RegisterType((*VolumeServerDrainResponse)(nil), "master_pb.VolumeServerDrainResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1443,6 +1564,7 @@ type SeaweedClient interface {
 	VolumeList(ctx context.Context, in *VolumeListRequest, opts ...grpc.CallOption) (*VolumeListResponse, error)
 	LookupEcVolume(ctx context.Context, in *LookupEcVolumeRequest, opts ...grpc.CallOption) (*LookupEcVolumeResponse, error)
 	GetMasterConfiguration(ctx context.Context, in *GetMasterConfigurationRequest, opts ...grpc.CallOption) (*GetMasterConfigurationResponse, error)
+	VolumeServerDrain(ctx context.Context, in *VolumeServerDrainRequest, opts ...grpc.CallOption) (*VolumeServerDrainResponse, error)
 }
 
 type seaweedClient struct {
@@ -1587,6 +1709,15 @@ func (c *seaweedClient) GetMasterConfiguration(ctx context.Context, in *GetMaste
 	return out, nil
 }
 
+func (c *seaweedClient) VolumeServerDrain(ctx context.Context, in *VolumeServerDrainRequest, opts ...grpc.CallOption) (*VolumeServerDrainResponse, error) {
+	out := new(VolumeServerDrainResponse)
+	err := grpc.Invoke(ctx, "/master_pb.Seaweed/VolumeServerDrain", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Seaweed service
 
 type SeaweedServer interface {
@@ -1600,6 +1731,7 @@ type SeaweedServer interface {
 	VolumeList(context.Context, *VolumeListRequest) (*VolumeListResponse, error)
 	LookupEcVolume(context.Context, *LookupEcVolumeRequest) (*LookupEcVolumeResponse, error)
 	GetMasterConfiguration(context.Context, *GetMasterConfigurationRequest) (*GetMasterConfigurationResponse, error)
+	VolumeServerDrain(context.Context, *VolumeServerDrainRequest) (*VolumeServerDrainResponse, error)
 }
 
 func RegisterSeaweedServer(s *grpc.Server, srv SeaweedServer) {
@@ -1802,6 +1934,24 @@ func _Seaweed_GetMasterConfiguration_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Seaweed_VolumeServerDrain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeServerDrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeaweedServer).VolumeServerDrain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/master_pb.Seaweed/VolumeServerDrain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeaweedServer).VolumeServerDrain(ctx, req.(*VolumeServerDrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Seaweed_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "master_pb.Seaweed",
 	HandlerType: (*SeaweedServer)(nil),
@@ -1838,6 +1988,10 @@ var _Seaweed_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMasterConfiguration",
 			Handler:    _Seaweed_GetMasterConfiguration_Handler,
 		},
+		{
+			MethodName: "VolumeServerDrain",
+			Handler:    _Seaweed_VolumeServerDrain_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1859,133 +2013,144 @@ var _Seaweed_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("master.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2048 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xd4, 0x59, 0x4f, 0x6f, 0xdc, 0xc6,
-	0x15, 0x37, 0xa9, 0xd5, 0x6a, 0xf7, 0xed, 0xff, 0x91, 0xac, 0xac, 0x37, 0x71, 0xb4, 0x66, 0x0a,
-	0x44, 0x76, 0x53, 0x35, 0x55, 0x02, 0x34, 0x68, 0x1b, 0x04, 0xb6, 0xac, 0xa4, 0x82, 0x2d, 0xc7,
-	0xa6, 0x5c, 0x07, 0x28, 0x50, 0xb0, 0xb3, 0xe4, 0x48, 0x22, 0xc4, 0x25, 0x59, 0xce, 0xac, 0xac,
-	0x4d, 0x2f, 0x05, 0xda, 0x5b, 0x81, 0x5e, 0x7a, 0xe8, 0xa9, 0xf7, 0xde, 0x7b, 0xef, 0xa5, 0x1f,
-	0xa2, 0xe7, 0x7e, 0x85, 0x5e, 0x8b, 0x02, 0xc5, 0xfc, 0x23, 0x87, 0xdc, 0x95, 0x14, 0x05, 0xc8,
-	0xc1, 0x37, 0xce, 0x7b, 0x6f, 0xde, 0xbc, 0xf9, 0xbd, 0x79, 0x6f, 0x7e, 0xb3, 0x0b, 0xed, 0x29,
-	0xa6, 0x8c, 0x64, 0x3b, 0x69, 0x96, 0xb0, 0x04, 0x35, 0xe5, 0xc8, 0x4b, 0x27, 0xce, 0x1f, 0xeb,
-	0xd0, 0xfc, 0x39, 0xc1, 0x19, 0x9b, 0x10, 0xcc, 0x50, 0x17, 0xec, 0x30, 0x1d, 0x5a, 0x63, 0x6b,
-	0xbb, 0xe9, 0xda, 0x61, 0x8a, 0x10, 0xd4, 0xd2, 0x24, 0x63, 0x43, 0x7b, 0x6c, 0x6d, 0x77, 0x5c,
-	0xf1, 0x8d, 0xee, 0x02, 0xa4, 0xb3, 0x49, 0x14, 0xfa, 0xde, 0x2c, 0x8b, 0x86, 0x2b, 0xc2, 0xb6,
-	0x29, 0x25, 0xbf, 0xc8, 0x22, 0xb4, 0x0d, 0xfd, 0x29, 0xbe, 0xf0, 0xce, 0x93, 0x68, 0x36, 0x25,
-	0x9e, 0x9f, 0xcc, 0x62, 0x36, 0xac, 0x89, 0xe9, 0xdd, 0x29, 0xbe, 0x78, 0x25, 0xc4, 0x7b, 0x5c,
-	0x8a, 0xc6, 0x3c, 0xaa, 0x0b, 0xef, 0x38, 0x8c, 0x88, 0x77, 0x46, 0xe6, 0xc3, 0xd5, 0xb1, 0xb5,
-	0x5d, 0x73, 0x61, 0x8a, 0x2f, 0x3e, 0x0f, 0x23, 0xf2, 0x84, 0xcc, 0xd1, 0x16, 0xb4, 0x02, 0xcc,
-	0xb0, 0xe7, 0x93, 0x98, 0x91, 0x6c, 0x58, 0x17, 0x6b, 0x01, 0x17, 0xed, 0x09, 0x09, 0x8f, 0x2f,
-	0xc3, 0xfe, 0xd9, 0x70, 0x4d, 0x68, 0xc4, 0x37, 0x8f, 0x0f, 0x07, 0xd3, 0x30, 0xf6, 0x44, 0xe4,
-	0x0d, 0xb1, 0x74, 0x53, 0x48, 0x9e, 0xf3, 0xf0, 0x3f, 0x85, 0x35, 0x19, 0x1b, 0x1d, 0x36, 0xc7,
-	0x2b, 0xdb, 0xad, 0xdd, 0xf7, 0x76, 0x72, 0x34, 0x76, 0x64, 0x78, 0x07, 0xf1, 0x71, 0x92, 0x4d,
-	0x31, 0x0b, 0x93, 0xf8, 0x90, 0x50, 0x8a, 0x4f, 0x88, 0xab, 0xe7, 0xa0, 0x03, 0x68, 0xc5, 0xe4,
-	0xb5, 0xa7, 0x5d, 0x80, 0x70, 0xb1, 0xbd, 0xe0, 0xe2, 0xe8, 0x34, 0xc9, 0xd8, 0x12, 0x3f, 0x10,
-	0x93, 0xd7, 0xaf, 0x94, 0xab, 0x17, 0xd0, 0x0b, 0x48, 0x44, 0x18, 0x09, 0x72, 0x77, 0xad, 0x1b,
-	0xba, 0xeb, 0x2a, 0x07, 0xda, 0xe5, 0xf7, 0xa0, 0x7b, 0x8a, 0xa9, 0x17, 0x27, 0xb9, 0xc7, 0xf6,
-	0xd8, 0xda, 0x6e, 0xb8, 0xed, 0x53, 0x4c, 0x9f, 0x25, 0xda, 0xea, 0x0b, 0x68, 0x12, 0xdf, 0xa3,
-	0xa7, 0x38, 0x0b, 0xe8, 0xb0, 0x2f, 0x96, 0x7c, 0xb0, 0xb0, 0xe4, 0xbe, 0x7f, 0xc4, 0x0d, 0x96,
-	0x2c, 0xda, 0x20, 0x52, 0x45, 0xd1, 0x33, 0xe8, 0x70, 0x30, 0x0a, 0x67, 0x83, 0x1b, 0x3b, 0xe3,
-	0x68, 0xee, 0x6b, 0x7f, 0xaf, 0x60, 0xa0, 0x11, 0x29, 0x7c, 0xa2, 0x1b, 0xfb, 0xd4, 0xb0, 0xe6,
-	0x7e, 0xdf, 0x87, 0xbe, 0x82, 0xa5, 0x70, 0xbb, 0x2e, 0x80, 0xe9, 0x08, 0x60, 0xb4, 0xa1, 0xf3,
-	0x3b, 0x1b, 0x06, 0x79, 0x35, 0xb8, 0x84, 0xa6, 0x49, 0x4c, 0x09, 0x7a, 0x00, 0x03, 0x75, 0x9c,
-	0x69, 0xf8, 0x35, 0xf1, 0xa2, 0x70, 0x1a, 0x32, 0x51, 0x24, 0x35, 0xb7, 0x27, 0x15, 0x47, 0xe1,
-	0xd7, 0xe4, 0x29, 0x17, 0xa3, 0x4d, 0xa8, 0x47, 0x04, 0x07, 0x24, 0x13, 0x35, 0xd3, 0x74, 0xd5,
-	0x08, 0xbd, 0x0f, 0xbd, 0x29, 0x61, 0x59, 0xe8, 0x53, 0x0f, 0x07, 0x41, 0x46, 0x28, 0x55, 0xa5,
-	0xd3, 0x55, 0xe2, 0x87, 0x52, 0x8a, 0x3e, 0x81, 0xa1, 0x36, 0x0c, 0xf9, 0x19, 0x3f, 0xc7, 0x91,
-	0x47, 0x89, 0x9f, 0xc4, 0x01, 0x55, 0x75, 0xb4, 0xa9, 0xf4, 0x07, 0x4a, 0x7d, 0x24, 0xb5, 0xe8,
-	0x31, 0xf4, 0x29, 0x4b, 0x32, 0x7c, 0x42, 0xbc, 0x09, 0xf6, 0xcf, 0x08, 0x9f, 0xb1, 0x2a, 0xc0,
-	0xbb, 0x63, 0x80, 0x77, 0x24, 0x4d, 0x1e, 0x49, 0x0b, 0xb7, 0x47, 0x4b, 0x63, 0xea, 0xfc, 0x75,
-	0x05, 0x86, 0x97, 0x95, 0x81, 0xe8, 0x0f, 0x81, 0xd8, 0x7a, 0xc7, 0xb5, 0xc3, 0x80, 0xd7, 0x1f,
-	0x87, 0x44, 0xec, 0xb5, 0xe6, 0x8a, 0x6f, 0xf4, 0x2e, 0x80, 0x9f, 0x44, 0x11, 0xf1, 0xf9, 0x44,
-	0xb5, 0x49, 0x43, 0xc2, 0xeb, 0x53, 0x94, 0x7c, 0xd1, 0x1a, 0x6a, 0x6e, 0x93, 0x4b, 0x64, 0x57,
-	0xb8, 0x07, 0x6d, 0x99, 0x3e, 0x65, 0x20, 0xbb, 0x42, 0x4b, 0xca, 0xa4, 0xc9, 0x07, 0x80, 0xf4,
-	0x31, 0x99, 0xcc, 0x73, 0xc3, 0xba, 0x30, 0xec, 0x2b, 0xcd, 0xa3, 0xb9, 0xb6, 0x7e, 0x1b, 0x9a,
-	0x19, 0xc1, 0x81, 0x97, 0xc4, 0xd1, 0x5c, 0x34, 0x8a, 0x86, 0xdb, 0xe0, 0x82, 0x2f, 0xe3, 0x68,
-	0x8e, 0xbe, 0x0f, 0x83, 0x8c, 0xa4, 0x51, 0xe8, 0x63, 0x2f, 0x8d, 0xb0, 0x4f, 0xa6, 0x24, 0xd6,
-	0x3d, 0xa3, 0xaf, 0x14, 0xcf, 0xb5, 0x1c, 0x0d, 0x61, 0xed, 0x9c, 0x64, 0x94, 0x6f, 0xab, 0x29,
-	0x4c, 0xf4, 0x10, 0xf5, 0x61, 0x85, 0xb1, 0x68, 0x08, 0x42, 0xca, 0x3f, 0xd1, 0x7d, 0xe8, 0xfb,
-	0xc9, 0x34, 0xc5, 0x3e, 0xf3, 0x32, 0x72, 0x1e, 0x8a, 0x49, 0x2d, 0xa1, 0xee, 0x29, 0xb9, 0xab,
-	0xc4, 0x7c, 0x3b, 0xd3, 0x24, 0x08, 0x8f, 0x43, 0x12, 0x78, 0x98, 0xa9, 0x64, 0x8b, 0xc2, 0x5d,
-	0x71, 0xfb, 0x5a, 0xf3, 0x90, 0xc9, 0x34, 0x3b, 0x7f, 0xb3, 0xe0, 0xee, 0x95, 0x4d, 0x61, 0x21,
-	0x49, 0xd7, 0x25, 0xe4, 0xbb, 0xc2, 0xc0, 0x99, 0xc1, 0xd6, 0x35, 0xa5, 0x7a, 0x4d, 0xac, 0xf6,
-	0x42, 0xac, 0x0e, 0x74, 0x88, 0xef, 0x85, 0x71, 0x40, 0x2e, 0xbc, 0x49, 0xc8, 0x64, 0x11, 0x75,
-	0xdc, 0x16, 0xf1, 0x0f, 0xb8, 0xec, 0x51, 0xc8, 0xa8, 0xf3, 0x0f, 0x0b, 0xba, 0xe5, 0x53, 0xce,
-	0xcf, 0x29, 0x9b, 0xa7, 0x44, 0xdd, 0x6c, 0xe2, 0x5b, 0x2d, 0x6d, 0xab, 0xbb, 0x2e, 0x40, 0x07,
-	0x00, 0x69, 0x96, 0xa4, 0x24, 0x63, 0x21, 0xe1, 0x7e, 0x79, 0xe1, 0xdc, 0xbf, 0xb4, 0x70, 0x76,
-	0x9e, 0xe7, 0xb6, 0xfb, 0x31, 0xcb, 0xe6, 0xae, 0x31, 0x79, 0xf4, 0x29, 0xf4, 0x2a, 0x6a, 0x8e,
-	0x0e, 0xbf, 0xe3, 0x64, 0x00, 0xfc, 0x13, 0x6d, 0xc0, 0xea, 0x39, 0x8e, 0x66, 0x44, 0x85, 0x20,
-	0x07, 0x3f, 0xb1, 0x3f, 0xb1, 0x9c, 0x35, 0x58, 0xdd, 0x9f, 0xa6, 0x6c, 0xce, 0x77, 0xd2, 0x3b,
-	0x9a, 0xa5, 0x24, 0x7b, 0x14, 0x25, 0xfe, 0xd9, 0xfe, 0x05, 0xcb, 0x30, 0xfa, 0x12, 0xba, 0x24,
-	0xc3, 0x74, 0x96, 0xf1, 0x73, 0x1f, 0x84, 0xf1, 0x89, 0xf0, 0x59, 0xbe, 0x34, 0x2a, 0x73, 0x76,
-	0xf6, 0xe5, 0x84, 0x3d, 0x61, 0xef, 0x76, 0x88, 0x39, 0x1c, 0xfd, 0x12, 0x3a, 0x25, 0x3d, 0x07,
-	0x8b, 0x5f, 0xb1, 0x2a, 0x2b, 0xe2, 0x9b, 0xb7, 0xb5, 0x14, 0x67, 0x21, 0x9b, 0x2b, 0x2a, 0xa0,
-	0x46, 0xbc, 0x98, 0x55, 0x6b, 0x0c, 0x03, 0x09, 0x5a, 0xc7, 0x6d, 0x4a, 0xc9, 0x41, 0x40, 0x9d,
-	0x07, 0xb0, 0xf1, 0x84, 0x90, 0x74, 0x2f, 0x89, 0x63, 0xe2, 0x33, 0x12, 0xb8, 0xe4, 0x37, 0x33,
-	0x42, 0x19, 0x5f, 0x22, 0xc6, 0xd3, 0x3c, 0x1f, 0xfc, 0xdb, 0xf9, 0x8b, 0x05, 0x5d, 0x79, 0x5c,
-	0x9e, 0x26, 0xbe, 0x38, 0x24, 0x1c, 0x34, 0xce, 0x31, 0x14, 0x68, 0xb3, 0x2c, 0xaa, 0x90, 0x0f,
-	0xbb, 0x4a, 0x3e, 0xee, 0x40, 0x43, 0xdc, 0xce, 0x45, 0x30, 0x6b, 0xfc, 0xc2, 0x0d, 0x03, 0x5a,
-	0xf4, 0x95, 0x40, 0xaa, 0x6b, 0x42, 0xdd, 0xd2, 0x17, 0x28, 0x37, 0x29, 0x7a, 0xf7, 0xaa, 0xd9,
-	0xbb, 0x9d, 0x97, 0xb0, 0xfe, 0x34, 0x49, 0xce, 0x66, 0xa9, 0x0c, 0x4f, 0x6f, 0xa2, 0xbc, 0x77,
-	0x6b, 0xbc, 0xc2, 0x63, 0xc9, 0xf7, 0x7e, 0xdd, 0x51, 0x76, 0xfe, 0x63, 0xc1, 0x46, 0xd9, 0xad,
-	0xba, 0x6e, 0x7e, 0x0d, 0xeb, 0xb9, 0x5f, 0x2f, 0x52, 0x58, 0xc8, 0x05, 0x5a, 0xbb, 0x1f, 0x1a,
-	0x69, 0x5e, 0x36, 0x5b, 0x53, 0x98, 0x40, 0x83, 0xe8, 0x0e, 0xce, 0x2b, 0x12, 0x3a, 0xba, 0x80,
-	0x7e, 0xd5, 0x8c, 0xb7, 0xc9, 0x7c, 0x55, 0x85, 0x78, 0x43, 0xcf, 0x44, 0x3f, 0x82, 0x66, 0x11,
-	0x88, 0x2d, 0x02, 0x59, 0x2f, 0x05, 0xa2, 0xd6, 0x2a, 0xac, 0xf8, 0xf1, 0x26, 0x59, 0x96, 0x64,
-	0xaa, 0xe1, 0xc8, 0x81, 0xf3, 0x53, 0x68, 0x7c, 0xeb, 0xec, 0x3a, 0x7f, 0xb7, 0xa1, 0xf3, 0x90,
-	0xd2, 0xf0, 0x24, 0xd6, 0x29, 0xd8, 0x80, 0x55, 0xd9, 0xfc, 0xe5, 0x6d, 0x2c, 0x07, 0x68, 0x0c,
-	0x2d, 0xd5, 0xb7, 0x0c, 0xe8, 0x4d, 0xd1, 0xb5, 0x2d, 0x51, 0xf5, 0xb2, 0x9a, 0x0c, 0x8d, 0xf7,
-	0xf3, 0x0a, 0x15, 0x5d, 0xbd, 0x94, 0x8a, 0xd6, 0x0d, 0x2a, 0xfa, 0x36, 0x34, 0xc5, 0xa4, 0x38,
-	0x09, 0x88, 0xe2, 0xa8, 0x0d, 0x2e, 0x78, 0x96, 0x04, 0x04, 0xed, 0xc2, 0xe6, 0x94, 0x4c, 0x93,
-	0x6c, 0xee, 0x4d, 0x71, 0xea, 0x71, 0x26, 0x2c, 0xd8, 0xc5, 0x74, 0xa2, 0x7a, 0x2f, 0x92, 0xda,
-	0x43, 0x9c, 0x1e, 0xe2, 0x0b, 0x4e, 0x30, 0x0e, 0x27, 0x68, 0x17, 0x6e, 0x7f, 0x95, 0x85, 0x0c,
-	0x4f, 0x22, 0x52, 0x66, 0xd8, 0xb2, 0x17, 0xaf, 0x6b, 0xa5, 0x41, 0xb3, 0x9d, 0x3f, 0x5b, 0xd0,
-	0xd5, 0xa8, 0xa9, 0x13, 0xd6, 0x87, 0x95, 0xe3, 0x3c, 0xcb, 0xfc, 0x53, 0xe7, 0xc2, 0xbe, 0x2c,
-	0x17, 0x0b, 0x34, 0x3f, 0x47, 0xbe, 0x66, 0x22, 0x9f, 0x27, 0x7d, 0xd5, 0x48, 0x3a, 0x87, 0x06,
-	0xcf, 0xd8, 0xa9, 0x86, 0x86, 0x7f, 0x3b, 0x27, 0x30, 0x38, 0x62, 0x98, 0x85, 0x94, 0x85, 0x3e,
-	0xd5, 0xe9, 0xac, 0x24, 0xce, 0xba, 0x2e, 0x71, 0xf6, 0x65, 0x89, 0x5b, 0xc9, 0x13, 0xe7, 0xfc,
-	0xd3, 0x02, 0x64, 0xae, 0xa4, 0x20, 0xf8, 0x0e, 0x96, 0xe2, 0x90, 0xb1, 0x84, 0x71, 0xbe, 0xc6,
-	0x39, 0x91, 0x62, 0x36, 0x42, 0xc2, 0xd3, 0xc7, 0x4f, 0xc3, 0x8c, 0x92, 0x40, 0x6a, 0x25, 0xad,
-	0x69, 0x70, 0x81, 0x50, 0x96, 0x59, 0x51, 0xbd, 0xc2, 0x8a, 0x9c, 0x87, 0xd0, 0x52, 0xf7, 0xcf,
-	0x4b, 0x7e, 0x77, 0x5d, 0x1f, 0xbd, 0x8a, 0xce, 0x2e, 0x80, 0x18, 0x03, 0xec, 0x15, 0xd1, 0x2f,
-	0xeb, 0xc0, 0xbf, 0x85, 0xdb, 0x85, 0xc5, 0xd3, 0x90, 0x32, 0x9d, 0x97, 0x8f, 0x61, 0x33, 0x8c,
-	0xfd, 0x68, 0x16, 0x10, 0x2f, 0xe6, 0x37, 0x78, 0x94, 0x3f, 0x2f, 0x2c, 0xc1, 0xa7, 0x36, 0x94,
-	0xf6, 0x99, 0x50, 0xea, 0x67, 0xc6, 0x07, 0x80, 0xf4, 0x2c, 0xe2, 0xe7, 0x33, 0x6c, 0x31, 0xa3,
-	0xaf, 0x34, 0xfb, 0xbe, 0xb2, 0x76, 0x5e, 0xc0, 0x66, 0x75, 0x71, 0x95, 0xaa, 0x1f, 0x43, 0xab,
-	0x80, 0x5d, 0xf7, 0xc1, 0xdb, 0x46, 0xfb, 0x29, 0xe6, 0xb9, 0xa6, 0xa5, 0xf3, 0x03, 0x78, 0xab,
-	0x50, 0x3d, 0x16, 0x8d, 0xfe, 0xaa, 0x0b, 0x68, 0x04, 0xc3, 0x45, 0x73, 0x19, 0x83, 0xf3, 0x6f,
-	0x1b, 0xda, 0x8f, 0x55, 0xe5, 0x72, 0x1a, 0x63, 0x10, 0x17, 0xc9, 0x1e, 0xee, 0x41, 0xbb, 0x54,
-	0x90, 0x92, 0x11, 0xb7, 0xce, 0x8d, 0xf7, 0xee, 0xb2, 0x97, 0xf1, 0x8a, 0x30, 0xab, 0xbe, 0x8c,
-	0x1f, 0xc0, 0xe0, 0x38, 0x23, 0x64, 0xf1, 0x11, 0x5d, 0x73, 0x7b, 0x5c, 0x61, 0xda, 0xee, 0xc0,
-	0x3a, 0xf6, 0x59, 0x78, 0x5e, 0xb1, 0x96, 0xe7, 0x6b, 0x20, 0x55, 0xa6, 0xfd, 0xe7, 0x79, 0xa0,
-	0x61, 0x7c, 0x9c, 0xd0, 0x61, 0xfd, 0x9b, 0x3f, 0x82, 0xd5, 0x6e, 0xb8, 0x86, 0xa2, 0xe7, 0xd0,
-	0xd5, 0x8f, 0x29, 0xe5, 0x69, 0xed, 0xc6, 0x0f, 0xb5, 0x36, 0x29, 0x54, 0xd4, 0xf9, 0x83, 0x0d,
-	0x0d, 0x17, 0xfb, 0x67, 0x6f, 0x36, 0xbe, 0x9f, 0x41, 0x2f, 0xef, 0xf9, 0x25, 0x88, 0xdf, 0x32,
-	0x80, 0x31, 0x8f, 0x92, 0xdb, 0x09, 0x8c, 0x11, 0x75, 0xfe, 0x67, 0x41, 0xf7, 0x71, 0x7e, 0xaf,
-	0xbc, 0xd9, 0x60, 0xec, 0x02, 0xf0, 0x8b, 0xb0, 0x84, 0x83, 0x49, 0x1c, 0x74, 0xba, 0xdd, 0x66,
-	0xa6, 0xbe, 0xa8, 0xf3, 0x27, 0x1b, 0xda, 0x2f, 0x93, 0x34, 0x89, 0x92, 0x93, 0xf9, 0x9b, 0xbd,
-	0xfb, 0x7d, 0x18, 0x18, 0x9c, 0xa1, 0x04, 0xc2, 0x9d, 0xca, 0x61, 0x28, 0x92, 0xed, 0xf6, 0x82,
-	0xd2, 0x98, 0x3a, 0xeb, 0x30, 0x50, 0xbc, 0xb8, 0x68, 0xc9, 0xce, 0xef, 0x2d, 0x40, 0xa6, 0x54,
-	0xf5, 0xca, 0x9f, 0x41, 0x87, 0x29, 0xec, 0xc4, 0x7a, 0xea, 0x71, 0x60, 0x9e, 0x3d, 0x13, 0x5b,
-	0xb7, 0xcd, 0x4c, 0xa4, 0x7f, 0x08, 0x1b, 0x0b, 0x3f, 0x74, 0x70, 0x42, 0x22, 0x11, 0x1e, 0x54,
-	0x7e, 0xeb, 0x38, 0x9c, 0x38, 0x1f, 0xc3, 0x6d, 0x49, 0x42, 0x75, 0x1f, 0xd7, 0xfd, 0x75, 0x81,
-	0x4d, 0x76, 0x0a, 0x36, 0xe9, 0xfc, 0xd7, 0x82, 0xcd, 0xea, 0x34, 0x15, 0xff, 0x55, 0xf3, 0x10,
-	0x06, 0xa4, 0xfa, 0x8d, 0xc9, 0x8b, 0x25, 0x1d, 0xfd, 0x68, 0x81, 0x17, 0x57, 0x7d, 0xef, 0xe8,
-	0x3e, 0x54, 0x50, 0xe3, 0x3e, 0x2d, 0x0b, 0xe8, 0x08, 0xc3, 0x60, 0xc1, 0x8c, 0xbf, 0x2a, 0xf4,
-	0xba, 0x2a, 0xa6, 0x35, 0x35, 0xf1, 0x5b, 0x10, 0x63, 0x67, 0x0b, 0xee, 0x7e, 0x41, 0xd8, 0xa1,
-	0xb0, 0xd9, 0x4b, 0xe2, 0xe3, 0xf0, 0x64, 0x96, 0x49, 0xa3, 0x22, 0xb5, 0xef, 0x5e, 0x66, 0xa1,
-	0x60, 0x5a, 0xf2, 0x6b, 0x92, 0x75, 0xe3, 0x5f, 0x93, 0xec, 0xab, 0x7e, 0x4d, 0xda, 0xfd, 0x57,
-	0x1d, 0xd6, 0x8e, 0x08, 0x7e, 0x4d, 0x08, 0x7f, 0x1a, 0x77, 0x8e, 0x48, 0x1c, 0x14, 0xbf, 0x13,
-	0x6f, 0x18, 0x7b, 0xcc, 0xa5, 0xa3, 0x77, 0x96, 0x49, 0xf3, 0x2b, 0xf4, 0xd6, 0xb6, 0xf5, 0xa1,
-	0x85, 0x5e, 0x40, 0xa7, 0xf4, 0x22, 0x44, 0x5b, 0xc6, 0xa4, 0x65, 0x6f, 0xc5, 0xd1, 0x9d, 0x85,
-	0x0b, 0x45, 0xa3, 0x9a, 0xbb, 0x6c, 0x9b, 0x2f, 0x21, 0xf4, 0xee, 0xa5, 0x4f, 0x24, 0xe9, 0x70,
-	0xeb, 0x9a, 0x27, 0x94, 0x73, 0x0b, 0x7d, 0x06, 0x75, 0x49, 0x99, 0xd1, 0xd0, 0x30, 0x2e, 0xbd,
-	0x3d, 0x4a, 0x71, 0x95, 0xf9, 0xb5, 0x73, 0x0b, 0x3d, 0x01, 0x28, 0x48, 0x27, 0x7a, 0xa7, 0xf4,
-	0x33, 0x42, 0x85, 0xf5, 0x8e, 0xee, 0x5e, 0xa2, 0xcd, 0x9d, 0x7d, 0x05, 0xdd, 0x32, 0x35, 0x42,
-	0xe3, 0xa5, 0xec, 0xc7, 0xe8, 0x0f, 0xa3, 0x7b, 0x57, 0x58, 0xe4, 0x8e, 0x7f, 0x05, 0xfd, 0x2a,
-	0xe3, 0x41, 0xce, 0xd2, 0x89, 0x25, 0xf6, 0x34, 0x7a, 0xef, 0x4a, 0x1b, 0x13, 0x84, 0xa2, 0x45,
-	0x95, 0x40, 0x58, 0xe8, 0x67, 0x25, 0x10, 0x16, 0xfb, 0x9a, 0x04, 0xa1, 0x5c, 0xd7, 0x25, 0x10,
-	0x96, 0x76, 0xa1, 0x12, 0x08, 0xcb, 0x9b, 0x82, 0x73, 0x0b, 0x25, 0xb0, 0xb9, 0xbc, 0xda, 0x90,
-	0xf9, 0x93, 0xca, 0x95, 0x25, 0x3b, 0xba, 0xff, 0x0d, 0x2c, 0xf5, 0x82, 0x93, 0xba, 0xf8, 0x13,
-	0xe6, 0xa3, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x29, 0xc6, 0x5d, 0x94, 0x19, 0x00, 0x00,
+	// 2220 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6e, 0x1c, 0xc7,
+	0x11, 0xf6, 0x2c, 0xff, 0x76, 0x6b, 0x7f, 0xb8, 0xdb, 0xa4, 0xe8, 0xe5, 0xda, 0x92, 0x56, 0x63,
+	0x03, 0xa6, 0x14, 0x87, 0x71, 0x68, 0x03, 0x31, 0x9c, 0x18, 0x86, 0x44, 0x52, 0x0e, 0x23, 0x51,
+	0x96, 0x66, 0x15, 0x19, 0x08, 0x10, 0x8c, 0x7b, 0x67, 0x7a, 0xa9, 0x06, 0x67, 0x67, 0x26, 0xdd,
+	0xbd, 0x2b, 0xae, 0x73, 0x09, 0x90, 0x9c, 0x73, 0xc9, 0x21, 0xaf, 0x90, 0x57, 0xc8, 0xc5, 0x97,
+	0x1c, 0xf2, 0x00, 0xb9, 0xe7, 0x21, 0x72, 0x0d, 0x02, 0x04, 0xfd, 0x33, 0xbf, 0xbb, 0x4b, 0x8a,
+	0x06, 0x7c, 0xd0, 0x6d, 0xba, 0xaa, 0xba, 0xba, 0xba, 0xaa, 0xab, 0xea, 0xeb, 0x1e, 0x68, 0x8c,
+	0x31, 0x17, 0x84, 0xed, 0xc7, 0x2c, 0x12, 0x11, 0xaa, 0xe9, 0x91, 0x1b, 0x0f, 0xed, 0x7f, 0xae,
+	0x43, 0xed, 0x97, 0x04, 0x33, 0x31, 0x24, 0x58, 0xa0, 0x16, 0x54, 0x68, 0xdc, 0xb5, 0xfa, 0xd6,
+	0x5e, 0xcd, 0xa9, 0xd0, 0x18, 0x21, 0x58, 0x8d, 0x23, 0x26, 0xba, 0x95, 0xbe, 0xb5, 0xd7, 0x74,
+	0xd4, 0x37, 0xba, 0x09, 0x10, 0x4f, 0x86, 0x01, 0xf5, 0xdc, 0x09, 0x0b, 0xba, 0x2b, 0x4a, 0xb6,
+	0xa6, 0x29, 0xbf, 0x66, 0x01, 0xda, 0x83, 0xf6, 0x18, 0x5f, 0xb8, 0xd3, 0x28, 0x98, 0x8c, 0x89,
+	0xeb, 0x45, 0x93, 0x50, 0x74, 0x57, 0xd5, 0xf4, 0xd6, 0x18, 0x5f, 0xbc, 0x50, 0xe4, 0x43, 0x49,
+	0x45, 0x7d, 0x69, 0xd5, 0x85, 0x3b, 0xa2, 0x01, 0x71, 0xcf, 0xc9, 0xac, 0xbb, 0xd6, 0xb7, 0xf6,
+	0x56, 0x1d, 0x18, 0xe3, 0x8b, 0x87, 0x34, 0x20, 0x8f, 0xc8, 0x0c, 0xdd, 0x86, 0xba, 0x8f, 0x05,
+	0x76, 0x3d, 0x12, 0x0a, 0xc2, 0xba, 0xeb, 0x6a, 0x2d, 0x90, 0xa4, 0x43, 0x45, 0x91, 0xf6, 0x31,
+	0xec, 0x9d, 0x77, 0x37, 0x14, 0x47, 0x7d, 0x4b, 0xfb, 0xb0, 0x3f, 0xa6, 0xa1, 0xab, 0x2c, 0xaf,
+	0xaa, 0xa5, 0x6b, 0x8a, 0xf2, 0x54, 0x9a, 0xff, 0x39, 0x6c, 0x68, 0xdb, 0x78, 0xb7, 0xd6, 0x5f,
+	0xd9, 0xab, 0x1f, 0xbc, 0xb7, 0x9f, 0x7a, 0x63, 0x5f, 0x9b, 0x77, 0x12, 0x8e, 0x22, 0x36, 0xc6,
+	0x82, 0x46, 0xe1, 0x29, 0xe1, 0x1c, 0x9f, 0x11, 0x27, 0x99, 0x83, 0x4e, 0xa0, 0x1e, 0x92, 0x57,
+	0x6e, 0xa2, 0x02, 0x94, 0x8a, 0xbd, 0x39, 0x15, 0x83, 0x97, 0x11, 0x13, 0x0b, 0xf4, 0x40, 0x48,
+	0x5e, 0xbd, 0x30, 0xaa, 0x9e, 0xc1, 0xa6, 0x4f, 0x02, 0x22, 0x88, 0x9f, 0xaa, 0xab, 0x5f, 0x53,
+	0x5d, 0xcb, 0x28, 0x48, 0x54, 0xbe, 0x0f, 0xad, 0x97, 0x98, 0xbb, 0x61, 0x94, 0x6a, 0x6c, 0xf4,
+	0xad, 0xbd, 0xaa, 0xd3, 0x78, 0x89, 0xf9, 0x93, 0x28, 0x91, 0xfa, 0x12, 0x6a, 0xc4, 0x73, 0xf9,
+	0x4b, 0xcc, 0x7c, 0xde, 0x6d, 0xab, 0x25, 0xef, 0xcd, 0x2d, 0x79, 0xec, 0x0d, 0xa4, 0xc0, 0x82,
+	0x45, 0xab, 0x44, 0xb3, 0x38, 0x7a, 0x02, 0x4d, 0xe9, 0x8c, 0x4c, 0x59, 0xe7, 0xda, 0xca, 0xa4,
+	0x37, 0x8f, 0x13, 0x7d, 0x2f, 0xa0, 0x93, 0x78, 0x24, 0xd3, 0x89, 0xae, 0xad, 0x33, 0x71, 0x6b,
+	0xaa, 0xf7, 0x03, 0x68, 0x1b, 0xb7, 0x64, 0x6a, 0xb7, 0x94, 0x63, 0x9a, 0xca, 0x31, 0xa9, 0xe0,
+	0x67, 0xd0, 0xf4, 0x29, 0x3f, 0x77, 0xb9, 0xc0, 0x62, 0xc2, 0x09, 0xef, 0x6e, 0xab, 0xc5, 0x6f,
+	0xe4, 0x16, 0x3f, 0xa2, 0xfc, 0x7c, 0xa0, 0xd8, 0x4e, 0xc3, 0x4f, 0xbf, 0x09, 0xb7, 0xff, 0x50,
+	0x81, 0x4e, 0x9a, 0x49, 0x0e, 0xe1, 0x71, 0x14, 0x72, 0x82, 0xee, 0x41, 0xc7, 0xa4, 0x02, 0xa7,
+	0xdf, 0x12, 0x37, 0xa0, 0x63, 0x2a, 0x54, 0x82, 0xad, 0x3a, 0x9b, 0x9a, 0x31, 0xa0, 0xdf, 0x92,
+	0xc7, 0x92, 0x8c, 0x76, 0x60, 0x3d, 0x20, 0xd8, 0x27, 0x4c, 0xe5, 0x5b, 0xcd, 0x31, 0x23, 0xf4,
+	0x01, 0x6c, 0x8e, 0x89, 0x60, 0xd4, 0xe3, 0x2e, 0xf6, 0x7d, 0x46, 0x38, 0x37, 0x69, 0xd7, 0x32,
+	0xe4, 0xfb, 0x9a, 0x8a, 0x3e, 0x85, 0x6e, 0x22, 0x48, 0x65, 0x7e, 0x4c, 0x71, 0xe0, 0x72, 0xe2,
+	0x45, 0xa1, 0xcf, 0x4d, 0x0e, 0xee, 0x18, 0xfe, 0x89, 0x61, 0x0f, 0x34, 0x17, 0x1d, 0x41, 0x9b,
+	0x8b, 0x88, 0xe1, 0x33, 0xe2, 0x0e, 0xb1, 0x77, 0x4e, 0xe4, 0x8c, 0x35, 0xb5, 0xf7, 0xdd, 0xdc,
+	0xde, 0x07, 0x5a, 0xe4, 0x81, 0x96, 0x70, 0x36, 0x79, 0x61, 0xcc, 0xed, 0x7f, 0xaf, 0x40, 0x77,
+	0x59, 0x0a, 0xa9, 0xda, 0xe2, 0xab, 0xad, 0x37, 0x9d, 0x0a, 0xf5, 0x65, 0xee, 0x4a, 0x97, 0xa8,
+	0xbd, 0xae, 0x3a, 0xea, 0x1b, 0xdd, 0x02, 0xf0, 0xa2, 0x20, 0x20, 0x9e, 0x9c, 0x68, 0x36, 0x99,
+	0xa3, 0xc8, 0xdc, 0x56, 0xe5, 0x22, 0x2b, 0x2b, 0xab, 0x4e, 0x4d, 0x52, 0x74, 0x45, 0xb9, 0x03,
+	0x0d, 0x1d, 0x7a, 0x23, 0xa0, 0x2b, 0x4a, 0x5d, 0xd3, 0xb4, 0xc8, 0x87, 0x80, 0x92, 0x23, 0x36,
+	0x9c, 0xa5, 0x82, 0xeb, 0x4a, 0xb0, 0x6d, 0x38, 0x0f, 0x66, 0x89, 0xf4, 0x3b, 0x50, 0x63, 0x04,
+	0xfb, 0x6e, 0x14, 0x06, 0x33, 0x55, 0x64, 0xaa, 0x4e, 0x55, 0x12, 0xbe, 0x0a, 0x83, 0x19, 0xfa,
+	0x11, 0x74, 0x18, 0x89, 0x03, 0xea, 0x61, 0x37, 0x0e, 0xb0, 0x47, 0xc6, 0x24, 0x4c, 0xea, 0x4d,
+	0xdb, 0x30, 0x9e, 0x26, 0x74, 0xd4, 0x85, 0x8d, 0x29, 0x61, 0x5c, 0x6e, 0xab, 0xa6, 0x44, 0x92,
+	0x21, 0x6a, 0xc3, 0x8a, 0x10, 0x41, 0x17, 0x14, 0x55, 0x7e, 0xa2, 0xbb, 0xd0, 0xf6, 0xa2, 0x71,
+	0x8c, 0x3d, 0xe1, 0x32, 0x32, 0xa5, 0x6a, 0x52, 0x5d, 0xb1, 0x37, 0x0d, 0xdd, 0x31, 0x64, 0xb9,
+	0x9d, 0x71, 0xe4, 0xd3, 0x11, 0x25, 0xbe, 0x8b, 0x85, 0x09, 0xb6, 0x4a, 0xfa, 0x15, 0xa7, 0x9d,
+	0x70, 0xee, 0x0b, 0x1d, 0x66, 0xe9, 0x5e, 0x7f, 0xc2, 0xf0, 0x90, 0x06, 0x54, 0xcc, 0xba, 0x4d,
+	0x53, 0x4e, 0x53, 0x8a, 0xac, 0xb7, 0xd1, 0x68, 0xc4, 0x89, 0x50, 0x87, 0xb5, 0xdb, 0x52, 0x6b,
+	0x82, 0x26, 0xc9, 0x63, 0x6a, 0x3f, 0x03, 0xc8, 0xce, 0xbf, 0xb4, 0xdc, 0xa7, 0xcc, 0xb4, 0x0b,
+	0xf9, 0x29, 0xfd, 0x45, 0xb9, 0x3b, 0xc2, 0x34, 0x20, 0xbe, 0x0a, 0x6c, 0xd5, 0xa9, 0x52, 0xfe,
+	0x50, 0x8d, 0xd1, 0x36, 0xac, 0x11, 0xc6, 0x22, 0x66, 0xe2, 0xaa, 0x07, 0xf6, 0xdf, 0x2c, 0xb8,
+	0x79, 0x69, 0x91, 0x9b, 0x3b, 0x38, 0x57, 0x1d, 0x92, 0x1f, 0x2a, 0x2e, 0xf6, 0x04, 0x6e, 0x5f,
+	0x51, 0x7a, 0xae, 0xb0, 0xb5, 0x32, 0x67, 0xab, 0x0d, 0x4d, 0xe2, 0xb9, 0x34, 0xf4, 0xc9, 0x85,
+	0x3b, 0xa4, 0x42, 0x27, 0x76, 0xd3, 0xa9, 0x13, 0xef, 0x44, 0xd2, 0x1e, 0x50, 0xc1, 0xed, 0xef,
+	0x2c, 0x68, 0x15, 0x33, 0x4f, 0xe6, 0x8e, 0x98, 0xc5, 0xc4, 0xb8, 0x5e, 0x7d, 0x9b, 0xa5, 0x2b,
+	0xa6, 0x77, 0xfb, 0xe8, 0x04, 0x20, 0x66, 0x51, 0x4c, 0x98, 0xa0, 0x44, 0xea, 0x95, 0xc9, 0x7c,
+	0x77, 0x69, 0x32, 0xef, 0x3f, 0x4d, 0x65, 0x8f, 0x43, 0xc1, 0x66, 0x4e, 0x6e, 0x72, 0xef, 0x73,
+	0xd8, 0x2c, 0xb1, 0xa5, 0x77, 0x64, 0xcf, 0x36, 0xb1, 0x3f, 0x27, 0x33, 0x19, 0xde, 0x29, 0x0e,
+	0x26, 0xc4, 0x98, 0xa0, 0x07, 0x9f, 0x55, 0x3e, 0xb5, 0xec, 0x0d, 0x58, 0x3b, 0x1e, 0xc7, 0x62,
+	0x26, 0x77, 0xb2, 0x39, 0x98, 0xc4, 0x84, 0x3d, 0x08, 0x22, 0xef, 0xfc, 0xf8, 0x42, 0x30, 0x8c,
+	0xbe, 0x82, 0x16, 0x61, 0x98, 0x4f, 0x98, 0xcc, 0x45, 0x9f, 0x86, 0x67, 0x4a, 0x67, 0xb1, 0x09,
+	0x96, 0xe6, 0xec, 0x1f, 0xeb, 0x09, 0x87, 0x4a, 0xde, 0x69, 0x92, 0xfc, 0xb0, 0xf7, 0x1b, 0x68,
+	0x16, 0xf8, 0xd2, 0x59, 0x12, 0x32, 0x98, 0xa8, 0xa8, 0x6f, 0x59, 0x6a, 0x63, 0xcc, 0x64, 0x16,
+	0x68, 0x68, 0x63, 0x46, 0xb2, 0xc0, 0x98, 0x72, 0x4d, 0x7d, 0xed, 0xb4, 0xa6, 0x53, 0xd3, 0x94,
+	0x13, 0x9f, 0xdb, 0xf7, 0x60, 0xfb, 0x11, 0x21, 0xf1, 0x61, 0x14, 0x86, 0xc4, 0x13, 0xc4, 0x77,
+	0xc8, 0xef, 0x26, 0x84, 0x0b, 0xb9, 0x44, 0x88, 0xc7, 0x69, 0x3c, 0xe4, 0xb7, 0xfd, 0x57, 0x0b,
+	0x5a, 0xfa, 0xb8, 0x3c, 0x8e, 0x3c, 0x75, 0x48, 0xa4, 0xd3, 0x24, 0x66, 0x32, 0x4e, 0x9b, 0xb0,
+	0xa0, 0x04, 0xa6, 0x2a, 0x65, 0x30, 0xb5, 0x0b, 0x55, 0x85, 0x36, 0x32, 0x63, 0x36, 0x24, 0x80,
+	0xa0, 0x3e, 0xcf, 0x6a, 0x9d, 0xaf, 0xd9, 0xab, 0x8a, 0x6d, 0x6a, 0x9d, 0xaf, 0x44, 0xb2, 0x7e,
+	0xb2, 0x96, 0xef, 0x27, 0xf6, 0x73, 0xd8, 0x7a, 0x1c, 0x45, 0xe7, 0x93, 0x58, 0x9b, 0x97, 0x6c,
+	0xa2, 0xb8, 0x77, 0xab, 0xbf, 0x22, 0x6d, 0x49, 0xf7, 0x7e, 0xd5, 0x51, 0xb6, 0xff, 0x63, 0xc1,
+	0x76, 0x51, 0xad, 0x69, 0x81, 0xdf, 0xc0, 0x56, 0xaa, 0xd7, 0x0d, 0x8c, 0x2f, 0xf4, 0x02, 0xf5,
+	0x83, 0x8f, 0x72, 0x61, 0x5e, 0x34, 0x3b, 0x81, 0x64, 0x7e, 0xe2, 0x44, 0xa7, 0x33, 0x2d, 0x51,
+	0x78, 0xef, 0x02, 0xda, 0x65, 0x31, 0x59, 0x8a, 0xd2, 0x55, 0x8d, 0xc7, 0xab, 0xc9, 0x4c, 0xf4,
+	0x53, 0xa8, 0x65, 0x86, 0x54, 0x94, 0x21, 0x5b, 0x05, 0x43, 0xcc, 0x5a, 0x99, 0xd4, 0x92, 0xea,
+	0xf5, 0x73, 0xa8, 0x7e, 0xef, 0xe8, 0xda, 0xff, 0xaa, 0x40, 0xf3, 0x3e, 0xe7, 0xf4, 0x2c, 0x4c,
+	0x42, 0xb0, 0x0d, 0x6b, 0xba, 0x21, 0x69, 0x84, 0xa0, 0x07, 0xa8, 0x0f, 0x75, 0x53, 0xb7, 0x72,
+	0xae, 0xcf, 0x93, 0xae, 0x2c, 0x89, 0xa6, 0x96, 0xad, 0x6a, 0xd3, 0x64, 0x8f, 0x29, 0x41, 0xeb,
+	0xb5, 0xa5, 0xd0, 0x7a, 0x3d, 0x07, 0xad, 0xdf, 0x81, 0x9a, 0x9a, 0x14, 0x46, 0x3e, 0x31, 0x98,
+	0xbb, 0x2a, 0x09, 0x4f, 0x22, 0x9f, 0xa0, 0x03, 0xd8, 0x19, 0x93, 0x71, 0xc4, 0x66, 0xee, 0x18,
+	0xc7, 0xae, 0x44, 0xf6, 0x0a, 0xf1, 0x8c, 0x87, 0xa6, 0xf6, 0x22, 0xcd, 0x3d, 0xc5, 0xf1, 0x29,
+	0xbe, 0x90, 0xdd, 0xe4, 0x74, 0x88, 0x0e, 0xe0, 0xc6, 0xd7, 0x8c, 0x0a, 0x3c, 0x0c, 0x48, 0xf1,
+	0xc6, 0xa0, 0x6b, 0xf1, 0x56, 0xc2, 0xcc, 0x5f, 0x1b, 0x8a, 0x4d, 0x0c, 0xca, 0x4d, 0xcc, 0xfe,
+	0x8b, 0x05, 0xad, 0xc4, 0xab, 0xe6, 0x04, 0xb6, 0x61, 0x65, 0x94, 0x9e, 0x02, 0xf9, 0x99, 0xc4,
+	0xaa, 0xb2, 0x2c, 0x56, 0x73, 0xd7, 0x9a, 0x34, 0x32, 0xab, 0xf9, 0xc8, 0xa4, 0x87, 0x62, 0x2d,
+	0x77, 0x28, 0xa4, 0xeb, 0xf0, 0x44, 0xbc, 0x4c, 0x5c, 0x27, 0xbf, 0xed, 0x33, 0xe8, 0xc8, 0xae,
+	0x49, 0xb9, 0xa0, 0x1e, 0x4f, 0xc2, 0x5d, 0x0a, 0xac, 0x75, 0x55, 0x60, 0x2b, 0xcb, 0x02, 0xbb,
+	0x92, 0x06, 0xd6, 0xfe, 0x87, 0x05, 0x28, 0xbf, 0x92, 0x71, 0xc1, 0x0f, 0xb0, 0x94, 0x74, 0x99,
+	0x88, 0x04, 0x0e, 0x34, 0x5a, 0x30, 0x68, 0x4c, 0x51, 0x64, 0x78, 0xe5, 0x69, 0x99, 0x70, 0xe2,
+	0x6b, 0xae, 0x86, 0x62, 0x55, 0x49, 0x50, 0xcc, 0x22, 0x92, 0x5b, 0x2f, 0x21, 0x39, 0xfb, 0x3e,
+	0xd4, 0x4d, 0x7f, 0x7a, 0x3e, 0x8b, 0x5f, 0xc7, 0x7a, 0x63, 0x5d, 0x25, 0x73, 0x44, 0x1f, 0xe0,
+	0x30, 0xb3, 0x7e, 0x51, 0x85, 0xfe, 0x3d, 0xdc, 0xc8, 0x24, 0x1e, 0x53, 0x2e, 0x92, 0xb8, 0x7c,
+	0x02, 0x3b, 0x34, 0xf4, 0x82, 0x89, 0x4f, 0xdc, 0x50, 0x76, 0xf8, 0x20, 0xbd, 0x4e, 0x59, 0x0a,
+	0xd3, 0x6c, 0x1b, 0xee, 0x13, 0xc5, 0x4c, 0xae, 0x55, 0x1f, 0x02, 0x4a, 0x66, 0x11, 0x2f, 0x9d,
+	0xa1, 0x51, 0x50, 0xdb, 0x70, 0x8e, 0x3d, 0x23, 0x6d, 0x3f, 0x83, 0x9d, 0xf2, 0xe2, 0x26, 0x54,
+	0x3f, 0x83, 0x7a, 0xe6, 0xf6, 0xa4, 0x4e, 0xe6, 0xaf, 0x20, 0xd9, 0x3c, 0x27, 0x2f, 0x69, 0xff,
+	0x18, 0xde, 0xce, 0x58, 0x47, 0xaa, 0x11, 0x5c, 0xd6, 0xa0, 0x7a, 0xd0, 0x9d, 0x17, 0xd7, 0x36,
+	0xd8, 0xdf, 0xad, 0x40, 0xe3, 0xc8, 0x64, 0xb6, 0x84, 0x39, 0x39, 0x60, 0xa3, 0xd1, 0xc5, 0x1d,
+	0x68, 0x14, 0x12, 0x56, 0xa3, 0xf8, 0xfa, 0x34, 0x97, 0xa8, 0x8b, 0x5e, 0x02, 0x56, 0x94, 0x58,
+	0xf9, 0x25, 0xe0, 0x1e, 0x74, 0x46, 0x8c, 0x90, 0xf9, 0x47, 0x83, 0x55, 0x67, 0x53, 0x32, 0xf2,
+	0xb2, 0xfb, 0xb0, 0x85, 0x3d, 0x41, 0xa7, 0x25, 0x69, 0x7d, 0xbe, 0x3a, 0x9a, 0x95, 0x97, 0x7f,
+	0x98, 0x1a, 0x4a, 0xc3, 0x51, 0xc4, 0xbb, 0xeb, 0xaf, 0x7f, 0xe9, 0xaf, 0x4f, 0x53, 0x0e, 0x47,
+	0x4f, 0xa1, 0x95, 0x5c, 0x1e, 0x8d, 0xa6, 0x8d, 0x6b, 0x5f, 0x4c, 0x1b, 0x24, 0x63, 0x71, 0x59,
+	0x82, 0x29, 0x77, 0x7d, 0x86, 0x69, 0x28, 0x61, 0x4f, 0x55, 0x1d, 0x14, 0xa0, 0xfc, 0xc8, 0x50,
+	0xe6, 0x6f, 0xa3, 0xb5, 0xd7, 0xbf, 0x8d, 0xfe, 0xa9, 0x02, 0x55, 0x07, 0x7b, 0xe7, 0x6f, 0x76,
+	0xf0, 0xbe, 0x80, 0xcd, 0xb4, 0xe1, 0x14, 0xe2, 0xf7, 0x76, 0xde, 0x07, 0xb9, 0x73, 0xea, 0x34,
+	0xfd, 0xdc, 0x88, 0xdb, 0xff, 0xb3, 0xa0, 0x75, 0x94, 0x36, 0xb5, 0x37, 0xdb, 0x19, 0x07, 0x00,
+	0xb2, 0x0b, 0x17, 0xfc, 0x90, 0x47, 0x2d, 0x49, 0xb8, 0x9d, 0x1a, 0x33, 0x5f, 0xdc, 0xfe, 0x73,
+	0x05, 0x1a, 0xcf, 0xa3, 0x38, 0x0a, 0xa2, 0xb3, 0xd9, 0x9b, 0xbd, 0xfb, 0x63, 0xe8, 0xe4, 0x00,
+	0x4b, 0xc1, 0x09, 0xbb, 0xa5, 0xc3, 0x90, 0x05, 0xdb, 0xd9, 0xf4, 0x0b, 0x63, 0x6e, 0x6f, 0x41,
+	0xc7, 0x80, 0xf2, 0xac, 0xde, 0xdb, 0x7f, 0xb4, 0x00, 0xe5, 0xa9, 0xa6, 0x10, 0xff, 0x02, 0x9a,
+	0xc2, 0xf8, 0x4e, 0xad, 0x67, 0x6e, 0x26, 0xf9, 0xb3, 0x97, 0xf7, 0xad, 0xd3, 0x10, 0xb9, 0x11,
+	0xfa, 0x09, 0x6c, 0xcf, 0xbd, 0xfc, 0x48, 0x34, 0xa4, 0x3d, 0xdc, 0x29, 0x3d, 0xfe, 0x9c, 0x0e,
+	0xed, 0x4f, 0xe0, 0x86, 0x46, 0xc0, 0x49, 0x93, 0x48, 0x8a, 0xf7, 0x1c, 0x94, 0x6d, 0x66, 0x50,
+	0xd6, 0xfe, 0xaf, 0x05, 0x3b, 0xe5, 0x69, 0xc6, 0xfe, 0xcb, 0xe6, 0x21, 0x0c, 0xc8, 0x14, 0x33,
+	0xdf, 0x2d, 0x63, 0xe1, 0x8f, 0xe7, 0x40, 0x79, 0x59, 0xf7, 0x7e, 0x52, 0xe4, 0x32, 0x5c, 0xde,
+	0xe6, 0x45, 0x02, 0xef, 0x61, 0xe8, 0xcc, 0x89, 0xc9, 0x2b, 0x4d, 0xb2, 0xae, 0xb1, 0x69, 0xc3,
+	0x4c, 0xfc, 0x1e, 0xa8, 0xdc, 0xbe, 0x0d, 0x37, 0xbf, 0x24, 0xe2, 0x54, 0xc9, 0x1c, 0x46, 0xe1,
+	0x88, 0x9e, 0x4d, 0x98, 0x16, 0xca, 0x42, 0x7b, 0x6b, 0x99, 0x84, 0x71, 0xd3, 0x82, 0xe7, 0x35,
+	0xeb, 0xda, 0xcf, 0x6b, 0x95, 0xcb, 0x9e, 0xd7, 0xec, 0x5f, 0x25, 0xef, 0x62, 0x03, 0xc2, 0xa6,
+	0x84, 0xa9, 0x0a, 0x9f, 0x6f, 0xcd, 0x12, 0x4f, 0x27, 0xad, 0x59, 0x62, 0xe9, 0x1e, 0x54, 0xd3,
+	0xbe, 0x60, 0x9e, 0x51, 0x92, 0xb1, 0xfd, 0x0e, 0xec, 0x2e, 0xd0, 0xa5, 0xf7, 0x72, 0xf0, 0xf7,
+	0x0d, 0xd8, 0x18, 0x10, 0xfc, 0x8a, 0x10, 0xf9, 0x00, 0xd0, 0x1c, 0x90, 0xd0, 0xcf, 0x5e, 0xf7,
+	0xb7, 0x73, 0xce, 0x4c, 0xa9, 0xbd, 0x77, 0x17, 0x51, 0x53, 0x20, 0xf0, 0xd6, 0x9e, 0xf5, 0x91,
+	0x85, 0x9e, 0x41, 0xb3, 0x70, 0xef, 0x45, 0xb7, 0x73, 0x93, 0x16, 0xdd, 0x88, 0x7b, 0xbb, 0x73,
+	0x6d, 0x31, 0x09, 0x5f, 0xaa, 0xb2, 0x91, 0xbf, 0xef, 0xa1, 0x5b, 0x4b, 0x2f, 0x82, 0x5a, 0xe1,
+	0xed, 0x2b, 0x2e, 0x8a, 0xf6, 0x5b, 0xe8, 0x0b, 0x58, 0xd7, 0xc0, 0x1f, 0x75, 0x73, 0xc2, 0x85,
+	0x1b, 0x56, 0x6f, 0x77, 0x01, 0x27, 0x55, 0xf0, 0x08, 0x20, 0x83, 0xce, 0xe8, 0xdd, 0xc2, 0x63,
+	0x49, 0x09, 0xbb, 0xf7, 0x6e, 0x2e, 0xe1, 0xa6, 0xca, 0xbe, 0x86, 0x56, 0x11, 0xe0, 0xa1, 0xfe,
+	0x42, 0x0c, 0x97, 0x2b, 0x44, 0xbd, 0x3b, 0x97, 0x48, 0xa4, 0x8a, 0x7f, 0x0b, 0xed, 0x32, 0x6e,
+	0x43, 0xf6, 0xc2, 0x89, 0x05, 0x0c, 0xd8, 0x7b, 0xef, 0x52, 0x99, 0xbc, 0x13, 0xb2, 0x5a, 0x58,
+	0x70, 0xc2, 0x5c, 0xe1, 0xec, 0xdd, 0x5c, 0xc2, 0xcd, 0x3b, 0xa1, 0x58, 0x40, 0x0a, 0x4e, 0x58,
+	0x58, 0xee, 0x7a, 0x77, 0x2e, 0x91, 0x48, 0x15, 0x47, 0xb0, 0xb3, 0x38, 0xad, 0x51, 0xfe, 0xe1,
+	0xe8, 0xd2, 0xda, 0xd0, 0xbb, 0xfb, 0x1a, 0x92, 0xe9, 0x82, 0xdf, 0x40, 0x67, 0x2e, 0xed, 0xd0,
+	0x3c, 0x8c, 0x9c, 0x4f, 0xf0, 0xde, 0xfb, 0x97, 0x0b, 0x25, 0x2b, 0x0c, 0xd7, 0xd5, 0xcf, 0xb9,
+	0x8f, 0xff, 0x3f, 0x00, 0xcd, 0x4a, 0x5c, 0xdf, 0xac, 0x1b, 0x00, 0x00,
 }
@@ -12,6 +12,10 @@ It has these top-level messages:
 	BatchDeleteRequest
 	BatchDeleteResponse
 	DeleteResult
+	ReadNeedleRequest
+	ReadNeedleResponse
+	WriteNeedleRequest
+	WriteNeedleResponse
 	Empty
 	VacuumVolumeCheckRequest
 	VacuumVolumeCheckResponse
@@ -68,8 +72,12 @@ It has these top-level messages:
 	TieredVolume
 	VolumeTierCopyDatToRemoteRequest
 	VolumeTierCopyDatToRemoteResponse
+	IoLimit
+	VolumeServerIoLimitRequest
+	VolumeServerIoLimitResponse
 	QueryRequest
 	QueriedStripe
+	VolumeInfo
 */
 package volume_server_pb
 
@@ -173,13 +181,319 @@ func (m *DeleteResult) GetVersion() uint32 {
 	return 0
 }
 
+type ReadNeedleRequest struct {
+	FileId string `protobuf:"bytes,1,opt,name=file_id,json=fileId" json:"file_id,omitempty"`
+	Offset int64  `protobuf:"varint,2,opt,name=offset" json:"offset,omitempty"`
+	Size   int64  `protobuf:"varint,3,opt,name=size" json:"size,omitempty"`
+	Jwt    string `protobuf:"bytes,4,opt,name=jwt" json:"jwt,omitempty"`
+}
+
+func (m *ReadNeedleRequest) Reset()                    { *m = ReadNeedleRequest{} }
+func (m *ReadNeedleRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReadNeedleRequest) ProtoMessage()               {}
+func (*ReadNeedleRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *ReadNeedleRequest) GetFileId() string {
+	if m != nil {
+		return m.FileId
+	}
+	return ""
+}
+
+func (m *ReadNeedleRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ReadNeedleRequest) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *ReadNeedleRequest) GetJwt() string {
+	if m != nil {
+		return m.Jwt
+	}
+	return ""
+}
+
+// the data of each file id is split into pieces, and the first piece has the attributes
+type ReadNeedleResponse struct {
+	FileId       string `protobuf:"bytes,1,opt,name=file_id,json=fileId" json:"file_id,omitempty"`
+	Data         []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	IsLast       bool   `protobuf:"varint,3,opt,name=is_last,json=isLast" json:"is_last,omitempty"`
+	Error        string `protobuf:"bytes,4,opt,name=error" json:"error,omitempty"`
+	Name         string `protobuf:"bytes,5,opt,name=name" json:"name,omitempty"`
+	Mime         string `protobuf:"bytes,6,opt,name=mime" json:"mime,omitempty"`
+	Etag         string `protobuf:"bytes,7,opt,name=etag" json:"etag,omitempty"`
+	LastModified uint64 `protobuf:"varint,8,opt,name=last_modified,json=lastModified" json:"last_modified,omitempty"`
+	TotalSize    int64  `protobuf:"varint,9,opt,name=total_size,json=totalSize" json:"total_size,omitempty"`
+}
+
+func (m *ReadNeedleResponse) Reset()                    { *m = ReadNeedleResponse{} }
+func (m *ReadNeedleResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReadNeedleResponse) ProtoMessage()               {}
+func (*ReadNeedleResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *ReadNeedleResponse) GetFileId() string {
+	if m != nil {
+		return m.FileId
+	}
+	return ""
+}
+
+func (m *ReadNeedleResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ReadNeedleResponse) GetIsLast() bool {
+	if m != nil {
+		return m.IsLast
+	}
+	return false
+}
+
+func (m *ReadNeedleResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ReadNeedleResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ReadNeedleResponse) GetMime() string {
+	if m != nil {
+		return m.Mime
+	}
+	return ""
+}
+
+func (m *ReadNeedleResponse) GetEtag() string {
+	if m != nil {
+		return m.Etag
+	}
+	return ""
+}
+
+func (m *ReadNeedleResponse) GetLastModified() uint64 {
+	if m != nil {
+		return m.LastModified
+	}
+	return 0
+}
+
+func (m *ReadNeedleResponse) GetTotalSize() int64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+// the data of each file id is split into pieces, and the first piece has the attributes
+type WriteNeedleRequest struct {
+	FileId          string            `protobuf:"bytes,1,opt,name=file_id,json=fileId" json:"file_id,omitempty"`
+	Data            []byte            `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	IsLast          bool              `protobuf:"varint,3,opt,name=is_last,json=isLast" json:"is_last,omitempty"`
+	Jwt             string            `protobuf:"bytes,4,opt,name=jwt" json:"jwt,omitempty"`
+	Name            string            `protobuf:"bytes,5,opt,name=name" json:"name,omitempty"`
+	Mime            string            `protobuf:"bytes,6,opt,name=mime" json:"mime,omitempty"`
+	Compression     string            `protobuf:"bytes,7,opt,name=compression" json:"compression,omitempty"`
+	Pairs           map[string]string `protobuf:"bytes,8,rep,name=pairs" json:"pairs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Ttl             string            `protobuf:"bytes,9,opt,name=ttl" json:"ttl,omitempty"`
+	LastModified    uint64            `protobuf:"varint,10,opt,name=last_modified,json=lastModified" json:"last_modified,omitempty"`
+	IsChunkManifest bool              `protobuf:"varint,11,opt,name=is_chunk_manifest,json=isChunkManifest" json:"is_chunk_manifest,omitempty"`
+	IsReplicate     bool              `protobuf:"varint,12,opt,name=is_replicate,json=isReplicate" json:"is_replicate,omitempty"`
+	Durability      string            `protobuf:"bytes,13,opt,name=durability" json:"durability,omitempty"`
+	ContentMd5      []byte            `protobuf:"bytes,14,opt,name=content_md5,json=contentMd5,proto3" json:"content_md5,omitempty"`
+	ContentSha256   []byte            `protobuf:"bytes,15,opt,name=content_sha256,json=contentSha256,proto3" json:"content_sha256,omitempty"`
+}
+
+func (m *WriteNeedleRequest) Reset()                    { *m = WriteNeedleRequest{} }
+func (m *WriteNeedleRequest) String() string            { return proto.CompactTextString(m) }
+func (*WriteNeedleRequest) ProtoMessage()               {}
+func (*WriteNeedleRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *WriteNeedleRequest) GetFileId() string {
+	if m != nil {
+		return m.FileId
+	}
+	return ""
+}
+
+func (m *WriteNeedleRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *WriteNeedleRequest) GetIsLast() bool {
+	if m != nil {
+		return m.IsLast
+	}
+	return false
+}
+
+func (m *WriteNeedleRequest) GetJwt() string {
+	if m != nil {
+		return m.Jwt
+	}
+	return ""
+}
+
+func (m *WriteNeedleRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WriteNeedleRequest) GetMime() string {
+	if m != nil {
+		return m.Mime
+	}
+	return ""
+}
+
+func (m *WriteNeedleRequest) GetCompression() string {
+	if m != nil {
+		return m.Compression
+	}
+	return ""
+}
+
+func (m *WriteNeedleRequest) GetPairs() map[string]string {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+func (m *WriteNeedleRequest) GetTtl() string {
+	if m != nil {
+		return m.Ttl
+	}
+	return ""
+}
+
+func (m *WriteNeedleRequest) GetLastModified() uint64 {
+	if m != nil {
+		return m.LastModified
+	}
+	return 0
+}
+
+func (m *WriteNeedleRequest) GetIsChunkManifest() bool {
+	if m != nil {
+		return m.IsChunkManifest
+	}
+	return false
+}
+
+func (m *WriteNeedleRequest) GetIsReplicate() bool {
+	if m != nil {
+		return m.IsReplicate
+	}
+	return false
+}
+
+func (m *WriteNeedleRequest) GetDurability() string {
+	if m != nil {
+		return m.Durability
+	}
+	return ""
+}
+
+func (m *WriteNeedleRequest) GetContentMd5() []byte {
+	if m != nil {
+		return m.ContentMd5
+	}
+	return nil
+}
+
+func (m *WriteNeedleRequest) GetContentSha256() []byte {
+	if m != nil {
+		return m.ContentSha256
+	}
+	return nil
+}
+
+type WriteNeedleResponse struct {
+	FileId      string `protobuf:"bytes,1,opt,name=file_id,json=fileId" json:"file_id,omitempty"`
+	Error       string `protobuf:"bytes,2,opt,name=error" json:"error,omitempty"`
+	Size        uint32 `protobuf:"varint,3,opt,name=size" json:"size,omitempty"`
+	Etag        string `protobuf:"bytes,4,opt,name=etag" json:"etag,omitempty"`
+	IsUnchanged bool   `protobuf:"varint,5,opt,name=is_unchanged,json=isUnchanged" json:"is_unchanged,omitempty"`
+	ContentMd5  []byte `protobuf:"bytes,6,opt,name=content_md5,json=contentMd5,proto3" json:"content_md5,omitempty"`
+}
+
+func (m *WriteNeedleResponse) Reset()                    { *m = WriteNeedleResponse{} }
+func (m *WriteNeedleResponse) String() string            { return proto.CompactTextString(m) }
+func (*WriteNeedleResponse) ProtoMessage()               {}
+func (*WriteNeedleResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *WriteNeedleResponse) GetFileId() string {
+	if m != nil {
+		return m.FileId
+	}
+	return ""
+}
+
+func (m *WriteNeedleResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *WriteNeedleResponse) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *WriteNeedleResponse) GetEtag() string {
+	if m != nil {
+		return m.Etag
+	}
+	return ""
+}
+
+func (m *WriteNeedleResponse) GetIsUnchanged() bool {
+	if m != nil {
+		return m.IsUnchanged
+	}
+	return false
+}
+
+func (m *WriteNeedleResponse) GetContentMd5() []byte {
+	if m != nil {
+		return m.ContentMd5
+	}
+	return nil
+}
+
 type Empty struct {
 }
 
 func (m *Empty) Reset()                    { *m = Empty{} }
 func (m *Empty) String() string            { return proto.CompactTextString(m) }
 func (*Empty) ProtoMessage()               {}
-func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 type VacuumVolumeCheckRequest struct {
 	VolumeId uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
@@ -188,7 +502,7 @@ type VacuumVolumeCheckRequest struct {
 func (m *VacuumVolumeCheckRequest) Reset()                    { *m = VacuumVolumeCheckRequest{} }
 func (m *VacuumVolumeCheckRequest) String() string            { return proto.CompactTextString(m) }
 func (*VacuumVolumeCheckRequest) ProtoMessage()               {}
-func (*VacuumVolumeCheckRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*VacuumVolumeCheckRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 func (m *VacuumVolumeCheckRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -204,7 +518,7 @@ type VacuumVolumeCheckResponse struct {
 func (m *VacuumVolumeCheckResponse) Reset()                    { *m = VacuumVolumeCheckResponse{} }
 func (m *VacuumVolumeCheckResponse) String() string            { return proto.CompactTextString(m) }
 func (*VacuumVolumeCheckResponse) ProtoMessage()               {}
-func (*VacuumVolumeCheckResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*VacuumVolumeCheckResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *VacuumVolumeCheckResponse) GetGarbageRatio() float64 {
 	if m != nil {
@@ -221,7 +535,7 @@ type VacuumVolumeCompactRequest struct {
 func (m *VacuumVolumeCompactRequest) Reset()                    { *m = VacuumVolumeCompactRequest{} }
 func (m *VacuumVolumeCompactRequest) String() string            { return proto.CompactTextString(m) }
 func (*VacuumVolumeCompactRequest) ProtoMessage()               {}
-func (*VacuumVolumeCompactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*VacuumVolumeCompactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *VacuumVolumeCompactRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -243,7 +557,7 @@ type VacuumVolumeCompactResponse struct {
 func (m *VacuumVolumeCompactResponse) Reset()                    { *m = VacuumVolumeCompactResponse{} }
 func (m *VacuumVolumeCompactResponse) String() string            { return proto.CompactTextString(m) }
 func (*VacuumVolumeCompactResponse) ProtoMessage()               {}
-func (*VacuumVolumeCompactResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*VacuumVolumeCompactResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 type VacuumVolumeCommitRequest struct {
 	VolumeId uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
@@ -252,7 +566,7 @@ type VacuumVolumeCommitRequest struct {
 func (m *VacuumVolumeCommitRequest) Reset()                    { *m = VacuumVolumeCommitRequest{} }
 func (m *VacuumVolumeCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*VacuumVolumeCommitRequest) ProtoMessage()               {}
-func (*VacuumVolumeCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*VacuumVolumeCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *VacuumVolumeCommitRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -267,7 +581,7 @@ type VacuumVolumeCommitResponse struct {
 func (m *VacuumVolumeCommitResponse) Reset()                    { *m = VacuumVolumeCommitResponse{} }
 func (m *VacuumVolumeCommitResponse) String() string            { return proto.CompactTextString(m) }
 func (*VacuumVolumeCommitResponse) ProtoMessage()               {}
-func (*VacuumVolumeCommitResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*VacuumVolumeCommitResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 type VacuumVolumeCleanupRequest struct {
 	VolumeId uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
@@ -276,7 +590,7 @@ type VacuumVolumeCleanupRequest struct {
 func (m *VacuumVolumeCleanupRequest) Reset()                    { *m = VacuumVolumeCleanupRequest{} }
 func (m *VacuumVolumeCleanupRequest) String() string            { return proto.CompactTextString(m) }
 func (*VacuumVolumeCleanupRequest) ProtoMessage()               {}
-func (*VacuumVolumeCleanupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*VacuumVolumeCleanupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *VacuumVolumeCleanupRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -291,7 +605,7 @@ type VacuumVolumeCleanupResponse struct {
 func (m *VacuumVolumeCleanupResponse) Reset()                    { *m = VacuumVolumeCleanupResponse{} }
 func (m *VacuumVolumeCleanupResponse) String() string            { return proto.CompactTextString(m) }
 func (*VacuumVolumeCleanupResponse) ProtoMessage()               {}
-func (*VacuumVolumeCleanupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*VacuumVolumeCleanupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 type DeleteCollectionRequest struct {
 	Collection string `protobuf:"bytes,1,opt,name=collection" json:"collection,omitempty"`
@@ -300,7 +614,7 @@ type DeleteCollectionRequest struct {
 func (m *DeleteCollectionRequest) Reset()                    { *m = DeleteCollectionRequest{} }
 func (m *DeleteCollectionRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteCollectionRequest) ProtoMessage()               {}
-func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *DeleteCollectionRequest) GetCollection() string {
 	if m != nil {
@@ -315,7 +629,7 @@ type DeleteCollectionResponse struct {
 func (m *DeleteCollectionResponse) Reset()                    { *m = DeleteCollectionResponse{} }
 func (m *DeleteCollectionResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteCollectionResponse) ProtoMessage()               {}
-func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 type AllocateVolumeRequest struct {
 	VolumeId           uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
@@ -324,12 +638,13 @@ type AllocateVolumeRequest struct {
 	Replication        string `protobuf:"bytes,4,opt,name=replication" json:"replication,omitempty"`
 	Ttl                string `protobuf:"bytes,5,opt,name=ttl" json:"ttl,omitempty"`
 	MemoryMapMaxSizeMb uint32 `protobuf:"varint,6,opt,name=memory_map_max_size_mb,json=memoryMapMaxSizeMb" json:"memory_map_max_size_mb,omitempty"`
+	Durability         string `protobuf:"bytes,7,opt,name=durability" json:"durability,omitempty"`
 }
 
 func (m *AllocateVolumeRequest) Reset()                    { *m = AllocateVolumeRequest{} }
 func (m *AllocateVolumeRequest) String() string            { return proto.CompactTextString(m) }
 func (*AllocateVolumeRequest) ProtoMessage()               {}
-func (*AllocateVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*AllocateVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 func (m *AllocateVolumeRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -373,13 +688,20 @@ func (m *AllocateVolumeRequest) GetMemoryMapMaxSizeMb() uint32 {
 	return 0
 }
 
+func (m *AllocateVolumeRequest) GetDurability() string {
+	if m != nil {
+		return m.Durability
+	}
+	return ""
+}
+
 type AllocateVolumeResponse struct {
 }
 
 func (m *AllocateVolumeResponse) Reset()                    { *m = AllocateVolumeResponse{} }
 func (m *AllocateVolumeResponse) String() string            { return proto.CompactTextString(m) }
 func (*AllocateVolumeResponse) ProtoMessage()               {}
-func (*AllocateVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*AllocateVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 type VolumeSyncStatusRequest struct {
 	VolumeId uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
@@ -388,7 +710,7 @@ type VolumeSyncStatusRequest struct {
 func (m *VolumeSyncStatusRequest) Reset()                    { *m = VolumeSyncStatusRequest{} }
 func (m *VolumeSyncStatusRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeSyncStatusRequest) ProtoMessage()               {}
-func (*VolumeSyncStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*VolumeSyncStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 func (m *VolumeSyncStatusRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -410,7 +732,7 @@ type VolumeSyncStatusResponse struct {
 func (m *VolumeSyncStatusResponse) Reset()                    { *m = VolumeSyncStatusResponse{} }
 func (m *VolumeSyncStatusResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeSyncStatusResponse) ProtoMessage()               {}
-func (*VolumeSyncStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*VolumeSyncStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *VolumeSyncStatusResponse) GetVolumeId() uint32 {
 	if m != nil {
@@ -469,7 +791,7 @@ type VolumeIncrementalCopyRequest struct {
 func (m *VolumeIncrementalCopyRequest) Reset()                    { *m = VolumeIncrementalCopyRequest{} }
 func (m *VolumeIncrementalCopyRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeIncrementalCopyRequest) ProtoMessage()               {}
-func (*VolumeIncrementalCopyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*VolumeIncrementalCopyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *VolumeIncrementalCopyRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -492,7 +814,7 @@ type VolumeIncrementalCopyResponse struct {
 func (m *VolumeIncrementalCopyResponse) Reset()                    { *m = VolumeIncrementalCopyResponse{} }
 func (m *VolumeIncrementalCopyResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeIncrementalCopyResponse) ProtoMessage()               {}
-func (*VolumeIncrementalCopyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*VolumeIncrementalCopyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *VolumeIncrementalCopyResponse) GetFileContent() []byte {
 	if m != nil {
@@ -508,7 +830,7 @@ type VolumeMountRequest struct {
 func (m *VolumeMountRequest) Reset()                    { *m = VolumeMountRequest{} }
 func (m *VolumeMountRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeMountRequest) ProtoMessage()               {}
-func (*VolumeMountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*VolumeMountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *VolumeMountRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -523,7 +845,7 @@ type VolumeMountResponse struct {
 func (m *VolumeMountResponse) Reset()                    { *m = VolumeMountResponse{} }
 func (m *VolumeMountResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeMountResponse) ProtoMessage()               {}
-func (*VolumeMountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*VolumeMountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 type VolumeUnmountRequest struct {
 	VolumeId uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
@@ -532,7 +854,7 @@ type VolumeUnmountRequest struct {
 func (m *VolumeUnmountRequest) Reset()                    { *m = VolumeUnmountRequest{} }
 func (m *VolumeUnmountRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeUnmountRequest) ProtoMessage()               {}
-func (*VolumeUnmountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*VolumeUnmountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *VolumeUnmountRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -547,7 +869,7 @@ type VolumeUnmountResponse struct {
 func (m *VolumeUnmountResponse) Reset()                    { *m = VolumeUnmountResponse{} }
 func (m *VolumeUnmountResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeUnmountResponse) ProtoMessage()               {}
-func (*VolumeUnmountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*VolumeUnmountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 type VolumeDeleteRequest struct {
 	VolumeId uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
@@ -556,7 +878,7 @@ type VolumeDeleteRequest struct {
 func (m *VolumeDeleteRequest) Reset()                    { *m = VolumeDeleteRequest{} }
 func (m *VolumeDeleteRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeDeleteRequest) ProtoMessage()               {}
-func (*VolumeDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*VolumeDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 func (m *VolumeDeleteRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -571,7 +893,7 @@ type VolumeDeleteResponse struct {
 func (m *VolumeDeleteResponse) Reset()                    { *m = VolumeDeleteResponse{} }
 func (m *VolumeDeleteResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeDeleteResponse) ProtoMessage()               {}
-func (*VolumeDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*VolumeDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
 type VolumeMarkReadonlyRequest struct {
 	VolumeId uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
@@ -580,7 +902,7 @@ type VolumeMarkReadonlyRequest struct {
 func (m *VolumeMarkReadonlyRequest) Reset()                    { *m = VolumeMarkReadonlyRequest{} }
 func (m *VolumeMarkReadonlyRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeMarkReadonlyRequest) ProtoMessage()               {}
-func (*VolumeMarkReadonlyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*VolumeMarkReadonlyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
 func (m *VolumeMarkReadonlyRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -595,7 +917,7 @@ type VolumeMarkReadonlyResponse struct {
 func (m *VolumeMarkReadonlyResponse) Reset()                    { *m = VolumeMarkReadonlyResponse{} }
 func (m *VolumeMarkReadonlyResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeMarkReadonlyResponse) ProtoMessage()               {}
-func (*VolumeMarkReadonlyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*VolumeMarkReadonlyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
 type VolumeCopyRequest struct {
 	VolumeId       uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
@@ -608,7 +930,7 @@ type VolumeCopyRequest struct {
 func (m *VolumeCopyRequest) Reset()                    { *m = VolumeCopyRequest{} }
 func (m *VolumeCopyRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeCopyRequest) ProtoMessage()               {}
-func (*VolumeCopyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*VolumeCopyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
 
 func (m *VolumeCopyRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -652,7 +974,7 @@ type VolumeCopyResponse struct {
 func (m *VolumeCopyResponse) Reset()                    { *m = VolumeCopyResponse{} }
 func (m *VolumeCopyResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeCopyResponse) ProtoMessage()               {}
-func (*VolumeCopyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*VolumeCopyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
 
 func (m *VolumeCopyResponse) GetLastAppendAtNs() uint64 {
 	if m != nil {
@@ -673,7 +995,7 @@ type CopyFileRequest struct {
 func (m *CopyFileRequest) Reset()                    { *m = CopyFileRequest{} }
 func (m *CopyFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*CopyFileRequest) ProtoMessage()               {}
-func (*CopyFileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*CopyFileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
 
 func (m *CopyFileRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -724,7 +1046,7 @@ type CopyFileResponse struct {
 func (m *CopyFileResponse) Reset()                    { *m = CopyFileResponse{} }
 func (m *CopyFileResponse) String() string            { return proto.CompactTextString(m) }
 func (*CopyFileResponse) ProtoMessage()               {}
-func (*CopyFileResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*CopyFileResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
 
 func (m *CopyFileResponse) GetFileContent() []byte {
 	if m != nil {
@@ -742,7 +1064,7 @@ type VolumeTailSenderRequest struct {
 func (m *VolumeTailSenderRequest) Reset()                    { *m = VolumeTailSenderRequest{} }
 func (m *VolumeTailSenderRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeTailSenderRequest) ProtoMessage()               {}
-func (*VolumeTailSenderRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (*VolumeTailSenderRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
 
 func (m *VolumeTailSenderRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -774,7 +1096,7 @@ type VolumeTailSenderResponse struct {
 func (m *VolumeTailSenderResponse) Reset()                    { *m = VolumeTailSenderResponse{} }
 func (m *VolumeTailSenderResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeTailSenderResponse) ProtoMessage()               {}
-func (*VolumeTailSenderResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (*VolumeTailSenderResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
 
 func (m *VolumeTailSenderResponse) GetNeedleHeader() []byte {
 	if m != nil {
@@ -807,7 +1129,7 @@ type VolumeTailReceiverRequest struct {
 func (m *VolumeTailReceiverRequest) Reset()                    { *m = VolumeTailReceiverRequest{} }
 func (m *VolumeTailReceiverRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeTailReceiverRequest) ProtoMessage()               {}
-func (*VolumeTailReceiverRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+func (*VolumeTailReceiverRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
 
 func (m *VolumeTailReceiverRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -843,7 +1165,7 @@ type VolumeTailReceiverResponse struct {
 func (m *VolumeTailReceiverResponse) Reset()                    { *m = VolumeTailReceiverResponse{} }
 func (m *VolumeTailReceiverResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeTailReceiverResponse) ProtoMessage()               {}
-func (*VolumeTailReceiverResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+func (*VolumeTailReceiverResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
 
 type VolumeEcShardsGenerateRequest struct {
 	VolumeId   uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
@@ -853,7 +1175,7 @@ type VolumeEcShardsGenerateRequest struct {
 func (m *VolumeEcShardsGenerateRequest) Reset()                    { *m = VolumeEcShardsGenerateRequest{} }
 func (m *VolumeEcShardsGenerateRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsGenerateRequest) ProtoMessage()               {}
-func (*VolumeEcShardsGenerateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+func (*VolumeEcShardsGenerateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
 
 func (m *VolumeEcShardsGenerateRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -872,10 +1194,12 @@ func (m *VolumeEcShardsGenerateRequest) GetCollection() string {
 type VolumeEcShardsGenerateResponse struct {
 }
 
-func (m *VolumeEcShardsGenerateResponse) Reset()                    { *m = VolumeEcShardsGenerateResponse{} }
-func (m *VolumeEcShardsGenerateResponse) String() string            { return proto.CompactTextString(m) }
-func (*VolumeEcShardsGenerateResponse) ProtoMessage()               {}
-func (*VolumeEcShardsGenerateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+func (m *VolumeEcShardsGenerateResponse) Reset()         { *m = VolumeEcShardsGenerateResponse{} }
+func (m *VolumeEcShardsGenerateResponse) String() string { return proto.CompactTextString(m) }
+func (*VolumeEcShardsGenerateResponse) ProtoMessage()    {}
+func (*VolumeEcShardsGenerateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{41}
+}
 
 type VolumeEcShardsRebuildRequest struct {
 	VolumeId   uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
@@ -885,7 +1209,7 @@ type VolumeEcShardsRebuildRequest struct {
 func (m *VolumeEcShardsRebuildRequest) Reset()                    { *m = VolumeEcShardsRebuildRequest{} }
 func (m *VolumeEcShardsRebuildRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsRebuildRequest) ProtoMessage()               {}
-func (*VolumeEcShardsRebuildRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+func (*VolumeEcShardsRebuildRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
 
 func (m *VolumeEcShardsRebuildRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -908,7 +1232,7 @@ type VolumeEcShardsRebuildResponse struct {
 func (m *VolumeEcShardsRebuildResponse) Reset()                    { *m = VolumeEcShardsRebuildResponse{} }
 func (m *VolumeEcShardsRebuildResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsRebuildResponse) ProtoMessage()               {}
-func (*VolumeEcShardsRebuildResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+func (*VolumeEcShardsRebuildResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
 
 func (m *VolumeEcShardsRebuildResponse) GetRebuiltShardIds() []uint32 {
 	if m != nil {
@@ -928,7 +1252,7 @@ type VolumeEcShardsCopyRequest struct {
 func (m *VolumeEcShardsCopyRequest) Reset()                    { *m = VolumeEcShardsCopyRequest{} }
 func (m *VolumeEcShardsCopyRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsCopyRequest) ProtoMessage()               {}
-func (*VolumeEcShardsCopyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+func (*VolumeEcShardsCopyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
 
 func (m *VolumeEcShardsCopyRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -971,7 +1295,7 @@ type VolumeEcShardsCopyResponse struct {
 func (m *VolumeEcShardsCopyResponse) Reset()                    { *m = VolumeEcShardsCopyResponse{} }
 func (m *VolumeEcShardsCopyResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsCopyResponse) ProtoMessage()               {}
-func (*VolumeEcShardsCopyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+func (*VolumeEcShardsCopyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
 
 type VolumeEcShardsDeleteRequest struct {
 	VolumeId   uint32   `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
@@ -982,7 +1306,7 @@ type VolumeEcShardsDeleteRequest struct {
 func (m *VolumeEcShardsDeleteRequest) Reset()                    { *m = VolumeEcShardsDeleteRequest{} }
 func (m *VolumeEcShardsDeleteRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsDeleteRequest) ProtoMessage()               {}
-func (*VolumeEcShardsDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+func (*VolumeEcShardsDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
 
 func (m *VolumeEcShardsDeleteRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -1011,7 +1335,7 @@ type VolumeEcShardsDeleteResponse struct {
 func (m *VolumeEcShardsDeleteResponse) Reset()                    { *m = VolumeEcShardsDeleteResponse{} }
 func (m *VolumeEcShardsDeleteResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsDeleteResponse) ProtoMessage()               {}
-func (*VolumeEcShardsDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+func (*VolumeEcShardsDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
 
 type VolumeEcShardsMountRequest struct {
 	VolumeId   uint32   `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
@@ -1022,7 +1346,7 @@ type VolumeEcShardsMountRequest struct {
 func (m *VolumeEcShardsMountRequest) Reset()                    { *m = VolumeEcShardsMountRequest{} }
 func (m *VolumeEcShardsMountRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsMountRequest) ProtoMessage()               {}
-func (*VolumeEcShardsMountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+func (*VolumeEcShardsMountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
 
 func (m *VolumeEcShardsMountRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -1051,7 +1375,7 @@ type VolumeEcShardsMountResponse struct {
 func (m *VolumeEcShardsMountResponse) Reset()                    { *m = VolumeEcShardsMountResponse{} }
 func (m *VolumeEcShardsMountResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsMountResponse) ProtoMessage()               {}
-func (*VolumeEcShardsMountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+func (*VolumeEcShardsMountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
 
 type VolumeEcShardsUnmountRequest struct {
 	VolumeId uint32   `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
@@ -1061,7 +1385,7 @@ type VolumeEcShardsUnmountRequest struct {
 func (m *VolumeEcShardsUnmountRequest) Reset()                    { *m = VolumeEcShardsUnmountRequest{} }
 func (m *VolumeEcShardsUnmountRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsUnmountRequest) ProtoMessage()               {}
-func (*VolumeEcShardsUnmountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+func (*VolumeEcShardsUnmountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
 
 func (m *VolumeEcShardsUnmountRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -1083,7 +1407,7 @@ type VolumeEcShardsUnmountResponse struct {
 func (m *VolumeEcShardsUnmountResponse) Reset()                    { *m = VolumeEcShardsUnmountResponse{} }
 func (m *VolumeEcShardsUnmountResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardsUnmountResponse) ProtoMessage()               {}
-func (*VolumeEcShardsUnmountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+func (*VolumeEcShardsUnmountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
 
 type VolumeEcShardReadRequest struct {
 	VolumeId uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
@@ -1096,7 +1420,7 @@ type VolumeEcShardReadRequest struct {
 func (m *VolumeEcShardReadRequest) Reset()                    { *m = VolumeEcShardReadRequest{} }
 func (m *VolumeEcShardReadRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardReadRequest) ProtoMessage()               {}
-func (*VolumeEcShardReadRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+func (*VolumeEcShardReadRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
 
 func (m *VolumeEcShardReadRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -1141,7 +1465,7 @@ type VolumeEcShardReadResponse struct {
 func (m *VolumeEcShardReadResponse) Reset()                    { *m = VolumeEcShardReadResponse{} }
 func (m *VolumeEcShardReadResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcShardReadResponse) ProtoMessage()               {}
-func (*VolumeEcShardReadResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+func (*VolumeEcShardReadResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
 
 func (m *VolumeEcShardReadResponse) GetData() []byte {
 	if m != nil {
@@ -1167,7 +1491,7 @@ type VolumeEcBlobDeleteRequest struct {
 func (m *VolumeEcBlobDeleteRequest) Reset()                    { *m = VolumeEcBlobDeleteRequest{} }
 func (m *VolumeEcBlobDeleteRequest) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcBlobDeleteRequest) ProtoMessage()               {}
-func (*VolumeEcBlobDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+func (*VolumeEcBlobDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
 
 func (m *VolumeEcBlobDeleteRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -1203,7 +1527,7 @@ type VolumeEcBlobDeleteResponse struct {
 func (m *VolumeEcBlobDeleteResponse) Reset()                    { *m = VolumeEcBlobDeleteResponse{} }
 func (m *VolumeEcBlobDeleteResponse) String() string            { return proto.CompactTextString(m) }
 func (*VolumeEcBlobDeleteResponse) ProtoMessage()               {}
-func (*VolumeEcBlobDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+func (*VolumeEcBlobDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
 
 type ReadVolumeFileStatusRequest struct {
 	VolumeId uint32 `protobuf:"varint,1,opt,name=volume_id,json=volumeId" json:"volume_id,omitempty"`
@@ -1212,7 +1536,7 @@ type ReadVolumeFileStatusRequest struct {
 func (m *ReadVolumeFileStatusRequest) Reset()                    { *m = ReadVolumeFileStatusRequest{} }
 func (m *ReadVolumeFileStatusRequest) String() string            { return proto.CompactTextString(m) }
 func (*ReadVolumeFileStatusRequest) ProtoMessage()               {}
-func (*ReadVolumeFileStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+func (*ReadVolumeFileStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
 
 func (m *ReadVolumeFileStatusRequest) GetVolumeId() uint32 {
 	if m != nil {
@@ -1235,7 +1559,7 @@ type ReadVolumeFileStatusResponse struct {
 func (m *ReadVolumeFileStatusResponse) Reset()                    { *m = ReadVolumeFileStatusResponse{} }
 func (m *ReadVolumeFileStatusResponse) String() string            { return proto.CompactTextString(m) }
 func (*ReadVolumeFileStatusResponse) ProtoMessage()               {}
-func (*ReadVolumeFileStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+func (*ReadVolumeFileStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
 
 func (m *ReadVolumeFileStatusResponse) GetVolumeId() uint32 {
 	if m != nil {
@@ -1303,7 +1627,7 @@ type DiskStatus struct {
 func (m *DiskStatus) Reset()                    { *m = DiskStatus{} }
 func (m *DiskStatus) String() string            { return proto.CompactTextString(m) }
 func (*DiskStatus) ProtoMessage()               {}
-func (*DiskStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+func (*DiskStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
 
 func (m *DiskStatus) GetDir() string {
 	if m != nil {
@@ -1346,7 +1670,7 @@ type MemStatus struct {
 func (m *MemStatus) Reset()                    { *m = MemStatus{} }
 func (m *MemStatus) String() string            { return proto.CompactTextString(m) }
 func (*MemStatus) ProtoMessage()               {}
-func (*MemStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+func (*MemStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
 
 func (m *MemStatus) GetGoroutines() int32 {
 	if m != nil {
@@ -1407,7 +1731,7 @@ type TieredVolume struct {
 func (m *TieredVolume) Reset()                    { *m = TieredVolume{} }
 func (m *TieredVolume) String() string            { return proto.CompactTextString(m) }
 func (*TieredVolume) ProtoMessage()               {}
-func (*TieredVolume) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+func (*TieredVolume) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
 
 func (m *TieredVolume) GetBackendType() string {
 	if m != nil {
@@ -1440,7 +1764,7 @@ func (m *VolumeTierCopyDatToRemoteRequest) Reset()         { *m = VolumeTierCopy
 func (m *VolumeTierCopyDatToRemoteRequest) String() string { return proto.CompactTextString(m) }
 func (*VolumeTierCopyDatToRemoteRequest) ProtoMessage()    {}
 func (*VolumeTierCopyDatToRemoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{57}
+	return fileDescriptor0, []int{61}
 }
 
 func (m *VolumeTierCopyDatToRemoteRequest) GetVolumeId() uint32 {
@@ -1471,7 +1795,65 @@ func (m *VolumeTierCopyDatToRemoteResponse) Reset()         { *m = VolumeTierCop
 func (m *VolumeTierCopyDatToRemoteResponse) String() string { return proto.CompactTextString(m) }
 func (*VolumeTierCopyDatToRemoteResponse) ProtoMessage()    {}
 func (*VolumeTierCopyDatToRemoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{58}
+	return fileDescriptor0, []int{62}
+}
+
+// 0 bytes_per_second means unlimited
+type IoLimit struct {
+	Category       string `protobuf:"bytes,1,opt,name=category" json:"category,omitempty"`
+	BytesPerSecond int64  `protobuf:"varint,2,opt,name=bytes_per_second,json=bytesPerSecond" json:"bytes_per_second,omitempty"`
+}
+
+func (m *IoLimit) Reset()                    { *m = IoLimit{} }
+func (m *IoLimit) String() string            { return proto.CompactTextString(m) }
+func (*IoLimit) ProtoMessage()               {}
+func (*IoLimit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+
+func (m *IoLimit) GetCategory() string {
+	if m != nil {
+		return m.Category
+	}
+	return ""
+}
+
+func (m *IoLimit) GetBytesPerSecond() int64 {
+	if m != nil {
+		return m.BytesPerSecond
+	}
+	return 0
+}
+
+// change the bandwidth budgets of the listed categories, and return the budgets of all categories
+type VolumeServerIoLimitRequest struct {
+	IoLimits []*IoLimit `protobuf:"bytes,1,rep,name=io_limits,json=ioLimits" json:"io_limits,omitempty"`
+}
+
+func (m *VolumeServerIoLimitRequest) Reset()                    { *m = VolumeServerIoLimitRequest{} }
+func (m *VolumeServerIoLimitRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeServerIoLimitRequest) ProtoMessage()               {}
+func (*VolumeServerIoLimitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+
+func (m *VolumeServerIoLimitRequest) GetIoLimits() []*IoLimit {
+	if m != nil {
+		return m.IoLimits
+	}
+	return nil
+}
+
+type VolumeServerIoLimitResponse struct {
+	IoLimits []*IoLimit `protobuf:"bytes,1,rep,name=io_limits,json=ioLimits" json:"io_limits,omitempty"`
+}
+
+func (m *VolumeServerIoLimitResponse) Reset()                    { *m = VolumeServerIoLimitResponse{} }
+func (m *VolumeServerIoLimitResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeServerIoLimitResponse) ProtoMessage()               {}
+func (*VolumeServerIoLimitResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+
+func (m *VolumeServerIoLimitResponse) GetIoLimits() []*IoLimit {
+	if m != nil {
+		return m.IoLimits
+	}
+	return nil
 }
 
 // select on volume servers
@@ -1486,7 +1868,7 @@ type QueryRequest struct {
 func (m *QueryRequest) Reset()                    { *m = QueryRequest{} }
 func (m *QueryRequest) String() string            { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()               {}
-func (*QueryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+func (*QueryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
 
 func (m *QueryRequest) GetSelections() []string {
 	if m != nil {
@@ -1532,7 +1914,7 @@ type QueryRequest_Filter struct {
 func (m *QueryRequest_Filter) Reset()                    { *m = QueryRequest_Filter{} }
 func (m *QueryRequest_Filter) String() string            { return proto.CompactTextString(m) }
 func (*QueryRequest_Filter) ProtoMessage()               {}
-func (*QueryRequest_Filter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59, 0} }
+func (*QueryRequest_Filter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66, 0} }
 
 func (m *QueryRequest_Filter) GetField() string {
 	if m != nil {
@@ -1567,7 +1949,7 @@ func (m *QueryRequest_InputSerialization) Reset()         { *m = QueryRequest_In
 func (m *QueryRequest_InputSerialization) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest_InputSerialization) ProtoMessage()    {}
 func (*QueryRequest_InputSerialization) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{59, 1}
+	return fileDescriptor0, []int{66, 1}
 }
 
 func (m *QueryRequest_InputSerialization) GetCompressionType() string {
@@ -1615,7 +1997,7 @@ func (m *QueryRequest_InputSerialization_CSVInput) Reset() {
 func (m *QueryRequest_InputSerialization_CSVInput) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest_InputSerialization_CSVInput) ProtoMessage()    {}
 func (*QueryRequest_InputSerialization_CSVInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{59, 1, 0}
+	return fileDescriptor0, []int{66, 1, 0}
 }
 
 func (m *QueryRequest_InputSerialization_CSVInput) GetFileHeaderInfo() string {
@@ -1674,10 +2056,12 @@ type QueryRequest_InputSerialization_JSONInput struct {
 func (m *QueryRequest_InputSerialization_JSONInput) Reset() {
 	*m = QueryRequest_InputSerialization_JSONInput{}
 }
-func (m *QueryRequest_InputSerialization_JSONInput) String() string { return proto.CompactTextString(m) }
-func (*QueryRequest_InputSerialization_JSONInput) ProtoMessage()    {}
+func (m *QueryRequest_InputSerialization_JSONInput) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryRequest_InputSerialization_JSONInput) ProtoMessage() {}
 func (*QueryRequest_InputSerialization_JSONInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{59, 1, 1}
+	return fileDescriptor0, []int{66, 1, 1}
 }
 
 func (m *QueryRequest_InputSerialization_JSONInput) GetType() string {
@@ -1698,7 +2082,7 @@ func (m *QueryRequest_InputSerialization_ParquetInput) String() string {
 }
 func (*QueryRequest_InputSerialization_ParquetInput) ProtoMessage() {}
 func (*QueryRequest_InputSerialization_ParquetInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{59, 1, 2}
+	return fileDescriptor0, []int{66, 1, 2}
 }
 
 type QueryRequest_OutputSerialization struct {
@@ -1710,7 +2094,7 @@ func (m *QueryRequest_OutputSerialization) Reset()         { *m = QueryRequest_O
 func (m *QueryRequest_OutputSerialization) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest_OutputSerialization) ProtoMessage()    {}
 func (*QueryRequest_OutputSerialization) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{59, 2}
+	return fileDescriptor0, []int{66, 2}
 }
 
 func (m *QueryRequest_OutputSerialization) GetCsvOutput() *QueryRequest_OutputSerialization_CSVOutput {
@@ -1743,7 +2127,7 @@ func (m *QueryRequest_OutputSerialization_CSVOutput) String() string {
 }
 func (*QueryRequest_OutputSerialization_CSVOutput) ProtoMessage() {}
 func (*QueryRequest_OutputSerialization_CSVOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{59, 2, 0}
+	return fileDescriptor0, []int{66, 2, 0}
 }
 
 func (m *QueryRequest_OutputSerialization_CSVOutput) GetQuoteFields() string {
@@ -1793,7 +2177,7 @@ func (m *QueryRequest_OutputSerialization_JSONOutput) String() string {
 }
 func (*QueryRequest_OutputSerialization_JSONOutput) ProtoMessage() {}
 func (*QueryRequest_OutputSerialization_JSONOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{59, 2, 1}
+	return fileDescriptor0, []int{66, 2, 1}
 }
 
 func (m *QueryRequest_OutputSerialization_JSONOutput) GetRecordDelimiter() string {
@@ -1810,7 +2194,7 @@ type QueriedStripe struct {
 func (m *QueriedStripe) Reset()                    { *m = QueriedStripe{} }
 func (m *QueriedStripe) String() string            { return proto.CompactTextString(m) }
 func (*QueriedStripe) ProtoMessage()               {}
-func (*QueriedStripe) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+func (*QueriedStripe) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
 
 func (m *QueriedStripe) GetRecords() []byte {
 	if m != nil {
@@ -1819,10 +2203,47 @@ func (m *QueriedStripe) GetRecords() []byte {
 	return nil
 }
 
+// saved in the .vif file of each volume
+type VolumeInfo struct {
+	Version     uint32 `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	BytesOffset uint32 `protobuf:"varint,2,opt,name=bytes_offset,json=bytesOffset" json:"bytes_offset,omitempty"`
+	Durability  string `protobuf:"bytes,3,opt,name=durability" json:"durability,omitempty"`
+}
+
+func (m *VolumeInfo) Reset()                    { *m = VolumeInfo{} }
+func (m *VolumeInfo) String() string            { return proto.CompactTextString(m) }
+func (*VolumeInfo) ProtoMessage()               {}
+func (*VolumeInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+
+func (m *VolumeInfo) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *VolumeInfo) GetBytesOffset() uint32 {
+	if m != nil {
+		return m.BytesOffset
+	}
+	return 0
+}
+
+func (m *VolumeInfo) GetDurability() string {
+	if m != nil {
+		return m.Durability
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*BatchDeleteRequest)(nil), "volume_server_pb.BatchDeleteRequest")
 	proto.RegisterType((*BatchDeleteResponse)(nil), "volume_server_pb.BatchDeleteResponse")
 	proto.RegisterType((*DeleteResult)(nil), "volume_server_pb.DeleteResult")
+	proto.RegisterType((*ReadNeedleRequest)(nil), "volume_server_pb.ReadNeedleRequest")
+	proto.RegisterType((*ReadNeedleResponse)(nil), "volume_server_pb.ReadNeedleResponse")
+	proto.RegisterType((*WriteNeedleRequest)(nil), "volume_server_pb.WriteNeedleRequest")
+	proto.RegisterType((*WriteNeedleResponse)(nil), "volume_server_pb.WriteNeedleResponse")
 	proto.RegisterType((*Empty)(nil), "volume_server_pb.Empty")
 	proto.RegisterType((*VacuumVolumeCheckRequest)(nil), "volume_server_pb.VacuumVolumeCheckRequest")
 	proto.RegisterType((*VacuumVolumeCheckResponse)(nil), "volume_server_pb.VacuumVolumeCheckResponse")
@@ -1879,6 +2300,9 @@ func init() {
 	proto.RegisterType((*TieredVolume)(nil), "volume_server_pb.TieredVolume")
 	proto.RegisterType((*VolumeTierCopyDatToRemoteRequest)(nil), "volume_server_pb.VolumeTierCopyDatToRemoteRequest")
 	proto.RegisterType((*VolumeTierCopyDatToRemoteResponse)(nil), "volume_server_pb.VolumeTierCopyDatToRemoteResponse")
+	proto.RegisterType((*IoLimit)(nil), "volume_server_pb.IoLimit")
+	proto.RegisterType((*VolumeServerIoLimitRequest)(nil), "volume_server_pb.VolumeServerIoLimitRequest")
+	proto.RegisterType((*VolumeServerIoLimitResponse)(nil), "volume_server_pb.VolumeServerIoLimitResponse")
 	proto.RegisterType((*QueryRequest)(nil), "volume_server_pb.QueryRequest")
 	proto.RegisterType((*QueryRequest_Filter)(nil), "volume_server_pb.QueryRequest.Filter")
 	proto.RegisterType((*QueryRequest_InputSerialization)(nil), "volume_server_pb.QueryRequest.InputSerialization")
@@ -1889,6 +2313,7 @@ func init() {
 	proto.RegisterType((*QueryRequest_OutputSerialization_CSVOutput)(nil), "volume_server_pb.QueryRequest.OutputSerialization.CSVOutput")
 	proto.RegisterType((*QueryRequest_OutputSerialization_JSONOutput)(nil), "volume_server_pb.QueryRequest.OutputSerialization.JSONOutput")
 	proto.RegisterType((*QueriedStripe)(nil), "volume_server_pb.QueriedStripe")
+	proto.RegisterType((*VolumeInfo)(nil), "volume_server_pb.VolumeInfo")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1904,6 +2329,9 @@ const _ = grpc.SupportPackageIsVersion4
 type VolumeServerClient interface {
 	// Experts only: takes multiple fid parameters. This function does not propagate deletes to replicas.
 	BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteResponse, error)
+	// needle data plane, each call can stream several file ids
+	ReadNeedle(ctx context.Context, opts ...grpc.CallOption) (VolumeServer_ReadNeedleClient, error)
+	WriteNeedle(ctx context.Context, opts ...grpc.CallOption) (VolumeServer_WriteNeedleClient, error)
 	VacuumVolumeCheck(ctx context.Context, in *VacuumVolumeCheckRequest, opts ...grpc.CallOption) (*VacuumVolumeCheckResponse, error)
 	VacuumVolumeCompact(ctx context.Context, in *VacuumVolumeCompactRequest, opts ...grpc.CallOption) (*VacuumVolumeCompactResponse, error)
 	VacuumVolumeCommit(ctx context.Context, in *VacuumVolumeCommitRequest, opts ...grpc.CallOption) (*VacuumVolumeCommitResponse, error)
@@ -1933,6 +2361,8 @@ type VolumeServerClient interface {
 	VolumeEcBlobDelete(ctx context.Context, in *VolumeEcBlobDeleteRequest, opts ...grpc.CallOption) (*VolumeEcBlobDeleteResponse, error)
 	// tiered storage
 	VolumeTierCopyDatToRemote(ctx context.Context, in *VolumeTierCopyDatToRemoteRequest, opts ...grpc.CallOption) (*VolumeTierCopyDatToRemoteResponse, error)
+	// background io scheduling
+	VolumeServerIoLimit(ctx context.Context, in *VolumeServerIoLimitRequest, opts ...grpc.CallOption) (*VolumeServerIoLimitResponse, error)
 	// query
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (VolumeServer_QueryClient, error)
 }
@@ -1954,6 +2384,68 @@ func (c *volumeServerClient) BatchDelete(ctx context.Context, in *BatchDeleteReq
 	return out, nil
 }
 
+func (c *volumeServerClient) ReadNeedle(ctx context.Context, opts ...grpc.CallOption) (VolumeServer_ReadNeedleClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_VolumeServer_serviceDesc.Streams[0], c.cc, "/volume_server_pb.VolumeServer/ReadNeedle", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &volumeServerReadNeedleClient{stream}
+	return x, nil
+}
+
+type VolumeServer_ReadNeedleClient interface {
+	Send(*ReadNeedleRequest) error
+	Recv() (*ReadNeedleResponse, error)
+	grpc.ClientStream
+}
+
+type volumeServerReadNeedleClient struct {
+	grpc.ClientStream
+}
+
+func (x *volumeServerReadNeedleClient) Send(m *ReadNeedleRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *volumeServerReadNeedleClient) Recv() (*ReadNeedleResponse, error) {
+	m := new(ReadNeedleResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *volumeServerClient) WriteNeedle(ctx context.Context, opts ...grpc.CallOption) (VolumeServer_WriteNeedleClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_VolumeServer_serviceDesc.Streams[1], c.cc, "/volume_server_pb.VolumeServer/WriteNeedle", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &volumeServerWriteNeedleClient{stream}
+	return x, nil
+}
+
+type VolumeServer_WriteNeedleClient interface {
+	Send(*WriteNeedleRequest) error
+	Recv() (*WriteNeedleResponse, error)
+	grpc.ClientStream
+}
+
+type volumeServerWriteNeedleClient struct {
+	grpc.ClientStream
+}
+
+func (x *volumeServerWriteNeedleClient) Send(m *WriteNeedleRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *volumeServerWriteNeedleClient) Recv() (*WriteNeedleResponse, error) {
+	m := new(WriteNeedleResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *volumeServerClient) VacuumVolumeCheck(ctx context.Context, in *VacuumVolumeCheckRequest, opts ...grpc.CallOption) (*VacuumVolumeCheckResponse, error) {
 	out := new(VacuumVolumeCheckResponse)
 	err := grpc.Invoke(ctx, "/volume_server_pb.VolumeServer/VacuumVolumeCheck", in, out, c.cc, opts...)
@@ -2018,7 +2510,7 @@ func (c *volumeServerClient) VolumeSyncStatus(ctx context.Context, in *VolumeSyn
 }
 
 func (c *volumeServerClient) VolumeIncrementalCopy(ctx context.Context, in *VolumeIncrementalCopyRequest, opts ...grpc.CallOption) (VolumeServer_VolumeIncrementalCopyClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_VolumeServer_serviceDesc.Streams[0], c.cc, "/volume_server_pb.VolumeServer/VolumeIncrementalCopy", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_VolumeServer_serviceDesc.Streams[2], c.cc, "/volume_server_pb.VolumeServer/VolumeIncrementalCopy", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2104,7 +2596,7 @@ func (c *volumeServerClient) ReadVolumeFileStatus(ctx context.Context, in *ReadV
 }
 
 func (c *volumeServerClient) CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (VolumeServer_CopyFileClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_VolumeServer_serviceDesc.Streams[1], c.cc, "/volume_server_pb.VolumeServer/CopyFile", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_VolumeServer_serviceDesc.Streams[3], c.cc, "/volume_server_pb.VolumeServer/CopyFile", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2136,7 +2628,7 @@ func (x *volumeServerCopyFileClient) Recv() (*CopyFileResponse, error) {
 }
 
 func (c *volumeServerClient) VolumeTailSender(ctx context.Context, in *VolumeTailSenderRequest, opts ...grpc.CallOption) (VolumeServer_VolumeTailSenderClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_VolumeServer_serviceDesc.Streams[2], c.cc, "/volume_server_pb.VolumeServer/VolumeTailSender", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_VolumeServer_serviceDesc.Streams[4], c.cc, "/volume_server_pb.VolumeServer/VolumeTailSender", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2231,7 +2723,7 @@ func (c *volumeServerClient) VolumeEcShardsUnmount(ctx context.Context, in *Volu
 }
 
 func (c *volumeServerClient) VolumeEcShardRead(ctx context.Context, in *VolumeEcShardReadRequest, opts ...grpc.CallOption) (VolumeServer_VolumeEcShardReadClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_VolumeServer_serviceDesc.Streams[3], c.cc, "/volume_server_pb.VolumeServer/VolumeEcShardRead", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_VolumeServer_serviceDesc.Streams[5], c.cc, "/volume_server_pb.VolumeServer/VolumeEcShardRead", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2280,8 +2772,17 @@ func (c *volumeServerClient) VolumeTierCopyDatToRemote(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *volumeServerClient) VolumeServerIoLimit(ctx context.Context, in *VolumeServerIoLimitRequest, opts ...grpc.CallOption) (*VolumeServerIoLimitResponse, error) {
+	out := new(VolumeServerIoLimitResponse)
+	err := grpc.Invoke(ctx, "/volume_server_pb.VolumeServer/VolumeServerIoLimit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *volumeServerClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (VolumeServer_QueryClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_VolumeServer_serviceDesc.Streams[4], c.cc, "/volume_server_pb.VolumeServer/Query", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_VolumeServer_serviceDesc.Streams[6], c.cc, "/volume_server_pb.VolumeServer/Query", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2317,6 +2818,9 @@ func (x *volumeServerQueryClient) Recv() (*QueriedStripe, error) {
 type VolumeServerServer interface {
 	// Experts only: takes multiple fid parameters. This function does not propagate deletes to replicas.
 	BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteResponse, error)
+	// needle data plane, each call can stream several file ids
+	ReadNeedle(VolumeServer_ReadNeedleServer) error
+	WriteNeedle(VolumeServer_WriteNeedleServer) error
 	VacuumVolumeCheck(context.Context, *VacuumVolumeCheckRequest) (*VacuumVolumeCheckResponse, error)
 	VacuumVolumeCompact(context.Context, *VacuumVolumeCompactRequest) (*VacuumVolumeCompactResponse, error)
 	VacuumVolumeCommit(context.Context, *VacuumVolumeCommitRequest) (*VacuumVolumeCommitResponse, error)
@@ -2346,6 +2850,8 @@ type VolumeServerServer interface {
 	VolumeEcBlobDelete(context.Context, *VolumeEcBlobDeleteRequest) (*VolumeEcBlobDeleteResponse, error)
 	// tiered storage
 	VolumeTierCopyDatToRemote(context.Context, *VolumeTierCopyDatToRemoteRequest) (*VolumeTierCopyDatToRemoteResponse, error)
+	// background io scheduling
+	VolumeServerIoLimit(context.Context, *VolumeServerIoLimitRequest) (*VolumeServerIoLimitResponse, error)
 	// query
 	Query(*QueryRequest, VolumeServer_QueryServer) error
 }
@@ -2372,6 +2878,58 @@ func _VolumeServer_BatchDelete_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VolumeServer_ReadNeedle_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VolumeServerServer).ReadNeedle(&volumeServerReadNeedleServer{stream})
+}
+
+type VolumeServer_ReadNeedleServer interface {
+	Send(*ReadNeedleResponse) error
+	Recv() (*ReadNeedleRequest, error)
+	grpc.ServerStream
+}
+
+type volumeServerReadNeedleServer struct {
+	grpc.ServerStream
+}
+
+func (x *volumeServerReadNeedleServer) Send(m *ReadNeedleResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *volumeServerReadNeedleServer) Recv() (*ReadNeedleRequest, error) {
+	m := new(ReadNeedleRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _VolumeServer_WriteNeedle_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VolumeServerServer).WriteNeedle(&volumeServerWriteNeedleServer{stream})
+}
+
+type VolumeServer_WriteNeedleServer interface {
+	Send(*WriteNeedleResponse) error
+	Recv() (*WriteNeedleRequest, error)
+	grpc.ServerStream
+}
+
+type volumeServerWriteNeedleServer struct {
+	grpc.ServerStream
+}
+
+func (x *volumeServerWriteNeedleServer) Send(m *WriteNeedleResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *volumeServerWriteNeedleServer) Recv() (*WriteNeedleRequest, error) {
+	m := new(WriteNeedleRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _VolumeServer_VacuumVolumeCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VacuumVolumeCheckRequest)
 	if err := dec(in); err != nil {
@@ -2852,6 +3410,24 @@ func _VolumeServer_VolumeTierCopyDatToRemote_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VolumeServer_VolumeServerIoLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeServerIoLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServerServer).VolumeServerIoLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volume_server_pb.VolumeServer/VolumeServerIoLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServerServer).VolumeServerIoLimit(ctx, req.(*VolumeServerIoLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VolumeServer_Query_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(QueryRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2969,8 +3545,24 @@ var _VolumeServer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VolumeTierCopyDatToRemote",
 			Handler:    _VolumeServer_VolumeTierCopyDatToRemote_Handler,
 		},
+		{
+			MethodName: "VolumeServerIoLimit",
+			Handler:    _VolumeServer_VolumeServerIoLimit_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ReadNeedle",
+			Handler:       _VolumeServer_ReadNeedle_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WriteNeedle",
+			Handler:       _VolumeServer_WriteNeedle_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "VolumeIncrementalCopy",
 			Handler:       _VolumeServer_VolumeIncrementalCopy_Handler,
@@ -3003,169 +3595,201 @@ var _VolumeServer_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("volume_server.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xd4, 0x3a, 0xcd, 0x73, 0x1c, 0x47,
-	0xf5, 0x5a, 0xaf, 0x3e, 0x76, 0xdf, 0xae, 0x2c, 0xb9, 0x25, 0x4b, 0xeb, 0xb1, 0x25, 0xcb, 0xe3,
-	0x24, 0x96, 0xed, 0x44, 0x76, 0xe4, 0xdf, 0x8f, 0x98, 0x84, 0x00, 0xb6, 0x6c, 0x83, 0x49, 0x2c,
-	0x93, 0x91, 0x62, 0x02, 0x4e, 0x31, 0xd5, 0x9a, 0x69, 0x59, 0x83, 0x66, 0xa6, 0xc7, 0x33, 0x3d,
-	0xb2, 0xd7, 0x05, 0xa7, 0x50, 0xdc, 0xf8, 0x03, 0x72, 0xe6, 0xce, 0x81, 0x0b, 0x7f, 0x00, 0x17,
-	0xfe, 0x00, 0xb8, 0x72, 0xe1, 0xcc, 0x81, 0x1b, 0x55, 0x5c, 0xa8, 0xfe, 0x98, 0xd9, 0xf9, 0xd4,
-	0x8e, 0x62, 0x57, 0x51, 0xdc, 0x66, 0x5f, 0xbf, 0xef, 0x7e, 0xaf, 0xfb, 0xf5, 0x7b, 0x0b, 0x0b,
-	0x47, 0xd4, 0x8d, 0x3d, 0x62, 0x46, 0x24, 0x3c, 0x22, 0xe1, 0x46, 0x10, 0x52, 0x46, 0xd1, 0x7c,
-	0x0e, 0x68, 0x06, 0x7b, 0xfa, 0x0d, 0x40, 0x77, 0x31, 0xb3, 0x0e, 0xee, 0x11, 0x97, 0x30, 0x62,
-	0x90, 0xe7, 0x31, 0x89, 0x18, 0x3a, 0x07, 0x9d, 0x7d, 0xc7, 0x25, 0xa6, 0x63, 0x47, 0x83, 0xd6,
-	0x5a, 0x7b, 0xbd, 0x6b, 0xcc, 0xf0, 0xdf, 0x0f, 0xed, 0x48, 0x7f, 0x0c, 0x0b, 0x39, 0x82, 0x28,
-	0xa0, 0x7e, 0x44, 0xd0, 0x6d, 0x98, 0x09, 0x49, 0x14, 0xbb, 0x4c, 0x12, 0xf4, 0x36, 0x57, 0x37,
-	0x8a, 0xb2, 0x36, 0x52, 0x92, 0xd8, 0x65, 0x46, 0x82, 0xae, 0x7f, 0xd5, 0x82, 0x7e, 0x76, 0x05,
-	0x2d, 0xc3, 0x8c, 0x12, 0x3e, 0x68, 0xad, 0xb5, 0xd6, 0xbb, 0xc6, 0xb4, 0x94, 0x8d, 0x96, 0x60,
-	0x3a, 0x62, 0x98, 0xc5, 0xd1, 0xe0, 0xd4, 0x5a, 0x6b, 0x7d, 0xca, 0x50, 0xbf, 0xd0, 0x22, 0x4c,
-	0x91, 0x30, 0xa4, 0xe1, 0xa0, 0x2d, 0xd0, 0xe5, 0x0f, 0x84, 0x60, 0x32, 0x72, 0x5e, 0x91, 0xc1,
-	0xe4, 0x5a, 0x6b, 0x7d, 0xd6, 0x10, 0xdf, 0x68, 0x00, 0x33, 0x47, 0x24, 0x8c, 0x1c, 0xea, 0x0f,
-	0xa6, 0x04, 0x38, 0xf9, 0xa9, 0xcf, 0xc0, 0xd4, 0x7d, 0x2f, 0x60, 0x43, 0xfd, 0x03, 0x18, 0x3c,
-	0xc1, 0x56, 0x1c, 0x7b, 0x4f, 0x84, 0xfa, 0x5b, 0x07, 0xc4, 0x3a, 0x4c, 0xdc, 0x72, 0x1e, 0xba,
-	0xca, 0x28, 0xa5, 0xdb, 0xac, 0xd1, 0x91, 0x80, 0x87, 0xb6, 0xfe, 0x7d, 0x38, 0x57, 0x41, 0xa8,
-	0xdc, 0x73, 0x19, 0x66, 0x9f, 0xe1, 0x70, 0x0f, 0x3f, 0x23, 0x66, 0x88, 0x99, 0x43, 0x05, 0x75,
-	0xcb, 0xe8, 0x2b, 0xa0, 0xc1, 0x61, 0xfa, 0x53, 0xd0, 0x72, 0x1c, 0xa8, 0x17, 0x60, 0x8b, 0x35,
-	0x11, 0x8e, 0xd6, 0xa0, 0x17, 0x84, 0x04, 0xbb, 0x2e, 0xb5, 0x30, 0x23, 0xc2, 0x3f, 0x6d, 0x23,
-	0x0b, 0xd2, 0x57, 0xe0, 0x7c, 0x25, 0x73, 0xa9, 0xa0, 0x7e, 0xbb, 0xa0, 0x3d, 0xf5, 0x3c, 0xa7,
-	0x91, 0x68, 0xfd, 0x42, 0x49, 0x6b, 0x41, 0xa9, 0xf8, 0x7e, 0xbb, 0xb0, 0xea, 0x12, 0xec, 0xc7,
-	0x41, 0x23, 0xc6, 0x45, 0x8d, 0x13, 0xd2, 0x94, 0xf3, 0xb2, 0x0c, 0x9b, 0x2d, 0xea, 0xba, 0xc4,
-	0x62, 0x0e, 0xf5, 0x13, 0xb6, 0xab, 0x00, 0x56, 0x0a, 0x54, 0x41, 0x94, 0x81, 0xe8, 0x1a, 0x0c,
-	0xca, 0xa4, 0x8a, 0xed, 0xdf, 0x5a, 0x70, 0xf6, 0x8e, 0x72, 0x9a, 0x14, 0xdc, 0x68, 0x03, 0xf2,
-	0x22, 0x4f, 0x15, 0x45, 0x16, 0x37, 0xa8, 0x5d, 0xda, 0x20, 0x8e, 0x11, 0x92, 0xc0, 0x75, 0x2c,
-	0x2c, 0x58, 0x4c, 0x0a, 0x16, 0x59, 0x10, 0x9a, 0x87, 0x36, 0x63, 0xae, 0x88, 0xdc, 0xae, 0xc1,
-	0x3f, 0xd1, 0x26, 0x2c, 0x79, 0xc4, 0xa3, 0xe1, 0xd0, 0xf4, 0x70, 0x60, 0x7a, 0xf8, 0xa5, 0xc9,
-	0xc3, 0xdc, 0xf4, 0xf6, 0x06, 0xd3, 0x42, 0x3f, 0x24, 0x57, 0x1f, 0xe1, 0xe0, 0x11, 0x7e, 0xb9,
-	0xe3, 0xbc, 0x22, 0x8f, 0xf6, 0xf4, 0x01, 0x2c, 0x15, 0xed, 0x53, 0xa6, 0x7f, 0x0b, 0x96, 0x25,
-	0x64, 0x67, 0xe8, 0x5b, 0x3b, 0x22, 0xb7, 0x1a, 0x6d, 0xd4, 0xbf, 0x5b, 0x30, 0x28, 0x13, 0xaa,
-	0xc8, 0x7f, 0x5d, 0xaf, 0x9d, 0xd8, 0x27, 0x17, 0xa1, 0xc7, 0xb0, 0xe3, 0x9a, 0x74, 0x7f, 0x3f,
-	0x22, 0x4c, 0x38, 0x62, 0xd2, 0x00, 0x0e, 0x7a, 0x2c, 0x20, 0xe8, 0x2a, 0xcc, 0x5b, 0x32, 0xfa,
-	0xcd, 0x90, 0x1c, 0x39, 0xe2, 0x34, 0x98, 0x11, 0x8a, 0xcd, 0x59, 0x49, 0x56, 0x48, 0x30, 0xd2,
-	0x61, 0xd6, 0xb1, 0x5f, 0x9a, 0xe2, 0x38, 0x12, 0x87, 0x49, 0x47, 0x70, 0xeb, 0x39, 0xf6, 0xcb,
-	0x07, 0x8e, 0x4b, 0xb8, 0x47, 0xf5, 0x27, 0x70, 0x41, 0x1a, 0xff, 0xd0, 0xb7, 0x42, 0xe2, 0x11,
-	0x9f, 0x61, 0x77, 0x8b, 0x06, 0xc3, 0x46, 0x61, 0x73, 0x0e, 0x3a, 0x91, 0xe3, 0x5b, 0xc4, 0xf4,
-	0xe5, 0xa1, 0x36, 0x69, 0xcc, 0x88, 0xdf, 0xdb, 0x91, 0x7e, 0x17, 0x56, 0x6a, 0xf8, 0x2a, 0xcf,
-	0x5e, 0x82, 0xbe, 0x50, 0xcc, 0xa2, 0x3e, 0x23, 0x3e, 0x13, 0xbc, 0xfb, 0x46, 0x8f, 0xc3, 0xb6,
-	0x24, 0x48, 0x7f, 0x1f, 0x90, 0xe4, 0xf1, 0x88, 0xc6, 0x7e, 0xb3, 0x74, 0x3e, 0x0b, 0x0b, 0x39,
-	0x12, 0x15, 0x1b, 0xb7, 0x60, 0x51, 0x82, 0x3f, 0xf7, 0xbd, 0xc6, 0xbc, 0x96, 0xe1, 0x6c, 0x81,
-	0x48, 0x71, 0xdb, 0x4c, 0x84, 0xe4, 0xaf, 0x9d, 0x63, 0x99, 0x2d, 0x25, 0x1a, 0xe4, 0x6f, 0x1e,
-	0x71, 0x72, 0x49, 0x85, 0x71, 0x78, 0x68, 0x10, 0x6c, 0x53, 0xdf, 0x1d, 0x36, 0x3e, 0xb9, 0x2a,
-	0x28, 0x15, 0xdf, 0xdf, 0xb7, 0xe0, 0x4c, 0x72, 0xa4, 0x35, 0xdc, 0xcd, 0x13, 0x86, 0x73, 0xbb,
-	0x36, 0x9c, 0x27, 0x47, 0xe1, 0xbc, 0x0e, 0xf3, 0x11, 0x8d, 0x43, 0x8b, 0x98, 0x36, 0x66, 0xd8,
-	0xf4, 0xa9, 0x4d, 0x54, 0xb4, 0x9f, 0x96, 0xf0, 0x7b, 0x98, 0xe1, 0x6d, 0x6a, 0x13, 0xfd, 0x7b,
-	0xc9, 0x66, 0xe7, 0xa2, 0xe4, 0x2a, 0x9c, 0x71, 0x71, 0xc4, 0x4c, 0x1c, 0x04, 0xc4, 0xb7, 0x4d,
-	0xcc, 0x78, 0xa8, 0xb5, 0x44, 0xa8, 0x9d, 0xe6, 0x0b, 0x77, 0x04, 0xfc, 0x0e, 0xdb, 0x8e, 0xf4,
-	0xbf, 0xb4, 0x60, 0x8e, 0xd3, 0xf2, 0xd0, 0x6e, 0x64, 0xef, 0x3c, 0xb4, 0xc9, 0x4b, 0xa6, 0x0c,
-	0xe5, 0x9f, 0xe8, 0x06, 0x2c, 0xa8, 0x1c, 0x72, 0xa8, 0x3f, 0x4a, 0xaf, 0xb6, 0x3c, 0x8d, 0x46,
-	0x4b, 0x69, 0x86, 0x5d, 0x84, 0x5e, 0xc4, 0x68, 0x90, 0x64, 0xeb, 0xa4, 0xcc, 0x56, 0x0e, 0x52,
-	0xd9, 0x9a, 0xf7, 0xe9, 0x54, 0x85, 0x4f, 0xfb, 0x4e, 0x64, 0x12, 0xcb, 0x94, 0x5a, 0x89, 0x7c,
-	0xef, 0x18, 0xe0, 0x44, 0xf7, 0x2d, 0xe9, 0x0d, 0xfd, 0xff, 0x61, 0x7e, 0x64, 0x55, 0xf3, 0xdc,
-	0xf9, 0xaa, 0x95, 0x1c, 0x87, 0xbb, 0xd8, 0x71, 0x77, 0x88, 0x6f, 0x93, 0xf0, 0x35, 0x73, 0x1a,
-	0xdd, 0x84, 0x45, 0xc7, 0x76, 0x89, 0xc9, 0x1c, 0x8f, 0xd0, 0x98, 0x99, 0x11, 0xb1, 0xa8, 0x6f,
-	0x47, 0x89, 0x7f, 0xf8, 0xda, 0xae, 0x5c, 0xda, 0x91, 0x2b, 0xfa, 0xaf, 0xd3, 0xb3, 0x35, 0xab,
-	0xc5, 0xa8, 0xaa, 0xf0, 0x09, 0xe1, 0x0c, 0x0f, 0x08, 0xb6, 0x49, 0xa8, 0xcc, 0xe8, 0x4b, 0xe0,
-	0x0f, 0x05, 0x8c, 0x7b, 0x58, 0x21, 0xed, 0x51, 0x7b, 0x28, 0x34, 0xea, 0x1b, 0x20, 0x41, 0x77,
-	0xa9, 0x3d, 0x14, 0x87, 0x5c, 0x64, 0x8a, 0x20, 0xb1, 0x0e, 0x62, 0xff, 0x50, 0x68, 0xd3, 0x31,
-	0x7a, 0x4e, 0xf4, 0x29, 0x8e, 0xd8, 0x16, 0x07, 0xe9, 0x7f, 0x6c, 0x25, 0x59, 0xc6, 0xd5, 0x30,
-	0x88, 0x45, 0x9c, 0xa3, 0xff, 0x82, 0x3b, 0x38, 0x85, 0xca, 0x86, 0x5c, 0x75, 0xa9, 0x12, 0x06,
-	0xc9, 0x35, 0x75, 0x17, 0x89, 0x95, 0x51, 0x92, 0xe7, 0x15, 0x57, 0x49, 0xfe, 0x65, 0x72, 0xc8,
-	0xde, 0xb7, 0x76, 0x0e, 0x70, 0x68, 0x47, 0x3f, 0x20, 0x3e, 0x09, 0x31, 0x7b, 0x23, 0x97, 0xbe,
-	0xbe, 0x06, 0xab, 0x75, 0xdc, 0x95, 0xfc, 0xa7, 0xc9, 0xe5, 0x91, 0x60, 0x18, 0x64, 0x2f, 0x76,
-	0x5c, 0xfb, 0x8d, 0x88, 0xff, 0xa4, 0x68, 0x5c, 0xca, 0x5c, 0xc5, 0xcf, 0x35, 0x38, 0x13, 0x0a,
-	0x10, 0x33, 0x23, 0x8e, 0x90, 0xd6, 0xfb, 0xb3, 0xc6, 0x9c, 0x5a, 0x10, 0x84, 0xbc, 0xee, 0xff,
-	0x53, 0x1a, 0x01, 0x09, 0xb7, 0x37, 0x76, 0x2c, 0x9e, 0x87, 0xee, 0x48, 0x7c, 0x5b, 0x88, 0xef,
-	0x44, 0x4a, 0x2e, 0x8f, 0x4e, 0x8b, 0x06, 0x43, 0x93, 0x58, 0xf2, 0x1e, 0x16, 0x5b, 0xdd, 0x31,
-	0x7a, 0x1c, 0x78, 0xdf, 0x12, 0xd7, 0xf0, 0x09, 0xce, 0xc8, 0x34, 0x1a, 0xf2, 0x46, 0xa8, 0xdd,
-	0x78, 0x01, 0xe7, 0xf3, 0xab, 0xcd, 0xaf, 0xa7, 0xd7, 0x32, 0x52, 0x5f, 0x2d, 0x86, 0x41, 0xe1,
-	0x8e, 0x3b, 0x2a, 0xaa, 0xdd, 0xf8, 0x3e, 0x7f, 0x3d, 0xbd, 0x56, 0x8a, 0x0e, 0xc9, 0x17, 0x05,
-	0x5f, 0x14, 0xd5, 0x3e, 0x41, 0x71, 0x70, 0xbc, 0xe0, 0x8b, 0xc5, 0xd0, 0x2d, 0x56, 0x10, 0x5f,
-	0xa7, 0xe7, 0xa2, 0xc2, 0xe0, 0xf7, 0x77, 0xe3, 0xf3, 0x48, 0xc9, 0x15, 0xee, 0x98, 0x35, 0x66,
-	0x94, 0x58, 0xfe, 0xc0, 0x54, 0xf7, 0x90, 0xac, 0xcf, 0xd5, 0xaf, 0xdc, 0x53, 0xb2, 0xad, 0x9e,
-	0x92, 0xc9, 0x13, 0xf9, 0x90, 0x0c, 0x45, 0xac, 0x4d, 0xca, 0x27, 0xf2, 0x27, 0x64, 0xa8, 0x6f,
-	0x17, 0x32, 0x45, 0xaa, 0xa6, 0x72, 0x0e, 0xc1, 0x24, 0x0f, 0x52, 0x75, 0x54, 0x8b, 0x6f, 0xb4,
-	0x02, 0xe0, 0x44, 0xa6, 0x2d, 0xf6, 0x5c, 0x2a, 0xd5, 0x31, 0xba, 0x8e, 0x0a, 0x02, 0x5b, 0xff,
-	0x6d, 0x26, 0xf5, 0xee, 0xba, 0x74, 0xef, 0x0d, 0x46, 0x65, 0xd6, 0x8a, 0x76, 0xce, 0x8a, 0xec,
-	0x5b, 0x79, 0x32, 0xff, 0x56, 0xce, 0x24, 0x51, 0x56, 0x1d, 0xb5, 0x33, 0x1f, 0xc2, 0x79, 0x6e,
-	0xb0, 0xc4, 0x10, 0x55, 0x72, 0xf3, 0x97, 0xc4, 0x3f, 0x4e, 0xc1, 0x85, 0x6a, 0xe2, 0x26, 0xaf,
-	0x89, 0x8f, 0x40, 0x4b, 0xab, 0x75, 0x7e, 0xa5, 0x44, 0x0c, 0x7b, 0x41, 0x7a, 0xa9, 0xc8, 0xbb,
-	0x67, 0x59, 0x95, 0xee, 0xbb, 0xc9, 0x7a, 0x72, 0xb3, 0x94, 0x4a, 0xfd, 0x76, 0xa9, 0xd4, 0xe7,
-	0x02, 0x6c, 0xcc, 0xea, 0x04, 0xc8, 0xda, 0x65, 0xd9, 0xc6, 0xac, 0x4e, 0x40, 0x4a, 0x2c, 0x04,
-	0xc8, 0xa8, 0xe9, 0x29, 0x7c, 0x21, 0x60, 0x05, 0x40, 0x95, 0x25, 0xb1, 0x9f, 0x3c, 0x5d, 0xba,
-	0xb2, 0x28, 0x89, 0xfd, 0xda, 0xea, 0x6a, 0xa6, 0xb6, 0xba, 0xca, 0x6f, 0x7f, 0xa7, 0x74, 0x43,
-	0x7c, 0x01, 0x70, 0xcf, 0x89, 0x0e, 0xa5, 0x93, 0x79, 0x39, 0x67, 0x3b, 0xa1, 0x7a, 0x2f, 0xf3,
-	0x4f, 0x0e, 0xc1, 0xae, 0xab, 0x5c, 0xc7, 0x3f, 0x79, 0xf8, 0xc6, 0x11, 0xb1, 0x95, 0x77, 0xc4,
-	0x37, 0x87, 0xed, 0x87, 0x84, 0x28, 0x07, 0x88, 0x6f, 0xfd, 0x77, 0x2d, 0xe8, 0x3e, 0x22, 0x9e,
-	0xe2, 0xbc, 0x0a, 0xf0, 0x8c, 0x86, 0x34, 0x66, 0x8e, 0x4f, 0x64, 0xf5, 0x39, 0x65, 0x64, 0x20,
-	0xdf, 0x5c, 0x8e, 0x48, 0x4d, 0xe2, 0xee, 0x2b, 0x67, 0x8a, 0x6f, 0x0e, 0x3b, 0x20, 0x38, 0x50,
-	0xfe, 0x13, 0xdf, 0x68, 0x11, 0xa6, 0x22, 0x86, 0xad, 0x43, 0xe1, 0xac, 0x49, 0x43, 0xfe, 0xd0,
-	0x7d, 0xe8, 0xef, 0x3a, 0x24, 0x24, 0x2a, 0xe0, 0x78, 0x59, 0xb8, 0x87, 0xad, 0x43, 0x5e, 0x28,
-	0xb3, 0x61, 0x40, 0x94, 0x2b, 0x7a, 0x0a, 0xb6, 0x3b, 0x0c, 0x72, 0x28, 0x3e, 0xf6, 0x88, 0xca,
-	0xa9, 0x04, 0x65, 0x1b, 0x7b, 0xb9, 0x2e, 0x93, 0xca, 0xa9, 0x24, 0x73, 0xbe, 0x6e, 0xc1, 0x9a,
-	0xaa, 0x46, 0x1c, 0x12, 0xf2, 0xbb, 0xe7, 0x1e, 0x66, 0xbb, 0xd4, 0x20, 0x1e, 0x7d, 0x43, 0x09,
-	0x7d, 0x1b, 0x06, 0x36, 0x89, 0x98, 0xe3, 0x8b, 0xf7, 0x84, 0x99, 0x53, 0x55, 0xbe, 0x37, 0x96,
-	0x32, 0xeb, 0x77, 0x47, 0x5a, 0xeb, 0x97, 0xe1, 0xd2, 0x31, 0xaa, 0xa9, 0xe4, 0xfe, 0x57, 0x1f,
-	0xfa, 0x9f, 0xc5, 0x24, 0x1c, 0x66, 0x5a, 0x2d, 0x11, 0x51, 0xc2, 0x93, 0x5e, 0x61, 0x06, 0xc2,
-	0xa3, 0x7e, 0x3f, 0xa4, 0x9e, 0x99, 0xb6, 0x13, 0x4f, 0x09, 0x94, 0x1e, 0x07, 0x3e, 0x90, 0x2d,
-	0x45, 0xf4, 0x31, 0x4c, 0xef, 0x3b, 0x2e, 0x23, 0xb2, 0x81, 0xd7, 0xdb, 0x7c, 0xbb, 0xdc, 0x3a,
-	0xcc, 0xca, 0xdc, 0x78, 0x20, 0x90, 0x0d, 0x45, 0x84, 0xf6, 0x60, 0xc1, 0xf1, 0x03, 0x51, 0x3e,
-	0x86, 0x0e, 0x76, 0x9d, 0x57, 0xa3, 0x66, 0x41, 0x6f, 0xf3, 0xfd, 0x31, 0xbc, 0x1e, 0x72, 0xca,
-	0x9d, 0x2c, 0xa1, 0x81, 0x9c, 0x12, 0x0c, 0x11, 0x58, 0xa4, 0x31, 0x2b, 0x0b, 0x99, 0x12, 0x42,
-	0x36, 0xc7, 0x08, 0x79, 0x2c, 0x48, 0xf3, 0x52, 0x16, 0x68, 0x19, 0xa8, 0x6d, 0xc3, 0xb4, 0x34,
-	0x8e, 0xc7, 0xeb, 0xbe, 0x43, 0xdc, 0xa4, 0x05, 0x2a, 0x7f, 0xf0, 0xc8, 0xa2, 0x01, 0x09, 0xb1,
-	0x6f, 0xab, 0xad, 0x4f, 0x7e, 0x72, 0xfc, 0x23, 0xec, 0xc6, 0xc9, 0x26, 0xcb, 0x1f, 0xda, 0x5f,
-	0xa7, 0x00, 0x95, 0x2d, 0x4c, 0x3a, 0x20, 0x21, 0x89, 0x78, 0x54, 0x66, 0x43, 0x7d, 0x2e, 0x03,
-	0x17, 0xe1, 0xfe, 0x13, 0xe8, 0x5a, 0xd1, 0x91, 0x29, 0x5c, 0x22, 0x64, 0xf6, 0x36, 0x3f, 0x3c,
-	0xb1, 0x4b, 0x37, 0xb6, 0x76, 0x9e, 0x08, 0xa8, 0xd1, 0xb1, 0xa2, 0x23, 0xf1, 0x85, 0x7e, 0x06,
-	0xf0, 0x8b, 0x88, 0xfa, 0x8a, 0xb3, 0xdc, 0xf8, 0x8f, 0x4e, 0xce, 0xf9, 0x47, 0x3b, 0x8f, 0xb7,
-	0x25, 0xeb, 0x2e, 0x67, 0x27, 0x79, 0x5b, 0x30, 0x1b, 0xe0, 0xf0, 0x79, 0x4c, 0x98, 0x62, 0x2f,
-	0x63, 0xe1, 0xbb, 0x27, 0x67, 0xff, 0x63, 0xc9, 0x46, 0x4a, 0xe8, 0x07, 0x99, 0x5f, 0xda, 0x9f,
-	0x4f, 0x41, 0x27, 0xb1, 0x8b, 0x57, 0xa0, 0x22, 0xc2, 0xe5, 0x3b, 0xcc, 0x74, 0xfc, 0x7d, 0xaa,
-	0x3c, 0x7a, 0x9a, 0xc3, 0xe5, 0x53, 0xec, 0xa1, 0xbf, 0x4f, 0xb9, 0xef, 0x43, 0x62, 0xd1, 0xd0,
-	0xe6, 0xf7, 0xbd, 0xe3, 0x39, 0x3c, 0xec, 0xe5, 0x5e, 0xce, 0x49, 0xf8, 0xbd, 0x04, 0x8c, 0xae,
-	0xc0, 0x9c, 0xd8, 0xf6, 0x0c, 0x66, 0x3b, 0xe1, 0x49, 0xdc, 0x0c, 0xe2, 0x55, 0x98, 0x7f, 0x1e,
-	0x53, 0x46, 0x4c, 0xeb, 0x00, 0x87, 0xd8, 0x62, 0x34, 0x7d, 0x11, 0xcd, 0x09, 0xf8, 0x56, 0x0a,
-	0x46, 0xff, 0x07, 0x4b, 0x12, 0x95, 0x44, 0x16, 0x0e, 0x52, 0x0a, 0x12, 0xaa, 0x82, 0x79, 0x51,
-	0xac, 0xde, 0x17, 0x8b, 0x5b, 0xc9, 0x1a, 0xd2, 0xa0, 0x63, 0x51, 0xcf, 0x23, 0x3e, 0x8b, 0xc4,
-	0xa9, 0xda, 0x35, 0xd2, 0xdf, 0xe8, 0x0e, 0xac, 0x60, 0xd7, 0xa5, 0x2f, 0x4c, 0x41, 0x69, 0x9b,
-	0x25, 0xeb, 0x66, 0x44, 0x3d, 0xa3, 0x09, 0xa4, 0xcf, 0x04, 0x8e, 0x91, 0x37, 0x54, 0xbb, 0x08,
-	0xdd, 0x74, 0x1f, 0xf9, 0xe9, 0x9d, 0x09, 0x48, 0xf1, 0xad, 0x9d, 0x86, 0x7e, 0x76, 0x27, 0xb4,
-	0x7f, 0xb6, 0x61, 0xa1, 0x22, 0xa9, 0xd0, 0x53, 0x00, 0x1e, 0xad, 0x32, 0xb5, 0x54, 0xb8, 0x7e,
-	0xe7, 0xe4, 0xc9, 0xc9, 0xe3, 0x55, 0x82, 0x0d, 0x1e, 0xfd, 0xf2, 0x13, 0xfd, 0x1c, 0x7a, 0x22,
-	0x62, 0x15, 0x77, 0x19, 0xb2, 0x1f, 0x7f, 0x03, 0xee, 0xdc, 0x56, 0xc5, 0x5e, 0xe4, 0x80, 0xfc,
-	0xd6, 0xfe, 0xde, 0x82, 0x6e, 0x2a, 0x98, 0xdf, 0x33, 0x72, 0xa3, 0xc4, 0x5e, 0x47, 0xc9, 0x55,
-	0x24, 0x60, 0x0f, 0x04, 0xe8, 0x7f, 0x32, 0x94, 0xb4, 0x0f, 0x00, 0x46, 0xf6, 0x57, 0x9a, 0xd0,
-	0xaa, 0x34, 0x41, 0xbf, 0x0a, 0xb3, 0xdc, 0xb3, 0x0e, 0xb1, 0x77, 0x58, 0xe8, 0x04, 0xe2, 0x9a,
-	0x95, 0x38, 0x91, 0x2a, 0xa6, 0x93, 0x9f, 0x9b, 0x7f, 0x18, 0x40, 0x3f, 0xdb, 0x04, 0x40, 0x5f,
-	0x42, 0x2f, 0x33, 0xb4, 0x42, 0x6f, 0x95, 0x37, 0xad, 0x3c, 0x04, 0xd3, 0xde, 0x1e, 0x83, 0xa5,
-	0xae, 0xc4, 0x09, 0xe4, 0xc3, 0x99, 0xd2, 0xe4, 0x07, 0x5d, 0x2b, 0x53, 0xd7, 0xcd, 0x95, 0xb4,
-	0xeb, 0x8d, 0x70, 0x53, 0x79, 0x0c, 0x16, 0x2a, 0x46, 0x39, 0xe8, 0xdd, 0x31, 0x5c, 0x72, 0xe3,
-	0x24, 0xed, 0xbd, 0x86, 0xd8, 0xa9, 0xd4, 0xe7, 0x80, 0xca, 0x73, 0x1e, 0x74, 0x7d, 0x2c, 0x9b,
-	0xd1, 0x1c, 0x49, 0x7b, 0xb7, 0x19, 0x72, 0xad, 0xa1, 0x72, 0x02, 0x34, 0xd6, 0xd0, 0xdc, 0x8c,
-	0x69, 0xac, 0xa1, 0x85, 0xb1, 0xd2, 0x04, 0x3a, 0x84, 0xf9, 0xe2, 0x74, 0x08, 0x5d, 0xad, 0x9b,
-	0x66, 0x96, 0x86, 0x4f, 0xda, 0xb5, 0x26, 0xa8, 0xa9, 0x30, 0x02, 0xa7, 0xf3, 0xd3, 0x18, 0x74,
-	0xa5, 0x4c, 0x5f, 0x39, 0x8f, 0xd2, 0xd6, 0xc7, 0x23, 0x66, 0x6d, 0x2a, 0x4e, 0x68, 0xaa, 0x6c,
-	0xaa, 0x19, 0xff, 0x54, 0xd9, 0x54, 0x37, 0xf0, 0xd1, 0x27, 0xd0, 0x2f, 0x93, 0xb6, 0x7f, 0x61,
-	0x72, 0x81, 0x36, 0xea, 0xd8, 0x54, 0x8f, 0x4e, 0xb4, 0x1b, 0x8d, 0xf1, 0x13, 0xd9, 0x37, 0x5b,
-	0x3c, 0xd7, 0x33, 0x03, 0x8c, 0xaa, 0x5c, 0x2f, 0x8f, 0x44, 0xaa, 0x72, 0xbd, 0x6a, 0x0a, 0x32,
-	0x81, 0xf6, 0x60, 0x36, 0x37, 0xd2, 0x40, 0xef, 0xd4, 0x51, 0xe6, 0x7b, 0x21, 0xda, 0x95, 0xb1,
-	0x78, 0xa9, 0x0c, 0x33, 0x39, 0xbd, 0xd4, 0x71, 0x55, 0xab, 0x5c, 0xfe, 0xbc, 0x7a, 0x67, 0x1c,
-	0x5a, 0x2e, 0x95, 0x4b, 0x83, 0x8f, 0xca, 0x54, 0xae, 0x1b, 0xac, 0x54, 0xa6, 0x72, 0xfd, 0x2c,
-	0x65, 0x02, 0xfd, 0x14, 0x60, 0x34, 0x9c, 0x40, 0x97, 0xeb, 0xa8, 0xb3, 0xbb, 0xff, 0xd6, 0xf1,
-	0x48, 0x29, 0xeb, 0x17, 0xb0, 0x58, 0xd5, 0x33, 0x40, 0x15, 0x89, 0x7f, 0x4c, 0x63, 0x42, 0xdb,
-	0x68, 0x8a, 0x9e, 0x0a, 0xfe, 0x1c, 0x3a, 0xc9, 0x60, 0x01, 0x5d, 0x2a, 0x53, 0x17, 0x46, 0x29,
-	0x9a, 0x7e, 0x1c, 0x4a, 0x26, 0x80, 0xbd, 0x24, 0x57, 0x47, 0x1d, 0xff, 0xfa, 0x5c, 0x2d, 0xcd,
-	0x26, 0xea, 0x73, 0xb5, 0x3c, 0x40, 0x10, 0xe2, 0xd2, 0x60, 0xc8, 0x36, 0xc8, 0xeb, 0x83, 0xa1,
-	0xa2, 0xff, 0x5f, 0x1f, 0x0c, 0x95, 0x3d, 0xf7, 0x09, 0xf4, 0x2b, 0x58, 0xaa, 0xee, 0x8b, 0xa3,
-	0xda, 0x8c, 0xaf, 0xe9, 0xcf, 0x6b, 0x37, 0x9b, 0x13, 0xa4, 0xe2, 0x5f, 0x25, 0xe7, 0x53, 0xa1,
-	0x2f, 0x5e, 0x7f, 0x3e, 0x55, 0x77, 0xe7, 0xb5, 0x1b, 0x8d, 0xf1, 0xcb, 0xa9, 0x97, 0x6d, 0x40,
-	0xd7, 0x7b, 0xbb, 0xa2, 0xd7, 0x5e, 0xef, 0xed, 0xca, 0x9e, 0xb6, 0xc8, 0x8f, 0xaa, 0xe6, 0x72,
-	0x55, 0x7e, 0x1c, 0xd3, 0xfd, 0xd6, 0x36, 0x9a, 0xa2, 0xe7, 0xae, 0xef, 0x72, 0xf7, 0x18, 0x8d,
-	0xd5, 0x3f, 0x77, 0x32, 0xbf, 0xd7, 0x10, 0xbb, 0x7e, 0x77, 0x93, 0x93, 0x7a, 0xac, 0x01, 0x85,
-	0x13, 0xfb, 0x46, 0x63, 0xfc, 0x54, 0x76, 0x90, 0x8c, 0x8c, 0x33, 0x9d, 0x5f, 0x74, 0x6d, 0x0c,
-	0x9f, 0x4c, 0xe7, 0x5a, 0xbb, 0xde, 0x08, 0xb7, 0x2a, 0x7b, 0xb3, 0xbd, 0xd8, 0xe3, 0xe2, 0xa9,
-	0xd4, 0x40, 0x3e, 0x2e, 0x9e, 0x2a, 0xda, 0xbb, 0x13, 0xe8, 0x37, 0xa3, 0x59, 0x60, 0xb9, 0x53,
-	0x84, 0x36, 0x6b, 0xcf, 0x82, 0xda, 0x8e, 0x97, 0x76, 0xeb, 0x44, 0x34, 0xa9, 0x22, 0x9f, 0xc2,
-	0x94, 0x78, 0x6b, 0xa1, 0xd5, 0xe3, 0x1f, 0x61, 0xda, 0xc5, 0xea, 0xf5, 0xf4, 0x29, 0xc1, 0x3d,
-	0xb9, 0x37, 0x2d, 0xfe, 0x22, 0x77, 0xeb, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x81, 0x26, 0xf7,
-	0x49, 0x39, 0x27, 0x00, 0x00,
+	// 3136 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0xcd, 0x72, 0xdc, 0xc6,
+	0xd1, 0xdf, 0x72, 0xf9, 0xb3, 0xdb, 0xbb, 0x4b, 0x52, 0x43, 0xfd, 0xac, 0x40, 0x91, 0xa2, 0x20,
+	0xcb, 0xa6, 0x68, 0x89, 0x92, 0xe9, 0x4f, 0xb6, 0x3e, 0xfb, 0x73, 0x12, 0x89, 0xa2, 0x12, 0xc5,
+	0x26, 0x65, 0x83, 0x94, 0xec, 0xc4, 0x4e, 0x90, 0x21, 0x30, 0x2b, 0x8e, 0x89, 0x3f, 0x01, 0xb3,
+	0x94, 0x56, 0x95, 0x9c, 0x9c, 0xca, 0x2d, 0x0f, 0xe0, 0x73, 0x2a, 0xd7, 0x54, 0x6e, 0x79, 0x80,
+	0x5c, 0xf2, 0x00, 0xc9, 0x13, 0xe4, 0x94, 0x43, 0xaa, 0x92, 0x5b, 0xaa, 0x52, 0x95, 0x4a, 0xcd,
+	0x0f, 0xb0, 0xc0, 0x02, 0xe0, 0x82, 0x96, 0xaa, 0x52, 0xb9, 0x0d, 0x7a, 0x7a, 0xba, 0xa7, 0x7b,
+	0xba, 0x7b, 0x7a, 0xba, 0x01, 0x0b, 0x47, 0xbe, 0xd3, 0x77, 0x89, 0x19, 0x91, 0xf0, 0x88, 0x84,
+	0xeb, 0x41, 0xe8, 0x33, 0x1f, 0xcd, 0x67, 0x80, 0x66, 0xb0, 0xaf, 0xdf, 0x00, 0x74, 0x17, 0x33,
+	0xeb, 0xe0, 0x1e, 0x71, 0x08, 0x23, 0x06, 0x79, 0xda, 0x27, 0x11, 0x43, 0xe7, 0xa1, 0xd1, 0xa3,
+	0x0e, 0x31, 0xa9, 0x1d, 0x75, 0x6b, 0x2b, 0xf5, 0xd5, 0xa6, 0x31, 0xc3, 0xbf, 0x1f, 0xd8, 0x91,
+	0xfe, 0x10, 0x16, 0x32, 0x0b, 0xa2, 0xc0, 0xf7, 0x22, 0x82, 0x6e, 0xc3, 0x4c, 0x48, 0xa2, 0xbe,
+	0xc3, 0xe4, 0x82, 0xd6, 0xc6, 0xf2, 0xfa, 0x28, 0xaf, 0xf5, 0x64, 0x49, 0xdf, 0x61, 0x46, 0x8c,
+	0xae, 0x7f, 0x55, 0x83, 0x76, 0x7a, 0x06, 0x9d, 0x83, 0x19, 0xc5, 0xbc, 0x5b, 0x5b, 0xa9, 0xad,
+	0x36, 0x8d, 0x69, 0xc9, 0x1b, 0x9d, 0x85, 0xe9, 0x88, 0x61, 0xd6, 0x8f, 0xba, 0x13, 0x2b, 0xb5,
+	0xd5, 0x29, 0x43, 0x7d, 0xa1, 0xd3, 0x30, 0x45, 0xc2, 0xd0, 0x0f, 0xbb, 0x75, 0x81, 0x2e, 0x3f,
+	0x10, 0x82, 0xc9, 0x88, 0xbe, 0x20, 0xdd, 0xc9, 0x95, 0xda, 0x6a, 0xc7, 0x10, 0x63, 0xd4, 0x85,
+	0x99, 0x23, 0x12, 0x46, 0xd4, 0xf7, 0xba, 0x53, 0x02, 0x1c, 0x7f, 0xea, 0x5f, 0xc2, 0x29, 0x83,
+	0x60, 0x7b, 0x87, 0x10, 0xdb, 0x49, 0xd4, 0x70, 0xdc, 0x4e, 0xfc, 0x5e, 0x2f, 0x22, 0x4c, 0xec,
+	0xa4, 0x6e, 0xa8, 0xaf, 0x84, 0x67, 0x5d, 0x40, 0x25, 0xcf, 0x79, 0xa8, 0x7f, 0xf9, 0x8c, 0x89,
+	0x6d, 0x34, 0x0d, 0x3e, 0xd4, 0xff, 0x56, 0x03, 0x94, 0x66, 0xa6, 0x54, 0x58, 0xca, 0x0d, 0xc1,
+	0xa4, 0x8d, 0x19, 0x16, 0xbc, 0xda, 0x86, 0x18, 0x73, 0x64, 0x1a, 0x99, 0x0e, 0x8e, 0x98, 0x60,
+	0xd6, 0x30, 0xa6, 0x69, 0xf4, 0x11, 0x8e, 0xd8, 0x50, 0x19, 0x93, 0x23, 0xca, 0xf0, 0xb0, 0x4b,
+	0x84, 0xd4, 0x4d, 0x43, 0x8c, 0x39, 0xcc, 0xa5, 0x2e, 0xe9, 0x4e, 0x4b, 0x98, 0x4b, 0x25, 0x8c,
+	0x30, 0xfc, 0xa4, 0x3b, 0x23, 0x61, 0x7c, 0x8c, 0x2e, 0x43, 0x87, 0xf3, 0x31, 0x5d, 0xdf, 0xa6,
+	0x3d, 0x4a, 0xec, 0x6e, 0x63, 0xa5, 0xb6, 0x3a, 0x69, 0xb4, 0x39, 0x70, 0x5b, 0xc1, 0xd0, 0x12,
+	0x00, 0xf3, 0x19, 0x76, 0x4c, 0x21, 0x7f, 0x53, 0xc8, 0xdf, 0x14, 0x90, 0x5d, 0xfa, 0x82, 0xe8,
+	0xbf, 0x9e, 0x04, 0xf4, 0x69, 0x48, 0x19, 0xa9, 0xa8, 0xe0, 0x13, 0x89, 0x9c, 0xd3, 0x70, 0x65,
+	0x71, 0x57, 0xa0, 0x65, 0xf9, 0x6e, 0x10, 0x92, 0x48, 0xd8, 0x84, 0x94, 0x3a, 0x0d, 0x42, 0x5b,
+	0x30, 0x15, 0x60, 0x1a, 0x46, 0xdd, 0x86, 0xb0, 0xea, 0x1b, 0x79, 0xab, 0xce, 0x8b, 0xb5, 0xfe,
+	0x31, 0x5f, 0xb1, 0xe5, 0xb1, 0x70, 0x60, 0xc8, 0xd5, 0x7c, 0x8b, 0x8c, 0x39, 0x42, 0x2f, 0x4d,
+	0x83, 0x0f, 0xf3, 0x5a, 0x85, 0x02, 0xad, 0xae, 0xc1, 0x29, 0x1a, 0x99, 0xd6, 0x41, 0xdf, 0x3b,
+	0x34, 0x5d, 0xec, 0xd1, 0x1e, 0x89, 0x58, 0xb7, 0x25, 0x84, 0x9f, 0xa3, 0xd1, 0x26, 0x87, 0x6f,
+	0x2b, 0x30, 0xba, 0x04, 0x6d, 0x1a, 0x99, 0x21, 0x09, 0x1c, 0x6a, 0x61, 0x46, 0xba, 0x6d, 0x81,
+	0xd6, 0xa2, 0x91, 0x11, 0x83, 0xd0, 0x32, 0x80, 0xdd, 0x0f, 0xf1, 0x3e, 0x75, 0x28, 0x1b, 0x74,
+	0x3b, 0x62, 0x33, 0x29, 0x08, 0xba, 0xc8, 0xd5, 0xe1, 0x31, 0xe2, 0x31, 0xd3, 0xb5, 0x6f, 0x75,
+	0x67, 0x85, 0xf2, 0x41, 0x81, 0xb6, 0xed, 0x5b, 0xe8, 0x0a, 0xcc, 0xc6, 0x08, 0xd1, 0x01, 0xde,
+	0xb8, 0xf5, 0x4e, 0x77, 0x4e, 0xe0, 0x74, 0x14, 0x74, 0x57, 0x00, 0xb5, 0xdb, 0x00, 0x43, 0x15,
+	0x70, 0xd9, 0x0f, 0xc9, 0x40, 0x1d, 0x30, 0x1f, 0x72, 0x1b, 0x3d, 0xc2, 0x4e, 0x9f, 0x88, 0xe3,
+	0x6d, 0x1a, 0xf2, 0xe3, 0xbd, 0x89, 0xdb, 0x35, 0xfd, 0xb7, 0x35, 0x58, 0xc8, 0x28, 0x74, 0x9c,
+	0x6f, 0x24, 0xe6, 0x3e, 0x51, 0xe4, 0xfb, 0xf5, 0x94, 0xef, 0xc7, 0xa6, 0x3d, 0x99, 0x32, 0x6d,
+	0xa9, 0xb3, 0xbe, 0x67, 0x1d, 0x60, 0xef, 0x09, 0xb1, 0xbb, 0x53, 0xb1, 0xce, 0x1e, 0xc5, 0xa0,
+	0x51, 0x9d, 0x4c, 0x8f, 0xea, 0x44, 0x9f, 0x81, 0xa9, 0x2d, 0x37, 0x60, 0x03, 0xfd, 0x5d, 0xe8,
+	0x3e, 0xc6, 0x56, 0xbf, 0xef, 0x3e, 0x16, 0x26, 0xb2, 0x79, 0x40, 0xac, 0xc3, 0xd8, 0xd0, 0x17,
+	0xa1, 0xa9, 0x0c, 0x47, 0x49, 0xd0, 0x31, 0x1a, 0x12, 0xf0, 0xc0, 0xd6, 0xbf, 0x03, 0xe7, 0x0b,
+	0x16, 0x2a, 0xc9, 0x2f, 0x43, 0xe7, 0x09, 0x0e, 0xf7, 0xf1, 0x13, 0x62, 0x86, 0x98, 0x51, 0x5f,
+	0xac, 0xae, 0x19, 0x6d, 0x05, 0x34, 0x38, 0x4c, 0xff, 0x1c, 0xb4, 0x0c, 0x05, 0xdf, 0x0d, 0xb0,
+	0xc5, 0xaa, 0x30, 0xe7, 0x2e, 0x10, 0x84, 0x04, 0x3b, 0x8e, 0x2f, 0xac, 0x46, 0xc6, 0xb3, 0x34,
+	0x48, 0x5f, 0x82, 0xc5, 0x42, 0xe2, 0x72, 0x83, 0xfa, 0xed, 0x91, 0xdd, 0xfb, 0xae, 0x4b, 0x2b,
+	0xb1, 0xd6, 0x2f, 0x80, 0x56, 0xb4, 0x52, 0xd1, 0xfd, 0xbf, 0x91, 0x59, 0x87, 0x60, 0xaf, 0x1f,
+	0x54, 0x22, 0x3c, 0xba, 0xe3, 0x78, 0x69, 0x42, 0xf9, 0x9c, 0xbc, 0x70, 0x36, 0x7d, 0xc7, 0x21,
+	0x16, 0xa3, 0xbe, 0x17, 0x93, 0x5d, 0x06, 0xb0, 0x12, 0xa0, 0x32, 0xb5, 0x14, 0x44, 0xd7, 0xa0,
+	0x9b, 0x5f, 0xaa, 0xc8, 0xfe, 0xab, 0x06, 0x67, 0xee, 0x28, 0xa5, 0x49, 0xc6, 0x95, 0x0e, 0x20,
+	0xcb, 0x72, 0x62, 0x94, 0xe5, 0xe8, 0x01, 0xd5, 0x73, 0x07, 0xc4, 0x31, 0x62, 0xb7, 0xe7, 0x24,
+	0xa4, 0x81, 0xa7, 0x41, 0x71, 0xf8, 0x99, 0x1a, 0x86, 0x9f, 0x0d, 0x38, 0xeb, 0x12, 0xd7, 0x0f,
+	0x07, 0xa6, 0x8b, 0x03, 0xd3, 0xc5, 0xcf, 0x45, 0xe0, 0x36, 0xdd, 0x7d, 0x61, 0xe1, 0x1d, 0x03,
+	0xc9, 0xd9, 0x6d, 0x1c, 0x6c, 0xe3, 0xe7, 0x3c, 0x84, 0x6f, 0xef, 0x8f, 0x84, 0x8f, 0x99, 0xd1,
+	0xf0, 0xa1, 0x77, 0xe1, 0xec, 0xa8, 0xfc, 0x4a, 0x35, 0xef, 0xc0, 0x39, 0x09, 0xd9, 0x1d, 0x78,
+	0xd6, 0xae, 0xb8, 0xb5, 0x2b, 0x1d, 0xe4, 0x3f, 0x6b, 0xd0, 0xcd, 0x2f, 0x54, 0x9e, 0xf1, 0xb2,
+	0x5a, 0x3d, 0xb1, 0xce, 0x2e, 0x42, 0x8b, 0x61, 0xea, 0x98, 0xea, 0xea, 0x9f, 0x16, 0x01, 0x1b,
+	0x38, 0xe8, 0xa1, 0x80, 0xa0, 0xab, 0x30, 0x6f, 0x49, 0xef, 0x30, 0x43, 0x72, 0x44, 0x93, 0x3b,
+	0xa5, 0x63, 0xcc, 0x59, 0xb1, 0xd7, 0x48, 0x30, 0xd2, 0xa1, 0x43, 0xed, 0xe7, 0xa6, 0x08, 0x6a,
+	0x22, 0x54, 0xc9, 0x4b, 0xb5, 0x45, 0xed, 0xe7, 0xf7, 0xa9, 0x43, 0xc4, 0xa5, 0xf9, 0x18, 0x2e,
+	0x48, 0xe1, 0x1f, 0x78, 0x56, 0x48, 0x5c, 0xe2, 0x31, 0xec, 0x6c, 0xfa, 0xc1, 0xa0, 0x92, 0x59,
+	0x9d, 0x87, 0x46, 0x44, 0x3d, 0x8b, 0x98, 0x9e, 0x4c, 0x97, 0x26, 0x8d, 0x19, 0xf1, 0xbd, 0x13,
+	0xe9, 0x77, 0x61, 0xa9, 0x84, 0xae, 0xd2, 0xec, 0x25, 0x68, 0x8b, 0x8d, 0xa9, 0x28, 0x27, 0x68,
+	0xb7, 0x8d, 0x16, 0x87, 0x6d, 0x4a, 0x90, 0xfe, 0x16, 0x20, 0x49, 0x63, 0xdb, 0xef, 0x7b, 0xd5,
+	0xdc, 0xfd, 0x0c, 0x2c, 0x64, 0x96, 0x28, 0xdb, 0x78, 0x1b, 0x4e, 0x4b, 0xf0, 0x23, 0xcf, 0xad,
+	0x4c, 0xeb, 0x1c, 0x9c, 0x19, 0x59, 0xa4, 0xa8, 0x6d, 0xc4, 0x4c, 0xb2, 0x09, 0xed, 0xb1, 0xc4,
+	0xce, 0xc2, 0xe9, 0xec, 0x9a, 0x54, 0x64, 0x93, 0x1b, 0xc6, 0xe1, 0x21, 0x4f, 0xd8, 0x7c, 0xcf,
+	0x19, 0x54, 0x8e, 0x6c, 0x05, 0x2b, 0x15, 0xdd, 0xdf, 0xd4, 0xe0, 0x54, 0x1c, 0xf2, 0x2a, 0x9e,
+	0xe6, 0x09, 0xcd, 0xb9, 0x5e, 0x6a, 0xce, 0x93, 0x43, 0x73, 0x5e, 0x85, 0xf9, 0xc8, 0xef, 0x87,
+	0x16, 0x31, 0x79, 0x7a, 0x65, 0x7a, 0xbe, 0x1d, 0x27, 0x4c, 0xb3, 0x12, 0x7e, 0x0f, 0x33, 0xbc,
+	0xe3, 0xdb, 0x44, 0xff, 0x36, 0xa0, 0xf4, 0x7e, 0x95, 0x95, 0x5c, 0x85, 0x53, 0x22, 0x83, 0xc1,
+	0x41, 0x40, 0x3c, 0xdb, 0xc4, 0x8c, 0x9b, 0x5a, 0x4d, 0x98, 0xda, 0x2c, 0x9f, 0xb8, 0x23, 0xe0,
+	0x77, 0xd8, 0x4e, 0xa4, 0xff, 0xb1, 0x06, 0x73, 0x7c, 0x2d, 0x37, 0xed, 0x4a, 0xf2, 0xce, 0x43,
+	0x9d, 0x3c, 0x67, 0x4a, 0x50, 0x3e, 0x44, 0x37, 0x60, 0x41, 0xf9, 0x10, 0xf5, 0xbd, 0xa1, 0x7b,
+	0xc9, 0x1b, 0x1e, 0x0d, 0xa7, 0x12, 0x0f, 0xbb, 0x08, 0xad, 0x88, 0xf9, 0x41, 0xec, 0xad, 0x93,
+	0xd2, 0x5b, 0x39, 0x48, 0x79, 0x6b, 0x56, 0xa7, 0x53, 0x05, 0x3a, 0xe5, 0xc9, 0x01, 0xb1, 0x4c,
+	0xb9, 0x2b, 0xe1, 0xef, 0x0d, 0x03, 0x68, 0xb4, 0x65, 0x49, 0x6d, 0xe8, 0xb7, 0x60, 0x7e, 0x28,
+	0x55, 0x75, 0xdf, 0xf9, 0xaa, 0x16, 0x87, 0xc3, 0x3d, 0x4c, 0x9d, 0x5d, 0xe2, 0xd9, 0x24, 0x7c,
+	0x49, 0x9f, 0x46, 0x37, 0xe1, 0x34, 0xb5, 0x1d, 0x62, 0x32, 0xea, 0x12, 0xbf, 0xcf, 0xcc, 0x88,
+	0x58, 0xbe, 0x67, 0x47, 0xb1, 0x7e, 0xf8, 0xdc, 0x9e, 0x9c, 0xda, 0x95, 0x33, 0xfa, 0xcf, 0x93,
+	0xd8, 0x9a, 0xde, 0xc5, 0x30, 0xeb, 0xf0, 0x44, 0x06, 0x66, 0x1e, 0x10, 0x6c, 0x93, 0x50, 0x89,
+	0xd1, 0x96, 0xc0, 0xef, 0x09, 0x18, 0xd7, 0xb0, 0x42, 0xda, 0xf7, 0xed, 0x81, 0xca, 0xd5, 0x41,
+	0x82, 0xee, 0xfa, 0xf6, 0x40, 0x04, 0x39, 0x99, 0xb1, 0xcb, 0x1c, 0x56, 0xe5, 0xed, 0x2d, 0x99,
+	0xb7, 0x8b, 0xf4, 0x55, 0xff, 0x5d, 0x0d, 0xce, 0x0f, 0xb7, 0x61, 0x10, 0x8b, 0xd0, 0xa3, 0xff,
+	0x80, 0x3a, 0xf8, 0x0a, 0xe5, 0x0d, 0x99, 0x0c, 0x5f, 0x39, 0x0c, 0x92, 0x73, 0xea, 0x2e, 0x12,
+	0x33, 0x43, 0x27, 0xcf, 0x6e, 0x5c, 0x39, 0xf9, 0x17, 0x71, 0x90, 0xdd, 0xb2, 0x76, 0x0f, 0x70,
+	0x68, 0x47, 0xdf, 0x25, 0x1e, 0x09, 0x31, 0x7b, 0x25, 0x49, 0x81, 0xbe, 0x02, 0xcb, 0x65, 0xd4,
+	0x15, 0xff, 0xcf, 0xe1, 0x42, 0x16, 0xc3, 0x20, 0xfb, 0x7d, 0xea, 0xd8, 0xaf, 0x84, 0xfd, 0x87,
+	0xb0, 0x54, 0x42, 0x5c, 0xd9, 0xcf, 0x1a, 0x9c, 0x0a, 0x05, 0x48, 0x3c, 0x14, 0x42, 0x3b, 0xa9,
+	0x24, 0x74, 0x8c, 0x39, 0x35, 0x21, 0x16, 0xf2, 0x8a, 0xc2, 0xef, 0x13, 0x0b, 0x88, 0xa9, 0xbd,
+	0xb2, 0xb0, 0xb8, 0x08, 0xcd, 0x21, 0xfb, 0xba, 0x60, 0xdf, 0x88, 0x14, 0x5f, 0x6e, 0x9d, 0x96,
+	0x1f, 0x0c, 0x4c, 0x62, 0xc9, 0x7b, 0x58, 0x1c, 0x75, 0x83, 0x3f, 0xff, 0x82, 0xc1, 0x96, 0x25,
+	0xae, 0xe1, 0x13, 0xc4, 0xc8, 0xc4, 0x1a, 0xb2, 0x42, 0xa8, 0xd3, 0x78, 0x06, 0x8b, 0xd9, 0xd9,
+	0xea, 0xd7, 0xd3, 0x4b, 0x09, 0xa9, 0x2f, 0xc3, 0x85, 0x62, 0xc6, 0x6a, 0x63, 0x47, 0xa3, 0xdb,
+	0xae, 0x7c, 0x9f, 0xbf, 0xdc, 0xbe, 0x96, 0x60, 0xb1, 0x90, 0xaf, 0xda, 0xd6, 0x67, 0xa3, 0xdb,
+	0x3e, 0x41, 0x72, 0x70, 0x3c, 0xe3, 0x8b, 0xb0, 0x54, 0x42, 0x59, 0xb1, 0xfe, 0x3a, 0x89, 0x8b,
+	0x0a, 0x83, 0xdf, 0xdf, 0x95, 0xe3, 0x91, 0xe2, 0x2b, 0xd4, 0xd1, 0x31, 0x66, 0x14, 0xdb, 0x54,
+	0xc1, 0xa8, 0x5e, 0x58, 0x30, 0x9a, 0x4c, 0x15, 0x8c, 0xe2, 0xe2, 0x1b, 0x7f, 0x34, 0x4f, 0xc9,
+	0xb0, 0xc6, 0xbf, 0x3f, 0x24, 0x03, 0x7d, 0x07, 0xce, 0x17, 0x6c, 0x4d, 0xf9, 0x5c, 0x5c, 0x33,
+	0xa9, 0xa5, 0x6a, 0x26, 0x4b, 0x00, 0x34, 0x32, 0x6d, 0x71, 0xe6, 0x72, 0x53, 0x0d, 0xa3, 0x49,
+	0x95, 0x11, 0xd8, 0xfa, 0x2f, 0x53, 0xae, 0x77, 0xd7, 0xf1, 0xf7, 0x5f, 0xa1, 0x55, 0xa6, 0xa5,
+	0xa8, 0x67, 0xa4, 0x48, 0x57, 0xe1, 0x26, 0xb3, 0x55, 0xb8, 0x94, 0x13, 0xa5, 0xb7, 0xa3, 0x4e,
+	0xe6, 0x3d, 0x58, 0xe4, 0x02, 0x4b, 0x0c, 0x91, 0x25, 0x57, 0x7f, 0x49, 0xfc, 0x75, 0x02, 0x2e,
+	0x14, 0x2f, 0xae, 0xf2, 0x9a, 0x78, 0x1f, 0xb4, 0x24, 0x5b, 0xe7, 0x57, 0x4a, 0xc4, 0xb0, 0x1b,
+	0x24, 0x97, 0x8a, 0xbc, 0x7b, 0xce, 0xa9, 0xd4, 0x7d, 0x2f, 0x9e, 0x8f, 0x6f, 0x96, 0x5c, 0xaa,
+	0x5f, 0xcf, 0xa5, 0xfa, 0x9c, 0x81, 0x8d, 0x59, 0x19, 0x03, 0x99, 0xbb, 0x9c, 0xb3, 0x31, 0x2b,
+	0x63, 0x90, 0x2c, 0x16, 0x0c, 0xa4, 0xd5, 0xb4, 0x14, 0xbe, 0x60, 0xb0, 0x04, 0xa0, 0xd2, 0x92,
+	0xbe, 0x17, 0x3f, 0x5d, 0x9a, 0x32, 0x29, 0xe9, 0x7b, 0xa5, 0xd9, 0xd5, 0x4c, 0x69, 0x76, 0x95,
+	0x3d, 0xfe, 0x46, 0xee, 0x86, 0xf8, 0x0c, 0xe0, 0x1e, 0x8d, 0x0e, 0xa5, 0x92, 0x79, 0x3a, 0x67,
+	0xd3, 0x30, 0x2e, 0x01, 0xd9, 0x34, 0xe4, 0x10, 0xec, 0x38, 0x4a, 0x75, 0x7c, 0xc8, 0xcd, 0xb7,
+	0x1f, 0x11, 0x5b, 0x69, 0x47, 0x8c, 0x39, 0xac, 0x17, 0x12, 0xa2, 0x14, 0x20, 0xc6, 0xfa, 0xaf,
+	0x6a, 0xd0, 0xdc, 0x26, 0xae, 0xa2, 0xbc, 0x0c, 0xf0, 0xc4, 0x0f, 0xfd, 0x3e, 0xa3, 0x1e, 0x91,
+	0xd9, 0xe7, 0x94, 0x91, 0x82, 0x7c, 0x73, 0x3e, 0x1c, 0x16, 0x11, 0xa7, 0xa7, 0x94, 0x29, 0xc6,
+	0x1c, 0x76, 0x40, 0x70, 0xa0, 0xf4, 0x27, 0xc6, 0xbc, 0x02, 0x15, 0x31, 0x6c, 0x1d, 0x0a, 0x65,
+	0x4d, 0x1a, 0xf2, 0x43, 0xf7, 0xa0, 0xbd, 0x47, 0x49, 0x48, 0x94, 0xc1, 0xf1, 0xb4, 0x70, 0x1f,
+	0x5b, 0x87, 0x3c, 0x51, 0x66, 0x83, 0x80, 0x28, 0x55, 0xb4, 0x14, 0x6c, 0x6f, 0x10, 0x64, 0x50,
+	0x44, 0xf1, 0x72, 0x22, 0x83, 0xb2, 0x83, 0xdd, 0x4c, 0xfd, 0x5a, 0xf9, 0x54, 0xec, 0x39, 0x5f,
+	0xd7, 0x60, 0x45, 0x65, 0x23, 0x94, 0x84, 0xfc, 0xee, 0xb9, 0x87, 0xd9, 0x9e, 0x6f, 0x10, 0xd7,
+	0x7f, 0x45, 0x0e, 0x7d, 0x1b, 0xba, 0x36, 0x89, 0x18, 0xf5, 0xc4, 0x7b, 0xc2, 0xcc, 0x6c, 0x55,
+	0xbe, 0x37, 0xce, 0xa6, 0xe6, 0xef, 0x0e, 0x77, 0xad, 0x5f, 0x86, 0x4b, 0xc7, 0x6c, 0x4d, 0x39,
+	0xf7, 0x43, 0x98, 0x79, 0xe0, 0x7f, 0x44, 0x5d, 0xca, 0x90, 0x06, 0x0d, 0x0b, 0x33, 0xf2, 0xc4,
+	0x0f, 0xe3, 0xaa, 0x61, 0xf2, 0xcd, 0x2f, 0xe4, 0xfd, 0x01, 0x23, 0x91, 0x19, 0x90, 0x50, 0xf9,
+	0x87, 0xaa, 0x59, 0xcd, 0x0a, 0xf8, 0xc7, 0x24, 0x94, 0x6e, 0xa1, 0xef, 0x81, 0x96, 0x4e, 0xd7,
+	0x14, 0xf1, 0x58, 0x15, 0xef, 0x40, 0x93, 0xfa, 0xa6, 0xc3, 0x41, 0x71, 0xc7, 0xe2, 0x7c, 0xbe,
+	0xb6, 0x1b, 0x2f, 0x6a, 0x50, 0x39, 0x88, 0xf4, 0x47, 0xb0, 0x58, 0x48, 0x55, 0x45, 0x91, 0x6f,
+	0x4a, 0xf6, 0x1f, 0x6d, 0x68, 0x7f, 0xd2, 0x27, 0xe1, 0x20, 0x55, 0x88, 0x8a, 0x88, 0x52, 0x7d,
+	0xdc, 0x83, 0x49, 0x41, 0xb8, 0xcf, 0xf7, 0x42, 0xdf, 0x35, 0x93, 0x36, 0xcd, 0x84, 0x40, 0x69,
+	0x71, 0xe0, 0x7d, 0xd9, 0xaa, 0x41, 0x1f, 0x00, 0xaf, 0x92, 0x32, 0x22, 0x1b, 0x23, 0xad, 0x8d,
+	0x2b, 0xf9, 0x9d, 0xa4, 0x79, 0xae, 0xdf, 0x17, 0xc8, 0x86, 0x5a, 0x84, 0xf6, 0x61, 0x81, 0x7a,
+	0x81, 0x48, 0x9e, 0x43, 0x8a, 0x1d, 0xfa, 0x62, 0x58, 0x2a, 0x69, 0x6d, 0xbc, 0x35, 0x86, 0xd6,
+	0x03, 0xbe, 0x72, 0x37, 0xbd, 0xd0, 0x40, 0x34, 0x07, 0x43, 0x04, 0x4e, 0xfb, 0x7d, 0x96, 0x67,
+	0x32, 0x25, 0x98, 0x6c, 0x8c, 0x61, 0xf2, 0xb0, 0xcf, 0x46, 0x29, 0x1a, 0x0b, 0x7e, 0x1e, 0xa8,
+	0xed, 0xc0, 0xb4, 0x14, 0x8e, 0x7b, 0x6b, 0x8f, 0x12, 0x27, 0x2e, 0x23, 0xcb, 0x0f, 0xee, 0x57,
+	0x7e, 0x40, 0x42, 0xac, 0x8c, 0xa9, 0x69, 0xc4, 0x9f, 0xc3, 0x52, 0x75, 0x3d, 0x55, 0xaa, 0xd6,
+	0xfe, 0x34, 0x05, 0x28, 0x2f, 0x61, 0x5c, 0xff, 0x51, 0xbd, 0x83, 0xb4, 0xa3, 0xcf, 0xa5, 0xe0,
+	0xc2, 0xd9, 0x3f, 0x85, 0xa6, 0x15, 0x1d, 0x99, 0x42, 0x25, 0x82, 0x67, 0x6b, 0xe3, 0xbd, 0x13,
+	0xab, 0x74, 0x7d, 0x73, 0xf7, 0xb1, 0x80, 0x1a, 0x0d, 0x2b, 0x3a, 0x12, 0x23, 0xf4, 0x43, 0x80,
+	0x2f, 0x23, 0xdf, 0x53, 0x94, 0xe5, 0xc1, 0xbf, 0x7f, 0x72, 0xca, 0xdf, 0xdf, 0x7d, 0xb8, 0x23,
+	0x49, 0x37, 0x39, 0x39, 0x49, 0xdb, 0x82, 0x4e, 0x80, 0xc3, 0xa7, 0x7d, 0xc2, 0x14, 0x79, 0x69,
+	0x0b, 0xdf, 0x3a, 0x39, 0xf9, 0x8f, 0x25, 0x19, 0xc9, 0xa1, 0x1d, 0xa4, 0xbe, 0xb4, 0x3f, 0x4c,
+	0x40, 0x23, 0x96, 0x8b, 0xbb, 0x7b, 0x8f, 0x26, 0xaf, 0x50, 0x93, 0x7a, 0x3d, 0x5f, 0x69, 0x74,
+	0xb6, 0x47, 0xe3, 0x87, 0xe8, 0x03, 0xaf, 0xe7, 0x73, 0xdd, 0x87, 0xc4, 0xf2, 0x43, 0xdb, 0xb4,
+	0x89, 0xf0, 0x3f, 0x12, 0xf7, 0x04, 0xe6, 0x24, 0xfc, 0x5e, 0x0c, 0x46, 0x6f, 0xc0, 0x9c, 0x38,
+	0xf6, 0x14, 0x66, 0x3d, 0xa6, 0x49, 0x9c, 0x14, 0xe2, 0x55, 0x98, 0x7f, 0xda, 0xf7, 0x19, 0x31,
+	0xad, 0x03, 0x1c, 0x62, 0x8b, 0xf9, 0xc9, 0x7b, 0x70, 0x4e, 0xc0, 0x37, 0x13, 0x30, 0xfa, 0x5f,
+	0x38, 0x2b, 0x51, 0x49, 0x64, 0xe1, 0x20, 0x59, 0x41, 0x42, 0xf5, 0x5c, 0x38, 0x2d, 0x66, 0xb7,
+	0xc4, 0xe4, 0x66, 0x3c, 0x27, 0x22, 0x9d, 0xef, 0xba, 0xc4, 0x63, 0x91, 0xea, 0x4b, 0x25, 0xdf,
+	0xe8, 0x0e, 0x2c, 0x61, 0xc7, 0xf1, 0x9f, 0x99, 0x62, 0xa5, 0x6d, 0xe6, 0xa4, 0x9b, 0x11, 0xd9,
+	0x9c, 0x26, 0x90, 0x3e, 0x11, 0x38, 0x46, 0x56, 0x50, 0xed, 0x22, 0x34, 0x93, 0x73, 0xe4, 0x77,
+	0x57, 0xca, 0x20, 0xc5, 0x58, 0x9b, 0x85, 0x76, 0xfa, 0x24, 0xb4, 0xbf, 0xd7, 0x61, 0xa1, 0xc0,
+	0xa9, 0xd0, 0xe7, 0x00, 0xdc, 0x5a, 0xa5, 0x6b, 0x29, 0x73, 0xfd, 0xff, 0x93, 0x3b, 0x27, 0xb7,
+	0x57, 0x09, 0x36, 0xb8, 0xf5, 0xcb, 0x21, 0xfa, 0x31, 0xb4, 0x84, 0xc5, 0x2a, 0xea, 0xd2, 0x64,
+	0x3f, 0xf8, 0x06, 0xd4, 0xb9, 0xac, 0x8a, 0xbc, 0xf0, 0x01, 0x39, 0xd6, 0xfe, 0x5c, 0x83, 0x66,
+	0xc2, 0x98, 0xdf, 0xb2, 0xf2, 0xa0, 0xc4, 0x59, 0x47, 0xf1, 0x45, 0x2c, 0x60, 0xf7, 0x05, 0xe8,
+	0xbf, 0xd2, 0x94, 0xb4, 0x77, 0x01, 0x86, 0xf2, 0x17, 0x8a, 0x50, 0x2b, 0x14, 0x41, 0xbf, 0x0a,
+	0x1d, 0xae, 0x59, 0x4a, 0xec, 0x5d, 0x16, 0xd2, 0x40, 0x24, 0x19, 0x12, 0x27, 0x52, 0x4f, 0x89,
+	0xf8, 0x53, 0xa7, 0x00, 0x71, 0xe1, 0xb8, 0xe7, 0xa7, 0x93, 0x91, 0x5a, 0x26, 0x8d, 0x17, 0x99,
+	0x8c, 0xb8, 0xa4, 0x53, 0x4d, 0xf2, 0x8e, 0xd1, 0x12, 0xb0, 0x61, 0xf1, 0x2d, 0xd5, 0x4b, 0xa8,
+	0x8f, 0xf6, 0x12, 0x36, 0xfe, 0xa2, 0x41, 0x3b, 0x7d, 0xd1, 0xa2, 0x2f, 0xa0, 0x95, 0xfa, 0xef,
+	0x00, 0xbd, 0x96, 0xb7, 0x8f, 0xfc, 0x7f, 0x0c, 0xda, 0x95, 0x31, 0x58, 0x2a, 0xf7, 0xf8, 0x1f,
+	0xf4, 0x23, 0x80, 0x61, 0x47, 0x1e, 0x5d, 0xce, 0x2f, 0xcb, 0xfd, 0x1c, 0xa0, 0xbd, 0x76, 0x3c,
+	0x52, 0x4c, 0x7a, 0xb5, 0x76, 0xb3, 0x86, 0x7e, 0x02, 0xad, 0x54, 0x57, 0xb3, 0x68, 0xf3, 0xf9,
+	0x2e, 0xb2, 0x76, 0x65, 0x0c, 0x56, 0x86, 0x83, 0x07, 0xa7, 0x72, 0x3d, 0x44, 0xb4, 0x96, 0xa7,
+	0x50, 0xd6, 0xa1, 0xd4, 0xde, 0xac, 0x84, 0x9b, 0x28, 0x8c, 0xc1, 0x42, 0x41, 0x53, 0x10, 0x5d,
+	0x1b, 0x43, 0x25, 0xd3, 0x98, 0xd4, 0xae, 0x57, 0xc4, 0x4e, 0xb8, 0x3e, 0x05, 0x94, 0xef, 0x18,
+	0xa2, 0x37, 0xc7, 0x92, 0x19, 0x26, 0x7e, 0xda, 0xb5, 0x6a, 0xc8, 0xa5, 0x82, 0xca, 0x5e, 0xe2,
+	0x58, 0x41, 0x33, 0xdd, 0x4a, 0xed, 0x7a, 0x45, 0xec, 0x84, 0xeb, 0x21, 0xcc, 0x8f, 0xf6, 0x19,
+	0xd1, 0xd5, 0xb2, 0x3f, 0x6a, 0x72, 0x6d, 0x4c, 0x6d, 0xad, 0x0a, 0x6a, 0xc2, 0x8c, 0xc0, 0x6c,
+	0xb6, 0x6f, 0x87, 0xde, 0xc8, 0xaf, 0x2f, 0xec, 0x6c, 0x6a, 0xab, 0xe3, 0x11, 0xd3, 0x32, 0x8d,
+	0xf6, 0xf2, 0x8a, 0x64, 0x2a, 0x69, 0x14, 0x6a, 0x6b, 0x55, 0x50, 0x13, 0x66, 0x3f, 0x85, 0x33,
+	0x85, 0x3d, 0x2e, 0xb4, 0x5e, 0x46, 0xa6, 0xb8, 0xc9, 0xa6, 0xdd, 0xa8, 0x8c, 0x1f, 0xf3, 0xbe,
+	0x59, 0xe3, 0xc1, 0x2a, 0xd5, 0xea, 0x2a, 0xf2, 0xf7, 0x7c, 0xf3, 0x4c, 0xbb, 0x32, 0x06, 0x2b,
+	0x91, 0x6d, 0x1f, 0x3a, 0x99, 0xe6, 0x17, 0x7a, 0xbd, 0x6c, 0x65, 0xb6, 0x6a, 0xa6, 0xbd, 0x31,
+	0x16, 0x2f, 0xe1, 0x61, 0xc6, 0xe1, 0x57, 0xc5, 0xdb, 0xd2, 0xcd, 0x65, 0x03, 0xee, 0xeb, 0xe3,
+	0xd0, 0x32, 0xae, 0x9c, 0x6b, 0x91, 0x15, 0xba, 0x72, 0x59, 0x0b, 0x4e, 0xbb, 0x56, 0x0d, 0x39,
+	0x61, 0xf9, 0x83, 0xf8, 0xfa, 0x12, 0x86, 0x70, 0xb9, 0x6c, 0x75, 0xfa, 0xf4, 0x5f, 0x3b, 0x1e,
+	0x29, 0x21, 0xfd, 0x0c, 0x4e, 0x17, 0x55, 0x97, 0xd0, 0xf5, 0xe2, 0x4b, 0xa2, 0xa4, 0x84, 0xa5,
+	0xad, 0x57, 0x45, 0x4f, 0x18, 0x3f, 0x82, 0x46, 0xdc, 0x82, 0x42, 0x97, 0xf2, 0xab, 0x47, 0x9a,
+	0x6e, 0x9a, 0x7e, 0x1c, 0x4a, 0xca, 0x80, 0x5d, 0x98, 0x1f, 0xf6, 0x36, 0x64, 0x6f, 0xa8, 0xdc,
+	0x57, 0x73, 0x5d, 0x2c, 0x6d, 0xad, 0x0a, 0x6a, 0x8a, 0x5d, 0x62, 0x0c, 0xe9, 0x56, 0x4a, 0xb9,
+	0x31, 0x14, 0x74, 0x8a, 0xb4, 0x6b, 0xd5, 0x90, 0x13, 0xc5, 0xfd, 0x0c, 0xce, 0x16, 0x77, 0x50,
+	0x50, 0xa9, 0xc7, 0x97, 0x74, 0x72, 0xb4, 0x9b, 0xd5, 0x17, 0x24, 0xec, 0x5f, 0xc0, 0x99, 0x2c,
+	0x8e, 0xea, 0xa0, 0x94, 0xc7, 0xa7, 0xe2, 0x3e, 0x8e, 0x76, 0xa3, 0x32, 0x7e, 0xde, 0xf5, 0xd2,
+	0xad, 0x8a, 0x72, 0x6d, 0x17, 0x74, 0x65, 0xb4, 0x6b, 0xd5, 0x90, 0xd3, 0xfe, 0x51, 0xd4, 0x86,
+	0x28, 0xf2, 0x8f, 0x63, 0xfa, 0x24, 0xda, 0x7a, 0x55, 0xf4, 0xcc, 0xf5, 0x9d, 0xef, 0x33, 0xa0,
+	0xb1, 0xfb, 0xcf, 0x44, 0xe6, 0xeb, 0x15, 0xb1, 0xcb, 0x4f, 0x37, 0x8e, 0xd4, 0x63, 0x05, 0x18,
+	0x89, 0xd8, 0x37, 0x2a, 0xe3, 0x27, 0xbc, 0x03, 0x38, 0x95, 0x41, 0xe1, 0x01, 0x04, 0xad, 0x8d,
+	0xa1, 0x93, 0xea, 0x71, 0x68, 0x6f, 0x56, 0xc2, 0x2d, 0xf2, 0xde, 0x74, 0xd5, 0xfe, 0x38, 0x7b,
+	0xca, 0xb5, 0x1a, 0xb4, 0x6b, 0xd5, 0x90, 0x13, 0x21, 0x7f, 0x31, 0xec, 0x1a, 0xe7, 0x6b, 0x8a,
+	0x68, 0xa3, 0x34, 0x16, 0x94, 0xd6, 0x46, 0xb5, 0xb7, 0x4f, 0xb4, 0x26, 0x6f, 0x5f, 0x99, 0x7a,
+	0x60, 0xb9, 0x7d, 0x15, 0x15, 0x23, 0xb5, 0xeb, 0x15, 0xb1, 0x13, 0xae, 0x1f, 0xc1, 0x94, 0x78,
+	0x0d, 0xa3, 0xe5, 0xe3, 0x9f, 0xc9, 0xda, 0xc5, 0xe2, 0xf9, 0xe4, 0xb1, 0xc7, 0xcf, 0x6f, 0x7f,
+	0x5a, 0xfc, 0x1c, 0xfe, 0xf6, 0xbf, 0x07, 0x00, 0x3c, 0x50, 0xb0, 0x7e, 0x33, 0x2e, 0x00, 0x00,
 }
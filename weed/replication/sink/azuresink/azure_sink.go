@@ -95,8 +95,13 @@ func (g *AzureSink) CreateEntry(ctx context.Context, key string, entry *filer_pb
 		return nil
 	}
 
-	totalSize := filer2.TotalSize(entry.Chunks)
-	chunkViews := filer2.ViewFromChunks(entry.Chunks, 0, int(totalSize))
+	chunks, resolveErr := g.filerSource.ResolveChunks(ctx, entry.Chunks)
+	if resolveErr != nil {
+		return resolveErr
+	}
+
+	totalSize := filer2.TotalSize(chunks)
+	chunkViews := filer2.ViewFromChunks(chunks, 0, int(totalSize))
 
 	// Create a URL that references a to-be-created blob in your
 	// Azure Storage account's container.
@@ -115,9 +120,14 @@ func (g *AzureSink) CreateEntry(ctx context.Context, key string, entry *filer_pb
 		}
 
 		var writeErr error
+		verifier := filer2.NewChunkViewVerifier(chunk)
 		_, readErr := util.ReadUrlAsStream(fileUrl, chunk.Offset, int(chunk.Size), func(data []byte) {
 			_, writeErr = appendBlobURL.AppendBlock(ctx, bytes.NewReader(data), azblob.AppendBlobAccessConditions{}, nil)
+			verifier.Write(data)
 		})
+		if readErr == nil {
+			readErr = verifier.Verify()
+		}
 
 		if readErr != nil {
 			return readErr
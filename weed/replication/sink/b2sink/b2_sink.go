@@ -85,8 +85,13 @@ func (g *B2Sink) CreateEntry(ctx context.Context, key string, entry *filer_pb.En
 		return nil
 	}
 
-	totalSize := filer2.TotalSize(entry.Chunks)
-	chunkViews := filer2.ViewFromChunks(entry.Chunks, 0, int(totalSize))
+	chunks, resolveErr := g.filerSource.ResolveChunks(ctx, entry.Chunks)
+	if resolveErr != nil {
+		return resolveErr
+	}
+
+	totalSize := filer2.TotalSize(chunks)
+	chunkViews := filer2.ViewFromChunks(chunks, 0, int(totalSize))
 
 	bucket, err := g.client.Bucket(ctx, g.bucket)
 	if err != nil {
@@ -104,12 +109,17 @@ func (g *B2Sink) CreateEntry(ctx context.Context, key string, entry *filer_pb.En
 		}
 
 		var writeErr error
+		verifier := filer2.NewChunkViewVerifier(chunk)
 		_, readErr := util.ReadUrlAsStream(fileUrl, chunk.Offset, int(chunk.Size), func(data []byte) {
 			_, err := writer.Write(data)
 			if err != nil {
 				writeErr = err
 			}
+			verifier.Write(data)
 		})
+		if readErr == nil {
+			readErr = verifier.Verify()
+		}
 
 		if readErr != nil {
 			return readErr
@@ -1,7 +1,9 @@
 package filersink
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"google.golang.org/grpc"
 	"strings"
@@ -48,6 +50,7 @@ func (fs *FilerSink) replicateOneChunk(ctx context.Context, sourceChunk *filer_p
 		Size:         sourceChunk.Size,
 		Mtime:        sourceChunk.Mtime,
 		ETag:         sourceChunk.ETag,
+		Md5:          sourceChunk.Md5,
 		SourceFileId: sourceChunk.GetFileIdString(),
 	}, nil
 }
@@ -60,7 +63,7 @@ func (fs *FilerSink) fetchAndWrite(ctx context.Context, sourceChunk *filer_pb.Fi
 	}
 	defer readCloser.Close()
 
-	var host string
+	var host, durability string
 	var auth security.EncodedJwt
 
 	if err := fs.withFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
@@ -79,19 +82,27 @@ func (fs *FilerSink) fetchAndWrite(ctx context.Context, sourceChunk *filer_pb.Fi
 			return err
 		}
 
-		fileId, host, auth = resp.FileId, resp.Url, security.EncodedJwt(resp.Auth)
+		fileId, host, durability, auth = resp.FileId, resp.Url, resp.Durability, security.EncodedJwt(resp.Auth)
 
 		return nil
 	}); err != nil {
 		return "", fmt.Errorf("filerGrpcAddress assign volume: %v", err)
 	}
 
-	fileUrl := fmt.Sprintf("http://%s/%s", host, fileId)
+	fileUrl := operation.WithDurability(fmt.Sprintf("http://%s/%s", host, fileId), durability)
 
 	glog.V(4).Infof("replicating %s to %s header:%+v", filename, fileUrl, header)
 
+	// the volume server verifies the copy against the md5 of the source chunk, if known
+	var pairMap map[string]string
+	if sourceChunk.Md5 != nil {
+		pairMap = map[string]string{
+			util.ContentMd5Header: base64.StdEncoding.EncodeToString(sourceChunk.Md5),
+		}
+	}
+
 	uploadResult, err := operation.Upload(fileUrl, filename, readCloser,
-		"gzip" == header.Get("Content-Encoding"), header.Get("Content-Type"), nil, auth)
+		header.Get("Content-Encoding"), header.Get("Content-Type"), pairMap, auth)
 	if err != nil {
 		glog.V(0).Infof("upload data %v to %s: %v", filename, fileUrl, err)
 		return "", fmt.Errorf("upload data: %v", err)
@@ -100,6 +111,9 @@ func (fs *FilerSink) fetchAndWrite(ctx context.Context, sourceChunk *filer_pb.Fi
 		glog.V(0).Infof("upload failure %v to %s: %v", filename, fileUrl, err)
 		return "", fmt.Errorf("upload result: %v", uploadResult.Error)
 	}
+	if sourceChunk.Md5 != nil && uploadResult.ContentMd5 != nil && !bytes.Equal(sourceChunk.Md5, uploadResult.ContentMd5) {
+		return "", fmt.Errorf("copy of %s is corrupted: md5 %x, expected %x", sourceChunk.GetFileIdString(), uploadResult.ContentMd5, sourceChunk.Md5)
+	}
 
 	return
 }
@@ -98,13 +98,18 @@ func (fs *FilerSink) CreateEntry(ctx context.Context, key string, entry *filer_p
 		}
 		glog.V(1).Infof("lookup: %v", lookupRequest)
 		if resp, err := client.LookupDirectoryEntry(ctx, lookupRequest); err == nil {
-			if filer2.ETag(resp.Entry.Chunks) == filer2.ETag(entry.Chunks) {
+			if filer2.ETag(resp.Entry) == filer2.ETag(entry) {
 				glog.V(0).Infof("already replicated %s", key)
 				return nil
 			}
 		}
 
-		replicatedChunks, err := fs.replicateChunks(ctx, entry.Chunks)
+		chunks, err := fs.filerSource.ResolveChunks(ctx, entry.Chunks)
+		if err != nil {
+			return fmt.Errorf("resolve manifest chunks %s: %v", key, err)
+		}
+
+		replicatedChunks, err := fs.replicateChunks(ctx, chunks)
 
 		if err != nil {
 			glog.V(0).Infof("replicate entry chunks %s: %v", key, err)
@@ -168,13 +173,16 @@ func (fs *FilerSink) UpdateEntry(ctx context.Context, key string, oldEntry *file
 		// skip if already changed
 		// this usually happens when the messages are not ordered
 		glog.V(0).Infof("late updates %s", key)
-	} else if filer2.ETag(newEntry.Chunks) == filer2.ETag(existingEntry.Chunks) {
+	} else if filer2.ETag(newEntry) == filer2.ETag(existingEntry) {
 		// skip if no change
 		// this usually happens when retrying the replication
 		glog.V(0).Infof("already replicated %s", key)
 	} else {
 		// find out what changed
-		deletedChunks, newChunks := compareChunks(oldEntry, newEntry)
+		deletedChunks, newChunks, err := fs.compareChunks(ctx, oldEntry, newEntry)
+		if err != nil {
+			return true, fmt.Errorf("compare %s chunks error: %v", key, err)
+		}
 
 		// delete the chunks that are deleted from the source
 		if deleteIncludeChunks {
@@ -188,6 +196,8 @@ func (fs *FilerSink) UpdateEntry(ctx context.Context, key string, oldEntry *file
 			return true, fmt.Errorf("replicte %s chunks error: %v", key, err)
 		}
 		existingEntry.Chunks = append(existingEntry.Chunks, replicatedChunks...)
+		// the md5 of the source content, or nil to drop the md5 of the replaced content
+		existingEntry.Attributes.Md5 = newEntry.Attributes.Md5
 	}
 
 	// save updated meta data
@@ -206,8 +216,17 @@ func (fs *FilerSink) UpdateEntry(ctx context.Context, key string, oldEntry *file
 	})
 
 }
-func compareChunks(oldEntry, newEntry *filer_pb.Entry) (deletedChunks, newChunks []*filer_pb.FileChunk) {
-	deletedChunks = filer2.MinusChunks(oldEntry.Chunks, newEntry.Chunks)
-	newChunks = filer2.MinusChunks(newEntry.Chunks, oldEntry.Chunks)
+func (fs *FilerSink) compareChunks(ctx context.Context, oldEntry, newEntry *filer_pb.Entry) (deletedChunks, newChunks []*filer_pb.FileChunk, err error) {
+	// the chunks in the manifest chunks are compared, since the manifest chunks are not replicated as is
+	oldChunks, err := fs.filerSource.ResolveChunks(ctx, oldEntry.Chunks)
+	if err != nil {
+		return nil, nil, err
+	}
+	newEntryChunks, err := fs.filerSource.ResolveChunks(ctx, newEntry.Chunks)
+	if err != nil {
+		return nil, nil, err
+	}
+	deletedChunks = filer2.MinusChunks(oldChunks, newEntryChunks)
+	newChunks = filer2.MinusChunks(newEntryChunks, oldChunks)
 	return
 }
@@ -89,8 +89,13 @@ func (g *GcsSink) CreateEntry(ctx context.Context, key string, entry *filer_pb.E
 		return nil
 	}
 
-	totalSize := filer2.TotalSize(entry.Chunks)
-	chunkViews := filer2.ViewFromChunks(entry.Chunks, 0, int(totalSize))
+	chunks, resolveErr := g.filerSource.ResolveChunks(ctx, entry.Chunks)
+	if resolveErr != nil {
+		return resolveErr
+	}
+
+	totalSize := filer2.TotalSize(chunks)
+	chunkViews := filer2.ViewFromChunks(chunks, 0, int(totalSize))
 
 	wc := g.client.Bucket(g.bucket).Object(key).NewWriter(ctx)
 
@@ -101,9 +106,14 @@ func (g *GcsSink) CreateEntry(ctx context.Context, key string, entry *filer_pb.E
 			return err
 		}
 
+		verifier := filer2.NewChunkViewVerifier(chunk)
 		_, err = util.ReadUrlAsStream(fileUrl, chunk.Offset, int(chunk.Size), func(data []byte) {
 			wc.Write(data)
+			verifier.Write(data)
 		})
+		if err == nil {
+			err = verifier.Verify()
+		}
 
 		if err != nil {
 			return err
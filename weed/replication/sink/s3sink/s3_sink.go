@@ -102,8 +102,13 @@ func (s3sink *S3Sink) CreateEntry(ctx context.Context, key string, entry *filer_
 		return err
 	}
 
-	totalSize := filer2.TotalSize(entry.Chunks)
-	chunkViews := filer2.ViewFromChunks(entry.Chunks, 0, int(totalSize))
+	chunks, resolveErr := s3sink.filerSource.ResolveChunks(ctx, entry.Chunks)
+	if resolveErr != nil {
+		return resolveErr
+	}
+
+	totalSize := filer2.TotalSize(chunks)
+	chunkViews := filer2.ViewFromChunks(chunks, 0, int(totalSize))
 
 	var parts []*s3.CompletedPart
 	var wg sync.WaitGroup
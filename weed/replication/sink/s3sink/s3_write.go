@@ -162,6 +162,11 @@ func (s3sink *S3Sink) buildReadSeeker(ctx context.Context, chunk *filer2.ChunkVi
 		return nil, err
 	}
 	buf := make([]byte, chunk.Size)
-	util.ReadUrl(fileUrl, chunk.Offset, int(chunk.Size), buf, true)
+	if _, err = util.ReadUrl(fileUrl, chunk.Offset, int(chunk.Size), buf, true); err != nil {
+		return nil, err
+	}
+	if err = filer2.VerifyChunkView(chunk, buf); err != nil {
+		return nil, err
+	}
 	return bytes.NewReader(buf), nil
 }
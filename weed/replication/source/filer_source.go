@@ -7,9 +7,11 @@ import (
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 	"io"
+	"math"
 	"net/http"
 	"strings"
 
+	"github.com/chrislusf/seaweedfs/weed/filer2"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 	"github.com/chrislusf/seaweedfs/weed/util"
@@ -77,6 +79,14 @@ func (fs *FilerSource) LookupFileId(ctx context.Context, part string) (fileUrl s
 	return
 }
 
+// ResolveChunks replaces the manifest chunks with the data chunks stored in them
+func (fs *FilerSource) ResolveChunks(ctx context.Context, chunks []*filer_pb.FileChunk) ([]*filer_pb.FileChunk, error) {
+	dataChunks, _, err := filer2.ResolveChunkManifest(func(fileId string) (string, error) {
+		return fs.LookupFileId(ctx, fileId)
+	}, chunks, 0, math.MaxInt64)
+	return dataChunks, err
+}
+
 func (fs *FilerSource) ReadPart(ctx context.Context, part string) (filename string, header http.Header, readCloser io.ReadCloser, err error) {
 
 	fileUrl, err := fs.LookupFileId(ctx, part)
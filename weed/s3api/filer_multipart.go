@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/xml"
 	"fmt"
+	"math"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -67,13 +69,25 @@ func (s3a *S3ApiServer) completeMultipartUpload(ctx context.Context, input *s3.C
 
 	for _, entry := range entries {
 		if strings.HasSuffix(entry.Name, ".part") && !entry.IsDirectory {
-			for _, chunk := range entry.Chunks {
+			// a large part can have its chunks folded into manifest chunks
+			chunks, _, resolveErr := filer2.ResolveChunkManifest(filer2.LookupFn(ctx, s3a), entry.Chunks, 0, math.MaxInt64)
+			if resolveErr != nil {
+				glog.Errorf("completeMultipartUpload %s %s part %s: %v", *input.Bucket, *input.UploadId, entry.Name, resolveErr)
+				return nil, ErrInternalError
+			}
+			sort.Slice(chunks, func(i, j int) bool {
+				return chunks[i].Offset < chunks[j].Offset
+			})
+			for _, chunk := range chunks {
 				p := &filer_pb.FileChunk{
 					FileId: chunk.GetFileIdString(),
 					Offset: offset,
 					Size:   chunk.Size,
 					Mtime:  chunk.Mtime,
 					ETag:   chunk.ETag,
+					Md5:    chunk.Md5,
+					// the reference to a deduplicated chunk moves from the part to the object
+					ContentHash: chunk.ContentHash,
 				}
 				finalParts = append(finalParts, p)
 				offset += int64(chunk.Size)
@@ -107,7 +121,7 @@ func (s3a *S3ApiServer) completeMultipartUpload(ctx context.Context, input *s3.C
 		CompleteMultipartUploadOutput: s3.CompleteMultipartUploadOutput{
 			Location: aws.String(fmt.Sprintf("http://%s%s/%s", s3a.option.Filer, dirName, entryName)),
 			Bucket:   input.Bucket,
-			ETag:     aws.String("\"" + filer2.ETag(finalParts) + "\""),
+			ETag:     aws.String("\"" + filer2.ETagChunks(finalParts) + "\""),
 			Key:      objectKey(input.Key),
 		},
 	}
@@ -209,7 +223,7 @@ func (s3a *S3ApiServer) listObjectParts(ctx context.Context, input *s3.ListParts
 				PartNumber:   aws.Int64(int64(partNumber)),
 				LastModified: aws.Time(time.Unix(entry.Attributes.Mtime, 0)),
 				Size:         aws.Int64(int64(filer2.TotalSize(entry.Chunks))),
-				ETag:         aws.String("\"" + filer2.ETag(entry.Chunks) + "\""),
+				ETag:         aws.String("\"" + filer2.ETag(entry) + "\""),
 			})
 		}
 	}
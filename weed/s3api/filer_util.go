@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/chrislusf/seaweedfs/weed/filer2"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 )
@@ -150,6 +151,34 @@ func (s3a *S3ApiServer) exists(ctx context.Context, parentDirectoryPath string,
 	return
 }
 
+// lookup returns the entry, or nil if it is not found
+func (s3a *S3ApiServer) lookup(ctx context.Context, parentDirectoryPath string, entryName string) (entry *filer_pb.Entry, err error) {
+
+	err = s3a.withFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
+
+		request := &filer_pb.LookupDirectoryEntryRequest{
+			Directory: parentDirectoryPath,
+			Name:      entryName,
+		}
+
+		glog.V(4).Infof("lookup entry %v/%v: %v", parentDirectoryPath, entryName, request)
+		resp, err := client.LookupDirectoryEntry(ctx, request)
+		if err != nil {
+			if strings.Contains(err.Error(), filer2.ErrNotFound.Error()) {
+				return nil
+			}
+			glog.V(0).Infof("lookup entry %v: %v", request, err)
+			return fmt.Errorf("lookup entry %s/%s: %v", parentDirectoryPath, entryName, err)
+		}
+
+		entry = resp.Entry
+
+		return nil
+	})
+
+	return
+}
+
 func objectKey(key *string) *string {
 	if strings.HasPrefix(*key, "/") {
 		t := (*key)[1:]
@@ -3,7 +3,6 @@ package s3api
 import (
 	"bytes"
 	"context"
-	"encoding/base64"
 	"encoding/xml"
 	"fmt"
 	"github.com/chrislusf/seaweedfs/weed/glog"
@@ -46,6 +45,11 @@ func (s3a *S3ApiServer) withFilerClient(ctx context.Context, fn func(filer_pb.Se
 
 }
 
+// WithFilerClient implements filer2.FilerClient
+func (s3a *S3ApiServer) WithFilerClient(ctx context.Context, fn func(filer_pb.SeaweedFilerClient) error) error {
+	return s3a.withFilerClient(ctx, fn)
+}
+
 // If none of the http routes match respond with MethodNotAllowed
 func notFoundHandler(w http.ResponseWriter, r *http.Request) {
 	glog.V(0).Infof("unsupported %s %s", r.Method, r.RequestURI)
@@ -54,6 +58,11 @@ func notFoundHandler(w http.ResponseWriter, r *http.Request) {
 
 func writeErrorResponse(w http.ResponseWriter, errorCode ErrorCode, reqURL *url.URL) {
 	apiError := getAPIError(errorCode)
+	if apiError.HTTPStatusCode == http.StatusNotModified {
+		// a 304 response must not contain a body
+		writeResponse(w, apiError.HTTPStatusCode, nil, mimeNone)
+		return
+	}
 	errorResponse := getRESTErrorResponse(apiError, reqURL.Path)
 	encodedErrorResponse := encodeResponse(errorResponse)
 	writeResponse(w, apiError.HTTPStatusCode, encodedErrorResponse, mimeXML)
@@ -88,14 +97,3 @@ func writeSuccessResponseXML(w http.ResponseWriter, response []byte) {
 func writeSuccessResponseEmpty(w http.ResponseWriter) {
 	writeResponse(w, http.StatusOK, nil, mimeNone)
 }
-
-func validateContentMd5(h http.Header) ([]byte, error) {
-	md5B64, ok := h["Content-Md5"]
-	if ok {
-		if md5B64[0] == "" {
-			return nil, fmt.Errorf("Content-Md5 header set to empty value")
-		}
-		return base64.StdEncoding.DecodeString(md5B64[0])
-	}
-	return []byte{}, nil
-}
@@ -0,0 +1,352 @@
+package s3api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// objectInfo is the object meta data needed to evaluate conditional and range requests
+type objectInfo struct {
+	etag         string
+	lastModified time.Time
+	size         int64
+	mime         string
+}
+
+func newObjectInfo(entry *filer_pb.Entry) *objectInfo {
+	info := &objectInfo{
+		etag: filer2.ETag(entry),
+		size: int64(filer2.TotalSize(entry.Chunks)),
+		mime: "application/octet-stream",
+	}
+	if entry.Attributes != nil {
+		info.lastModified = time.Unix(entry.Attributes.Mtime, 0).UTC()
+		if entry.Attributes.Mime != "" {
+			info.mime = entry.Attributes.Mime
+		}
+	}
+	return info
+}
+
+func (info *objectInfo) setHeaders(w http.ResponseWriter) {
+	setEtag(w, info.etag)
+	w.Header().Set("Last-Modified", info.lastModified.Format(http.TimeFormat))
+	w.Header().Set("Accept-Ranges", "bytes")
+}
+
+func (s3a *S3ApiServer) lookupObject(ctx context.Context, bucket, object string) (*filer_pb.Entry, ErrorCode) {
+	dir, name := filer2.FullPath(fmt.Sprintf("%s/%s%s", s3a.option.BucketsPath, bucket, object)).DirAndName()
+	entry, err := s3a.lookup(ctx, dir, name)
+	if err != nil {
+		glog.Errorf("lookup %s/%s: %v", bucket, object, err)
+		return nil, ErrInternalError
+	}
+	if entry == nil {
+		return nil, ErrNoSuchKey
+	}
+	return entry, ErrNone
+}
+
+// checkReadPreconditions evaluates the conditional headers of GET and HEAD requests,
+// in the order of https://tools.ietf.org/html/rfc7232#section-6
+func checkReadPreconditions(h http.Header, info *objectInfo) ErrorCode {
+
+	if ifMatch := h.Get("If-Match"); ifMatch != "" {
+		if !util.ETagMatches(ifMatch, info.etag) {
+			return ErrPreconditionFailed
+		}
+	} else if t, ok := parseHttpTime(h.Get("If-Unmodified-Since")); ok {
+		if info.lastModified.After(t) {
+			return ErrPreconditionFailed
+		}
+	}
+
+	if ifNoneMatch := h.Get("If-None-Match"); ifNoneMatch != "" {
+		if util.ETagMatches(ifNoneMatch, info.etag) {
+			return ErrNotModified
+		}
+	} else if t, ok := parseHttpTime(h.Get("If-Modified-Since")); ok {
+		if !info.lastModified.After(t) {
+			return ErrNotModified
+		}
+	}
+
+	return ErrNone
+}
+
+// checkWritePreconditions evaluates the If-Match and If-None-Match headers of PUT requests
+// against the existing entry, which is nil if the object does not exist.
+// The filer checks them again when saving the entry, to catch concurrent changes.
+func checkWritePreconditions(h http.Header, entry *filer_pb.Entry) ErrorCode {
+
+	ifMatch, ifNoneMatch := h.Get("If-Match"), h.Get("If-None-Match")
+
+	if entry == nil {
+		if ifMatch != "" {
+			return ErrNoSuchKey
+		}
+		return ErrNone
+	}
+
+	etag := filer2.ETag(entry)
+	if ifMatch != "" && !util.ETagMatches(ifMatch, etag) {
+		return ErrPreconditionFailed
+	}
+	if ifNoneMatch != "" && util.ETagMatches(ifNoneMatch, etag) {
+		return ErrPreconditionFailed
+	}
+
+	return ErrNone
+}
+
+func hasWritePreconditions(h http.Header) bool {
+	return h.Get("If-Match") != "" || h.Get("If-None-Match") != ""
+}
+
+func parseHttpTime(value string) (time.Time, bool) {
+	if value == "" {
+		return time.Time{}, false
+	}
+	t, err := http.ParseTime(value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// httpRange specifies the byte range to be sent to the client.
+type httpRange struct {
+	start, length int64
+}
+
+func (r httpRange) contentRange(size int64) string {
+	return fmt.Sprintf("bytes %d-%d/%d", r.start, r.start+r.length-1, size)
+}
+
+func (r httpRange) rangeHeader() string {
+	return fmt.Sprintf("bytes=%d-%d", r.start, r.start+r.length-1)
+}
+
+// requestedRanges returns the ranges to send, or nil to send the whole object.
+// As S3 does, a malformed Range header is ignored, and so is a Range header with a failed If-Range.
+func requestedRanges(h http.Header, info *objectInfo) ([]httpRange, ErrorCode) {
+
+	rangeHeader := h.Get("Range")
+	if rangeHeader == "" {
+		return nil, ErrNone
+	}
+
+	if ifRange := h.Get("If-Range"); ifRange != "" {
+		if t, ok := parseHttpTime(ifRange); ok {
+			if !info.lastModified.Equal(t) {
+				return nil, ErrNone
+			}
+		} else if strings.HasPrefix(ifRange, "W/") || !util.ETagMatches(ifRange, info.etag) {
+			return nil, ErrNone
+		}
+	}
+
+	ranges, err := parseRange(rangeHeader, info.size)
+	if err != nil {
+		glog.V(1).Infof("ignore range %s: %v", rangeHeader, err)
+		return nil, ErrNone
+	}
+	if len(ranges) == 0 {
+		return nil, ErrInvalidRange
+	}
+
+	var total int64
+	for _, ra := range ranges {
+		total += ra.length
+	}
+	if total > info.size {
+		// The ranges overlap, and add up to more than the object itself.
+		// This is probably an attack, or a dumb client, so send the whole object.
+		return nil, ErrNone
+	}
+
+	return ranges, ErrNone
+}
+
+// parseRange parses a Range header string as per RFC 7233.
+// The ranges not overlapping the object are dropped, so an empty result means the range is not satisfiable.
+func parseRange(s string, size int64) (ranges []httpRange, err error) {
+	const b = "bytes="
+	if !strings.HasPrefix(s, b) {
+		return nil, fmt.Errorf("invalid range unit")
+	}
+	for _, ra := range strings.Split(s[len(b):], ",") {
+		ra = strings.TrimSpace(ra)
+		if ra == "" {
+			continue
+		}
+		i := strings.Index(ra, "-")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid range %s", ra)
+		}
+		start, end := strings.TrimSpace(ra[:i]), strings.TrimSpace(ra[i+1:])
+		var r httpRange
+		if start == "" {
+			// If no start is specified, end specifies the
+			// range start relative to the end of the object.
+			n, err := strconv.ParseInt(end, 10, 64)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("invalid range %s", ra)
+			}
+			if n == 0 || size == 0 {
+				continue
+			}
+			if n > size {
+				n = size
+			}
+			r.start = size - n
+			r.length = n
+		} else {
+			n, err := strconv.ParseInt(start, 10, 64)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("invalid range %s", ra)
+			}
+			r.start = n
+			last := size - 1
+			if end != "" {
+				n, err := strconv.ParseInt(end, 10, 64)
+				if err != nil || r.start > n {
+					return nil, fmt.Errorf("invalid range %s", ra)
+				}
+				if n < last {
+					last = n
+				}
+			}
+			if r.start >= size {
+				continue
+			}
+			r.length = last - r.start + 1
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges, nil
+}
+
+// filerRequest copies the request to the filer, without the conditional and range headers already evaluated here
+func (s3a *S3ApiServer) filerRequest(r *http.Request, destUrl string, ra *httpRange) (*http.Request, error) {
+
+	proxyReq, err := http.NewRequest(r.Method, destUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for header, values := range r.Header {
+		switch header {
+		case "If-Match", "If-None-Match", "If-Modified-Since", "If-Unmodified-Since", "If-Range", "Range":
+			continue
+		}
+		for _, value := range values {
+			proxyReq.Header.Add(header, value)
+		}
+	}
+
+	proxyReq.Header.Set("Host", s3a.option.Filer)
+	proxyReq.Header.Set("X-Forwarded-For", r.RemoteAddr)
+	if ra != nil {
+		proxyReq.Header.Set("Range", ra.rangeHeader())
+	}
+
+	return proxyReq, nil
+}
+
+// getObjectContent sends the whole object or one range of it, and keeps the ETag consistent with the conditional checks
+func (s3a *S3ApiServer) getObjectContent(w http.ResponseWriter, r *http.Request, destUrl string, info *objectInfo, ra *httpRange) {
+
+	proxyReq, err := s3a.filerRequest(r, destUrl, ra)
+	if err != nil {
+		glog.Errorf("NewRequest %s: %v", destUrl, err)
+		writeErrorResponse(w, ErrInternalError, r.URL)
+		return
+	}
+
+	resp, err := client.Do(proxyReq)
+	if err != nil {
+		glog.Errorf("get from filer: %v", err)
+		writeErrorResponse(w, ErrInternalError, r.URL)
+		return
+	}
+	defer resp.Body.Close()
+
+	for k, v := range resp.Header {
+		w.Header()[k] = v
+	}
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusPartialContent {
+		info.setHeaders(w)
+	}
+	w.WriteHeader(resp.StatusCode)
+	io.Copy(w, resp.Body)
+}
+
+// getObjectRanges sends multiple ranges as a multipart/byteranges response, reading each range from the filer
+func (s3a *S3ApiServer) getObjectRanges(w http.ResponseWriter, r *http.Request, destUrl string, info *objectInfo, ranges []httpRange) {
+
+	mw := multipart.NewWriter(w)
+	info.setHeaders(w)
+	w.Header().Set("Content-Type", "multipart/byteranges; boundary="+mw.Boundary())
+	w.WriteHeader(http.StatusPartialContent)
+
+	for _, ra := range ranges {
+		if err := s3a.copyObjectRange(mw, r, destUrl, info, ra); err != nil {
+			// the status is already sent, so just cut the response short
+			glog.Errorf("get %s range %s: %v", destUrl, ra.rangeHeader(), err)
+			return
+		}
+	}
+
+	mw.Close()
+}
+
+func (s3a *S3ApiServer) copyObjectRange(mw *multipart.Writer, r *http.Request, destUrl string, info *objectInfo, ra httpRange) error {
+
+	proxyReq, err := s3a.filerRequest(r, destUrl, &ra)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(proxyReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var body io.Reader = resp.Body
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusOK:
+		// the whole object is sent back
+		if _, err = io.CopyN(ioutil.Discard, body, ra.start); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("filer status %d", resp.StatusCode)
+	}
+
+	part, err := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Range": {ra.contentRange(info.size)},
+		"Content-Type":  {info.mime},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.CopyN(part, body, ra.length)
+	return err
+}
@@ -0,0 +1,163 @@
+package s3api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func TestCheckReadPreconditions(t *testing.T) {
+
+	lastModified := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+	info := &objectInfo{etag: "4397da7a", lastModified: lastModified, size: 100}
+
+	before := lastModified.Add(-time.Hour).Format(http.TimeFormat)
+	after := lastModified.Add(time.Hour).Format(http.TimeFormat)
+
+	tests := []struct {
+		headers  map[string]string
+		expected ErrorCode
+	}{
+		{map[string]string{}, ErrNone},
+		{map[string]string{"If-Match": `"4397da7a"`}, ErrNone},
+		{map[string]string{"If-Match": `"other", "4397da7a"`}, ErrNone},
+		{map[string]string{"If-Match": "*"}, ErrNone},
+		{map[string]string{"If-Match": `"other"`}, ErrPreconditionFailed},
+		{map[string]string{"If-Unmodified-Since": before}, ErrPreconditionFailed},
+		{map[string]string{"If-Unmodified-Since": after}, ErrNone},
+		// If-Match takes precedence over If-Unmodified-Since
+		{map[string]string{"If-Match": `"4397da7a"`, "If-Unmodified-Since": before}, ErrNone},
+		{map[string]string{"If-None-Match": `"4397da7a"`}, ErrNotModified},
+		{map[string]string{"If-None-Match": `W/"4397da7a"`}, ErrNotModified},
+		{map[string]string{"If-None-Match": `"other"`}, ErrNone},
+		{map[string]string{"If-Modified-Since": after}, ErrNotModified},
+		{map[string]string{"If-Modified-Since": lastModified.Format(http.TimeFormat)}, ErrNotModified},
+		{map[string]string{"If-Modified-Since": before}, ErrNone},
+		{map[string]string{"If-Modified-Since": "not a date"}, ErrNone},
+		// If-None-Match takes precedence over If-Modified-Since
+		{map[string]string{"If-None-Match": `"other"`, "If-Modified-Since": after}, ErrNone},
+		{map[string]string{"If-Match": `"other"`, "If-None-Match": `"4397da7a"`}, ErrPreconditionFailed},
+	}
+
+	for i, test := range tests {
+		h := http.Header{}
+		for k, v := range test.headers {
+			h.Set(k, v)
+		}
+		if actual := checkReadPreconditions(h, info); actual != test.expected {
+			t.Errorf("case %d %v: got %v, expected %v", i, test.headers, actual, test.expected)
+		}
+	}
+
+}
+
+func TestCheckWritePreconditions(t *testing.T) {
+
+	entry := &filer_pb.Entry{
+		Name:   "a.txt",
+		Chunks: []*filer_pb.FileChunk{{FileId: "1,2345", Size: 100, ETag: "4397da7a"}},
+	}
+
+	tests := []struct {
+		headers  map[string]string
+		entry    *filer_pb.Entry
+		expected ErrorCode
+	}{
+		{map[string]string{"If-None-Match": "*"}, nil, ErrNone},
+		{map[string]string{"If-None-Match": "*"}, entry, ErrPreconditionFailed},
+		{map[string]string{"If-Match": `"4397da7a"`}, entry, ErrNone},
+		{map[string]string{"If-Match": `"other"`}, entry, ErrPreconditionFailed},
+		{map[string]string{"If-Match": `"4397da7a"`}, nil, ErrNoSuchKey},
+	}
+
+	for i, test := range tests {
+		h := http.Header{}
+		for k, v := range test.headers {
+			h.Set(k, v)
+		}
+		if actual := checkWritePreconditions(h, test.entry); actual != test.expected {
+			t.Errorf("case %d %v: got %v, expected %v", i, test.headers, actual, test.expected)
+		}
+	}
+
+}
+
+func TestRequestedRanges(t *testing.T) {
+
+	lastModified := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+	info := &objectInfo{etag: "4397da7a", lastModified: lastModified, size: 100}
+
+	tests := []struct {
+		rangeHeader string
+		ifRange     string
+		expected    []httpRange
+		errCode     ErrorCode
+	}{
+		{"", "", nil, ErrNone},
+		{"bytes=0-9", "", []httpRange{{0, 10}}, ErrNone},
+		{"bytes=90-", "", []httpRange{{90, 10}}, ErrNone},
+		{"bytes=-10", "", []httpRange{{90, 10}}, ErrNone},
+		{"bytes=90-200", "", []httpRange{{90, 10}}, ErrNone},
+		{"bytes=0-9, 20-29", "", []httpRange{{0, 10}, {20, 10}}, ErrNone},
+		{"bytes=0-9, 200-300", "", []httpRange{{0, 10}}, ErrNone},
+		{"bytes=100-", "", nil, ErrInvalidRange},
+		{"bytes=-0", "", nil, ErrInvalidRange},
+		{"bytes=9-0", "", nil, ErrNone},
+		{"lines=0-9", "", nil, ErrNone},
+		{"bytes=0-99, 0-99", "", nil, ErrNone},
+		{"bytes=0-9", `"4397da7a"`, []httpRange{{0, 10}}, ErrNone},
+		{"bytes=0-9", `"other"`, nil, ErrNone},
+		{"bytes=0-9", lastModified.Format(http.TimeFormat), []httpRange{{0, 10}}, ErrNone},
+		{"bytes=0-9", lastModified.Add(time.Hour).Format(http.TimeFormat), nil, ErrNone},
+	}
+
+	for i, test := range tests {
+		h := http.Header{}
+		if test.rangeHeader != "" {
+			h.Set("Range", test.rangeHeader)
+		}
+		if test.ifRange != "" {
+			h.Set("If-Range", test.ifRange)
+		}
+		ranges, errCode := requestedRanges(h, info)
+		if errCode != test.errCode {
+			t.Errorf("case %d %s: got error %v, expected %v", i, test.rangeHeader, errCode, test.errCode)
+			continue
+		}
+		if len(ranges) != len(test.expected) {
+			t.Errorf("case %d %s: got %+v, expected %+v", i, test.rangeHeader, ranges, test.expected)
+			continue
+		}
+		for j := range ranges {
+			if ranges[j] != test.expected[j] {
+				t.Errorf("case %d %s: got %+v, expected %+v", i, test.rangeHeader, ranges, test.expected)
+			}
+		}
+	}
+
+}
+
+func TestNotModifiedHasNoBody(t *testing.T) {
+
+	req := httptest.NewRequest("GET", "/bucket/object", nil)
+
+	w := httptest.NewRecorder()
+	writeErrorResponse(w, ErrNotModified, req.URL)
+	if w.Code != http.StatusNotModified || w.Body.Len() != 0 {
+		t.Errorf("unexpected 304 response: %d %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	writeErrorResponse(w, ErrPreconditionFailed, req.URL)
+	if w.Code != http.StatusPreconditionFailed {
+		t.Errorf("unexpected status %d", w.Code)
+	}
+	if body := w.Body.String(); !strings.Contains(body, "<Code>PreconditionFailed</Code>") {
+		t.Errorf("unexpected 412 response: %s", body)
+	}
+
+}
@@ -1,16 +1,18 @@
 package s3api
 
 import (
-	"crypto/md5"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/server"
+	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/gorilla/mux"
 )
 
@@ -32,10 +34,18 @@ func (s3a *S3ApiServer) PutObjectHandler(w http.ResponseWriter, r *http.Request)
 	bucket := vars["bucket"]
 	object := getObject(vars)
 
-	_, err := validateContentMd5(r.Header)
-	if err != nil {
-		writeErrorResponse(w, ErrInvalidDigest, r.URL)
-		return
+	if hasWritePreconditions(r.Header) {
+		entry, errCode := s3a.lookupObject(context.Background(), bucket, object)
+		if errCode == ErrNoSuchKey {
+			entry, errCode = nil, ErrNone
+		}
+		if errCode == ErrNone {
+			errCode = checkWritePreconditions(r.Header, entry)
+		}
+		if errCode != ErrNone {
+			writeErrorResponse(w, errCode, r.URL)
+			return
+		}
 	}
 
 	rAuthType := getRequestAuthType(r)
@@ -55,6 +65,7 @@ func (s3a *S3ApiServer) PutObjectHandler(w http.ResponseWriter, r *http.Request)
 	}
 
 	setEtag(w, etag)
+	setChecksumHeader(w, r)
 
 	writeSuccessResponseEmpty(w)
 }
@@ -73,7 +84,38 @@ func (s3a *S3ApiServer) GetObjectHandler(w http.ResponseWriter, r *http.Request)
 	destUrl := fmt.Sprintf("http://%s%s/%s%s",
 		s3a.option.Filer, s3a.option.BucketsPath, bucket, object)
 
-	s3a.proxyToFiler(w, r, destUrl, passThroughResponse)
+	entry, errCode := s3a.lookupObject(context.Background(), bucket, object)
+	if errCode != ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+	if entry.IsDirectory {
+		s3a.proxyToFiler(w, r, destUrl, passThroughResponse)
+		return
+	}
+
+	info := newObjectInfo(entry)
+	if errCode = checkReadPreconditions(r.Header, info); errCode != ErrNone {
+		info.setHeaders(w)
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	ranges, errCode := requestedRanges(r.Header, info)
+	if errCode != ErrNone {
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", info.size))
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	switch len(ranges) {
+	case 0:
+		s3a.getObjectContent(w, r, destUrl, info, nil)
+	case 1:
+		s3a.getObjectContent(w, r, destUrl, info, &ranges[0])
+	default:
+		s3a.getObjectRanges(w, r, destUrl, info, ranges)
+	}
 
 }
 
@@ -86,7 +128,39 @@ func (s3a *S3ApiServer) HeadObjectHandler(w http.ResponseWriter, r *http.Request
 	destUrl := fmt.Sprintf("http://%s%s/%s%s",
 		s3a.option.Filer, s3a.option.BucketsPath, bucket, object)
 
-	s3a.proxyToFiler(w, r, destUrl, passThroughResponse)
+	entry, errCode := s3a.lookupObject(context.Background(), bucket, object)
+	if errCode != ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+	if entry.IsDirectory {
+		s3a.proxyToFiler(w, r, destUrl, passThroughResponse)
+		return
+	}
+
+	info := newObjectInfo(entry)
+	info.setHeaders(w)
+	if errCode = checkReadPreconditions(r.Header, info); errCode != ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	ranges, errCode := requestedRanges(r.Header, info)
+	if errCode != ErrNone {
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", info.size))
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	w.Header().Set("Content-Type", info.mime)
+	if len(ranges) == 1 {
+		w.Header().Set("Content-Length", strconv.FormatInt(ranges[0].length, 10))
+		w.Header().Set("Content-Range", ranges[0].contentRange(info.size))
+		w.WriteHeader(http.StatusPartialContent)
+		return
+	}
+	w.Header().Set("Content-Length", strconv.FormatInt(info.size, 10))
+	w.WriteHeader(http.StatusOK)
 
 }
 
@@ -128,7 +202,6 @@ func (s3a *S3ApiServer) proxyToFiler(w http.ResponseWriter, r *http.Request, des
 
 	proxyReq.Header.Set("Host", s3a.option.Filer)
 	proxyReq.Header.Set("X-Forwarded-For", r.RemoteAddr)
-	proxyReq.Header.Set("Etag-MD5", "True")
 
 	for header, values := range r.Header {
 		for _, value := range values {
@@ -155,10 +228,19 @@ func passThroughResponse(proxyResonse *http.Response, w http.ResponseWriter) {
 	io.Copy(w, proxyResonse.Body)
 }
 
+// putToFiler streams the data to the filer, which also verifies it against the Content-MD5 and x-amz-checksum-sha256 headers.
+// The etag is the hex md5 of the data.
 func (s3a *S3ApiServer) putToFiler(r *http.Request, uploadUrl string, dataReader io.ReadCloser) (etag string, code ErrorCode) {
 
-	hash := md5.New()
-	var body io.Reader = io.TeeReader(dataReader, hash)
+	expectedDigests, err := util.ParseContentDigests(r.Header)
+	if err != nil {
+		glog.V(1).Infof("upload to %s: %v", uploadUrl, err)
+		dataReader.Close()
+		return "", ErrInvalidDigest
+	}
+
+	digestWriter := util.NewDigestWriter(expectedDigests.Sha256 != nil)
+	var body io.Reader = io.TeeReader(dataReader, digestWriter)
 
 	proxyReq, err := http.NewRequest("PUT", uploadUrl, body)
 
@@ -186,7 +268,17 @@ func (s3a *S3ApiServer) putToFiler(r *http.Request, uploadUrl string, dataReader
 	}
 	defer resp.Body.Close()
 
-	etag = fmt.Sprintf("%x", hash.Sum(nil))
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		glog.V(1).Infof("upload to %s: precondition failed", uploadUrl)
+		return "", ErrPreconditionFailed
+	}
+
+	digests := digestWriter.Sum()
+	if err = expectedDigests.Verify(digests); err != nil {
+		glog.V(1).Infof("upload to %s: %v", uploadUrl, err)
+		return "", ErrBadDigest
+	}
+	etag = fmt.Sprintf("%x", digests.Md5)
 
 	resp_body, ra_err := ioutil.ReadAll(resp.Body)
 	if ra_err != nil {
@@ -207,6 +299,13 @@ func (s3a *S3ApiServer) putToFiler(r *http.Request, uploadUrl string, dataReader
 	return etag, ErrNone
 }
 
+// setChecksumHeader sends back the verified checksum, as S3 does
+func setChecksumHeader(w http.ResponseWriter, r *http.Request) {
+	if checksum := r.Header.Get(util.ContentSha256Header); checksum != "" {
+		w.Header().Set(util.ContentSha256Header, checksum)
+	}
+}
+
 func setEtag(w http.ResponseWriter, etag string) {
 	if etag != "" {
 		if strings.HasPrefix(etag, "\"") {
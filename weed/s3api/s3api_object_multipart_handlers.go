@@ -211,6 +211,7 @@ func (s3a *S3ApiServer) PutObjectPartHandler(w http.ResponseWriter, r *http.Requ
 	}
 
 	setEtag(w, etag)
+	setChecksumHeader(w, r)
 
 	writeSuccessResponseEmpty(w)
 
@@ -96,32 +96,43 @@ func (g *Guard) checkWhiteList(w http.ResponseWriter, r *http.Request) error {
 	}
 
 	host, err := GetActualRemoteHost(r)
-	if err == nil {
-		for _, ip := range g.whiteList {
-
-			// If the whitelist entry contains a "/" it
-			// is a CIDR range, and we should check the
-			// remote host is within it
-			if strings.Contains(ip, "/") {
-				_, cidrnet, err := net.ParseCIDR(ip)
-				if err != nil {
-					panic(err)
-				}
-				remote := net.ParseIP(host)
-				if cidrnet.Contains(remote) {
-					return nil
-				}
-			}
+	if err == nil && g.IsWhiteListed(host) {
+		return nil
+	}
+
+	glog.V(0).Infof("Not in whitelist: %s", r.RemoteAddr)
+	return fmt.Errorf("Not in whitelis: %s", r.RemoteAddr)
+}
+
+// IsWhiteListed checks the remote host of the requests not going through http, e.g., grpc calls
+func (g *Guard) IsWhiteListed(host string) bool {
+	if len(g.whiteList) == 0 {
+		return true
+	}
 
-			//
-			// Otherwise we're looking for a literal match.
-			//
-			if ip == host {
-				return nil
+	for _, ip := range g.whiteList {
+
+		// If the whitelist entry contains a "/" it
+		// is a CIDR range, and we should check the
+		// remote host is within it
+		if strings.Contains(ip, "/") {
+			_, cidrnet, err := net.ParseCIDR(ip)
+			if err != nil {
+				panic(err)
+			}
+			remote := net.ParseIP(host)
+			if cidrnet.Contains(remote) {
+				return true
 			}
 		}
+
+		//
+		// Otherwise we're looking for a literal match.
+		//
+		if ip == host {
+			return true
+		}
 	}
 
-	glog.V(0).Infof("Not in whitelist: %s", r.RemoteAddr)
-	return fmt.Errorf("Not in whitelis: %s", r.RemoteAddr)
+	return false
 }
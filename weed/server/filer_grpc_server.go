@@ -14,6 +14,9 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (fs *FilerServer) LookupDirectoryEntry(ctx context.Context, req *filer_pb.LookupDirectoryEntryRequest) (*filer_pb.LookupDirectoryEntryResponse, error) {
@@ -29,6 +32,8 @@ func (fs *FilerServer) LookupDirectoryEntry(ctx context.Context, req *filer_pb.L
 			IsDirectory: entry.IsDirectory(),
 			Attributes:  filer2.EntryAttributeToPb(entry),
 			Chunks:      entry.Chunks,
+			Extended:    entry.Extended,
+			Version:     entry.Version,
 		},
 	}, nil
 }
@@ -74,6 +79,8 @@ func (fs *FilerServer) ListEntries(ctx context.Context, req *filer_pb.ListEntrie
 				IsDirectory: entry.IsDirectory(),
 				Chunks:      entry.Chunks,
 				Attributes:  filer2.EntryAttributeToPb(entry),
+				Extended:    entry.Extended,
+				Version:     entry.Version,
 			})
 			limit--
 			if limit == 0 {
@@ -130,29 +137,42 @@ func (fs *FilerServer) CreateEntry(ctx context.Context, req *filer_pb.CreateEntr
 		return nil, fmt.Errorf("can not create entry with empty attributes")
 	}
 
-	err = fs.filer.CreateEntry(ctx, &filer2.Entry{
+	newEntry := &filer2.Entry{
 		FullPath: fullpath,
 		Attr:     filer2.PbToEntryAttribute(req.Entry.Attributes),
 		Chunks:   chunks,
-	})
+		Extended: req.Entry.Extended,
+	}
+	if len(newEntry.Md5) > 0 {
+		if existingEntry, findErr := fs.filer.FindEntry(ctx, fullpath); findErr == nil {
+			filer2.DropCarriedOverMd5(existingEntry, newEntry)
+		}
+	}
+
+	err = fs.filer.CreateEntryWithPrecondition(ctx, newEntry, req.Precondition)
 
 	if err == nil {
 		fs.filer.DeleteChunks(fullpath, garbages)
 	}
 
-	return &filer_pb.CreateEntryResponse{}, err
+	return &filer_pb.CreateEntryResponse{}, toGrpcError(err)
 }
 
 func (fs *FilerServer) UpdateEntry(ctx context.Context, req *filer_pb.UpdateEntryRequest) (*filer_pb.UpdateEntryResponse, error) {
 
 	fullpath := filepath.ToSlash(filepath.Join(req.Directory, req.Entry.Name))
-	entry, err := fs.filer.FindEntry(ctx, filer2.FullPath(fullpath))
+
+	unlock := fs.filer.LockEntry(filer2.FullPath(fullpath))
+	defer unlock()
+
+	entry, err := fs.filer.FindEntryLocked(ctx, filer2.FullPath(fullpath))
 	if err != nil {
 		return &filer_pb.UpdateEntryResponse{}, fmt.Errorf("not found %s: %v", fullpath, err)
 	}
 
-	// remove old chunks if not included in the new ones
-	unusedChunks := filer2.MinusChunks(entry.Chunks, req.Entry.Chunks)
+	if err = filer2.CheckPrecondition(entry.FullPath, req.Precondition, entry); err != nil {
+		return &filer_pb.UpdateEntryResponse{}, toGrpcError(err)
+	}
 
 	chunks, garbages := filer2.CompactFileChunks(req.Entry.Chunks)
 
@@ -160,6 +180,11 @@ func (fs *FilerServer) UpdateEntry(ctx context.Context, req *filer_pb.UpdateEntr
 		FullPath: filer2.FullPath(filepath.ToSlash(filepath.Join(req.Directory, req.Entry.Name))),
 		Attr:     entry.Attr,
 		Chunks:   chunks,
+		Extended: entry.Extended,
+	}
+
+	if len(req.Entry.Extended) > 0 {
+		newEntry.Extended = req.Entry.Extended
 	}
 
 	glog.V(3).Infof("updating %s: %+v, chunks %d: %v => %+v, chunks %d: %v",
@@ -178,28 +203,59 @@ func (fs *FilerServer) UpdateEntry(ctx context.Context, req *filer_pb.UpdateEntr
 		newEntry.Attr.Mime = req.Entry.Attributes.Mime
 		newEntry.Attr.UserName = req.Entry.Attributes.UserName
 		newEntry.Attr.GroupNames = req.Entry.Attributes.GroupName
+		if len(req.Entry.Attributes.Md5) > 0 {
+			newEntry.Attr.Md5 = req.Entry.Attributes.Md5
+		}
 
 	}
 
+	filer2.DropCarriedOverMd5(entry, newEntry)
+
 	if filer2.EqualEntry(entry, newEntry) {
 		return &filer_pb.UpdateEntryResponse{}, err
 	}
 
 	if err = fs.filer.UpdateEntry(ctx, entry, newEntry); err == nil {
-		fs.filer.DeleteChunks(entry.FullPath, unusedChunks)
+		// remove old chunks if not included in the new ones
+		fs.filer.DeleteChunksIfNotNew(entry, newEntry)
 		fs.filer.DeleteChunks(entry.FullPath, garbages)
+		fs.filer.NotifyUpdateEvent(entry, newEntry, true)
 	}
 
-	fs.filer.NotifyUpdateEvent(entry, newEntry, true)
-
-	return &filer_pb.UpdateEntryResponse{}, err
+	return &filer_pb.UpdateEntryResponse{}, toGrpcError(err)
 }
 
 func (fs *FilerServer) DeleteEntry(ctx context.Context, req *filer_pb.DeleteEntryRequest) (resp *filer_pb.DeleteEntryResponse, err error) {
-	err = fs.filer.DeleteEntryMetaAndData(ctx, filer2.FullPath(filepath.ToSlash(filepath.Join(req.Directory, req.Name))), req.IsRecursive, req.IgnoreRecursiveError, req.IsDeleteData)
+	fullpath := filer2.FullPath(filepath.ToSlash(filepath.Join(req.Directory, req.Name)))
+
+	if req.Precondition != nil {
+		unlock := fs.filer.LockEntry(fullpath)
+		defer unlock()
+
+		entry, findErr := fs.filer.FindEntryLocked(ctx, fullpath)
+		if findErr != nil && findErr != filer2.ErrNotFound {
+			return &filer_pb.DeleteEntryResponse{}, findErr
+		}
+		if err = filer2.CheckPrecondition(fullpath, req.Precondition, entry); err != nil {
+			return &filer_pb.DeleteEntryResponse{}, toGrpcError(err)
+		}
+	}
+
+	err = fs.filer.DeleteEntryMetaAndData(ctx, fullpath, req.IsRecursive, req.IgnoreRecursiveError, req.IsDeleteData)
 	return &filer_pb.DeleteEntryResponse{}, err
 }
 
+// toGrpcError lets clients tell a failed precondition or an exceeded quota from other errors
+func toGrpcError(err error) error {
+	if _, ok := err.(*filer2.PreconditionFailedError); ok {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if _, ok := err.(*filer2.QuotaExceededError); ok {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return err
+}
+
 func (fs *FilerServer) AssignVolume(ctx context.Context, req *filer_pb.AssignVolumeRequest) (resp *filer_pb.AssignVolumeResponse, err error) {
 
 	ttlStr := ""
@@ -207,6 +263,28 @@ func (fs *FilerServer) AssignVolume(ctx context.Context, req *filer_pb.AssignVol
 		ttlStr = strconv.Itoa(int(req.TtlSec))
 	}
 
+	parentPath := req.ParentPath
+	if !strings.HasSuffix(parentPath, "/") {
+		parentPath += "/"
+	}
+	if policy := fs.filer.MatchStoragePolicy(parentPath); policy != nil {
+		if policy.Collection != "" {
+			req.Collection = policy.Collection
+		}
+		if policy.Replication != "" {
+			req.Replication = policy.Replication
+		}
+		if policy.Ttl != "" {
+			ttlStr = policy.Ttl
+		}
+		if policy.Durability != "" {
+			req.Durability = policy.Durability
+		}
+	}
+	if _, err = storage.ParseDurability(req.Durability); err != nil {
+		return nil, err
+	}
+
 	var altRequest *operation.VolumeAssignRequest
 
 	dataCenter := req.DataCenter
@@ -220,6 +298,7 @@ func (fs *FilerServer) AssignVolume(ctx context.Context, req *filer_pb.AssignVol
 		Collection:  req.Collection,
 		Ttl:         ttlStr,
 		DataCenter:  dataCenter,
+		Durability:  req.Durability,
 	}
 	if dataCenter != "" {
 		altRequest = &operation.VolumeAssignRequest{
@@ -228,6 +307,7 @@ func (fs *FilerServer) AssignVolume(ctx context.Context, req *filer_pb.AssignVol
 			Collection:  req.Collection,
 			Ttl:         ttlStr,
 			DataCenter:  "",
+			Durability:  req.Durability,
 		}
 	}
 	assignResult, err := operation.Assign(fs.filer.GetMaster(), fs.grpcDialOption, assignRequest, altRequest)
@@ -239,11 +319,12 @@ func (fs *FilerServer) AssignVolume(ctx context.Context, req *filer_pb.AssignVol
 	}
 
 	return &filer_pb.AssignVolumeResponse{
-		FileId:    assignResult.Fid,
-		Count:     int32(assignResult.Count),
-		Url:       assignResult.Url,
-		PublicUrl: assignResult.PublicUrl,
-		Auth:      string(assignResult.Auth),
+		FileId:     assignResult.Fid,
+		Count:      int32(assignResult.Count),
+		Url:        assignResult.Url,
+		PublicUrl:  assignResult.PublicUrl,
+		Auth:       string(assignResult.Auth),
+		Durability: req.Durability,
 	}, err
 }
 
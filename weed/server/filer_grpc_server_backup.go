@@ -0,0 +1,44 @@
+package weed_server
+
+import (
+	"fmt"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+const backupMetadataBatchSize = 1024
+
+func (fs *FilerServer) BackupMetadata(req *filer_pb.BackupMetadataRequest, stream filer_pb.SeaweedFiler_BackupMetadataServer) error {
+
+	dir := req.Directory
+	if dir == "" {
+		dir = "/"
+	}
+
+	glog.V(0).Infof("backup metadata under %s", dir)
+
+	resp := &filer_pb.BackupMetadataResponse{}
+
+	err := fs.filer.TraverseSnapshot(stream.Context(), filer2.FullPath(dir), func(entry *filer2.Entry) error {
+		resp.Entries = append(resp.Entries, entry.ToProtoFullEntry())
+		if len(resp.Entries) < backupMetadataBatchSize {
+			return nil
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+		resp = &filer_pb.BackupMetadataResponse{}
+		return nil
+	})
+	if err != nil {
+		glog.Errorf("backup metadata under %s: %v", dir, err)
+		return fmt.Errorf("backup metadata under %s: %v", dir, err)
+	}
+
+	if len(resp.Entries) > 0 {
+		return stream.Send(resp)
+	}
+	return nil
+}
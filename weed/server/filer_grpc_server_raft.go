@@ -0,0 +1,34 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chrislusf/raft"
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func (fs *FilerServer) ApplyStoreMutation(ctx context.Context, req *filer_pb.ApplyStoreMutationRequest) (*filer_pb.ApplyStoreMutationResponse, error) {
+
+	if fs.raftServer == nil {
+		return nil, fmt.Errorf("filer store replication is not enabled")
+	}
+
+	if fs.raftServer.raftServer.State() != raft.Leader {
+		return nil, fmt.Errorf("filer %s is not the filer store leader, leader is %s", fs.raftServer.serverAddr, fs.raftServer.raftServer.Leader())
+	}
+
+	err := fs.raftServer.store.Mutate(ctx, &filer2.StoreMutationCommand{
+		Op:       req.Op,
+		FullPath: req.FullPath,
+		Data:     req.Data,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &filer_pb.ApplyStoreMutationResponse{
+		CommitIndex: fs.raftServer.store.CommitIndex(),
+	}, nil
+}
@@ -18,6 +18,9 @@ func (fs *FilerServer) AtomicRenameEntry(ctx context.Context, req *filer_pb.Atom
 		return nil, err
 	}
 
+	// the moved files keep their owners, whose usage does not grow
+	ctx = filer2.WithoutQuotaEnforcement(ctx)
+
 	oldParent := filer2.FullPath(filepath.ToSlash(req.OldDirectory))
 
 	oldEntry, err := fs.filer.FindEntry(ctx, oldParent.Child(req.OldName))
@@ -106,6 +109,7 @@ func (fs *FilerServer) moveSelfEntry(ctx context.Context, oldParent filer2.FullP
 		FullPath: newPath,
 		Attr:     entry.Attr,
 		Chunks:   entry.Chunks,
+		Extended: entry.Extended,
 	}
 	createErr := fs.filer.CreateEntry(ctx, newEntry)
 	if createErr != nil {
@@ -0,0 +1,24 @@
+package weed_server
+
+import (
+	"fmt"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func (fs *FilerServer) SubscribeMetadata(req *filer_pb.SubscribeMetadataRequest, stream filer_pb.SeaweedFiler_SubscribeMetadataServer) error {
+
+	glog.V(0).Infof("%s subscribes metadata under %s since %d", req.ClientName, req.PathPrefix, req.SinceNs)
+	defer glog.V(0).Infof("%s unsubscribes metadata under %s", req.ClientName, req.PathPrefix)
+
+	err := fs.filer.SubscribeMetadata(stream.Context(), req.PathPrefix, req.SinceNs, func(event *filer_pb.SubscribeMetadataResponse) error {
+		return stream.Send(event)
+	})
+	if err != nil {
+		glog.V(0).Infof("%s subscribes metadata under %s: %v", req.ClientName, req.PathPrefix, err)
+		return fmt.Errorf("subscribe metadata under %s: %v", req.PathPrefix, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package weed_server
+
+import (
+	"os"
+	"path"
+	"time"
+
+	"github.com/chrislusf/raft"
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"google.golang.org/grpc"
+)
+
+const (
+	filerRaftSnapshotInterval   = time.Minute
+	filerRaftSnapshotMinChanges = 10000
+)
+
+// FilerRaftServer replicates the filer store mutations among a group of filers
+type FilerRaftServer struct {
+	peers      []string // initial peers to join with
+	raftServer raft.Server
+	dataDir    string
+	serverAddr string
+	store      *filer2.ReplicatedFilerStore
+	*raft.GrpcServer
+}
+
+func NewFilerRaftServer(grpcDialOption grpc.DialOption, peers []string, serverAddr, dataDir string, store *filer2.ReplicatedFilerStore) *FilerRaftServer {
+	s := &FilerRaftServer{
+		peers:      peers,
+		serverAddr: serverAddr,
+		dataDir:    dataDir,
+		store:      store,
+	}
+
+	if glog.V(4) {
+		raft.SetLogLevel(2)
+	}
+
+	var err error
+	transporter := raft.NewGrpcTransporter(grpcDialOption)
+	glog.V(0).Infof("Starting FilerRaftServer with %v", serverAddr)
+
+	if err = os.MkdirAll(s.dataDir, 0755); err != nil {
+		glog.V(0).Infoln(err)
+		return nil
+	}
+
+	// Clear old cluster configurations if peers are changed
+	if oldPeers, changed := isPeersChanged(s.dataDir, serverAddr, s.peers); changed {
+		glog.V(0).Infof("Peers Change: %v => %v", oldPeers, s.peers)
+		os.RemoveAll(path.Join(s.dataDir, "conf"))
+	}
+
+	s.raftServer, err = raft.NewServer(s.serverAddr, s.dataDir, transporter, store, store, "")
+	if err != nil {
+		glog.V(0).Infoln(err)
+		return nil
+	}
+
+	// the raft log is compacted up to the latest snapshot, so the snapshot must be loaded before the log
+	if err = os.MkdirAll(path.Join(s.dataDir, "snapshot"), 0700); err != nil {
+		glog.V(0).Infoln(err)
+		return nil
+	}
+	if err = store.LoadLocalSnapshot(s.raftServer.LoadSnapshot); err != nil {
+		glog.V(0).Infof("load filer store snapshot: %v", err)
+		return nil
+	}
+	s.raftServer.SetHeartbeatInterval(500 * time.Millisecond)
+	s.raftServer.SetElectionTimeout(2500 * time.Millisecond)
+	s.raftServer.Start()
+
+	for _, peer := range s.peers {
+		s.raftServer.AddPeer(peer, util.ServerToGrpcAddress(peer))
+	}
+
+	s.GrpcServer = raft.NewGrpcServer(s.raftServer)
+
+	if s.raftServer.IsLogEmpty() && isTheFirstOne(serverAddr, s.peers) {
+		// Initialize the server by joining itself.
+		glog.V(0).Infoln("Initializing new filer store cluster")
+
+		_, err := s.raftServer.Do(&raft.DefaultJoinCommand{
+			Name:             s.raftServer.Name(),
+			ConnectionString: util.ServerToGrpcAddress(s.serverAddr),
+		})
+
+		if err != nil {
+			glog.V(0).Infoln(err)
+			return nil
+		}
+	}
+
+	s.raftServer.AddEventListener(raft.LeaderChangeEventType, func(e raft.Event) {
+		glog.V(0).Infof("filer store leader change: %v => %v", e.PrevValue(), e.Value())
+	})
+
+	store.SetRaftServer(s.raftServer)
+
+	glog.V(0).Infof("current filer store leader: %v", s.raftServer.Leader())
+
+	go s.loopTakingSnapshots()
+
+	return s
+}
+
+// loopTakingSnapshots snapshots the filer store after enough changes, so that the raft log is compacted
+func (s *FilerRaftServer) loopTakingSnapshots() {
+	lastSnapshotIndex := s.raftServer.CommitIndex()
+	for range time.Tick(filerRaftSnapshotInterval) {
+		commitIndex := s.raftServer.CommitIndex()
+		if commitIndex < lastSnapshotIndex+filerRaftSnapshotMinChanges {
+			continue
+		}
+		if err := s.raftServer.TakeSnapshot(); err != nil {
+			glog.Warningf("take filer store snapshot at %d: %v", commitIndex, err)
+			continue
+		}
+		lastSnapshotIndex = commitIndex
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/chrislusf/seaweedfs/weed/filer2"
+	_ "github.com/chrislusf/seaweedfs/weed/filer2/badger"
 	_ "github.com/chrislusf/seaweedfs/weed/filer2/cassandra"
 	_ "github.com/chrislusf/seaweedfs/weed/filer2/etcd"
 	_ "github.com/chrislusf/seaweedfs/weed/filer2/leveldb"
@@ -46,6 +47,9 @@ type FilerOption struct {
 	DefaultLevelDbDir  string
 	DisableHttp        bool
 	Port               int
+	Host               string
+	Peers              []string
+	RaftDir            string
 }
 
 type FilerServer struct {
@@ -53,6 +57,7 @@ type FilerServer struct {
 	secret         security.SigningKey
 	filer          *filer2.Filer
 	grpcDialOption grpc.DialOption
+	raftServer     *FilerRaftServer
 }
 
 func NewFilerServer(defaultMux, readonlyMux *http.ServeMux, option *FilerOption) (fs *FilerServer, err error) {
@@ -67,6 +72,7 @@ func NewFilerServer(defaultMux, readonlyMux *http.ServeMux, option *FilerOption)
 	}
 
 	fs.filer = filer2.NewFiler(option.Masters, fs.grpcDialOption)
+	operation.EnableGrpcDataPlane(fs.grpcDialOption)
 
 	go fs.filer.KeepConnectedToMaster()
 
@@ -83,6 +89,15 @@ func NewFilerServer(defaultMux, readonlyMux *http.ServeMux, option *FilerOption)
 
 	fs.filer.LoadConfiguration(v)
 
+	if len(option.Peers) > 0 {
+		replicatedStore := fs.filer.ReplicateStore()
+		fs.raftServer = NewFilerRaftServer(fs.grpcDialOption, option.Peers,
+			fmt.Sprintf("%s:%d", option.Host, option.Port), option.RaftDir, replicatedStore)
+		if fs.raftServer == nil {
+			return nil, fmt.Errorf("failed to start filer store replication with peers %v", option.Peers)
+		}
+	}
+
 	notification.LoadConfiguration(v.Sub("notification"))
 
 	handleStaticResources(defaultMux)
@@ -98,6 +113,11 @@ func NewFilerServer(defaultMux, readonlyMux *http.ServeMux, option *FilerOption)
 	return fs, nil
 }
 
+// RaftServer returns the raft server replicating the filer store, or nil if not enabled
+func (fs *FilerServer) RaftServer() *FilerRaftServer {
+	return fs.raftServer
+}
+
 func maybeStartMetrics(fs *FilerServer, option *FilerOption) {
 	isConnected := false
 	var metricsAddress string
@@ -69,11 +69,11 @@ func (fs *FilerServer) GetOrHeadHandler(w http.ResponseWriter, r *http.Request,
 	if r.Method == "HEAD" {
 		w.Header().Set("Content-Length", strconv.FormatInt(int64(filer2.TotalSize(entry.Chunks)), 10))
 		w.Header().Set("Last-Modified", entry.Attr.Mtime.Format(http.TimeFormat))
-		setEtag(w, filer2.ETag(entry.Chunks))
+		setEtag(w, filer2.ETagEntry(entry))
 		return
 	}
 
-	if len(entry.Chunks) == 1 {
+	if len(entry.Chunks) == 1 && !entry.Chunks[0].IsChunkManifest {
 		fs.handleSingleChunk(w, r, entry)
 		return
 	}
@@ -135,8 +135,59 @@ func (fs *FilerServer) handleSingleChunk(w http.ResponseWriter, r *http.Request,
 	if entry.Attr.Mime != "" {
 		w.Header().Set("Content-Type", entry.Attr.Mime)
 	}
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusPartialContent {
+		// the same etag as the HEAD request, instead of the one of the needle
+		setEtag(w, filer2.ETagEntry(entry))
+	}
 	w.WriteHeader(resp.StatusCode)
-	io.Copy(w, resp.Body)
+
+	// only the whole chunk as stored can be checked against its md5
+	chunk := entry.Chunks[0]
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Encoding") != "" || len(chunk.Md5) == 0 {
+		io.Copy(w, resp.Body)
+		return
+	}
+	verifier := filer2.NewChunkViewVerifier(&filer2.ChunkView{FileId: fileId, Size: chunk.Size, Md5: chunk.Md5, IsFullChunk: true})
+	held := &lastByteHoldingWriter{w: w}
+	if _, err := io.Copy(io.MultiWriter(held, verifier), resp.Body); err != nil {
+		glog.V(1).Infof("read %s: %v", fileId, err)
+		return
+	}
+	if err := verifier.Verify(); err != nil {
+		glog.Errorf("read %s: %v", entry.FullPath, err)
+		stats.FilerRequestCounter.WithLabelValues("md5Mismatch").Inc()
+		// the client sees a truncated response, instead of the corrupted content as if it were complete
+		panic(http.ErrAbortHandler)
+	}
+	held.Flush()
+}
+
+// lastByteHoldingWriter writes all but the last byte, until Flush,
+// so that the response can still be cut short after all the content is read
+type lastByteHoldingWriter struct {
+	w    io.Writer
+	last []byte
+}
+
+func (h *lastByteHoldingWriter) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if len(h.last) > 0 {
+		if _, err := h.w.Write(h.last); err != nil {
+			return 0, err
+		}
+	}
+	if _, err := h.w.Write(p[:len(p)-1]); err != nil {
+		return 0, err
+	}
+	h.last = append(h.last[:0], p[len(p)-1])
+	return len(p), nil
+}
+
+func (h *lastByteHoldingWriter) Flush() error {
+	_, err := h.w.Write(h.last)
+	return err
 }
 
 func (fs *FilerServer) handleMultipleChunks(w http.ResponseWriter, r *http.Request, entry *filer2.Entry) {
@@ -150,7 +201,7 @@ func (fs *FilerServer) handleMultipleChunks(w http.ResponseWriter, r *http.Reque
 	if mimeType != "" {
 		w.Header().Set("Content-Type", mimeType)
 	}
-	setEtag(w, filer2.ETag(entry.Chunks))
+	setEtag(w, filer2.ETagEntry(entry))
 
 	totalSize := int64(filer2.TotalSize(entry.Chunks))
 
@@ -22,6 +22,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/stats"
+	"github.com/chrislusf/seaweedfs/weed/storage"
 	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
@@ -38,7 +39,7 @@ type FilerPostResult struct {
 	Url   string `json:"url,omitempty"`
 }
 
-func (fs *FilerServer) assignNewFileInfo(w http.ResponseWriter, r *http.Request, replication, collection string, dataCenter string) (fileId, urlLocation string, auth security.EncodedJwt, err error) {
+func (fs *FilerServer) assignNewFileInfo(w http.ResponseWriter, r *http.Request, replication, collection, ttl string, dataCenter string, durability string) (fileId, urlLocation string, auth security.EncodedJwt, err error) {
 
 	stats.FilerRequestCounter.WithLabelValues("assign").Inc()
 	start := time.Now()
@@ -48,8 +49,9 @@ func (fs *FilerServer) assignNewFileInfo(w http.ResponseWriter, r *http.Request,
 		Count:       1,
 		Replication: replication,
 		Collection:  collection,
-		Ttl:         r.URL.Query().Get("ttl"),
+		Ttl:         ttl,
 		DataCenter:  dataCenter,
+		Durability:  durability,
 	}
 	var altRequest *operation.VolumeAssignRequest
 	if dataCenter != "" {
@@ -57,8 +59,9 @@ func (fs *FilerServer) assignNewFileInfo(w http.ResponseWriter, r *http.Request,
 			Count:       1,
 			Replication: replication,
 			Collection:  collection,
-			Ttl:         r.URL.Query().Get("ttl"),
+			Ttl:         ttl,
 			DataCenter:  "",
+			Durability:  durability,
 		}
 	}
 
@@ -70,7 +73,7 @@ func (fs *FilerServer) assignNewFileInfo(w http.ResponseWriter, r *http.Request,
 		return
 	}
 	fileId = assignResult.Fid
-	urlLocation = "http://" + assignResult.Url + "/" + assignResult.Fid
+	urlLocation = operation.WithDurability("http://"+assignResult.Url+"/"+assignResult.Fid, durability)
 	auth = assignResult.Auth
 	return
 }
@@ -88,16 +91,44 @@ func (fs *FilerServer) PostHandler(w http.ResponseWriter, r *http.Request) {
 	if collection == "" {
 		collection = fs.option.Collection
 	}
+	ttl := query.Get("ttl")
 	dataCenter := query.Get("dataCenter")
 	if dataCenter == "" {
 		dataCenter = fs.option.DataCenter
 	}
+	durability := query.Get("durability")
 
-	if autoChunked := fs.autoChunk(ctx, w, r, replication, collection, dataCenter); autoChunked {
+	// the storage policy of the path overrides the requested values
+	maxMB, dedup := int32(0), false
+	if policy := fs.filer.MatchStoragePolicy(r.URL.Path); policy != nil {
+		if policy.Collection != "" {
+			collection = policy.Collection
+		}
+		if policy.Replication != "" {
+			replication = policy.Replication
+		}
+		if policy.Ttl != "" {
+			ttl = policy.Ttl
+		}
+		if policy.Durability != "" {
+			durability = policy.Durability
+		}
+		maxMB, dedup = policy.ChunkSizeMb, policy.Dedup
+	}
+	if _, err := storage.ParseDurability(durability); err != nil {
+		writeJsonError(w, r, http.StatusBadRequest, err)
 		return
 	}
+	// the chunks with a ttl expire with their volumes, and can not be shared
+	if ttl != "" {
+		dedup = false
+	}
 
-	fileId, urlLocation, auth, err := fs.assignNewFileInfo(w, r, replication, collection, dataCenter)
+	if autoChunked := fs.autoChunk(ctx, w, r, replication, collection, ttl, dataCenter, durability, maxMB, dedup); autoChunked {
+		return
+	}
+
+	fileId, urlLocation, auth, err := fs.assignNewFileInfo(w, r, replication, collection, ttl, dataCenter, durability)
 
 	if err != nil || fileId == "" || urlLocation == "" {
 		glog.V(0).Infof("fail to allocate volume for %s, collection:%s, datacenter:%s", r.URL.Path, collection, dataCenter)
@@ -123,7 +154,8 @@ func (fs *FilerServer) PostHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err = fs.updateFilerStore(ctx, r, w, replication, collection, ret, fileId); err != nil {
+	entry, err := fs.updateFilerStore(ctx, r, w, replication, collection, ret, fileId, cm)
+	if err != nil {
 		return
 	}
 
@@ -135,13 +167,15 @@ func (fs *FilerServer) PostHandler(w http.ResponseWriter, r *http.Request) {
 		Fid:   fileId,
 		Url:   urlLocation,
 	}
-	setEtag(w, ret.ETag)
+	setEtag(w, filer2.ETagEntry(entry))
+	util.ContentDigests{Md5: entry.Md5}.SetHeaders(w.Header())
 	writeJsonQuiet(w, r, http.StatusCreated, reply)
 }
 
 // update metadata in filer store
+// The md5 of the content is recorded, unless the content is a chunk manifest.
 func (fs *FilerServer) updateFilerStore(ctx context.Context, r *http.Request, w http.ResponseWriter,
-	replication string, collection string, ret operation.UploadResult, fileId string) (err error) {
+	replication string, collection string, ret operation.UploadResult, fileId string, isChunkManifest bool) (entry *filer2.Entry, err error) {
 
 	stats.FilerRequestCounter.WithLabelValues("postStoreWrite").Inc()
 	start := time.Now()
@@ -166,11 +200,14 @@ func (fs *FilerServer) updateFilerStore(ctx context.Context, r *http.Request, w
 		}
 	}
 	existingEntry, err := fs.filer.FindEntry(ctx, filer2.FullPath(path))
+	if err != nil {
+		existingEntry = nil
+	}
 	crTime := time.Now()
-	if err == nil && existingEntry != nil {
+	if existingEntry != nil {
 		crTime = existingEntry.Crtime
 	}
-	entry := &filer2.Entry{
+	entry = &filer2.Entry{
 		FullPath: filer2.FullPath(path),
 		Attr: filer2.Attr{
 			Mtime:       time.Now(),
@@ -189,19 +226,52 @@ func (fs *FilerServer) updateFilerStore(ctx context.Context, r *http.Request, w
 			ETag:   ret.ETag,
 		}},
 	}
+	if !isChunkManifest {
+		entry.Chunks[0].Md5 = ret.ContentMd5
+		entry.Attr.Md5 = ret.ContentMd5
+	}
 	if ext := filenamePath.Ext(path); ext != "" {
 		entry.Attr.Mime = mime.TypeByExtension(ext)
 	}
 	// glog.V(4).Infof("saving %s => %+v", path, entry)
-	if dbErr := fs.filer.CreateEntry(ctx, entry); dbErr != nil {
+	if dbErr := fs.filer.CreateEntryWithPrecondition(ctx, entry, writePrecondition(r, existingEntry)); dbErr != nil {
 		fs.filer.DeleteChunks(entry.FullPath, entry.Chunks)
 		glog.V(0).Infof("failing to write %s to filer server : %v", path, dbErr)
-		writeJsonError(w, r, http.StatusInternalServerError, dbErr)
+		if _, ok := dbErr.(*filer2.PreconditionFailedError); ok {
+			writeJsonError(w, r, http.StatusPreconditionFailed, dbErr)
+		} else {
+			writeJsonError(w, r, http.StatusInternalServerError, dbErr)
+		}
 		err = dbErr
 		return
 	}
 
-	return nil
+	return entry, nil
+}
+
+// writePrecondition turns the If-Match and If-None-Match headers into a precondition on the existing entry.
+// The entry is pinned to the version that was compared, so that a concurrent change also fails the write.
+func writePrecondition(r *http.Request, existingEntry *filer2.Entry) *filer_pb.EntryPrecondition {
+	ifMatch, ifNoneMatch := r.Header.Get("If-Match"), r.Header.Get("If-None-Match")
+	if ifMatch == "" && ifNoneMatch == "" {
+		return nil
+	}
+	if existingEntry == nil {
+		if ifMatch != "" {
+			return &filer_pb.EntryPrecondition{MustExist: true}
+		}
+		return &filer_pb.EntryPrecondition{MustNotExist: true}
+	}
+	etag := filer2.ETagEntry(existingEntry)
+	if (ifMatch != "" && !util.ETagMatches(ifMatch, etag)) || (ifNoneMatch != "" && util.ETagMatches(ifNoneMatch, etag)) {
+		// the existing entry always fails this precondition
+		return &filer_pb.EntryPrecondition{MustNotExist: true}
+	}
+	return &filer_pb.EntryPrecondition{
+		MustExist:       true,
+		ExpectedVersion: existingEntry.Version,
+		ExpectedMtime:   existingEntry.Mtime.Unix(),
+	}
 }
 
 // send request to volume server
@@ -255,7 +325,12 @@ func (fs *FilerServer) uploadToVolumeServer(r *http.Request, u *url.URL, auth se
 	if ret.Error != "" {
 		err = errors.New(ret.Error)
 		glog.V(0).Infoln("failing to post to volume server", r.RequestURI, ret.Error)
-		writeJsonError(w, r, http.StatusInternalServerError, err)
+		if resp.StatusCode == http.StatusBadRequest {
+			// e.g., the content does not match the Content-MD5 header
+			writeJsonError(w, r, http.StatusBadRequest, err)
+		} else {
+			writeJsonError(w, r, http.StatusInternalServerError, err)
+		}
 		return
 	}
 	// find correct final path
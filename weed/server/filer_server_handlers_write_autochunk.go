@@ -3,6 +3,8 @@ package weed_server
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -20,21 +22,32 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
+// the chunk size to deduplicate the files, if auto chunking is not enabled
+const dedupChunkSizeMB = 32
+
 func (fs *FilerServer) autoChunk(ctx context.Context, w http.ResponseWriter, r *http.Request,
-	replication string, collection string, dataCenter string) bool {
-	if r.Method != "POST" {
+	replication string, collection string, ttl string, dataCenter string, durability string, policyMaxMB int32, dedup bool) bool {
+	// the deduplicated files are always chunked, so that each chunk can be shared
+	if r.Method != "POST" && !dedup {
 		glog.V(4).Infoln("AutoChunking not supported for method", r.Method)
 		return false
 	}
 
-	// autoChunking can be set at the command-line level or as a query param. Query param overrides command-line
+	// autoChunking can be set at the command-line level, as a query param, or by the storage policy.
+	// The storage policy overrides the query param, which overrides command-line
 	query := r.URL.Query()
 
 	parsedMaxMB, _ := strconv.ParseInt(query.Get("maxMB"), 10, 32)
 	maxMB := int32(parsedMaxMB)
+	if policyMaxMB > 0 {
+		maxMB = policyMaxMB
+	}
 	if maxMB <= 0 && fs.option.MaxMB > 0 {
 		maxMB = int32(fs.option.MaxMB)
 	}
+	if maxMB <= 0 && dedup {
+		maxMB = dedupChunkSizeMB
+	}
 	if maxMB <= 0 {
 		glog.V(4).Infoln("AutoChunking not enabled")
 		return false
@@ -46,7 +59,7 @@ func (fs *FilerServer) autoChunk(ctx context.Context, w http.ResponseWriter, r *
 	contentLength := int64(0)
 	if contentLengthHeader := r.Header["Content-Length"]; len(contentLengthHeader) == 1 {
 		contentLength, _ = strconv.ParseInt(contentLengthHeader[0], 10, 64)
-		if contentLength <= int64(chunkSize) {
+		if contentLength <= int64(chunkSize) && !dedup {
 			glog.V(4).Infoln("Content-Length of", contentLength, "is less than the chunk size of", chunkSize, "so autoChunking will be skipped.")
 			return false
 		}
@@ -57,8 +70,18 @@ func (fs *FilerServer) autoChunk(ctx context.Context, w http.ResponseWriter, r *
 		return false
 	}
 
-	reply, err := fs.doAutoChunk(ctx, w, r, contentLength, chunkSize, replication, collection, dataCenter)
+	expectedDigests, err := util.ParseContentDigests(r.Header)
 	if err != nil {
+		writeJsonError(w, r, http.StatusBadRequest, err)
+		return true
+	}
+
+	reply, err := fs.doAutoChunk(ctx, w, r, contentLength, chunkSize, replication, collection, ttl, dataCenter, durability, dedup, expectedDigests)
+	if _, ok := err.(*filer2.PreconditionFailedError); ok {
+		writeJsonError(w, r, http.StatusPreconditionFailed, err)
+	} else if _, ok := err.(*util.DigestMismatchError); ok {
+		writeJsonError(w, r, http.StatusBadRequest, err)
+	} else if err != nil {
 		writeJsonError(w, r, http.StatusInternalServerError, err)
 	} else if reply != nil {
 		writeJsonQuiet(w, r, http.StatusCreated, reply)
@@ -66,8 +89,10 @@ func (fs *FilerServer) autoChunk(ctx context.Context, w http.ResponseWriter, r *
 	return true
 }
 
+// doAutoChunk saves the content in chunks, and verifies the whole content against the expected digests
 func (fs *FilerServer) doAutoChunk(ctx context.Context, w http.ResponseWriter, r *http.Request,
-	contentLength int64, chunkSize int32, replication string, collection string, dataCenter string) (filerResult *FilerPostResult, replyerr error) {
+	contentLength int64, chunkSize int32, replication string, collection string, ttl string, dataCenter string, durability string, dedup bool,
+	expectedDigests util.ContentDigests) (filerResult *FilerPostResult, replyerr error) {
 
 	stats.FilerRequestCounter.WithLabelValues("postAutoChunk").Inc()
 	start := time.Now()
@@ -75,19 +100,29 @@ func (fs *FilerServer) doAutoChunk(ctx context.Context, w http.ResponseWriter, r
 		stats.FilerRequestHistogram.WithLabelValues("postAutoChunk").Observe(time.Since(start).Seconds())
 	}()
 
-	multipartReader, multipartReaderErr := r.MultipartReader()
-	if multipartReaderErr != nil {
-		return nil, multipartReaderErr
-	}
+	var part1 io.Reader
+	var fileName, ext, mimeType string
+	if r.Method == "POST" {
+		multipartReader, multipartReaderErr := r.MultipartReader()
+		if multipartReaderErr != nil {
+			return nil, multipartReaderErr
+		}
 
-	part1, part1Err := multipartReader.NextPart()
-	if part1Err != nil {
-		return nil, part1Err
-	}
+		part, part1Err := multipartReader.NextPart()
+		if part1Err != nil {
+			return nil, part1Err
+		}
 
-	fileName := part1.FileName()
-	if fileName != "" {
-		fileName = path.Base(fileName)
+		fileName = part.FileName()
+		if fileName != "" {
+			fileName = path.Base(fileName)
+		}
+		part1, ext, mimeType = part, strings.ToLower(path.Ext(fileName)), part.Header.Get("Content-Type")
+	} else {
+		if strings.HasSuffix(r.URL.Path, "/") {
+			return nil, fmt.Errorf("can not to write to folder %s without a file name", r.URL.Path)
+		}
+		part1, ext, mimeType = r.Body, strings.ToLower(path.Ext(r.URL.Path)), r.Header.Get("Content-Type")
 	}
 
 	var fileChunks []*filer_pb.FileChunk
@@ -99,6 +134,7 @@ func (fs *FilerServer) doAutoChunk(ctx context.Context, w http.ResponseWriter, r
 	chunkBufOffset := int32(0)
 	chunkOffset := int64(0)
 	writtenChunks := 0
+	digestWriter := util.NewDigestWriter(expectedDigests.Sha256 != nil)
 
 	filerResult = &FilerPostResult{
 		Name: fileName,
@@ -110,33 +146,25 @@ func (fs *FilerServer) doAutoChunk(ctx context.Context, w http.ResponseWriter, r
 		readFully := readErr != nil && readErr == io.EOF
 		tmpBuf := tmpBuffer.Bytes()
 		bytesToCopy := tmpBuf[0:int(bytesRead)]
+		digestWriter.Write(bytesToCopy)
 
 		copy(chunkBuf[chunkBufOffset:chunkBufOffset+int32(bytesRead)], bytesToCopy)
 		chunkBufOffset = chunkBufOffset + int32(bytesRead)
 
 		if chunkBufOffset >= chunkSize || readFully || (chunkBufOffset > 0 && bytesRead == 0) {
 			writtenChunks = writtenChunks + 1
-			fileId, urlLocation, auth, assignErr := fs.assignNewFileInfo(w, r, replication, collection, dataCenter)
-			if assignErr != nil {
-				return nil, assignErr
-			}
 
-			// upload the chunk to the volume server
 			chunkName := fileName + "_chunk_" + strconv.FormatInt(int64(len(fileChunks)+1), 10)
-			uploadErr := fs.doUpload(urlLocation, w, r, chunkBuf[0:chunkBufOffset], chunkName, "application/octet-stream", fileId, auth)
-			if uploadErr != nil {
-				return nil, uploadErr
+			chunk, saveErr := fs.saveChunk(ctx, w, r, chunkBuf[0:chunkBufOffset], chunkName, ext, mimeType, replication, collection, ttl, dataCenter, durability, dedup)
+			if saveErr != nil {
+				fs.filer.DeleteChunks(filer2.FullPath(r.URL.Path), fileChunks)
+				return nil, saveErr
 			}
 
 			// Save to chunk manifest structure
-			fileChunks = append(fileChunks,
-				&filer_pb.FileChunk{
-					FileId: fileId,
-					Offset: chunkOffset,
-					Size:   uint64(chunkBufOffset),
-					Mtime:  time.Now().UnixNano(),
-				},
-			)
+			chunk.Offset = chunkOffset
+			chunk.Mtime = time.Now().UnixNano()
+			fileChunks = append(fileChunks, chunk)
 
 			// reset variables for the next chunk
 			chunkBufOffset = 0
@@ -150,10 +178,17 @@ func (fs *FilerServer) doAutoChunk(ctx context.Context, w http.ResponseWriter, r
 		}
 
 		if readErr != nil {
+			fs.filer.DeleteChunks(filer2.FullPath(r.URL.Path), fileChunks)
 			return nil, readErr
 		}
 	}
 
+	digests := digestWriter.Sum()
+	if verifyErr := expectedDigests.Verify(digests); verifyErr != nil {
+		fs.filer.DeleteChunks(filer2.FullPath(r.URL.Path), fileChunks)
+		return nil, verifyErr
+	}
+
 	path := r.URL.Path
 	if strings.HasSuffix(path, "/") {
 		if fileName != "" {
@@ -173,10 +208,15 @@ func (fs *FilerServer) doAutoChunk(ctx context.Context, w http.ResponseWriter, r
 			Replication: replication,
 			Collection:  collection,
 			TtlSec:      int32(util.ParseInt(r.URL.Query().Get("ttl"), 0)),
+			Md5:         digests.Md5,
 		},
 		Chunks: fileChunks,
 	}
-	if dbErr := fs.filer.CreateEntry(ctx, entry); dbErr != nil {
+	existingEntry, findErr := fs.filer.FindEntry(ctx, entry.FullPath)
+	if findErr != nil {
+		existingEntry = nil
+	}
+	if dbErr := fs.filer.CreateEntryWithPrecondition(ctx, entry, writePrecondition(r, existingEntry)); dbErr != nil {
 		fs.filer.DeleteChunks(entry.FullPath, entry.Chunks)
 		replyerr = dbErr
 		filerResult.Error = dbErr.Error()
@@ -184,11 +224,55 @@ func (fs *FilerServer) doAutoChunk(ctx context.Context, w http.ResponseWriter, r
 		return
 	}
 
+	setEtag(w, filer2.ETagEntry(entry))
+	util.ContentDigests{Md5: entry.Md5}.SetHeaders(w.Header())
+
 	return
 }
 
+// saveChunk uploads the chunk to a new file id, or reuses the chunk of the same content in the dedup index
+func (fs *FilerServer) saveChunk(ctx context.Context, w http.ResponseWriter, r *http.Request, chunkBuf []byte, chunkName, ext, mimeType string,
+	replication string, collection string, ttl string, dataCenter string, durability string, dedup bool) (*filer_pb.FileChunk, error) {
+
+	var contentHash string
+	placement := filer2.DedupPlacement{Collection: collection, Replication: replication, Ttl: ttl, Durability: durability}
+	if dedup {
+		contentHash = filer2.ContentHash(chunkBuf)
+		if chunk := fs.filer.ReferenceDedupChunk(ctx, placement, contentHash); chunk != nil {
+			stats.FilerRequestCounter.WithLabelValues("postDedupChunk").Inc()
+			glog.V(4).Infof("reuse chunk %s of %s", chunk.GetFileIdString(), chunkName)
+			return chunk, nil
+		}
+	}
+
+	fileId, urlLocation, auth, assignErr := fs.assignNewFileInfo(w, r, replication, collection, ttl, dataCenter, durability)
+	if assignErr != nil {
+		return nil, assignErr
+	}
+
+	// upload the chunk to the volume server, which verifies it against the md5
+	chunkMd5 := util.ComputeDigests(chunkBuf, false).Md5
+	compressible := util.IsGzippable(ext, mimeType, chunkBuf)
+	etag, uploadErr := fs.doUpload(urlLocation, w, r, chunkBuf, chunkMd5, chunkName, "application/octet-stream", compressible, fileId, auth)
+	if uploadErr != nil {
+		return nil, uploadErr
+	}
+
+	chunk := &filer_pb.FileChunk{
+		FileId: fileId,
+		Size:   uint64(len(chunkBuf)),
+		ETag:   etag,
+		Md5:    chunkMd5,
+	}
+	if dedup {
+		chunk.ContentHash = contentHash
+		fs.filer.AddDedupChunk(ctx, placement, chunk)
+	}
+	return chunk, nil
+}
+
 func (fs *FilerServer) doUpload(urlLocation string, w http.ResponseWriter, r *http.Request,
-	chunkBuf []byte, fileName string, contentType string, fileId string, auth security.EncodedJwt) (err error) {
+	chunkBuf []byte, chunkMd5 []byte, fileName string, contentType string, compressible bool, fileId string, auth security.EncodedJwt) (etag string, err error) {
 
 	stats.FilerRequestCounter.WithLabelValues("postAutoChunkUpload").Inc()
 	start := time.Now()
@@ -196,13 +280,25 @@ func (fs *FilerServer) doUpload(urlLocation string, w http.ResponseWriter, r *ht
 		stats.FilerRequestHistogram.WithLabelValues("postAutoChunkUpload").Observe(time.Since(start).Seconds())
 	}()
 
+	// the chunk name and type do not tell the volume server whether the chunk is compressible
+	contentEncoding := ""
+	if compressible {
+		if compressed, compressErr := util.CompressData(util.DefaultCompression, chunkBuf); compressErr == nil && len(compressed) < len(chunkBuf) {
+			chunkBuf, contentEncoding = compressed, util.DefaultCompression
+		}
+	}
+
 	ioReader := ioutil.NopCloser(bytes.NewBuffer(chunkBuf))
-	uploadResult, uploadError := operation.Upload(urlLocation, fileName, ioReader, false, contentType, nil, auth)
+	pairMap := map[string]string{
+		util.ContentMd5Header: base64.StdEncoding.EncodeToString(chunkMd5),
+	}
+	uploadResult, uploadError := operation.Upload(urlLocation, fileName, ioReader, contentEncoding, contentType, pairMap, auth)
 	if uploadResult != nil {
 		glog.V(0).Infoln("Chunk upload result. Name:", uploadResult.Name, "Fid:", fileId, "Size:", uploadResult.Size)
 	}
 	if uploadError != nil {
 		err = uploadError
+		return
 	}
-	return
+	return uploadResult.ETag, nil
 }
@@ -118,6 +118,14 @@ func (ms *MasterServer) SendHeartbeat(stream master_pb.Seaweed_SendHeartbeatServ
 			}
 		}
 
+		if len(heartbeat.DiskStatuses) > 0 {
+			// the replicated volumes on the failed disks are already taken out with heartbeat.Volumes
+			if newlyFailedDirs := dn.UpdateDiskStatuses(heartbeat.DiskStatuses); len(newlyFailedDirs) > 0 {
+				glog.Warningf("volume server %s reports failed disks %v", dn.Url(), newlyFailedDirs)
+				ms.triggerFixReplication()
+			}
+		}
+
 		if len(heartbeat.NewEcShards) > 0 || len(heartbeat.DeletedEcShards) > 0 {
 
 			// update master internal volume layouts
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/chrislusf/raft"
+	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
 	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/storage"
@@ -60,6 +61,9 @@ func (ms *MasterServer) Assign(ctx context.Context, req *master_pb.AssignRequest
 	if err != nil {
 		return nil, err
 	}
+	if _, err = storage.ParseDurability(req.Durability); err != nil {
+		return nil, err
+	}
 
 	option := &topology.VolumeGrowOption{
 		Collection:         req.Collection,
@@ -70,6 +74,7 @@ func (ms *MasterServer) Assign(ctx context.Context, req *master_pb.AssignRequest
 		Rack:               req.Rack,
 		DataNode:           req.DataNode,
 		MemoryMapMaxSizeMb: req.MemoryMapMaxSizeMb,
+		Durability:         req.Durability,
 	}
 
 	if !ms.Topo.HasWritableVolume(option) {
@@ -185,3 +190,19 @@ func (ms *MasterServer) GetMasterConfiguration(ctx context.Context, req *master_
 
 	return resp, nil
 }
+
+func (ms *MasterServer) VolumeServerDrain(ctx context.Context, req *master_pb.VolumeServerDrainRequest) (*master_pb.VolumeServerDrainResponse, error) {
+
+	if !ms.Topo.IsLeader() {
+		return nil, raft.NotLeaderError
+	}
+
+	dn := ms.Topo.FindDataNode(req.Node)
+	if dn == nil {
+		return nil, fmt.Errorf("volume server %s not found", req.Node)
+	}
+	dn.SetDraining(req.Draining)
+	glog.V(0).Infof("volume server %s draining: %v", req.Node, req.Draining)
+
+	return &master_pb.VolumeServerDrainResponse{}, nil
+}
@@ -65,6 +65,8 @@ type MasterServer struct {
 	grpcDialOption grpc.DialOption
 
 	MasterClient *wdclient.MasterClient
+
+	fixReplicationChan chan bool
 }
 
 func NewMasterServer(r *mux.Router, option *MasterOption, peers []string) *MasterServer {
@@ -92,6 +94,7 @@ func NewMasterServer(r *mux.Router, option *MasterOption, peers []string) *Maste
 		MasterClient:    wdclient.NewMasterClient(context.Background(), grpcDialOption, "master", peers),
 	}
 	ms.bounedLeaderChan = make(chan int, 16)
+	ms.fixReplicationChan = make(chan bool, 1)
 
 	seq := ms.createSequencer(option)
 	if nil == seq {
@@ -124,6 +127,7 @@ func NewMasterServer(r *mux.Router, option *MasterOption, peers []string) *Maste
 	ms.Topo.StartRefreshWritableVolumes(ms.grpcDialOption, ms.option.GarbageThreshold, ms.preallocateSize)
 
 	ms.startAdminScripts()
+	ms.startFixingReplication()
 
 	return ms
 }
@@ -198,12 +202,7 @@ func (ms *MasterServer) startAdminScripts() {
 
 	scriptLines := strings.Split(adminScripts, "\n")
 
-	masterAddress := "localhost:" + strconv.Itoa(ms.option.Port)
-
-	var shellOptions shell.ShellOptions
-	shellOptions.GrpcDialOption = security.LoadClientTLS(viper.Sub("grpc"), "master")
-	shellOptions.Masters = &masterAddress
-
+	shellOptions := ms.newShellOptions()
 	shellOptions.FilerHost, shellOptions.FilerPort, shellOptions.Directory, err = util.ParseFilerUrl(filerURL)
 	if err != nil {
 		glog.V(0).Infof("failed to parse master.filer.default_filer_urll=%s : %v\n", filerURL, err)
@@ -234,20 +233,58 @@ func (ms *MasterServer) startAdminScripts() {
 					}
 					cmd := strings.ToLower(cmds[0])
 
-					for _, c := range shell.Commands {
-						if c.Name() == cmd {
-							glog.V(0).Infof("executing: %s %v", cmd, args)
-							if err := c.Do(args, commandEnv, os.Stdout); err != nil {
-								glog.V(0).Infof("error: %v", err)
-							}
-						}
-					}
+					runShellCommand(commandEnv, cmd, args)
 				}
 			}
 		}
 	}()
 }
 
+// startFixingReplication runs volume.fix.replication when volume servers report failed disks,
+// to add back the missing replicas of the volumes on the failed disks.
+func (ms *MasterServer) startFixingReplication() {
+	go func() {
+		var commandEnv *shell.CommandEnv
+		for range ms.fixReplicationChan {
+			if !ms.Topo.IsLeader() {
+				continue
+			}
+			if commandEnv == nil {
+				commandEnv = shell.NewCommandEnv(ms.newShellOptions())
+				go commandEnv.MasterClient.KeepConnectedToMaster()
+				commandEnv.MasterClient.WaitUntilConnected()
+			}
+			runShellCommand(commandEnv, "volume.fix.replication", nil)
+		}
+	}()
+}
+
+func (ms *MasterServer) triggerFixReplication() {
+	select {
+	case ms.fixReplicationChan <- true:
+	default:
+		// already triggered
+	}
+}
+
+func (ms *MasterServer) newShellOptions() (shellOptions shell.ShellOptions) {
+	masterAddress := "localhost:" + strconv.Itoa(ms.option.Port)
+	shellOptions.GrpcDialOption = security.LoadClientTLS(viper.Sub("grpc"), "master")
+	shellOptions.Masters = &masterAddress
+	return
+}
+
+func runShellCommand(commandEnv *shell.CommandEnv, cmd string, args []string) {
+	for _, c := range shell.Commands {
+		if c.Name() == cmd {
+			glog.V(0).Infof("executing: %s %v", cmd, args)
+			if err := c.Do(args, commandEnv, os.Stdout); err != nil {
+				glog.V(0).Infof("error: %v", err)
+			}
+		}
+	}
+}
+
 func (ms *MasterServer) createSequencer(option *MasterOption) sequence.Sequencer {
 	var seq sequence.Sequencer
 	v := viper.GetViper()
@@ -157,6 +157,9 @@ func (ms *MasterServer) getVolumeGrowOption(r *http.Request) (*topology.VolumeGr
 	if err != nil {
 		return nil, err
 	}
+	if _, err = storage.ParseDurability(r.FormValue("durability")); err != nil {
+		return nil, err
+	}
 
 	preallocate := ms.preallocateSize
 	if r.FormValue("preallocate") != "" {
@@ -174,6 +177,7 @@ func (ms *MasterServer) getVolumeGrowOption(r *http.Request) (*topology.VolumeGr
 		Rack:               r.FormValue("rack"),
 		DataNode:           r.FormValue("dataNode"),
 		MemoryMapMaxSizeMb: memoryMapMaxSizeMb,
+		Durability:         r.FormValue("durability"),
 	}
 	return volumeGrowOption, nil
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 )
 
@@ -36,6 +37,7 @@ func (vs *VolumeServer) AllocateVolume(ctx context.Context, req *volume_server_p
 		req.Ttl,
 		req.Preallocate,
 		req.MemoryMapMaxSizeMb,
+		req.Durability,
 	)
 
 	if err != nil {
@@ -111,3 +113,25 @@ func (vs *VolumeServer) VolumeMarkReadonly(ctx context.Context, req *volume_serv
 	return resp, err
 
 }
+
+func (vs *VolumeServer) VolumeServerIoLimit(ctx context.Context, req *volume_server_pb.VolumeServerIoLimitRequest) (*volume_server_pb.VolumeServerIoLimitResponse, error) {
+
+	resp := &volume_server_pb.VolumeServerIoLimitResponse{}
+
+	for _, ioLimit := range req.IoLimits {
+		if err := vs.ioScheduler.SetBytesPerSecond(storage.IoCategory(ioLimit.Category), ioLimit.BytesPerSecond); err != nil {
+			return resp, err
+		}
+		glog.V(0).Infof("set %s io limit to %d bytes per second", ioLimit.Category, ioLimit.BytesPerSecond)
+	}
+
+	for _, category := range storage.IoCategories {
+		resp.IoLimits = append(resp.IoLimits, &volume_server_pb.IoLimit{
+			Category:       string(category),
+			BytesPerSecond: vs.ioScheduler.BytesPerSecond(category),
+		})
+	}
+
+	return resp, nil
+
+}
@@ -21,6 +21,7 @@ import (
 const BufferSizeLimit = 1024 * 1024 * 2
 
 // VolumeCopy copy the .idx .dat files, and mount the volume
+// The needles are copied as is, and their content can not be checked against the chunk md5 kept by the filer.
 func (vs *VolumeServer) VolumeCopy(ctx context.Context, req *volume_server_pb.VolumeCopyRequest) (*volume_server_pb.VolumeCopyResponse, error) {
 
 	v := vs.store.GetVolume(needle.VolumeId(req.VolumeId))
@@ -54,6 +55,8 @@ func (vs *VolumeServer) VolumeCopy(ctx context.Context, req *volume_server_pb.Vo
 		volumeFileName = storage.VolumeFileName(location.Directory, volFileInfoResp.Collection, int(req.VolumeId))
 
 		// println("source:", volFileInfoResp.String())
+		vs.doCopyVolumeInfo(ctx, client, false, req.Collection, req.VolumeId, volFileInfoResp.CompactionRevision, volumeFileName)
+
 		// copy ecx file
 		if err := vs.doCopyFile(ctx, client, false, req.Collection, req.VolumeId, volFileInfoResp.CompactionRevision, volFileInfoResp.IdxFileSize, volumeFileName, ".idx", false); err != nil {
 			return err
@@ -76,6 +79,7 @@ func (vs *VolumeServer) VolumeCopy(ctx context.Context, req *volume_server_pb.Vo
 		if datFileName != "" {
 			os.Remove(datFileName)
 		}
+		os.Remove(volumeFileName + ".vif")
 		return nil, err
 	}
 
@@ -94,6 +98,16 @@ func (vs *VolumeServer) VolumeCopy(ctx context.Context, req *volume_server_pb.Vo
 	}, err
 }
 
+// doCopyVolumeInfo copies the .vif file, which records the offset size of the .idx or .ecx file.
+// The servers of earlier versions do not have it, and the default offset size is assumed.
+func (vs *VolumeServer) doCopyVolumeInfo(ctx context.Context, client volume_server_pb.VolumeServerClient, isEcVolume bool, collection string, vid uint32,
+	compactRevision uint32, baseFileName string) {
+	if err := vs.doCopyFile(ctx, client, isEcVolume, collection, vid, compactRevision, math.MaxInt64, baseFileName, ".vif", false); err != nil {
+		glog.V(0).Infof("volume %d without volume info: %v", vid, err)
+		os.Remove(baseFileName + ".vif")
+	}
+}
+
 func (vs *VolumeServer) doCopyFile(ctx context.Context, client volume_server_pb.VolumeServerClient, isEcVolume bool, collection string, vid uint32,
 	compactRevision uint32, stopOffset uint64, baseFileName, ext string, isAppend bool) error {
 
@@ -109,7 +123,7 @@ func (vs *VolumeServer) doCopyFile(ctx context.Context, client volume_server_pb.
 		return fmt.Errorf("failed to start copying volume %d %s file: %v", vid, ext, err)
 	}
 
-	err = writeToFile(copyFileClient, baseFileName+ext, util.NewWriteThrottler(vs.compactionBytePerSecond), isAppend)
+	err = writeToFile(copyFileClient, baseFileName+ext, vs.copyThrottler(isEcVolume), isAppend)
 	if err != nil {
 		return fmt.Errorf("failed to copy %s file: %v", baseFileName+ext, err)
 	}
@@ -143,7 +157,14 @@ func checkCopyFiles(originFileInf *volume_server_pb.ReadVolumeFileStatusResponse
 	return nil
 }
 
-func writeToFile(client volume_server_pb.VolumeServer_CopyFileClient, fileName string, wt *util.WriteThrottler, isAppend bool) error {
+func (vs *VolumeServer) copyThrottler(isEcVolume bool) util.Throttler {
+	if isEcVolume {
+		return vs.ioScheduler.Throttler(storage.IoCategoryEc)
+	}
+	return vs.ioScheduler.Throttler(storage.IoCategoryCopy)
+}
+
+func writeToFile(client volume_server_pb.VolumeServer_CopyFileClient, fileName string, wt util.Throttler, isAppend bool) error {
 	glog.V(4).Infof("writing to %s", fileName)
 	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
 	if isAppend {
@@ -226,6 +247,7 @@ func (vs *VolumeServer) CopyFile(req *volume_server_pb.CopyFileRequest, stream v
 	defer file.Close()
 
 	buffer := make([]byte, BufferSizeLimit)
+	throttler := vs.copyThrottler(req.IsEcVolume)
 
 	for bytesToRead > 0 {
 		bytesread, err := file.Read(buffer)
@@ -252,6 +274,7 @@ func (vs *VolumeServer) CopyFile(req *volume_server_pb.CopyFileRequest, stream v
 		}
 
 		bytesToRead -= int64(bytesread)
+		throttler.MaybeSlowdown(int64(bytesread))
 
 	}
 
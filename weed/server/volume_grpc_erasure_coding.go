@@ -34,6 +34,7 @@ Steps to apply erasure coding to .dat .idx files
 */
 
 // VolumeEcShardsGenerate generates the .ecx and .ec01 ~ .ec14 files
+// The needle crc is checked when the needles are read from the shards, but not the chunk md5 kept by the filer.
 func (vs *VolumeServer) VolumeEcShardsGenerate(ctx context.Context, req *volume_server_pb.VolumeEcShardsGenerateRequest) (*volume_server_pb.VolumeEcShardsGenerateResponse, error) {
 
 	v := vs.store.GetVolume(needle.VolumeId(req.VolumeId))
@@ -52,7 +53,7 @@ func (vs *VolumeServer) VolumeEcShardsGenerate(ctx context.Context, req *volume_
 	}
 
 	// write .ec01 ~ .ec14 files
-	if err := erasure_coding.WriteEcFiles(baseFileName); err != nil {
+	if err := erasure_coding.WriteEcFiles(baseFileName, vs.ioScheduler.Throttler(storage.IoCategoryEc)); err != nil {
 		return nil, fmt.Errorf("WriteEcFiles %s: %v", baseFileName, err)
 	}
 
@@ -70,7 +71,7 @@ func (vs *VolumeServer) VolumeEcShardsRebuild(ctx context.Context, req *volume_s
 		if util.FileExists(path.Join(location.Directory, baseFileName+".ecx")) {
 			// write .ec01 ~ .ec14 files
 			baseFileName = path.Join(location.Directory, baseFileName)
-			if generatedShardIds, err := erasure_coding.RebuildEcFiles(baseFileName); err != nil {
+			if generatedShardIds, err := erasure_coding.RebuildEcFiles(baseFileName, vs.ioScheduler.Throttler(storage.IoCategoryEc)); err != nil {
 				return nil, fmt.Errorf("RebuildEcFiles %s: %v", baseFileName, err)
 			} else {
 				rebuiltShardIds = generatedShardIds
@@ -112,6 +113,8 @@ func (vs *VolumeServer) VolumeEcShardsCopy(ctx context.Context, req *volume_serv
 			return nil
 		}
 
+		vs.doCopyVolumeInfo(ctx, client, true, req.Collection, req.VolumeId, math.MaxUint32, baseFileName)
+
 		// copy ecx file
 		if err := vs.doCopyFile(ctx, client, true, req.Collection, req.VolumeId, math.MaxUint32, math.MaxInt64, baseFileName, ".ecx", false); err != nil {
 			return err
@@ -180,6 +183,9 @@ func (vs *VolumeServer) VolumeEcShardsDelete(ctx context.Context, req *volume_se
 		if err := os.Remove(baseFilename + ".ecj"); err != nil {
 			return nil, err
 		}
+		if !util.FileExists(baseFilename + ".dat") {
+			os.Remove(baseFilename + ".vif")
+		}
 	}
 
 	return &volume_server_pb.VolumeEcShardsDeleteResponse{}, nil
@@ -0,0 +1,270 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"path"
+	"time"
+
+	"google.golang.org/grpc/peer"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/stats"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/topology"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// ReadNeedle sends the data of each requested file id in pieces, in the order of the requests.
+// Errors of a file id are sent back in its response, and do not stop the stream.
+func (vs *VolumeServer) ReadNeedle(stream volume_server_pb.VolumeServer_ReadNeedleServer) error {
+
+	remoteAddr := remoteAddrOf(stream.Context())
+
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		stats.ReadRequest()
+		stats.VolumeServerRequestCounter.WithLabelValues("readNeedle").Inc()
+		start := time.Now()
+
+		n, data, readErr := vs.readNeedle(stream.Context(), req, remoteAddr)
+		if readErr != nil {
+			glog.V(1).Infof("read needle %s: %v", req.FileId, readErr)
+			err = stream.Send(&volume_server_pb.ReadNeedleResponse{
+				FileId: req.FileId,
+				IsLast: true,
+				Error:  readErr.Error(),
+			})
+		} else {
+			err = sendNeedleData(stream, req.FileId, n, data)
+		}
+		stats.VolumeServerRequestHistogram.WithLabelValues("readNeedle").Observe(time.Since(start).Seconds())
+		if err != nil {
+			return err
+		}
+	}
+
+}
+
+func (vs *VolumeServer) readNeedle(ctx context.Context, req *volume_server_pb.ReadNeedleRequest, remoteAddr string) (n *needle.Needle, data []byte, err error) {
+
+	vid, fid, err := operation.ParseFileId(req.FileId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !vs.maybeCheckJwt(security.EncodedJwt(req.Jwt), remoteAddr, vid, fid, false) {
+		return nil, nil, fmt.Errorf("wrong jwt")
+	}
+	volumeId, err := needle.NewVolumeId(vid)
+	if err != nil {
+		return nil, nil, err
+	}
+	n = new(needle.Needle)
+	if err = n.ParsePath(fid); err != nil {
+		return nil, nil, err
+	}
+
+	cookie := n.Cookie
+	var count int
+	if vs.store.HasVolume(volumeId) {
+		count, err = vs.store.ReadVolumeNeedle(volumeId, n)
+	} else if _, hasEcVolume := vs.store.FindEcVolume(volumeId); hasEcVolume {
+		count, err = vs.store.ReadEcShardNeedle(ctx, volumeId, n)
+	} else {
+		return nil, nil, fmt.Errorf("volume %d not found", volumeId)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if count < 0 || n.Cookie != cookie {
+		return nil, nil, fmt.Errorf("%s not found", req.FileId)
+	}
+	if n.IsChunkedManifest() {
+		return nil, nil, fmt.Errorf("%s is a chunk manifest, which is not supported", req.FileId)
+	}
+
+	data = n.Data
+	if compression := n.Compression(); compression != "" {
+		if data, err = util.DecompressData(compression, data); err != nil {
+			return nil, nil, fmt.Errorf("decompress %s: %v", req.FileId, err)
+		}
+	}
+
+	if req.Offset < 0 || req.Offset > int64(len(data)) || req.Size < 0 {
+		return nil, nil, fmt.Errorf("%s has %d bytes, out of range: offset %d size %d", req.FileId, len(data), req.Offset, req.Size)
+	}
+	stop := int64(len(data))
+	if req.Size > 0 && req.Offset+req.Size < stop {
+		stop = req.Offset + req.Size
+	}
+
+	return n, data[req.Offset:stop], nil
+}
+
+func sendNeedleData(stream volume_server_pb.VolumeServer_ReadNeedleServer, fileId string, n *needle.Needle, data []byte) error {
+
+	resp := &volume_server_pb.ReadNeedleResponse{
+		FileId:       fileId,
+		Name:         string(n.Name),
+		Mime:         string(n.Mime),
+		Etag:         n.Etag(),
+		LastModified: n.LastModified,
+		TotalSize:    int64(len(data)),
+	}
+
+	for {
+		stop := len(data)
+		if stop > BufferSizeLimit {
+			stop = BufferSizeLimit
+		}
+		resp.Data, data = data[:stop], data[stop:]
+		resp.IsLast = len(data) == 0
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+		if resp.IsLast {
+			return nil
+		}
+		resp = &volume_server_pb.ReadNeedleResponse{
+			FileId: fileId,
+		}
+	}
+
+}
+
+// WriteNeedle writes each file id after receiving all of its pieces, and responds in the order of the requests.
+// Errors of a file id are sent back in its response, and do not stop the stream.
+func (vs *VolumeServer) WriteNeedle(stream volume_server_pb.VolumeServer_WriteNeedleServer) error {
+
+	remoteAddr := remoteAddrOf(stream.Context())
+
+	var first *volume_server_pb.WriteNeedleRequest
+	var data []byte
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			if first != nil {
+				return fmt.Errorf("missing the last piece of %s", first.FileId)
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if first == nil {
+			first, data = req, req.Data
+		} else {
+			data = append(data, req.Data...)
+		}
+		if !req.IsLast {
+			continue
+		}
+
+		stats.WriteRequest()
+		stats.VolumeServerRequestCounter.WithLabelValues("writeNeedle").Inc()
+		start := time.Now()
+
+		resp := vs.writeNeedle(first, data, remoteAddr)
+		if resp.Error != "" {
+			glog.V(1).Infof("write needle %s: %v", first.FileId, resp.Error)
+		}
+		first, data = nil, nil
+
+		err = stream.Send(resp)
+		stats.VolumeServerRequestHistogram.WithLabelValues("writeNeedle").Observe(time.Since(start).Seconds())
+		if err != nil {
+			return err
+		}
+	}
+
+}
+
+func (vs *VolumeServer) writeNeedle(req *volume_server_pb.WriteNeedleRequest, data []byte, remoteAddr string) *volume_server_pb.WriteNeedleResponse {
+
+	resp := &volume_server_pb.WriteNeedleResponse{
+		FileId: req.FileId,
+	}
+
+	host, _, _ := net.SplitHostPort(remoteAddr)
+	if !vs.guard.IsWhiteListed(host) {
+		resp.Error = fmt.Sprintf("not in whitelist: %s", remoteAddr)
+		return resp
+	}
+
+	vid, fid, err := operation.ParseFileId(req.FileId)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp
+	}
+	if !vs.maybeCheckJwt(security.EncodedJwt(req.Jwt), remoteAddr, vid, fid, true) {
+		resp.Error = "wrong jwt"
+		return resp
+	}
+	volumeId, err := needle.NewVolumeId(vid)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp
+	}
+	ttl, err := needle.ReadTTL(req.Ttl)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp
+	}
+	durability, err := storage.ParseDurability(req.Durability)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp
+	}
+
+	fileName := req.Name
+	if fileName != "" {
+		fileName = path.Base(fileName)
+	}
+	mimeType, compression, originalSize := "", "", len(data)
+	if !req.IsChunkManifest {
+		mimeType, data, compression, originalSize = needle.PrepareUploadedData(fileName, req.Mime, req.Compression, data)
+	}
+
+	expectedDigests := util.ContentDigests{Md5: req.ContentMd5, Sha256: req.ContentSha256}
+	// the content with a digest is stored as is
+	fixJpgOrientation := vs.FixJpgOrientation && req.ContentMd5 == nil && req.ContentSha256 == nil
+	n := needle.NewUploadedNeedle(fileName, data, mimeType, req.Pairs, compression, req.LastModified, ttl, req.IsChunkManifest, fixJpgOrientation)
+	if err = n.ParsePath(fid); err != nil {
+		resp.Error = err.Error()
+		return resp
+	}
+	if resp.ContentMd5, err = n.VerifyContent(expectedDigests); err != nil {
+		resp.Error = err.Error()
+		return resp
+	}
+
+	_, isUnchanged, err := topology.ReplicatedWriteNeedle(vs.GetMaster(), vs.store, volumeId, n, resp.ContentMd5, "/"+req.FileId, req.IsReplicate, security.EncodedJwt(req.Jwt), durability)
+	if err != nil {
+		resp.Error = err.Error()
+	}
+	resp.IsUnchanged = isUnchanged
+	resp.Size = uint32(originalSize)
+	resp.Etag = n.Etag()
+	return resp
+}
+
+func remoteAddrOf(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
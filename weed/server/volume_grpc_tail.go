@@ -10,6 +10,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
 	"github.com/chrislusf/seaweedfs/weed/storage"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
 func (vs *VolumeServer) VolumeTailSender(req *volume_server_pb.VolumeTailSenderRequest, stream volume_server_pb.VolumeServer_VolumeTailSenderServer) error {
@@ -25,7 +26,7 @@ func (vs *VolumeServer) VolumeTailSender(req *volume_server_pb.VolumeTailSenderR
 	drainingSeconds := req.IdleTimeoutSeconds
 
 	for {
-		lastProcessedTimestampNs, err := sendNeedlesSince(stream, v, lastTimestampNs)
+		lastProcessedTimestampNs, err := sendNeedlesSince(stream, v, lastTimestampNs, vs.ioScheduler.Throttler(storage.IoCategoryCopy))
 		if err != nil {
 			glog.Infof("sendNeedlesSince: %v", err)
 			return fmt.Errorf("streamFollow: %v", err)
@@ -52,7 +53,7 @@ func (vs *VolumeServer) VolumeTailSender(req *volume_server_pb.VolumeTailSenderR
 
 }
 
-func sendNeedlesSince(stream volume_server_pb.VolumeServer_VolumeTailSenderServer, v *storage.Volume, lastTimestampNs uint64) (lastProcessedTimestampNs uint64, err error) {
+func sendNeedlesSince(stream volume_server_pb.VolumeServer_VolumeTailSenderServer, v *storage.Volume, lastTimestampNs uint64, throttler util.Throttler) (lastProcessedTimestampNs uint64, err error) {
 
 	foundOffset, isLastOne, err := v.BinarySearchByAppendAtNs(lastTimestampNs)
 	if err != nil {
@@ -68,7 +69,8 @@ func sendNeedlesSince(stream volume_server_pb.VolumeServer_VolumeTailSenderServe
 	}
 
 	scanner := &VolumeFileScanner4Tailing{
-		stream: stream,
+		stream:    stream,
+		throttler: throttler,
 	}
 
 	err = storage.ScanVolumeFileFrom(v.Version(), v.DataBackend, foundOffset.ToAcutalOffset(), scanner)
@@ -77,6 +79,8 @@ func sendNeedlesSince(stream volume_server_pb.VolumeServer_VolumeTailSenderServe
 
 }
 
+// VolumeTailReceiver writes the needles tailed from the source volume, checked only by their crc,
+// since the chunk md5 is kept by the filer instead of the needle
 func (vs *VolumeServer) VolumeTailReceiver(ctx context.Context, req *volume_server_pb.VolumeTailReceiverRequest) (*volume_server_pb.VolumeTailReceiverResponse, error) {
 
 	resp := &volume_server_pb.VolumeTailReceiverResponse{}
@@ -89,7 +93,7 @@ func (vs *VolumeServer) VolumeTailReceiver(ctx context.Context, req *volume_serv
 	defer glog.V(1).Infof("receive tailing volume %d finished", v.Id)
 
 	return resp, operation.TailVolumeFromSource(req.SourceVolumeServer, vs.grpcDialOption, v.Id, req.SinceNs, int(req.IdleTimeoutSeconds), func(n *needle.Needle) error {
-		_, _, err := vs.store.WriteVolumeNeedle(v.Id, n)
+		_, _, err := vs.store.WriteVolumeNeedle(v.Id, n, storage.Durability{})
 		return err
 	})
 
@@ -98,6 +102,7 @@ func (vs *VolumeServer) VolumeTailReceiver(ctx context.Context, req *volume_serv
 // generate the volume idx
 type VolumeFileScanner4Tailing struct {
 	stream                   volume_server_pb.VolumeServer_VolumeTailSenderServer
+	throttler                util.Throttler
 	lastProcessedTimestampNs uint64
 }
 
@@ -129,6 +134,7 @@ func (scanner *VolumeFileScanner4Tailing) VisitNeedle(n *needle.Needle, offset i
 			return sendErr
 		}
 	}
+	scanner.throttler.MaybeSlowdown(int64(len(needleHeader) + len(needleBody)))
 
 	scanner.lastProcessedTimestampNs = n.AppendAtNs
 	return nil
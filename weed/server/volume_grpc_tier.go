@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage"
 	"github.com/chrislusf/seaweedfs/weed/storage/backend"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
 // VolumeTierCopyDatToRemote copy dat file to a remote tier
@@ -26,13 +29,23 @@ func (vs *VolumeServer) VolumeTierCopyDatToRemote(ctx context.Context, req *volu
 	if !ok {
 		return nil, fmt.Errorf("volume %d is not on local disk", req.VolumeId)
 	}
-	err := uploadFileToRemote(ctx, req, diskFile.File)
+	err := uploadFileToRemote(ctx, req, diskFile.File, vs.ioScheduler.Throttler(storage.IoCategoryTier))
 
 	return &volume_server_pb.VolumeTierCopyDatToRemoteResponse{}, err
 }
 
-func uploadFileToRemote(ctx context.Context, req *volume_server_pb.VolumeTierCopyDatToRemoteRequest, f *os.File) error {
-	println("copying dat file of", f.Name(), "to remote")
+// uploadFileToRemote copies the file to the destination backend, sharing the bandwidth of the tier jobs
+func uploadFileToRemote(ctx context.Context, req *volume_server_pb.VolumeTierCopyDatToRemoteRequest, f *os.File, throttler util.Throttler) error {
+	backendStorage, found := backend.BackendStorages[req.DestinationBackendName]
+	if !found {
+		return fmt.Errorf("destination %s not found", req.DestinationBackendName)
+	}
+
+	key, size, err := backendStorage.CopyFile(f, throttler)
+	if err != nil {
+		return fmt.Errorf("copy %s to %s: %v", f.Name(), req.DestinationBackendName, err)
+	}
+	glog.V(0).Infof("copied %s to %s as %s: %d bytes", f.Name(), req.DestinationBackendName, key, size)
 
 	return nil
 }
@@ -3,11 +3,13 @@ package weed_server
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/chrislusf/seaweedfs/weed/stats"
 	"google.golang.org/grpc"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/storage"
 	"github.com/spf13/viper"
@@ -23,12 +25,12 @@ type VolumeServer struct {
 	guard           *security.Guard
 	grpcDialOption  grpc.DialOption
 
-	needleMapKind           storage.NeedleMapType
-	FixJpgOrientation       bool
-	ReadRedirect            bool
-	compactionBytePerSecond int64
-	MetricsAddress          string
-	MetricsIntervalSec      int
+	needleMapKind      storage.NeedleMapType
+	FixJpgOrientation  bool
+	ReadRedirect       bool
+	ioScheduler        *storage.IoScheduler
+	MetricsAddress     string
+	MetricsIntervalSec int
 }
 
 func NewVolumeServer(adminMux, publicMux *http.ServeMux, ip string,
@@ -54,20 +56,28 @@ func NewVolumeServer(adminMux, publicMux *http.ServeMux, ip string,
 	readExpiresAfterSec := v.GetInt("jwt.signing.read.expires_after_seconds")
 
 	vs := &VolumeServer{
-		pulseSeconds:            pulseSeconds,
-		dataCenter:              dataCenter,
-		rack:                    rack,
-		needleMapKind:           needleMapKind,
-		FixJpgOrientation:       fixJpgOrientation,
-		ReadRedirect:            readRedirect,
-		grpcDialOption:          security.LoadClientTLS(viper.Sub("grpc"), "volume"),
-		compactionBytePerSecond: int64(compactionMBPerSecond) * 1024 * 1024,
+		pulseSeconds:      pulseSeconds,
+		dataCenter:        dataCenter,
+		rack:              rack,
+		needleMapKind:     needleMapKind,
+		FixJpgOrientation: fixJpgOrientation,
+		ReadRedirect:      readRedirect,
+		grpcDialOption:    security.LoadClientTLS(viper.Sub("grpc"), "volume"),
 	}
+	// the background compaction and copying are limited together, until changed on the fly
+	compactionBytePerSecond := int64(compactionMBPerSecond) * 1024 * 1024
+	vs.ioScheduler = storage.NewIoScheduler(map[storage.IoCategory]int64{
+		storage.IoCategoryCompaction: compactionBytePerSecond,
+		storage.IoCategoryCopy:       compactionBytePerSecond,
+	})
 	vs.SeedMasterNodes = masterNodes
 	vs.store = storage.NewStore(vs.grpcDialOption, port, ip, publicUrl, folders, maxCounts, vs.needleMapKind)
 
 	vs.guard = security.NewGuard(whiteList, signingKey, expiresAfterSec, readSigningKey, readExpiresAfterSec)
 
+	// replicate the writes via grpc
+	operation.EnableGrpcDataPlane(vs.grpcDialOption)
+
 	handleStaticResources(adminMux)
 	if signingKey == "" || enableUiAccess {
 		// only expose the volume server details for safe environments
@@ -84,6 +94,7 @@ func NewVolumeServer(adminMux, publicMux *http.ServeMux, ip string,
 		publicMux.HandleFunc("/", vs.publicReadOnlyHandler)
 	}
 
+	go vs.store.LoopCheckingDiskLocations(time.Duration(pulseSeconds) * time.Second)
 	go vs.heartbeat()
 	hostAddress := fmt.Sprintf("%s:%d", ip, port)
 	go stats.LoopPushingMetric("volumeServer", hostAddress, stats.VolumeServerGather,
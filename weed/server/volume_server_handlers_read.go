@@ -149,14 +149,13 @@ func (vs *VolumeServer) GetOrHeadHandler(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
-	if ext != ".gz" {
-		if n.IsGzipped() {
-			if strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
-				w.Header().Set("Content-Encoding", "gzip")
-			} else {
-				if n.Data, err = util.UnGzipData(n.Data); err != nil {
-					glog.V(0).Infoln("ungzip error:", err, r.URL.Path)
-				}
+	if compression := n.Compression(); compression != "" && ext != ".gz" && ext != ".zst" {
+		// decompress for the clients not accepting the codec
+		if util.AcceptsEncoding(r.Header.Get("Accept-Encoding"), compression) {
+			w.Header().Set("Content-Encoding", compression)
+		} else {
+			if n.Data, err = util.DecompressData(compression, n.Data); err != nil {
+				glog.V(0).Infoln("decompress error:", err, r.URL.Path)
 			}
 		}
 	}
@@ -173,7 +172,7 @@ func (vs *VolumeServer) tryHandleChunkedFile(n *needle.Needle, fileName string,
 		return false
 	}
 
-	chunkManifest, e := operation.LoadChunkManifest(n.Data, n.IsGzipped())
+	chunkManifest, e := operation.LoadChunkManifest(n.Data, n.Compression())
 	if e != nil {
 		glog.V(0).Infof("load chunked manifest (%s) error: %v", r.URL.Path, e)
 		return false
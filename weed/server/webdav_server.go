@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"math"
 	"os"
 	"path"
 	"strings"
@@ -31,6 +32,8 @@ type WebDavOption struct {
 	Collection       string
 	Uid              uint32
 	Gid              uint32
+	// saves the user and group names of Uid and Gid along with them, nil to save only the numeric ids
+	IdentityMapper *util.IdentityMapper
 }
 
 type WebDavServer struct {
@@ -44,6 +47,7 @@ type WebDavServer struct {
 func NewWebDavServer(option *WebDavOption) (ws *WebDavServer, err error) {
 
 	fs, _ := NewWebDavFileSystem(option)
+	operation.EnableGrpcDataPlane(option.GrpcDialOption)
 
 	ws = &WebDavServer{
 		option:         option,
@@ -143,11 +147,13 @@ func (fs *WebDavFileSystem) Mkdir(ctx context.Context, fullDirPath string, perm
 				Name:        name,
 				IsDirectory: true,
 				Attributes: &filer_pb.FuseAttributes{
-					Mtime:    time.Now().Unix(),
-					Crtime:   time.Now().Unix(),
-					FileMode: uint32(perm | os.ModeDir),
-					Uid:      fs.option.Uid,
-					Gid:      fs.option.Gid,
+					Mtime:     time.Now().Unix(),
+					Crtime:    time.Now().Unix(),
+					FileMode:  uint32(perm | os.ModeDir),
+					Uid:       fs.option.Uid,
+					Gid:       fs.option.Gid,
+					UserName:  fs.option.IdentityMapper.UserName(fs.option.Uid),
+					GroupName: fs.groupNames(),
 				},
 			},
 		}
@@ -161,6 +167,13 @@ func (fs *WebDavFileSystem) Mkdir(ctx context.Context, fullDirPath string, perm
 	})
 }
 
+func (fs *WebDavFileSystem) groupNames() []string {
+	if name := fs.option.IdentityMapper.GroupName(fs.option.Gid); name != "" {
+		return []string{name}
+	}
+	return nil
+}
+
 func (fs *WebDavFileSystem) OpenFile(ctx context.Context, fullFilePath string, flag int, perm os.FileMode) (webdav.File, error) {
 
 	glog.V(2).Infof("WebDavFileSystem.OpenFile %v", fullFilePath)
@@ -202,6 +215,8 @@ func (fs *WebDavFileSystem) OpenFile(ctx context.Context, fullFilePath string, f
 						FileMode:    uint32(perm),
 						Uid:         fs.option.Uid,
 						Gid:         fs.option.Gid,
+						UserName:    fs.option.IdentityMapper.UserName(fs.option.Uid),
+						GroupName:   fs.groupNames(),
 						Collection:  fs.option.Collection,
 						Replication: "000",
 						TtlSec:      0,
@@ -386,7 +401,7 @@ func (f *WebDavFile) Write(buf []byte) (int, error) {
 		return 0, err
 	}
 
-	var fileId, host string
+	var fileId, host, durability string
 	var auth security.EncodedJwt
 
 	if err = f.fs.WithFilerClient(ctx, func(client filer_pb.SeaweedFilerClient) error {
@@ -395,6 +410,7 @@ func (f *WebDavFile) Write(buf []byte) (int, error) {
 			Count:       1,
 			Replication: "000",
 			Collection:  f.fs.option.Collection,
+			ParentPath:  path.Dir(f.name),
 		}
 
 		resp, err := client.AssignVolume(ctx, request)
@@ -403,16 +419,16 @@ func (f *WebDavFile) Write(buf []byte) (int, error) {
 			return err
 		}
 
-		fileId, host, auth = resp.FileId, resp.Url, security.EncodedJwt(resp.Auth)
+		fileId, host, durability, auth = resp.FileId, resp.Url, resp.Durability, security.EncodedJwt(resp.Auth)
 
 		return nil
 	}); err != nil {
 		return 0, fmt.Errorf("filerGrpcAddress assign volume: %v", err)
 	}
 
-	fileUrl := fmt.Sprintf("http://%s/%s", host, fileId)
+	fileUrl := operation.WithDurability(fmt.Sprintf("http://%s/%s", host, fileId), durability)
 	bufReader := bytes.NewReader(buf)
-	uploadResult, err := operation.Upload(fileUrl, f.name, bufReader, false, "application/octet-stream", nil, auth)
+	uploadResult, err := operation.Upload(fileUrl, f.name, bufReader, "", "application/octet-stream", nil, auth)
 	if err != nil {
 		glog.V(0).Infof("upload data %v to %s: %v", f.name, fileUrl, err)
 		return 0, fmt.Errorf("upload data: %v", err)
@@ -428,6 +444,7 @@ func (f *WebDavFile) Write(buf []byte) (int, error) {
 		Size:   uint64(len(buf)),
 		Mtime:  time.Now().UnixNano(),
 		ETag:   uploadResult.ETag,
+		Md5:    uploadResult.ContentMd5,
 	}
 
 	f.entry.Chunks = append(f.entry.Chunks, chunk)
@@ -484,7 +501,11 @@ func (f *WebDavFile) Read(p []byte) (readSize int, err error) {
 		return 0, io.EOF
 	}
 	if f.entryViewCache == nil {
-		f.entryViewCache = filer2.NonOverlappingVisibleIntervals(f.entry.Chunks)
+		chunks, _, resolveErr := filer2.ResolveChunkManifest(filer2.LookupFn(ctx, f.fs), f.entry.Chunks, 0, math.MaxInt64)
+		if resolveErr != nil {
+			return 0, resolveErr
+		}
+		f.entryViewCache = filer2.NonOverlappingVisibleIntervals(chunks)
 	}
 	chunkViews := filer2.ViewFromVisibleIntervals(f.entryViewCache, f.off, len(p))
 
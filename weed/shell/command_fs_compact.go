@@ -0,0 +1,312 @@
+package shell
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/security"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsCompact{})
+}
+
+type commandFsCompact struct {
+}
+
+func (c *commandFsCompact) Name() string {
+	return "fs.compact"
+}
+
+func (c *commandFsCompact) Help() string {
+	return `rewrite fragmented files into a few large chunks
+
+	fs.compact [-minChunks=64] [-chunkSizeMB=64] [-quietFor=1h] [-force] /dir/
+	fs.compact [-minChunks=64] [-chunkSizeMB=64] [-quietFor=1h] [-force] http://<filer_server>:<port>/dir/file_name
+
+	Files written through the mount or appended repeatedly can accumulate many overlapping chunks.
+	This command recursively finds the files with at least minChunks chunks, and not modified for the quietFor period.
+	With -force, the visible data of each file is rewritten into chunks of chunkSizeMB,
+	the file entry is replaced only if it has not been changed in the meantime,
+	and the replaced chunks are deleted by the filer. Holes in sparse files are kept.
+	Without -force, only the files to compact are listed.
+
+`
+}
+
+func (c *commandFsCompact) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	compactCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	minChunks := compactCommand.Int("minChunks", 64, "compact files with at least this many chunks")
+	chunkSizeMB := compactCommand.Int("chunkSizeMB", 64, "the size of the rewritten chunks")
+	quietPeriod := compactCommand.Duration("quietFor", time.Hour, "only compact files not modified for this period")
+	applyCompaction := compactCommand.Bool("force", false, "rewrite the files, instead of only listing them")
+	if err = compactCommand.Parse(args); err != nil {
+		return nil
+	}
+	if *chunkSizeMB <= 0 {
+		return fmt.Errorf("invalid chunkSizeMB %d", *chunkSizeMB)
+	}
+
+	filerServer, filerPort, path, err := commandEnv.parseUrl(findInputDirectory(compactCommand.Args()))
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	if commandEnv.isDirectory(ctx, filerServer, filerPort, path) {
+		path = path + "/"
+	}
+
+	dir, name := filer2.FullPath(path).DirAndName()
+
+	compactor := &fileCompactor{
+		commandEnv:  commandEnv,
+		writer:      writer,
+		minChunks:   *minChunks,
+		chunkSize:   int64(*chunkSizeMB) * 1024 * 1024,
+		quietBefore: time.Now().Add(-*quietPeriod).Unix(),
+		apply:       *applyCompaction,
+	}
+
+	return commandEnv.withFilerClient(ctx, filerServer, filerPort, func(client filer_pb.SeaweedFilerClient) error {
+
+		if err := compactor.compactDirectory(ctx, client, dir, name); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(writer, "%d files compacted, %d chunks => %d chunks\n", compactor.fileCount, compactor.oldChunkCount, compactor.newChunkCount)
+		return nil
+
+	})
+
+}
+
+type fileCompactor struct {
+	commandEnv  *CommandEnv
+	writer      io.Writer
+	minChunks   int
+	chunkSize   int64
+	quietBefore int64
+	apply       bool
+
+	fileCount     int
+	oldChunkCount int
+	newChunkCount int
+}
+
+func (fc *fileCompactor) compactDirectory(ctx context.Context, client filer_pb.SeaweedFilerClient, dir, name string) error {
+
+	paginatedCount := -1
+	startFromFileName := ""
+	paginateSize := 1000
+
+	for paginatedCount == -1 || paginatedCount == paginateSize {
+		resp, listErr := client.ListEntries(ctx, &filer_pb.ListEntriesRequest{
+			Directory:          dir,
+			Prefix:             name,
+			StartFromFileName:  startFromFileName,
+			InclusiveStartFrom: false,
+			Limit:              uint32(paginateSize),
+		})
+		if listErr != nil {
+			return listErr
+		}
+
+		paginatedCount = len(resp.Entries)
+
+		for _, entry := range resp.Entries {
+			startFromFileName = entry.Name
+			if entry.IsDirectory {
+				subDir := fmt.Sprintf("%s/%s", dir, entry.Name)
+				if dir == "/" {
+					subDir = "/" + entry.Name
+				}
+				if err := fc.compactDirectory(ctx, client, subDir, ""); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := fc.compactFile(ctx, client, dir, entry); err != nil {
+				fmt.Fprintf(fc.writer, "compact %s/%s: %v\n", dir, entry.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (fc *fileCompactor) compactFile(ctx context.Context, client filer_pb.SeaweedFilerClient, dir string, entry *filer_pb.Entry) error {
+
+	if len(entry.Chunks) < fc.minChunks && !filer2.HasChunkManifest(entry.Chunks) {
+		return nil
+	}
+	if entry.Attributes != nil && entry.Attributes.Mtime > fc.quietBefore {
+		return nil
+	}
+
+	chunks, err := filer2.ResolveAllChunks(fc.commandEnv.MasterClient.LookupFileId, entry.Chunks)
+	if err != nil {
+		return err
+	}
+	if len(chunks) < fc.minChunks {
+		return nil
+	}
+	dataChunks, _ := filer2.SeparateManifestChunks(chunks)
+
+	segments := planCompaction(dataChunks, fc.chunkSize)
+	if len(segments) >= len(chunks) {
+		return nil
+	}
+
+	fmt.Fprintf(fc.writer, "%s/%s: %d chunks => %d chunks\n", dir, entry.Name, len(chunks), len(segments))
+	if !fc.apply {
+		return nil
+	}
+
+	var newChunks []*filer_pb.FileChunk
+	for _, segment := range segments {
+		chunk, err := fc.rewriteSegment(ctx, client, entry, dataChunks, segment)
+		if err != nil {
+			fc.deleteChunks(newChunks)
+			return err
+		}
+		newChunks = append(newChunks, chunk)
+	}
+
+	// the filer deletes the replaced chunks, after the entry is updated
+	_, err = client.UpdateEntry(ctx, &filer_pb.UpdateEntryRequest{
+		Directory: dir,
+		Entry: &filer_pb.Entry{
+			Name:       entry.Name,
+			Chunks:     newChunks,
+			Attributes: entry.Attributes,
+			Extended:   entry.Extended,
+		},
+		Precondition: &filer_pb.EntryPrecondition{
+			MustExist:       true,
+			ExpectedVersion: entry.Version,
+			ExpectedMtime:   entry.Attributes.GetMtime(),
+		},
+	})
+	if err != nil {
+		// the file has been changed in the meantime, or the update failed
+		fc.deleteChunks(newChunks)
+		return fmt.Errorf("update entry: %v", err)
+	}
+
+	fc.fileCount++
+	fc.oldChunkCount += len(chunks)
+	fc.newChunkCount += len(newChunks)
+
+	return nil
+}
+
+// rewriteSegment reads the visible data of the segment, and uploads it as one new chunk
+func (fc *fileCompactor) rewriteSegment(ctx context.Context, client filer_pb.SeaweedFilerClient, entry *filer_pb.Entry, dataChunks []*filer_pb.FileChunk, segment compactSegment) (*filer_pb.FileChunk, error) {
+
+	var buf bytes.Buffer
+	if err := filer2.StreamContent(fc.commandEnv.MasterClient, &buf, dataChunks, segment.offset, int(segment.size)); err != nil {
+		return nil, fmt.Errorf("read [%d,%d): %v", segment.offset, segment.offset+segment.size, err)
+	}
+	if int64(buf.Len()) != segment.size {
+		return nil, fmt.Errorf("read [%d,%d): %d bytes", segment.offset, segment.offset+segment.size, buf.Len())
+	}
+
+	request := &filer_pb.AssignVolumeRequest{
+		Count: 1,
+	}
+	if entry.Attributes != nil {
+		request.Replication = entry.Attributes.Replication
+		request.Collection = entry.Attributes.Collection
+		request.TtlSec = entry.Attributes.TtlSec
+	}
+	resp, err := client.AssignVolume(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("assign volume: %v", err)
+	}
+
+	fileUrl := operation.WithDurability(fmt.Sprintf("http://%s/%s", resp.Url, resp.FileId), resp.Durability)
+	uploadResult, err := operation.Upload(fileUrl, entry.Name, &buf, "", "application/octet-stream", nil, security.EncodedJwt(resp.Auth))
+	if err != nil {
+		return nil, fmt.Errorf("upload to %s: %v", fileUrl, err)
+	}
+	if uploadResult.Error != "" {
+		return nil, fmt.Errorf("upload to %s: %v", fileUrl, uploadResult.Error)
+	}
+
+	return &filer_pb.FileChunk{
+		FileId: resp.FileId,
+		Offset: segment.offset,
+		Size:   uint64(segment.size),
+		Mtime:  time.Now().UnixNano(),
+		ETag:   uploadResult.ETag,
+		Md5:    uploadResult.ContentMd5,
+	}, nil
+}
+
+func (fc *fileCompactor) deleteChunks(chunks []*filer_pb.FileChunk) {
+	if len(chunks) == 0 {
+		return
+	}
+	var fileIds []string
+	for _, chunk := range chunks {
+		fileIds = append(fileIds, chunk.GetFileIdString())
+	}
+	if _, err := operation.DeleteFiles(fc.commandEnv.MasterClient.GetMaster(), fc.commandEnv.option.GrpcDialOption, fileIds); err != nil {
+		fmt.Fprintf(fc.writer, "delete chunks %v: %v\n", fileIds, err)
+	}
+}
+
+type compactSegment struct {
+	offset int64
+	size   int64
+}
+
+// planCompaction splits the visible data into segments of at most chunkSize.
+// A segment never spans a hole, so that sparse files stay sparse.
+func planCompaction(chunks []*filer_pb.FileChunk, chunkSize int64) (segments []compactSegment) {
+
+	// the visible data covers the union of all chunks, whichever chunk is visible at each offset
+	sortedChunks := make([]*filer_pb.FileChunk, len(chunks))
+	copy(sortedChunks, chunks)
+	sort.Slice(sortedChunks, func(i, j int) bool {
+		return sortedChunks[i].Offset < sortedChunks[j].Offset
+	})
+
+	var runStart, runStop int64 = 0, -1
+	addRun := func() {
+		for offset := runStart; offset < runStop; offset += chunkSize {
+			segments = append(segments, compactSegment{offset: offset, size: min(chunkSize, runStop-offset)})
+		}
+	}
+
+	for _, chunk := range sortedChunks {
+		stop := chunk.Offset + int64(chunk.Size)
+		if chunk.Offset > runStop {
+			addRun()
+			runStart, runStop = chunk.Offset, stop
+		} else if stop > runStop {
+			runStop = stop
+		}
+	}
+	addRun()
+
+	return
+}
+
+func min(x, y int64) int64 {
+	if x < y {
+		return x
+	}
+	return y
+}
@@ -0,0 +1,38 @@
+package shell
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func TestPlanCompaction(t *testing.T) {
+
+	chunks := []*filer_pb.FileChunk{
+		{FileId: "1,01", Offset: 0, Size: 100, Mtime: 1},
+		{FileId: "1,02", Offset: 50, Size: 100, Mtime: 2},
+		{FileId: "1,03", Offset: 20, Size: 10, Mtime: 3},
+		{FileId: "1,04", Offset: 140, Size: 30, Mtime: 4},
+		// a hole in [170,300)
+		{FileId: "1,05", Offset: 300, Size: 50, Mtime: 5},
+		{FileId: "1,06", Offset: 350, Size: 10, Mtime: 6},
+	}
+
+	segments := planCompaction(chunks, 64)
+
+	expected := []compactSegment{
+		{0, 64},
+		{64, 64},
+		{128, 42},
+		{300, 60},
+	}
+	if !reflect.DeepEqual(segments, expected) {
+		t.Errorf("unexpected segments %+v", segments)
+	}
+
+	if segments := planCompaction(nil, 64); len(segments) != 0 {
+		t.Errorf("unexpected segments for empty file %+v", segments)
+	}
+
+}
@@ -0,0 +1,142 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/golang/protobuf/proto"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsMetaBackup{})
+}
+
+type commandFsMetaBackup struct {
+}
+
+func (c *commandFsMetaBackup) Name() string {
+	return "fs.meta.backup"
+}
+
+func (c *commandFsMetaBackup) Help() string {
+	return `take a consistent snapshot of directory and file meta data into a local file.
+
+	fs.meta.backup /               # backup from the root
+	fs.meta.backup -o t.meta /     # backup from the root, output to t.meta file.
+	fs.meta.backup /path/to/save   # backup from the directory /path/to/save
+
+	The filer reads all meta data from one point-in-time view of its store, while writes continue.
+	This requires a filer store supporting snapshots, e.g., badger.
+
+	The meta data will be saved into a local <filer_host>-<port>-<time>.meta file,
+	in the same format as fs.meta.save, and can be restored by fs.meta.load command.
+
+`
+}
+
+func (c *commandFsMetaBackup) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	fsMetaBackupCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	verbose := fsMetaBackupCommand.Bool("v", false, "print out each processed files")
+	outputFileName := fsMetaBackupCommand.String("o", "", "output the meta data to this file")
+	if err = fsMetaBackupCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	filerServer, filerPort, path, err := commandEnv.parseUrl(findInputDirectory(fsMetaBackupCommand.Args()))
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	return commandEnv.withFilerClient(ctx, filerServer, filerPort, func(client filer_pb.SeaweedFilerClient) error {
+
+		t := time.Now()
+		fileName := *outputFileName
+		if fileName == "" {
+			fileName = fmt.Sprintf("%s-%d-%4d%02d%02d-%02d%02d%02d.meta",
+				filerServer, filerPort, t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second())
+		}
+
+		stream, err := client.BackupMetadata(ctx, &filer_pb.BackupMetadataRequest{
+			Directory: path,
+		})
+		if err != nil {
+			return fmt.Errorf("backup metadata: %v", err)
+		}
+
+		// write to a temporary file first, so a failed backup never leaves a partial file behind
+		tmpFileName := fileName + ".tmp"
+		dst, err := os.OpenFile(tmpFileName, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+		if err != nil {
+			return err
+		}
+
+		var dirCount, fileCount uint64
+
+		sizeBuf := make([]byte, 4)
+
+		for {
+			resp, recvErr := stream.Recv()
+			if recvErr == io.EOF {
+				break
+			}
+			if recvErr != nil {
+				dst.Close()
+				os.Remove(tmpFileName)
+				return fmt.Errorf("backup metadata: %v", recvErr)
+			}
+
+			for _, fullEntry := range resp.Entries {
+
+				bytes, err := proto.Marshal(fullEntry)
+				if err != nil {
+					dst.Close()
+					os.Remove(tmpFileName)
+					return fmt.Errorf("marshall error: %v", err)
+				}
+
+				util.Uint32toBytes(sizeBuf, uint32(len(bytes)))
+
+				dst.Write(sizeBuf)
+				dst.Write(bytes)
+
+				if fullEntry.Entry.IsDirectory {
+					dirCount++
+				} else {
+					fileCount++
+				}
+
+				if *verbose {
+					println(filer2.FullPath(fullEntry.Dir).Child(fullEntry.Entry.Name))
+				}
+			}
+		}
+
+		if err = dst.Sync(); err != nil {
+			dst.Close()
+			return err
+		}
+		if err = dst.Close(); err != nil {
+			return err
+		}
+		if err = os.Rename(tmpFileName, fileName); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(writer, "\ntotal %d directories, %d files", dirCount, fileCount)
+		fmt.Fprintf(writer, "\nmeta data snapshot for http://%s:%d%s is saved to %s\n", filerServer, filerPort, path, fileName)
+
+		return nil
+
+	})
+
+}
@@ -38,6 +38,7 @@ func (c *commandFsMetaSave) Help() string {
 	These meta data can be later loaded by fs.meta.load command, 
 
 	This assumes there are no deletions, so this is different from taking a snapshot.
+	Use fs.meta.backup for a consistent snapshot if the filer store supports it.
 
 `
 }
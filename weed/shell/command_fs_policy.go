@@ -0,0 +1,187 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer2"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsPolicy{})
+}
+
+type commandFsPolicy struct {
+}
+
+func (c *commandFsPolicy) Name() string {
+	return "fs.policy"
+}
+
+func (c *commandFsPolicy) Help() string {
+	return `manage the storage policies of the files by path prefix
+
+	fs.policy                                # list the storage policies
+	fs.policy [-collection=logs] [-replication=010] [-ttl=30d] [-chunkSizeMB=8] [-dedup] [-durability=fsync] /logs/
+	fs.policy -delete /logs/
+
+	A storage policy applies to the files whose full path starts with the path prefix.
+	The filer uses its collection, replication and ttl for new and overwritten files, instead of the requested ones,
+	and the mount and the filer upload the files in chunks of chunkSizeMB.
+	With -dedup, the filer and the S3 gateway reuse the existing chunks of the same content in the same collection,
+	instead of uploading them again. The files with a ttl are not deduplicated.
+	With -durability, the filer and the mount write the chunks to the volumes syncing the writes as often,
+	which is none, group[:<interval>] to sync the concurrent writes together, or fsync to sync each write.
+	When several policies match, the fields of the longest path prefix win, and dedup applies if any of them sets it.
+	A directory path is turned into a prefix ending with "/", so that /logs/ does not apply to /logs2.
+
+	The policies are saved in ` + string(filer2.StoragePolicyPath) + ` on the filer.
+
+`
+}
+
+func (c *commandFsPolicy) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	policyCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	collection := policyCommand.String("collection", "", "the collection of the files")
+	replication := policyCommand.String("replication", "", "the replication of the files, e.g. 010")
+	ttl := policyCommand.String("ttl", "", "the ttl of the files, e.g. 30d")
+	chunkSizeMB := policyCommand.Int("chunkSizeMB", 0, "the chunk size of the files")
+	dedup := policyCommand.Bool("dedup", false, "reuse the existing chunks of the same content")
+	durability := policyCommand.String("durability", "", "how the chunks are synced on the volumes: none, group[:<interval>], or fsync")
+	deletePolicy := policyCommand.Bool("delete", false, "delete the policy of the path prefix")
+	if err = policyCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	if *replication != "" {
+		if _, err = storage.NewReplicaPlacementFromString(*replication); err != nil {
+			return fmt.Errorf("invalid replication %s: %v", *replication, err)
+		}
+	}
+	if *ttl != "" {
+		if _, err = needle.ReadTTL(*ttl); err != nil {
+			return fmt.Errorf("invalid ttl %s: %v", *ttl, err)
+		}
+	}
+	if *durability != "" {
+		if _, err = storage.ParseDurability(*durability); err != nil {
+			return err
+		}
+	}
+	if *chunkSizeMB < 0 {
+		return fmt.Errorf("invalid chunkSizeMB %d", *chunkSizeMB)
+	}
+
+	filerServer, filerPort, path, err := commandEnv.parseUrl(findInputDirectory(policyCommand.Args()))
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	isChange := *deletePolicy || *collection != "" || *replication != "" || *ttl != "" || *chunkSizeMB > 0 || *dedup || *durability != ""
+	if isChange && len(policyCommand.Args()) == 0 {
+		return fmt.Errorf("missing the path prefix")
+	}
+	if isChange && !strings.HasSuffix(path, "/") && commandEnv.isDirectory(ctx, filerServer, filerPort, path) {
+		path = path + "/"
+	}
+
+	return commandEnv.withFilerClient(ctx, filerServer, filerPort, func(client filer_pb.SeaweedFilerClient) error {
+
+		policies, version, err := readStoragePolicies(ctx, client)
+		if err != nil {
+			return err
+		}
+
+		if !isChange {
+			for _, p := range filer2.NewStoragePolicies(policies).Policies() {
+				fmt.Fprintf(writer, "%s collection:%q replication:%q ttl:%q chunkSizeMB:%d dedup:%v durability:%q\n",
+					p.PathPrefix, p.Collection, p.Replication, p.Ttl, p.ChunkSizeMb, p.Dedup, p.Durability)
+			}
+			return nil
+		}
+
+		var kept []*filer_pb.StoragePolicy
+		for _, p := range policies.Policies {
+			if p.PathPrefix != path {
+				kept = append(kept, p)
+			}
+		}
+		if !*deletePolicy {
+			kept = append(kept, &filer_pb.StoragePolicy{
+				PathPrefix:  path,
+				Collection:  *collection,
+				Replication: *replication,
+				Ttl:         *ttl,
+				ChunkSizeMb: int32(*chunkSizeMB),
+				Dedup:       *dedup,
+				Durability:  *durability,
+			})
+		} else if len(kept) == len(policies.Policies) {
+			return fmt.Errorf("no storage policy for %s", path)
+		}
+		policies.Policies = kept
+
+		return saveStoragePolicies(ctx, client, policies, version)
+
+	})
+
+}
+
+// readStoragePolicies reads the policies and the version of the policy entry, which is 0 if not found
+func readStoragePolicies(ctx context.Context, client filer_pb.SeaweedFilerClient) (*filer_pb.StoragePolicies, uint64, error) {
+	resp, err := client.LookupDirectoryEntry(ctx, &filer_pb.LookupDirectoryEntryRequest{
+		Directory: filer2.StoragePolicyDirectory,
+		Name:      filer2.StoragePolicyName,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), filer2.ErrNotFound.Error()) {
+			return &filer_pb.StoragePolicies{}, 0, nil
+		}
+		return nil, 0, fmt.Errorf("read storage policies: %v", err)
+	}
+	policies, err := filer2.LoadStoragePolicies(resp.Entry.Extended)
+	if err != nil {
+		return nil, 0, err
+	}
+	return policies, resp.Entry.Version, nil
+}
+
+// saveStoragePolicies replaces the policy entry, if it has not been changed since it was read
+func saveStoragePolicies(ctx context.Context, client filer_pb.SeaweedFilerClient, policies *filer_pb.StoragePolicies, version uint64) error {
+	extended, err := filer2.SaveStoragePolicies(policies)
+	if err != nil {
+		return err
+	}
+	precondition := &filer_pb.EntryPrecondition{ExpectedVersion: version}
+	if version == 0 {
+		precondition = &filer_pb.EntryPrecondition{MustNotExist: true}
+	}
+	now := time.Now().Unix()
+	_, err = client.CreateEntry(ctx, &filer_pb.CreateEntryRequest{
+		Directory: filer2.StoragePolicyDirectory,
+		Entry: &filer_pb.Entry{
+			Name: filer2.StoragePolicyName,
+			Attributes: &filer_pb.FuseAttributes{
+				Mtime:    now,
+				Crtime:   now,
+				FileMode: 0644,
+			},
+			Extended: extended,
+		},
+		Precondition: precondition,
+	})
+	if err != nil {
+		return fmt.Errorf("save storage policies: %v", err)
+	}
+	return nil
+}
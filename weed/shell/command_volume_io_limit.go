@@ -0,0 +1,98 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandVolumeIoLimit{})
+}
+
+type commandVolumeIoLimit struct {
+}
+
+func (c *commandVolumeIoLimit) Name() string {
+	return "volume.io.limit"
+}
+
+func (c *commandVolumeIoLimit) Help() string {
+	return `show or change the bandwidth limits of the background jobs on volume servers
+
+	volume.io.limit [-node <volume server host:port>]                             # show the limits
+	volume.io.limit [-node <volume server host:port>] -category copy -MBps 50     # change the limit
+
+	The background jobs are grouped into these categories:
+		compaction: compacting volumes
+		copy: copying volumes and tailing their updates, e.g., when moving or balancing volumes
+		ec: generating, rebuilding and copying ec shards
+		tier: moving volumes to the remote tier
+	All concurrent jobs of the same category on a volume server share its bandwidth limit.
+	0 MBps means unlimited. The changes are lost when the volume server restarts.
+	Without -node, all volume servers are shown or changed.
+
+`
+}
+
+func (c *commandVolumeIoLimit) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	ioLimitCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	node := ioLimitCommand.String("node", "", "<host>:<port> of the volume server, default to all volume servers")
+	category := ioLimitCommand.String("category", "", "the category of the background jobs to change")
+	mbps := ioLimitCommand.Float64("MBps", 0, "bandwidth limit in mega bytes per second, 0 means unlimited")
+	if err = ioLimitCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	ctx := context.Background()
+
+	var nodes []string
+	if *node != "" {
+		nodes = append(nodes, *node)
+	} else {
+		topologyInfo, err := collectTopologyInfo(ctx, commandEnv)
+		if err != nil {
+			return err
+		}
+		eachDataNode(topologyInfo, func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
+			nodes = append(nodes, dn.Id)
+		})
+	}
+
+	req := &volume_server_pb.VolumeServerIoLimitRequest{}
+	if *category != "" {
+		req.IoLimits = append(req.IoLimits, &volume_server_pb.IoLimit{
+			Category:       *category,
+			BytesPerSecond: int64(*mbps * 1024 * 1024),
+		})
+	}
+
+	for _, server := range nodes {
+		err = operation.WithVolumeServerClient(server, commandEnv.option.GrpcDialOption, func(volumeServerClient volume_server_pb.VolumeServerClient) error {
+			resp, ioLimitErr := volumeServerClient.VolumeServerIoLimit(ctx, req)
+			if ioLimitErr != nil {
+				return ioLimitErr
+			}
+			fmt.Fprintf(writer, "%s\n", server)
+			for _, ioLimit := range resp.IoLimits {
+				if ioLimit.BytesPerSecond == 0 {
+					fmt.Fprintf(writer, "  %-10s unlimited\n", ioLimit.Category)
+				} else {
+					fmt.Fprintf(writer, "  %-10s %.2f MBps\n", ioLimit.Category, float64(ioLimit.BytesPerSecond)/1024/1024)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("io limit of volume server %s: %v", server, err)
+		}
+	}
+
+	return nil
+}
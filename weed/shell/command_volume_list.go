@@ -93,6 +93,11 @@ func writeDataNodeInfo(writer io.Writer, t *master_pb.DataNodeInfo) statistics {
 	for _, ecShardInfo := range t.EcShardInfos {
 		fmt.Fprintf(writer, "        ec volume id:%v collection:%v shards:%v\n", ecShardInfo.Id, ecShardInfo.Collection, erasure_coding.ShardBits(ecShardInfo.EcIndexBits).ShardIds())
 	}
+	for _, diskStatus := range t.DiskStatuses {
+		if diskStatus.IsFailed {
+			fmt.Fprintf(writer, "        failed disk %s: %s\n", diskStatus.Dir, diskStatus.Error)
+		}
+	}
 	fmt.Fprintf(writer, "      DataNode %s %+v \n", t.Id, s)
 	return s
 }
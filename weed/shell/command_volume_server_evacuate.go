@@ -0,0 +1,325 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+func init() {
+	Commands = append(Commands, &commandVolumeServerEvacuate{})
+}
+
+type commandVolumeServerEvacuate struct {
+}
+
+func (c *commandVolumeServerEvacuate) Name() string {
+	return "volume.server.evacuate"
+}
+
+func (c *commandVolumeServerEvacuate) Help() string {
+	return `move out all data on a volume server, so that it can be safely removed
+
+	volume.server.evacuate -node <volume server host:port> [-force]
+	volume.server.evacuate -node <volume server host:port> -cancel
+
+	This command drains a volume server. Here are the steps:
+
+	1. This command asks the master to mark the volume server as draining.
+		Now the master will not grow new volumes on it, nor pick its volumes for writes.
+	2. This command moves each volume replica to another volume server with free slots,
+		following the volume's replica placement.
+	3. This command moves each ec shard to another volume server with free ec shard slots,
+		spreading the shards of the same volume across servers and racks.
+	4. This command checks whether the volume server still has any volumes or ec shards,
+		and reports whether it is safe to remove.
+
+	Without -force, only the evacuation plan is printed.
+	The draining mark is only kept in the master memory. If the master restarts, or the volume server
+	reconnects, run this command again. Use -cancel to put the volume server back into service.
+
+`
+}
+
+func (c *commandVolumeServerEvacuate) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	evacuateCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	node := evacuateCommand.String("node", "", "<host>:<port> of the volume server to evacuate")
+	cancel := evacuateCommand.Bool("cancel", false, "stop draining the volume server")
+	applyEvacuation := evacuateCommand.Bool("force", false, "apply the evacuation plan")
+	if err = evacuateCommand.Parse(args); err != nil {
+		return nil
+	}
+	if *node == "" {
+		return fmt.Errorf("need to specify the volume server with -node")
+	}
+
+	ctx := context.Background()
+
+	if *cancel {
+		if err = drainVolumeServer(ctx, commandEnv, *node, false); err != nil {
+			return err
+		}
+		fmt.Fprintf(writer, "volume server %s is no longer draining\n", *node)
+		return nil
+	}
+
+	if *applyEvacuation {
+		if err = drainVolumeServer(ctx, commandEnv, *node, true); err != nil {
+			return err
+		}
+		fmt.Fprintf(writer, "volume server %s is draining\n", *node)
+	}
+
+	topologyInfo, err := collectTopologyInfo(ctx, commandEnv)
+	if err != nil {
+		return err
+	}
+
+	volumeMoves, err := planVolumeEvacuation(topologyInfo, *node)
+	if err != nil {
+		return err
+	}
+	ecShardMoves := planEcShardEvacuation(topologyInfo, *node)
+
+	total, failed := len(volumeMoves)+len(ecShardMoves), 0
+	for i, move := range volumeMoves {
+		if move.target == nil {
+			fmt.Fprintf(writer, "(%d/%d) volume %d: no volume server satisfies replication %s\n", i+1, total, move.volume.Id, move.replicaPlacement)
+			failed++
+			continue
+		}
+		fmt.Fprintf(writer, "(%d/%d) moving volume %d %s => %s\n", i+1, total, move.volume.Id, *node, move.target.dataNode.Id)
+		if !*applyEvacuation {
+			continue
+		}
+		if err = LiveMoveVolume(ctx, commandEnv.option.GrpcDialOption, needle.VolumeId(move.volume.Id), *node, move.target.dataNode.Id, 5*time.Second); err != nil {
+			fmt.Fprintf(writer, "move volume %d: %v\n", move.volume.Id, err)
+			failed++
+		}
+	}
+	for i, move := range ecShardMoves {
+		n := len(volumeMoves) + i + 1
+		if move.target == nil {
+			fmt.Fprintf(writer, "(%d/%d) ec shard %d.%d: no volume server has free ec shard slots\n", n, total, move.vid, move.shardId)
+			failed++
+			continue
+		}
+		fmt.Fprintf(writer, "(%d/%d) moving ec shard %d.%d %s => %s\n", n, total, move.vid, move.shardId, *node, move.target.info.Id)
+		if !*applyEvacuation {
+			continue
+		}
+		if err = moveMountedShardToEcNode(ctx, commandEnv, move.source, move.collection, move.vid, move.shardId, move.target, true); err != nil {
+			fmt.Fprintf(writer, "move ec shard %d.%d: %v\n", move.vid, move.shardId, err)
+			failed++
+		}
+	}
+
+	if !*applyEvacuation {
+		return nil
+	}
+
+	// the deletions are reported to the master with the volume server heartbeats
+	topologyInfo, err = collectTopologyInfo(ctx, commandEnv)
+	if err != nil {
+		return err
+	}
+	var remainingVolumes, remainingEcShards int
+	eachDataNode(topologyInfo, func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
+		if dn.Id == *node {
+			remainingVolumes, remainingEcShards = len(dn.VolumeInfos), countShards(dn.EcShardInfos)
+		}
+	})
+	if remainingVolumes == 0 && remainingEcShards == 0 {
+		fmt.Fprintf(writer, "volume server %s is evacuated and safe to remove\n", *node)
+		return nil
+	}
+	fmt.Fprintf(writer, "volume server %s still has %d volumes and %d ec shards\n", *node, remainingVolumes, remainingEcShards)
+	if failed > 0 {
+		return fmt.Errorf("failed to move %d of %d volumes and ec shards", failed, total)
+	}
+	return nil
+}
+
+func drainVolumeServer(ctx context.Context, commandEnv *CommandEnv, node string, draining bool) error {
+	return commandEnv.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		_, err := client.VolumeServerDrain(ctx, &master_pb.VolumeServerDrainRequest{
+			Node:     node,
+			Draining: draining,
+		})
+		return err
+	})
+}
+
+func collectTopologyInfo(ctx context.Context, commandEnv *CommandEnv) (topologyInfo *master_pb.TopologyInfo, err error) {
+	var resp *master_pb.VolumeListResponse
+	err = commandEnv.MasterClient.WithClient(ctx, func(client master_pb.SeaweedClient) error {
+		resp, err = client.VolumeList(ctx, &master_pb.VolumeListRequest{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.TopologyInfo, nil
+}
+
+type volumeEvacuation struct {
+	volume           *master_pb.VolumeInformationMessage
+	replicaPlacement *storage.ReplicaPlacement
+	target           *location // nil if no volume server can take the replica
+}
+
+// planVolumeEvacuation finds a new location for each volume replica on the node.
+// The target should satisfy the replica placement together with the other replicas,
+// and is preferably in the same rack or data center as the evacuated node.
+func planVolumeEvacuation(topologyInfo *master_pb.TopologyInfo, node string) (moves []*volumeEvacuation, err error) {
+
+	var source *location
+	var allLocations []location
+	volumeLocations := make(map[uint32][]location)
+	eachDataNode(topologyInfo, func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
+		loc := newLocation(dc, string(rack), dn)
+		for _, v := range dn.VolumeInfos {
+			volumeLocations[v.Id] = append(volumeLocations[v.Id], loc)
+		}
+		if dn.Id == node {
+			source = &loc
+			return
+		}
+		allLocations = append(allLocations, loc)
+	})
+	if source == nil {
+		return nil, fmt.Errorf("volume server %s not found", node)
+	}
+
+	for _, v := range source.dataNode.VolumeInfos {
+		replicaPlacement, _ := storage.NewReplicaPlacementFromByte(byte(v.ReplicaPlacement))
+		var otherLocations []location
+		for _, loc := range volumeLocations[v.Id] {
+			if loc.dataNode.Id != node {
+				otherLocations = append(otherLocations, loc)
+			}
+		}
+
+		var candidates []location
+		for _, dst := range allLocations {
+			if dst.dataNode.IsDraining || dst.dataNode.FreeVolumeCount <= 0 || hasLocation(volumeLocations[v.Id], dst) {
+				continue
+			}
+			if satisfyReplicaPlacement(replicaPlacement, otherLocations, dst) {
+				candidates = append(candidates, dst)
+			}
+		}
+		move := &volumeEvacuation{volume: v, replicaPlacement: replicaPlacement}
+		moves = append(moves, move)
+		if len(candidates) == 0 {
+			continue
+		}
+
+		sort.SliceStable(candidates, func(i, j int) bool {
+			a, b := candidates[i], candidates[j]
+			if (a.Rack() == source.Rack()) != (b.Rack() == source.Rack()) {
+				return a.Rack() == source.Rack()
+			}
+			if (a.DataCenter() == source.DataCenter()) != (b.DataCenter() == source.DataCenter()) {
+				return a.DataCenter() == source.DataCenter()
+			}
+			return a.dataNode.FreeVolumeCount > b.dataNode.FreeVolumeCount
+		})
+		move.target = &candidates[0]
+		move.target.dataNode.FreeVolumeCount--
+		volumeLocations[v.Id] = append(otherLocations, *move.target)
+	}
+
+	return moves, nil
+}
+
+func hasLocation(locations []location, loc location) bool {
+	for _, l := range locations {
+		if l.dataNode.Id == loc.dataNode.Id {
+			return true
+		}
+	}
+	return false
+}
+
+type ecShardEvacuation struct {
+	collection string
+	vid        needle.VolumeId
+	shardId    erasure_coding.ShardId
+	source     *EcNode
+	target     *EcNode // nil if no volume server has free ec shard slots
+}
+
+// planEcShardEvacuation finds a new location for each ec shard on the node,
+// preferring the servers and then the racks with the fewest shards of the same volume.
+func planEcShardEvacuation(topologyInfo *master_pb.TopologyInfo, node string) (moves []*ecShardEvacuation) {
+
+	var source *EcNode
+	var ecNodes []*EcNode
+	eachDataNode(topologyInfo, func(dc string, rack RackId, dn *master_pb.DataNodeInfo) {
+		ecNode := &EcNode{
+			info:       dn,
+			dc:         dc,
+			rack:       rack,
+			freeEcSlot: countFreeShardSlots(dn),
+		}
+		if dn.Id == node {
+			source = ecNode
+			return
+		}
+		ecNodes = append(ecNodes, ecNode)
+	})
+	if source == nil {
+		return nil
+	}
+
+	for _, shardInfo := range source.info.EcShardInfos {
+		vid := needle.VolumeId(shardInfo.Id)
+		for _, shardId := range erasure_coding.ShardBits(shardInfo.EcIndexBits).ShardIds() {
+			move := &ecShardEvacuation{
+				collection: shardInfo.Collection,
+				vid:        vid,
+				shardId:    shardId,
+				source:     source,
+			}
+			moves = append(moves, move)
+
+			rackShardCount := groupByCount(ecNodes, func(ecNode *EcNode) (string, int) {
+				return string(ecNode.rack), findEcVolumeShards(ecNode, vid).ShardIdCount()
+			})
+			var candidates []*EcNode
+			for _, ecNode := range ecNodes {
+				if ecNode.freeEcSlot > 0 && !ecNode.info.IsDraining && !findEcVolumeShards(ecNode, vid).HasShardId(shardId) {
+					candidates = append(candidates, ecNode)
+				}
+			}
+			if len(candidates) == 0 {
+				continue
+			}
+			sort.SliceStable(candidates, func(i, j int) bool {
+				a, b := candidates[i], candidates[j]
+				if countA, countB := findEcVolumeShards(a, vid).ShardIdCount(), findEcVolumeShards(b, vid).ShardIdCount(); countA != countB {
+					return countA < countB
+				}
+				if countA, countB := rackShardCount[string(a.rack)], rackShardCount[string(b.rack)]; countA != countB {
+					return countA < countB
+				}
+				return a.freeEcSlot > b.freeEcSlot
+			})
+			move.target = candidates[0]
+			// only update the planned location, the shards are actually moved later
+			move.target.addEcVolumeShards(vid, shardInfo.Collection, []uint32{uint32(shardId)})
+		}
+	}
+
+	return moves
+}
@@ -0,0 +1,73 @@
+package shell
+
+import (
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+)
+
+func TestPlanVolumeServerEvacuation(t *testing.T) {
+
+	// volume 1 is replicated to another rack, with "010"
+	topologyInfo := &master_pb.TopologyInfo{
+		DataCenterInfos: []*master_pb.DataCenterInfo{{
+			Id: "dc1",
+			RackInfos: []*master_pb.RackInfo{
+				{
+					Id: "rack1",
+					DataNodeInfos: []*master_pb.DataNodeInfo{
+						{Id: "dn1", FreeVolumeCount: 5, MaxVolumeCount: 10,
+							VolumeInfos:  []*master_pb.VolumeInformationMessage{{Id: 1, ReplicaPlacement: 10}, {Id: 2}},
+							EcShardInfos: []*master_pb.VolumeEcShardInformationMessage{{Id: 3, Collection: "c1", EcIndexBits: 0x3}}},
+						{Id: "dn2", FreeVolumeCount: 5, MaxVolumeCount: 10, IsDraining: true},
+						{Id: "dn3", FreeVolumeCount: 1, MaxVolumeCount: 10},
+					},
+				},
+				{
+					Id: "rack2",
+					DataNodeInfos: []*master_pb.DataNodeInfo{
+						{Id: "dn4", FreeVolumeCount: 5, MaxVolumeCount: 10,
+							VolumeInfos:  []*master_pb.VolumeInformationMessage{{Id: 1, ReplicaPlacement: 10}},
+							EcShardInfos: []*master_pb.VolumeEcShardInformationMessage{{Id: 3, Collection: "c1", EcIndexBits: 0x4}}},
+						{Id: "dn5", FreeVolumeCount: 5, MaxVolumeCount: 10},
+					},
+				},
+			},
+		}},
+	}
+
+	volumeMoves, err := planVolumeEvacuation(topologyInfo, "dn1")
+	if err != nil {
+		t.Fatalf("plan volume evacuation: %v", err)
+	}
+	targets := make(map[uint32]string)
+	for _, move := range volumeMoves {
+		if move.target == nil {
+			t.Fatalf("no target for volume %d", move.volume.Id)
+		}
+		targets[move.volume.Id] = move.target.dataNode.Id
+	}
+	// the other replica is in rack2, so the target should be back in rack1, but not the draining dn2
+	if targets[1] != "dn3" {
+		t.Errorf("volume 1 moved to %s, expected dn3", targets[1])
+	}
+	// dn3 is full after taking volume 1
+	if targets[2] != "dn4" && targets[2] != "dn5" {
+		t.Errorf("volume 2 moved to %s, expected dn4 or dn5", targets[2])
+	}
+
+	ecShardMoves := planEcShardEvacuation(topologyInfo, "dn1")
+	if len(ecShardMoves) != 2 {
+		t.Fatalf("expected 2 ec shard moves, got %d", len(ecShardMoves))
+	}
+	for _, move := range ecShardMoves {
+		if move.target == nil || move.target.info.Id == "dn2" || move.target.info.Id == "dn4" {
+			t.Errorf("ec shard %d.%d moved to %v", move.vid, move.shardId, move.target)
+		}
+	}
+	if ecShardMoves[0].target == ecShardMoves[1].target {
+		t.Errorf("ec shards %d.%d and %d.%d moved to the same server %s", ecShardMoves[0].vid, ecShardMoves[0].shardId,
+			ecShardMoves[1].vid, ecShardMoves[1].shardId, ecShardMoves[0].target.info.Id)
+	}
+
+}
@@ -88,6 +88,30 @@ var (
 			Name:      "total_disk_size",
 			Help:      "Actual disk size used by volumes.",
 		}, []string{"collection", "type"})
+
+	VolumeServerIoBytesCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "SeaweedFS",
+			Subsystem: "volumeServer",
+			Name:      "io_bytes",
+			Help:      "Bytes read or written by the background jobs.",
+		}, []string{"category"})
+
+	VolumeServerIoThrottledSecondsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "SeaweedFS",
+			Subsystem: "volumeServer",
+			Name:      "io_throttled_seconds",
+			Help:      "Time the background jobs are slowed down by the bandwidth limits.",
+		}, []string{"category"})
+
+	VolumeServerIoLimitGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "SeaweedFS",
+			Subsystem: "volumeServer",
+			Name:      "io_limit_bytes_per_second",
+			Help:      "Bandwidth limit of the background jobs, 0 means unlimited.",
+		}, []string{"category"})
 )
 
 func init() {
@@ -103,6 +127,9 @@ func init() {
 	VolumeServerGather.MustRegister(VolumeServerVolumeCounter)
 	VolumeServerGather.MustRegister(VolumeServerMaxVolumeCounter)
 	VolumeServerGather.MustRegister(VolumeServerDiskSizeGauge)
+	VolumeServerGather.MustRegister(VolumeServerIoBytesCounter)
+	VolumeServerGather.MustRegister(VolumeServerIoThrottledSecondsCounter)
+	VolumeServerGather.MustRegister(VolumeServerIoLimitGauge)
 
 }
 
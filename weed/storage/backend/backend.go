@@ -8,6 +8,7 @@ import (
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/spf13/viper"
 )
 
@@ -15,6 +16,7 @@ type BackendStorageFile interface {
 	io.ReaderAt
 	io.WriterAt
 	Truncate(off int64) error
+	Sync() error
 	io.Closer
 	GetStat() (datSize int64, modTime time.Time, err error)
 	String() string
@@ -24,6 +26,9 @@ type BackendStorageFile interface {
 type BackendStorage interface {
 	ToProperties() map[string]string
 	NewStorageFile(key string) BackendStorageFile
+	// CopyFile uploads the local file, and DownloadFile saves the remote file locally, slowed down by the throttler
+	CopyFile(f *os.File, throttler util.Throttler) (key string, size int64, err error)
+	DownloadFile(fileName string, key string, throttler util.Throttler) (size int64, err error)
 }
 
 type StringProperties interface {
@@ -33,6 +33,10 @@ func (df *DiskFile) Truncate(off int64) error {
 	return df.File.Truncate(off)
 }
 
+func (df *DiskFile) Sync() error {
+	return df.File.Sync()
+}
+
 func (df *DiskFile) Close() error {
 	return df.File.Close()
 }
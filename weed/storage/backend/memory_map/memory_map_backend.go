@@ -42,6 +42,11 @@ func (mmf *MemoryMappedFile) Truncate(off int64) error {
 	return nil
 }
 
+// Sync does nothing, since the memory mapped file is deleted when closed
+func (mmf *MemoryMappedFile) Sync() error {
+	return nil
+}
+
 func (mmf *MemoryMappedFile) Close() error {
 	mmf.mm.DeleteFileAndMemoryMap()
 	return nil
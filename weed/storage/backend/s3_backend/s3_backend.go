@@ -3,6 +3,7 @@ package s3_backend
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/storage/backend"
+	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
 func init() {
@@ -70,6 +72,25 @@ func (s *S3BackendStorage) NewStorageFile(key string) backend.BackendStorageFile
 	return f
 }
 
+func (s *S3BackendStorage) CopyFile(f *os.File, throttler util.Throttler) (key string, size int64, err error) {
+	key = filepath.Base(f.Name())
+
+	glog.V(1).Infof("copying dat file of %s to remote s3.%s as %s", f.Name(), s.id, key)
+
+	size, err = uploadToS3(s.conn, f.Name(), s.bucket, key, throttler)
+
+	return
+}
+
+func (s *S3BackendStorage) DownloadFile(fileName string, key string, throttler util.Throttler) (size int64, err error) {
+
+	glog.V(1).Infof("download dat file of %s from remote s3.%s as %s", fileName, s.id, key)
+
+	size, err = downloadFromS3(s.conn, fileName, s.bucket, key, throttler)
+
+	return
+}
+
 type S3BackendStorageFile struct {
 	backendStorage *S3BackendStorage
 	key            string
@@ -101,6 +122,10 @@ func (s3backendStorageFile S3BackendStorageFile) Truncate(off int64) error {
 	panic("implement me")
 }
 
+func (s3backendStorageFile S3BackendStorageFile) Sync() error {
+	return nil
+}
+
 func (s3backendStorageFile S3BackendStorageFile) Close() error {
 	return nil
 }
@@ -0,0 +1,43 @@
+package s3_backend
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func downloadFromS3(sess s3iface.S3API, destFileName string, sourceBucket string, sourceKey string, throttler util.Throttler) (fileSize int64, err error) {
+
+	f, err := os.OpenFile(destFileName, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open file %q, %v", destFileName, err)
+	}
+	defer f.Close()
+
+	downloader := s3manager.NewDownloaderWithClient(sess, func(u *s3manager.Downloader) {
+		u.PartSize = int64(64 * 1024 * 1024)
+		u.Concurrency = 5
+	})
+
+	// the parts are written concurrently, sharing the throttler
+	fileSize, err = downloader.Download(util.NewThrottledWriterAt(f, throttler), &s3.GetObjectInput{
+		Bucket: aws.String(sourceBucket),
+		Key:    aws.String(sourceKey),
+	})
+	if err != nil {
+		return fileSize, fmt.Errorf("failed to download file %s: %v", destFileName, err)
+	}
+	if err = f.Sync(); err != nil {
+		return fileSize, fmt.Errorf("failed to sync file %s: %v", destFileName, err)
+	}
+
+	glog.V(0).Infof("file %s downloaded from %s/%s", destFileName, sourceBucket, sourceKey)
+
+	return fileSize, nil
+}
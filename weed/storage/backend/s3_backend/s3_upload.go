@@ -7,23 +7,25 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
-func uploadToS3(sess s3iface.S3API, filename string, destBucket string, destKey string) error {
+func uploadToS3(sess s3iface.S3API, filename string, destBucket string, destKey string, throttler util.Throttler) (fileSize int64, err error) {
 
 	//open the file
 	f, err := os.Open(filename)
 	if err != nil {
-		return fmt.Errorf("failed to open file %q, %v", filename, err)
+		return 0, fmt.Errorf("failed to open file %q, %v", filename, err)
 	}
 	defer f.Close()
 
 	info, err := f.Stat()
 	if err != nil {
-		return fmt.Errorf("failed to stat file %q, %v", filename, err)
+		return 0, fmt.Errorf("failed to stat file %q, %v", filename, err)
 	}
 
-	fileSize := info.Size()
+	fileSize = info.Size()
 
 	partSize := int64(64 * 1024 * 1024) // The minimum/default allowed part size is 5MB
 	for partSize*1000 < fileSize {
@@ -36,11 +38,11 @@ func uploadToS3(sess s3iface.S3API, filename string, destBucket string, destKey
 		u.Concurrency = 15 // default is 15
 	})
 
-	// Upload the file to S3.
+	// Upload the file to S3, the parts are read in turn through the throttler
 	result, err := uploader.Upload(&s3manager.UploadInput{
 		Bucket:               aws.String(destBucket),
 		Key:                  aws.String(destKey),
-		Body:                 f,
+		Body:                 util.NewThrottledReader(f, throttler),
 		ACL:                  aws.String("private"),
 		ServerSideEncryption: aws.String("AES256"),
 		StorageClass:         aws.String("STANDARD_IA"),
@@ -48,9 +50,9 @@ func uploadToS3(sess s3iface.S3API, filename string, destBucket string, destKey
 
 	//in case it fails to upload
 	if err != nil {
-		return fmt.Errorf("failed to upload file, %v", err)
+		return 0, fmt.Errorf("failed to upload file, %v", err)
 	}
-	fmt.Printf("file %s uploaded to %s\n", filename, result.Location)
+	glog.V(0).Infof("file %s uploaded to %s", filename, result.Location)
 
-	return nil
+	return fileSize, nil
 }
@@ -22,6 +22,11 @@ type DiskLocation struct {
 	// erasure coding
 	ecVolumes     map[needle.VolumeId]*erasure_coding.EcVolume
 	ecVolumesLock sync.RWMutex
+
+	// disk health
+	failure     error
+	failureLock sync.RWMutex
+	isChecking  int32
 }
 
 func NewDiskLocation(dir string, maxVolumeCount int) *DiskLocation {
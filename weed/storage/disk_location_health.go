@@ -0,0 +1,168 @@
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+)
+
+const (
+	diskHealthCheckFile    = ".health_check"
+	diskHealthCheckTimeout = 10 * time.Second
+)
+
+var diskHealthCheckContent = []byte("seaweedfs disk health check")
+
+// IsFailed means the disk returned I/O errors or stopped responding.
+// A failed disk location is not checked again until the volume server restarts.
+func (l *DiskLocation) IsFailed() bool {
+	return l.Failure() != nil
+}
+
+func (l *DiskLocation) Failure() error {
+	l.failureLock.RLock()
+	defer l.failureLock.RUnlock()
+	return l.failure
+}
+
+// markFailed returns true if the disk location was not failed before
+func (l *DiskLocation) markFailed(err error) bool {
+	l.failureLock.Lock()
+	defer l.failureLock.Unlock()
+	if l.failure != nil {
+		return false
+	}
+	l.failure = err
+	return true
+}
+
+// checkHealth writes, syncs, reads back and removes a small file on the disk.
+// A disk that does not respond within the timeout is also considered failed,
+// and the hanging check is left behind, so that it does not block the other disks.
+func (l *DiskLocation) checkHealth(timeout time.Duration) error {
+	if !atomic.CompareAndSwapInt32(&l.isChecking, 0, 1) {
+		// the previous check is still running, or another check is just started
+		return nil
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- l.writeAndReadHealthCheckFile()
+		atomic.StoreInt32(&l.isChecking, 0)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(timeout):
+		return fmt.Errorf("disk health check timed out after %v", timeout)
+	}
+}
+
+func (l *DiskLocation) writeAndReadHealthCheckFile() error {
+	fileName := filepath.Join(l.Directory, diskHealthCheckFile)
+	f, err := os.OpenFile(fileName, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(diskHealthCheckContent); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(data, diskHealthCheckContent) {
+		return fmt.Errorf("read back %d bytes different from the written %d bytes", len(data), len(diskHealthCheckContent))
+	}
+	return os.Remove(fileName)
+}
+
+func (l *DiskLocation) ToDiskStatus() *master_pb.DiskStatus {
+	status := &master_pb.DiskStatus{
+		Dir: l.Directory,
+	}
+	if err := l.Failure(); err != nil {
+		status.IsFailed = true
+		status.Error = err.Error()
+	}
+	return status
+}
+
+// CheckDiskLocations checks the health of all disk locations concurrently,
+// and takes the volumes on the newly failed disk locations out of service.
+func (s *Store) CheckDiskLocations() {
+	done := make(chan bool)
+	for _, location := range s.Locations {
+		go func(location *DiskLocation) {
+			s.checkDiskLocation(location)
+			done <- true
+		}(location)
+	}
+	for range s.Locations {
+		<-done
+	}
+}
+
+// LoopCheckingDiskLocations checks the health of all disk locations every interval, apart from the heartbeats.
+func (s *Store) LoopCheckingDiskLocations(interval time.Duration) {
+	for {
+		s.CheckDiskLocations()
+		time.Sleep(interval)
+	}
+}
+
+func (s *Store) checkDiskLocation(location *DiskLocation) {
+	if location.IsFailed() {
+		return
+	}
+	err := location.checkHealth(diskHealthCheckTimeout)
+	if err == nil || !location.markFailed(err) {
+		return
+	}
+	glog.Errorf("disk %s failed: %v", location.Directory, err)
+	s.takeOutFailedDiskVolumes(location)
+}
+
+// checkDiskLocationAfterError checks the disk location in the background after a failed write,
+// in case the error comes from the disk.
+func (s *Store) checkDiskLocationAfterError(location *DiskLocation, err error) {
+	glog.V(1).Infof("check disk %s after error: %v", location.Directory, err)
+	go s.checkDiskLocation(location)
+}
+
+// takeOutFailedDiskVolumes unloads the replicated volumes on the failed disk, so that the master
+// sees them as missing replicas, and the replicas can be fixed from the other volume servers.
+// Volumes without other replicas are kept as read only, to serve what can still be read.
+// The unloaded volumes are closed in the background, since closing files on a failed disk may hang.
+func (s *Store) takeOutFailedDiskVolumes(location *DiskLocation) {
+	var unloaded []*Volume
+	location.Lock()
+	for vid, v := range location.volumes {
+		if v.ReplicaPlacement != nil && v.ReplicaPlacement.GetCopyCount() > 1 {
+			delete(location.volumes, vid)
+			unloaded = append(unloaded, v)
+			continue
+		}
+		v.readOnly = true
+	}
+	location.Unlock()
+
+	for _, v := range unloaded {
+		glog.V(0).Infof("volume %d on the failed disk %s is unloaded", v.Id, location.Directory)
+		go v.Close()
+	}
+}
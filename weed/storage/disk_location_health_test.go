@@ -0,0 +1,59 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestFailedDiskLocation(t *testing.T) {
+	failingDir, _ := ioutil.TempDir("", "failing")
+	healthyDir, _ := ioutil.TempDir("", "healthy")
+	defer os.RemoveAll(failingDir)
+	defer os.RemoveAll(healthyDir)
+
+	s := NewStore(nil, 8080, "localhost", "localhost", []string{failingDir, healthyDir}, []int{10, 1}, NeedleMapInMemory)
+	defer s.Close()
+	if err := s.AddVolume(1, "", NeedleMapInMemory, "001", "", 0, 0, ""); err != nil {
+		t.Fatalf("add volume 1: %v", err)
+	}
+	if err := s.AddVolume(2, "", NeedleMapInMemory, "000", "", 0, 0, ""); err != nil {
+		t.Fatalf("add volume 2: %v", err)
+	}
+
+	heartbeat := s.CollectHeartbeat()
+	if len(heartbeat.Volumes) != 2 || heartbeat.MaxVolumeCount != 11 {
+		t.Fatalf("healthy heartbeat: %d volumes, max %d", len(heartbeat.Volumes), heartbeat.MaxVolumeCount)
+	}
+	for _, status := range heartbeat.DiskStatuses {
+		if status.IsFailed {
+			t.Fatalf("unexpected failed disk %s: %s", status.Dir, status.Error)
+		}
+	}
+
+	// the opened volume files are still there, but nothing new can be written to the disk
+	os.RemoveAll(failingDir)
+
+	// the heartbeat only reports the last checked status
+	heartbeat = s.CollectHeartbeat()
+	if heartbeat.DiskStatuses[0].IsFailed || len(heartbeat.Volumes) != 2 {
+		t.Errorf("heartbeat before checking the disks: %+v", heartbeat)
+	}
+
+	s.CheckDiskLocations()
+	heartbeat = s.CollectHeartbeat()
+	if !heartbeat.DiskStatuses[0].IsFailed || heartbeat.DiskStatuses[1].IsFailed {
+		t.Errorf("disk statuses: %+v", heartbeat.DiskStatuses)
+	}
+	// the replicated volume 1 is taken out, and volume 2 without other replicas is kept as read only
+	if len(heartbeat.Volumes) != 1 || heartbeat.Volumes[0].Id != 2 || !heartbeat.Volumes[0].ReadOnly {
+		t.Errorf("volumes on the failed disk: %+v", heartbeat.Volumes)
+	}
+	// no free slots on the failed disk
+	if heartbeat.MaxVolumeCount != 2 {
+		t.Errorf("max volume count %d, expected 2", heartbeat.MaxVolumeCount)
+	}
+	if location := s.FindFreeLocation(); location == nil || location.Directory != healthyDir {
+		t.Errorf("free location %v, expected %s", location, healthyDir)
+	}
+}
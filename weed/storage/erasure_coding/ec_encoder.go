@@ -7,8 +7,7 @@ import (
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/storage/idx"
-	"github.com/chrislusf/seaweedfs/weed/storage/needle_map"
-	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/storage/volume_info"
 	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/klauspost/reedsolomon"
 )
@@ -22,47 +21,63 @@ const (
 )
 
 // WriteSortedEcxFile generates .ecx file from existing .idx file
-// all keys are sorted in ascending order
+// all keys are sorted in ascending order, and the offsets keep the size recorded in the .vif file
 func WriteSortedEcxFile(baseFileName string) (e error) {
+	return WriteSortedFileFromIdx(baseFileName, ".ecx")
+}
+
+// WriteSortedFileFromIdx generates a sorted index file with the extension, e.g. .ecx or .sdx, from existing .idx file
+// the .idx file is sorted in batches, so the memory used does not grow with the number of needles
+// the file is replaced only when completely written
+func WriteSortedFileFromIdx(baseFileName string, ext string) (e error) {
 
-	cm, err := readCompactMap(baseFileName)
+	offsetSize, err := volume_info.LoadOffsetSize(baseFileName + ".vif")
 	if err != nil {
-		return fmt.Errorf("readCompactMap: %v", err)
+		return err
 	}
 
-	ecxFile, err := os.OpenFile(baseFileName+".ecx", os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0644)
+	indexFile, err := os.OpenFile(baseFileName+".idx", os.O_RDONLY, 0644)
 	if err != nil {
-		return fmt.Errorf("failed to open ecx file: %v", err)
+		return fmt.Errorf("cannot read Volume Index %s.idx: %v", baseFileName, err)
 	}
-	defer ecxFile.Close()
-
-	err = cm.AscendingVisit(func(value needle_map.NeedleValue) error {
-		bytes := value.ToBytes()
-		_, writeErr := ecxFile.Write(bytes)
-		return writeErr
-	})
+	defer indexFile.Close()
 
+	tmpFileName := baseFileName + ext + ".tmp"
+	sortedFile, err := os.OpenFile(tmpFileName, os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0644)
 	if err != nil {
-		return fmt.Errorf("failed to visit ecx file: %v", err)
+		return fmt.Errorf("failed to open %s file: %v", ext, err)
+	}
+	defer os.Remove(tmpFileName)
+	defer sortedFile.Close()
+
+	if err = idx.SortIndexFile(indexFile, offsetSize, sortedFile, tmpFileName+".run"); err != nil {
+		return fmt.Errorf("failed to write %s file: %v", ext, err)
+	}
+
+	if err = sortedFile.Sync(); err != nil {
+		return fmt.Errorf("failed to sync %s file: %v", ext, err)
+	}
+	if err = os.Rename(tmpFileName, baseFileName+ext); err != nil {
+		return fmt.Errorf("failed to rename %s file: %v", ext, err)
 	}
 
 	return nil
 }
 
 // WriteEcFiles generates .ec01 ~ .ec14 files
-func WriteEcFiles(baseFileName string) error {
-	return generateEcFiles(baseFileName, 256*1024, ErasureCodingLargeBlockSize, ErasureCodingSmallBlockSize)
+func WriteEcFiles(baseFileName string, throttler util.Throttler) error {
+	return generateEcFiles(baseFileName, 256*1024, ErasureCodingLargeBlockSize, ErasureCodingSmallBlockSize, throttler)
 }
 
-func RebuildEcFiles(baseFileName string) ([]uint32, error) {
-	return generateMissingEcFiles(baseFileName, 256*1024, ErasureCodingLargeBlockSize, ErasureCodingSmallBlockSize)
+func RebuildEcFiles(baseFileName string, throttler util.Throttler) ([]uint32, error) {
+	return generateMissingEcFiles(baseFileName, 256*1024, ErasureCodingLargeBlockSize, ErasureCodingSmallBlockSize, throttler)
 }
 
 func ToExt(ecIndex int) string {
 	return fmt.Sprintf(".ec%02d", ecIndex)
 }
 
-func generateEcFiles(baseFileName string, bufferSize int, largeBlockSize int64, smallBlockSize int64) error {
+func generateEcFiles(baseFileName string, bufferSize int, largeBlockSize int64, smallBlockSize int64, throttler util.Throttler) error {
 	file, err := os.OpenFile(baseFileName+".dat", os.O_RDONLY, 0)
 	if err != nil {
 		return fmt.Errorf("failed to open dat file: %v", err)
@@ -73,14 +88,14 @@ func generateEcFiles(baseFileName string, bufferSize int, largeBlockSize int64,
 	if err != nil {
 		return fmt.Errorf("failed to stat dat file: %v", err)
 	}
-	err = encodeDatFile(fi.Size(), err, baseFileName, bufferSize, largeBlockSize, file, smallBlockSize)
+	err = encodeDatFile(fi.Size(), err, baseFileName, bufferSize, largeBlockSize, file, smallBlockSize, throttler)
 	if err != nil {
 		return fmt.Errorf("encodeDatFile: %v", err)
 	}
 	return nil
 }
 
-func generateMissingEcFiles(baseFileName string, bufferSize int, largeBlockSize int64, smallBlockSize int64) (generatedShardIds []uint32, err error) {
+func generateMissingEcFiles(baseFileName string, bufferSize int, largeBlockSize int64, smallBlockSize int64, throttler util.Throttler) (generatedShardIds []uint32, err error) {
 
 	shardHasData := make([]bool, TotalShardsCount)
 	inputFiles := make([]*os.File, TotalShardsCount)
@@ -104,14 +119,14 @@ func generateMissingEcFiles(baseFileName string, bufferSize int, largeBlockSize
 		}
 	}
 
-	err = rebuildEcFiles(shardHasData, inputFiles, outputFiles)
+	err = rebuildEcFiles(shardHasData, inputFiles, outputFiles, throttler)
 	if err != nil {
 		return nil, fmt.Errorf("rebuildEcFiles: %v", err)
 	}
 	return
 }
 
-func encodeData(file *os.File, enc reedsolomon.Encoder, startOffset, blockSize int64, buffers [][]byte, outputs []*os.File) error {
+func encodeData(file *os.File, enc reedsolomon.Encoder, startOffset, blockSize int64, buffers [][]byte, outputs []*os.File, throttler util.Throttler) error {
 
 	bufferSize := int64(len(buffers[0]))
 	batchCount := blockSize / bufferSize
@@ -124,6 +139,8 @@ func encodeData(file *os.File, enc reedsolomon.Encoder, startOffset, blockSize i
 		if err != nil {
 			return err
 		}
+		// read the data shards, and write all shards
+		throttler.MaybeSlowdown((DataShardsCount + TotalShardsCount) * bufferSize)
 	}
 
 	return nil
@@ -185,7 +202,7 @@ func encodeDataOneBatch(file *os.File, enc reedsolomon.Encoder, startOffset, blo
 	return nil
 }
 
-func encodeDatFile(remainingSize int64, err error, baseFileName string, bufferSize int, largeBlockSize int64, file *os.File, smallBlockSize int64) error {
+func encodeDatFile(remainingSize int64, err error, baseFileName string, bufferSize int, largeBlockSize int64, file *os.File, smallBlockSize int64, throttler util.Throttler) error {
 
 	var processedSize int64
 
@@ -206,7 +223,7 @@ func encodeDatFile(remainingSize int64, err error, baseFileName string, bufferSi
 	}
 
 	for remainingSize > largeBlockSize*DataShardsCount {
-		err = encodeData(file, enc, processedSize, largeBlockSize, buffers, outputs)
+		err = encodeData(file, enc, processedSize, largeBlockSize, buffers, outputs, throttler)
 		if err != nil {
 			return fmt.Errorf("failed to encode large chunk data: %v", err)
 		}
@@ -214,7 +231,7 @@ func encodeDatFile(remainingSize int64, err error, baseFileName string, bufferSi
 		processedSize += largeBlockSize * DataShardsCount
 	}
 	for remainingSize > 0 {
-		encodeData(file, enc, processedSize, smallBlockSize, buffers, outputs)
+		encodeData(file, enc, processedSize, smallBlockSize, buffers, outputs, throttler)
 		if err != nil {
 			return fmt.Errorf("failed to encode small chunk data: %v", err)
 		}
@@ -224,7 +241,7 @@ func encodeDatFile(remainingSize int64, err error, baseFileName string, bufferSi
 	return nil
 }
 
-func rebuildEcFiles(shardHasData []bool, inputFiles []*os.File, outputFiles []*os.File) error {
+func rebuildEcFiles(shardHasData []bool, inputFiles []*os.File, outputFiles []*os.File, throttler util.Throttler) error {
 
 	enc, err := reedsolomon.New(DataShardsCount, ParityShardsCount)
 	if err != nil {
@@ -276,25 +293,8 @@ func rebuildEcFiles(shardHasData []bool, inputFiles []*os.File, outputFiles []*o
 			}
 		}
 		startOffset += int64(inputBufferDataSize)
+		// read the existing shards, and write the missing shards
+		throttler.MaybeSlowdown(int64(TotalShardsCount * inputBufferDataSize))
 	}
 
 }
-
-func readCompactMap(baseFileName string) (*needle_map.CompactMap, error) {
-	indexFile, err := os.OpenFile(baseFileName+".idx", os.O_RDONLY, 0644)
-	if err != nil {
-		return nil, fmt.Errorf("cannot read Volume Index %s.idx: %v", baseFileName, err)
-	}
-	defer indexFile.Close()
-
-	cm := needle_map.NewCompactMap()
-	err = idx.WalkIndexFile(indexFile, func(key types.NeedleId, offset types.Offset, size uint32) error {
-		if !offset.IsZero() && size != types.TombstoneFileSize {
-			cm.Set(key, offset, size)
-		} else {
-			cm.Delete(key)
-		}
-		return nil
-	})
-	return cm, err
-}
@@ -7,8 +7,10 @@ import (
 	"os"
 	"testing"
 
+	"github.com/chrislusf/seaweedfs/weed/storage/idx"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle_map"
 	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/klauspost/reedsolomon"
 )
 
@@ -21,7 +23,7 @@ func TestEncodingDecoding(t *testing.T) {
 	bufferSize := 50
 	baseFileName := "1"
 
-	err := generateEcFiles(baseFileName, bufferSize, largeBlockSize, smallBlockSize)
+	err := generateEcFiles(baseFileName, bufferSize, largeBlockSize, smallBlockSize, util.NewWriteThrottler(0))
 	if err != nil {
 		t.Logf("generateEcFiles: %v", err)
 	}
@@ -41,7 +43,7 @@ func TestEncodingDecoding(t *testing.T) {
 }
 
 func validateFiles(baseFileName string) error {
-	cm, err := readCompactMap(baseFileName)
+	cm, err := readCompactMap(baseFileName, types.OffsetSize)
 	if err != nil {
 		return fmt.Errorf("readCompactMap: %v", err)
 	}
@@ -182,6 +184,7 @@ func removeGeneratedFiles(baseFileName string) {
 		os.Remove(fname)
 	}
 	os.Remove(baseFileName + ".ecx")
+	os.Remove(baseFileName + ".vif")
 }
 
 func TestLocateData(t *testing.T) {
@@ -203,3 +206,22 @@ func (this Interval) sameAs(that Interval) bool {
 		this.BlockIndex == that.BlockIndex &&
 		this.Size == that.Size
 }
+
+func readCompactMap(baseFileName string, offsetSize int) (*needle_map.CompactMap, error) {
+	indexFile, err := os.OpenFile(baseFileName+".idx", os.O_RDONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read Volume Index %s.idx: %v", baseFileName, err)
+	}
+	defer indexFile.Close()
+
+	cm := needle_map.NewCompactMap()
+	err = idx.WalkIndexFile(indexFile, offsetSize, func(key types.NeedleId, offset types.Offset, size uint32) error {
+		if !offset.IsZero() && size != types.TombstoneFileSize {
+			cm.Set(key, offset, size)
+		} else {
+			cm.Delete(key)
+		}
+		return nil
+	})
+	return cm, err
+}
@@ -3,6 +3,7 @@ package erasure_coding
 import (
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"sort"
@@ -13,6 +14,8 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/storage/idx"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/storage/volume_info"
+	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
 var (
@@ -33,6 +36,8 @@ type EcVolume struct {
 	Version                   needle.Version
 	ecjFile                   *os.File
 	ecjFileAccessLock         sync.Mutex
+	// of the offsets in the .ecx file, as recorded in the .vif file
+	offsetSize int
 }
 
 func NewEcVolume(dir string, collection string, vid needle.VolumeId) (ev *EcVolume, err error) {
@@ -40,6 +45,10 @@ func NewEcVolume(dir string, collection string, vid needle.VolumeId) (ev *EcVolu
 
 	baseFileName := EcShardFileName(collection, dir, int(vid))
 
+	if ev.offsetSize, err = volume_info.LoadOffsetSize(baseFileName + ".vif"); err != nil {
+		return nil, err
+	}
+
 	// open ecx file
 	if ev.ecxFile, err = os.OpenFile(baseFileName+".ecx", os.O_RDWR, 0644); err != nil {
 		return nil, fmt.Errorf("cannot open ec volume index %s.ecx: %v", baseFileName, err)
@@ -126,6 +135,9 @@ func (ev *EcVolume) Destroy() {
 	}
 	os.Remove(ev.FileName() + ".ecx")
 	os.Remove(ev.FileName() + ".ecj")
+	if !util.FileExists(ev.FileName() + ".dat") {
+		os.Remove(ev.FileName() + ".vif")
+	}
 }
 
 func (ev *EcVolume) FileName() string {
@@ -186,22 +198,25 @@ func (ev *EcVolume) LocateEcShardNeedle(needleId types.NeedleId, version needle.
 }
 
 func (ev *EcVolume) FindNeedleFromEcx(needleId types.NeedleId) (offset types.Offset, size uint32, err error) {
-	return searchNeedleFromEcx(ev.ecxFile, ev.ecxFileSize, needleId, nil)
+	return SearchNeedleFromSortedIndex(ev.ecxFile, ev.ecxFileSize, ev.offsetSize, needleId, nil)
 }
 
-func searchNeedleFromEcx(ecxFile *os.File, ecxFileSize int64, needleId types.NeedleId, processNeedleFn func(file *os.File, offset int64) error) (offset types.Offset, size uint32, err error) {
+// SearchNeedleFromSortedIndex binary searches the needle in the sorted .ecx or .sdx file,
+// and calls processNeedleFn with the offset of its entry
+func SearchNeedleFromSortedIndex(sortedFile io.ReaderAt, fileSize int64, offsetSize int, needleId types.NeedleId, processNeedleFn func(entryOffset int64) error) (offset types.Offset, size uint32, err error) {
 	var key types.NeedleId
-	buf := make([]byte, types.NeedleMapEntrySize)
-	l, h := int64(0), ecxFileSize/types.NeedleMapEntrySize
+	entrySize := int64(types.NeedleMapEntrySizeOf(offsetSize))
+	buf := make([]byte, entrySize)
+	l, h := int64(0), fileSize/entrySize
 	for l < h {
 		m := (l + h) / 2
-		if _, err := ecxFile.ReadAt(buf, m*types.NeedleMapEntrySize); err != nil {
-			return types.Offset{}, types.TombstoneFileSize, fmt.Errorf("ecx file %d read at %d: %v", ecxFileSize, m*types.NeedleMapEntrySize, err)
+		if _, err := sortedFile.ReadAt(buf, m*entrySize); err != nil {
+			return types.Offset{}, types.TombstoneFileSize, fmt.Errorf("sorted index file %d read at %d: %v", fileSize, m*entrySize, err)
 		}
 		key, offset, size = idx.IdxFileEntry(buf)
 		if key == needleId {
 			if processNeedleFn != nil {
-				err = processNeedleFn(ecxFile, m*types.NeedleHeaderSize)
+				err = processNeedleFn(m * entrySize)
 			}
 			return
 		}
@@ -6,14 +6,16 @@ import (
 	"os"
 
 	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/storage/volume_info"
 	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
-var (
-	markNeedleDeleted = func(file *os.File, offset int64) error {
+// markNeedleDeleted sets the size of the found entry of the .ecx file to the tombstone
+func markNeedleDeleted(file *os.File, offsetSize int) func(entryOffset int64) error {
+	return func(entryOffset int64) error {
 		b := make([]byte, types.SizeSize)
 		util.Uint32toBytes(b, types.TombstoneFileSize)
-		n, err := file.WriteAt(b, offset+types.NeedleIdSize+types.OffsetSize)
+		n, err := file.WriteAt(b, entryOffset+types.NeedleIdSize+int64(offsetSize))
 		if err != nil {
 			return fmt.Errorf("ecx write error: %v", err)
 		}
@@ -22,11 +24,11 @@ var (
 		}
 		return nil
 	}
-)
+}
 
 func (ev *EcVolume) DeleteNeedleFromEcx(needleId types.NeedleId) (err error) {
 
-	_, _, err = searchNeedleFromEcx(ev.ecxFile, ev.ecxFileSize, needleId, markNeedleDeleted)
+	_, _, err = SearchNeedleFromSortedIndex(ev.ecxFile, ev.ecxFileSize, ev.offsetSize, needleId, markNeedleDeleted(ev.ecxFile, ev.offsetSize))
 
 	if err != nil {
 		if err == NotFoundError {
@@ -67,6 +69,11 @@ func RebuildEcxFile(baseFileName string) error {
 
 	ecxFileSize := fstat.Size()
 
+	offsetSize, err := volume_info.LoadOffsetSize(baseFileName + ".vif")
+	if err != nil {
+		return err
+	}
+
 	ecjFile, err := os.OpenFile(baseFileName+".ecj", os.O_RDWR, 0644)
 	if err != nil {
 		return fmt.Errorf("rebuild: failed to open ecj file: %v", err)
@@ -81,7 +88,7 @@ func RebuildEcxFile(baseFileName string) error {
 
 		needleId := types.BytesToNeedleId(buf)
 
-		_, _, err = searchNeedleFromEcx(ecxFile, ecxFileSize, needleId, markNeedleDeleted)
+		_, _, err = SearchNeedleFromSortedIndex(ecxFile, ecxFileSize, offsetSize, needleId, markNeedleDeleted(ecxFile, offsetSize))
 
 		if err != nil && err != NotFoundError {
 			ecxFile.Close()
@@ -0,0 +1,70 @@
+package idx
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// ConvertIndexFile writes the entries of the .idx or .ecx file with offsets of fromOffsetSize bytes
+// into convertedFileName with offsets of toOffsetSize bytes, in the same order.
+// The converted file is synced when done, and removed on any error.
+func ConvertIndexFile(fileName, convertedFileName string, fromOffsetSize, toOffsetSize int) (err error) {
+
+	src, err := os.OpenFile(fileName, os.O_RDONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("open %s: %v", fileName, err)
+	}
+	defer src.Close()
+
+	fileSize, err := util.GetFileSize(src)
+	if err != nil {
+		return fmt.Errorf("stat %s: %v", fileName, err)
+	}
+	if fileSize%int64(types.NeedleMapEntrySizeOf(fromOffsetSize)) != 0 {
+		return fmt.Errorf("%s size %d is not of entries with %d bytes offset", fileName, fileSize, fromOffsetSize)
+	}
+
+	dst, err := os.OpenFile(convertedFileName, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("create %s: %v", convertedFileName, err)
+	}
+	defer func() {
+		if closeErr := dst.Close(); err == nil && closeErr != nil {
+			err = fmt.Errorf("close %s: %v", convertedFileName, closeErr)
+		}
+		if err != nil {
+			os.Remove(convertedFileName)
+		}
+	}()
+
+	maxOffset := types.MaxPossibleVolumeSizeOf(toOffsetSize)
+	writer := bufio.NewWriter(dst)
+	bytes := make([]byte, types.NeedleMapEntrySizeOf(toOffsetSize))
+
+	err = WalkIndexFile(src, fromOffsetSize, func(key types.NeedleId, offset types.Offset, size uint32) error {
+		if uint64(offset.ToAcutalOffset()) >= maxOffset {
+			return fmt.Errorf("needle %d at offset %d does not fit in %d bytes offset", key, offset.ToAcutalOffset(), toOffsetSize)
+		}
+		types.NeedleIdToBytes(bytes[0:types.NeedleIdSize], key)
+		types.OffsetToBytes(bytes[types.NeedleIdSize:types.NeedleIdSize+toOffsetSize], offset)
+		util.Uint32toBytes(bytes[types.NeedleIdSize+toOffsetSize:], size)
+		_, writeErr := writer.Write(bytes)
+		return writeErr
+	})
+	if err != nil {
+		return fmt.Errorf("convert %s: %v", fileName, err)
+	}
+
+	if err = writer.Flush(); err != nil {
+		return fmt.Errorf("write %s: %v", convertedFileName, err)
+	}
+	if err = dst.Sync(); err != nil {
+		return fmt.Errorf("sync %s: %v", convertedFileName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package idx
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+type indexEntry struct {
+	key    types.NeedleId
+	offset int64
+	size   uint32
+}
+
+func writeIndexFile(t *testing.T, fileName string, offsetSize int, entries []indexEntry) {
+	var data []byte
+	for _, e := range entries {
+		bytes := make([]byte, types.NeedleMapEntrySizeOf(offsetSize))
+		types.NeedleIdToBytes(bytes[0:types.NeedleIdSize], e.key)
+		types.OffsetToBytes(bytes[types.NeedleIdSize:types.NeedleIdSize+offsetSize], types.ToOffset(e.offset))
+		util.Uint32toBytes(bytes[types.NeedleIdSize+offsetSize:], e.size)
+		data = append(data, bytes...)
+	}
+	if err := ioutil.WriteFile(fileName, data, 0644); err != nil {
+		t.Fatalf("write %s: %v", fileName, err)
+	}
+}
+
+func readIndexFile(t *testing.T, fileName string, offsetSize int) (entries []indexEntry) {
+	f, err := os.Open(fileName)
+	if err != nil {
+		t.Fatalf("open %s: %v", fileName, err)
+	}
+	defer f.Close()
+	err = WalkIndexFile(f, offsetSize, func(key types.NeedleId, offset types.Offset, size uint32) error {
+		entries = append(entries, indexEntry{key, offset.ToAcutalOffset(), size})
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("walk %s: %v", fileName, err)
+	}
+	return
+}
+
+func TestConvertIndexFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "convert")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fileName := dir + "/1.idx"
+
+	entries := []indexEntry{
+		{key: 1, offset: 8, size: 100},
+		{key: 2, offset: 4096, size: types.TombstoneFileSize},
+		{key: 3, offset: 0, size: 0},
+	}
+	writeIndexFile(t, fileName, 4, entries)
+
+	convertedFileName := dir + "/1.idx.converted"
+	if err := ConvertIndexFile(fileName, convertedFileName, 4, 5); err != nil {
+		t.Fatalf("convert to 5 bytes: %v", err)
+	}
+	if err := os.Rename(convertedFileName, fileName); err != nil {
+		t.Fatal(err)
+	}
+	if fi, _ := os.Stat(fileName); fi.Size() != int64(len(entries)*types.NeedleMapEntrySizeOf(5)) {
+		t.Errorf("unexpected converted size %d", fi.Size())
+	}
+	if converted := readIndexFile(t, fileName, 5); len(converted) != len(entries) {
+		t.Fatalf("converted %d entries, expecting %d", len(converted), len(entries))
+	} else {
+		for i := range entries {
+			if converted[i] != entries[i] {
+				t.Errorf("entry %d: %+v, expecting %+v", i, converted[i], entries[i])
+			}
+		}
+	}
+
+	if err := ConvertIndexFile(fileName, convertedFileName, 5, 4); err != nil {
+		t.Fatalf("convert back to 4 bytes: %v", err)
+	}
+	if converted := readIndexFile(t, convertedFileName, 4); len(converted) != len(entries) || converted[1] != entries[1] {
+		t.Errorf("unexpected converted entries %+v", converted)
+	}
+}
+
+func TestConvertIndexFileOverflow(t *testing.T) {
+	dir, err := ioutil.TempDir("", "convert")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fileName := dir + "/1.idx"
+
+	// beyond the 32GB of 4 bytes offsets
+	writeIndexFile(t, fileName, 5, []indexEntry{{key: 1, offset: 40 * 1024 * 1024 * 1024, size: 100}})
+
+	if err := ConvertIndexFile(fileName, fileName+".converted", 5, 4); err == nil {
+		t.Errorf("expecting the offset not to fit in 4 bytes")
+	}
+	if _, err := os.Stat(fileName + ".converted"); !os.IsNotExist(err) {
+		t.Errorf("the partially converted file is left: %v", err)
+	}
+	if converted := readIndexFile(t, fileName, 5); len(converted) != 1 || converted[0].offset != 40*1024*1024*1024 {
+		t.Errorf("the index file should be unchanged: %+v", converted)
+	}
+}
@@ -0,0 +1,201 @@
+package idx
+
+import (
+	"bufio"
+	"container/heap"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/needle_map"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+)
+
+// SortBatchEntries is the number of index entries sorted in memory at a time
+var SortBatchEntries = 1024 * 1024
+
+// EntryReader reads index entries in ascending order of the keys, and returns io.EOF after the last one
+type EntryReader interface {
+	Next() (needle_map.NeedleValue, error)
+}
+
+// SortIndexFile writes the live needles of the index file to w, in ascending order of the keys.
+// The last entry of a key wins, and the deleted needles are left out.
+// The entries are sorted in batches of SortBatchEntries into run files named after runFileName, which are merged at last,
+// so that the memory used is bounded by the batch size instead of the number of needles.
+func SortIndexFile(indexFile *os.File, offsetSize int, w io.Writer, runFileName string) error {
+
+	var runs []EntryReader
+	var runFiles []*os.File
+	defer func() {
+		for _, f := range runFiles {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+
+	var batch []needle_map.NeedleValue
+	writeRun := func() error {
+		batch = sortEntries(batch)
+		f, err := os.OpenFile(fmt.Sprintf("%s.%d", runFileName, len(runFiles)), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+		if err != nil {
+			return err
+		}
+		runFiles = append(runFiles, f)
+		writer := bufio.NewWriter(f)
+		for _, nv := range batch {
+			if _, err = writer.Write(nv.ToBytes(offsetSize)); err != nil {
+				return err
+			}
+		}
+		if err = writer.Flush(); err != nil {
+			return err
+		}
+		if _, err = f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		runs = append(runs, NewEntryReader(f, offsetSize))
+		batch = batch[:0]
+		return nil
+	}
+
+	err := WalkIndexFile(indexFile, offsetSize, func(key types.NeedleId, offset types.Offset, size uint32) error {
+		batch = append(batch, needle_map.NeedleValue{Key: key, Offset: offset, Size: size})
+		if len(batch) >= SortBatchEntries {
+			return writeRun()
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("sort %s: %v", indexFile.Name(), err)
+	}
+
+	// the last batch is merged from memory
+	runs = append(runs, NewEntrySliceReader(sortEntries(batch)))
+
+	return MergeSortedEntries(w, offsetSize, runs...)
+}
+
+// sortEntries sorts the entries by key, keeping only the last entry of each key
+func sortEntries(entries []needle_map.NeedleValue) []needle_map.NeedleValue {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+	deduped := entries[:0]
+	for i, nv := range entries {
+		if i+1 < len(entries) && entries[i+1].Key == nv.Key {
+			continue
+		}
+		deduped = append(deduped, nv)
+	}
+	return deduped
+}
+
+// MergeSortedEntries writes the live needles of the sorted runs to w, in ascending order of the keys.
+// For the same key, the entry of the later run wins, and the deleted needles are left out.
+func MergeSortedEntries(w io.Writer, offsetSize int, runs ...EntryReader) error {
+
+	h := &entryHeap{}
+	for i, run := range runs {
+		if err := h.pushNext(run, i); err != nil {
+			return err
+		}
+	}
+
+	writer := bufio.NewWriter(w)
+	for h.Len() > 0 {
+		top := heap.Pop(h).(entryHeapItem)
+		if err := h.pushNext(runs[top.run], top.run); err != nil {
+			return err
+		}
+		// the older entries of the same key are skipped
+		for h.Len() > 0 && (*h)[0].Key == top.Key {
+			older := heap.Pop(h).(entryHeapItem)
+			if err := h.pushNext(runs[older.run], older.run); err != nil {
+				return err
+			}
+		}
+		if top.Offset.IsZero() || top.Size == types.TombstoneFileSize {
+			continue
+		}
+		if _, err := writer.Write(top.ToBytes(offsetSize)); err != nil {
+			return err
+		}
+	}
+	return writer.Flush()
+}
+
+type entryHeapItem struct {
+	needle_map.NeedleValue
+	run int
+}
+
+// entryHeap pops the smallest key first, and for the same key, the entry of the latest run first
+type entryHeap []entryHeapItem
+
+func (h entryHeap) Len() int { return len(h) }
+func (h entryHeap) Less(i, j int) bool {
+	if h[i].Key != h[j].Key {
+		return h[i].Key < h[j].Key
+	}
+	return h[i].run > h[j].run
+}
+func (h entryHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *entryHeap) Push(x interface{}) { *h = append(*h, x.(entryHeapItem)) }
+func (h *entryHeap) Pop() interface{} {
+	old := *h
+	item := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return item
+}
+
+func (h *entryHeap) pushNext(run EntryReader, i int) error {
+	nv, err := run.Next()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	heap.Push(h, entryHeapItem{NeedleValue: nv, run: i})
+	return nil
+}
+
+type entryReader struct {
+	reader *bufio.Reader
+	bytes  []byte
+}
+
+// NewEntryReader reads the entries with offsets of offsetSize bytes from a sorted index file
+func NewEntryReader(r io.Reader, offsetSize int) EntryReader {
+	return &entryReader{
+		reader: bufio.NewReader(r),
+		bytes:  make([]byte, types.NeedleMapEntrySizeOf(offsetSize)),
+	}
+}
+
+func (r *entryReader) Next() (nv needle_map.NeedleValue, err error) {
+	if _, err = io.ReadFull(r.reader, r.bytes); err != nil {
+		return
+	}
+	nv.Key, nv.Offset, nv.Size = IdxFileEntry(r.bytes)
+	return
+}
+
+type entrySliceReader struct {
+	entries []needle_map.NeedleValue
+}
+
+// NewEntrySliceReader reads the entries, which are already sorted by key
+func NewEntrySliceReader(entries []needle_map.NeedleValue) EntryReader {
+	return &entrySliceReader{entries: entries}
+}
+
+func (r *entrySliceReader) Next() (nv needle_map.NeedleValue, err error) {
+	if len(r.entries) == 0 {
+		return nv, io.EOF
+	}
+	nv, r.entries = r.entries[0], r.entries[1:]
+	return nv, nil
+}
@@ -0,0 +1,66 @@
+package idx
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+)
+
+func TestSortIndexFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sort")
+	if err != nil {
+		t.Fatalf("temp dir creation: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// several runs, with the later entries of a key in later runs, and within the same run
+	defer func(batchEntries int) { SortBatchEntries = batchEntries }(SortBatchEntries)
+	SortBatchEntries = 3
+
+	indexFileName := path.Join(dir, "1.idx")
+	writeIndexFile(t, indexFileName, types.OffsetSize, []indexEntry{
+		{5, 8, 50},
+		{3, 16, 30},
+		{9, 24, 90},
+		{1, 32, 10},
+		{3, 40, 31},
+		{3, 48, 32},
+		{9, 56, types.TombstoneFileSize},
+		{7, 0, 70},
+		{5, 64, 51},
+		{2, 72, 20},
+	})
+
+	indexFile, err := os.Open(indexFileName)
+	if err != nil {
+		t.Fatalf("open %s: %v", indexFileName, err)
+	}
+	defer indexFile.Close()
+
+	sortedFileName := path.Join(dir, "1.sdx")
+	sortedFile, err := os.Create(sortedFileName)
+	if err != nil {
+		t.Fatalf("create %s: %v", sortedFileName, err)
+	}
+	if err = SortIndexFile(indexFile, types.OffsetSize, sortedFile, sortedFileName+".run"); err != nil {
+		t.Fatalf("sort: %v", err)
+	}
+	sortedFile.Close()
+
+	expected := []indexEntry{
+		{1, 32, 10},
+		{2, 72, 20},
+		{3, 48, 32},
+		{5, 64, 51},
+	}
+	if entries := readIndexFile(t, sortedFileName, types.OffsetSize); !reflect.DeepEqual(entries, expected) {
+		t.Errorf("sorted %+v, expecting %+v", entries, expected)
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 2 {
+		t.Errorf("run files are left: %d files", len(files))
+	}
+}
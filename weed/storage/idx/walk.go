@@ -9,11 +9,12 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
-// walks through the index file, calls fn function with each key, offset, size
+// walks through the index file with offsets of offsetSize bytes, calls fn function with each key, offset, size
 // stops with the error returned by the fn function
-func WalkIndexFile(r *os.File, fn func(key types.NeedleId, offset types.Offset, size uint32) error) error {
+func WalkIndexFile(r *os.File, offsetSize int, fn func(key types.NeedleId, offset types.Offset, size uint32) error) error {
 	var readerOffset int64
-	bytes := make([]byte, types.NeedleMapEntrySize*RowsToRead)
+	entrySize := types.NeedleMapEntrySizeOf(offsetSize)
+	bytes := make([]byte, entrySize*RowsToRead)
 	count, e := r.ReadAt(bytes, readerOffset)
 	glog.V(3).Infoln("file", r.Name(), "readerOffset", readerOffset, "count", count, "e", e)
 	readerOffset += int64(count)
@@ -25,8 +26,8 @@ func WalkIndexFile(r *os.File, fn func(key types.NeedleId, offset types.Offset,
 	)
 
 	for count > 0 && e == nil || e == io.EOF {
-		for i = 0; i+types.NeedleMapEntrySize <= count; i += types.NeedleMapEntrySize {
-			key, offset, size = IdxFileEntry(bytes[i : i+types.NeedleMapEntrySize])
+		for i = 0; i+entrySize <= count; i += entrySize {
+			key, offset, size = IdxFileEntry(bytes[i : i+entrySize])
 			if e = fn(key, offset, size); e != nil {
 				return e
 			}
@@ -41,10 +42,12 @@ func WalkIndexFile(r *os.File, fn func(key types.NeedleId, offset types.Offset,
 	return e
 }
 
+// IdxFileEntry parses an index entry, whose offset size is told by the length of the entry
 func IdxFileEntry(bytes []byte) (key types.NeedleId, offset types.Offset, size uint32) {
+	offsetSize := len(bytes) - types.NeedleIdSize - types.SizeSize
 	key = types.BytesToNeedleId(bytes[:types.NeedleIdSize])
-	offset = types.BytesToOffset(bytes[types.NeedleIdSize : types.NeedleIdSize+types.OffsetSize])
-	size = util.BytesToUint32(bytes[types.NeedleIdSize+types.OffsetSize : types.NeedleIdSize+types.OffsetSize+types.SizeSize])
+	offset = types.BytesToOffset(bytes[types.NeedleIdSize : types.NeedleIdSize+offsetSize])
+	size = util.BytesToUint32(bytes[types.NeedleIdSize+offsetSize : types.NeedleIdSize+offsetSize+types.SizeSize])
 	return
 }
 
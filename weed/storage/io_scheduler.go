@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/chrislusf/seaweedfs/weed/stats"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// IoCategory groups the background jobs sharing one bandwidth budget
+type IoCategory string
+
+const (
+	IoCategoryCompaction IoCategory = "compaction"
+	IoCategoryCopy       IoCategory = "copy" // volume copy, and tailing the volume updates
+	IoCategoryEc         IoCategory = "ec"   // ec shards generation, rebuild and copy
+	IoCategoryTier       IoCategory = "tier"
+)
+
+var IoCategories = []IoCategory{IoCategoryCompaction, IoCategoryCopy, IoCategoryEc, IoCategoryTier}
+
+// IoScheduler limits the bandwidth of the background jobs, so that they do not starve the foreground reads and writes.
+// All concurrent jobs of the same category share the bandwidth budget of the category.
+type IoScheduler struct {
+	limiters map[IoCategory]*util.BandwidthLimiter
+}
+
+// NewIoScheduler creates the scheduler with the bandwidth budgets, and the missing categories are unlimited.
+func NewIoScheduler(bytesPerSecond map[IoCategory]int64) *IoScheduler {
+	s := &IoScheduler{
+		limiters: make(map[IoCategory]*util.BandwidthLimiter),
+	}
+	for _, category := range IoCategories {
+		s.limiters[category] = util.NewBandwidthLimiter(bytesPerSecond[category])
+		stats.VolumeServerIoLimitGauge.WithLabelValues(string(category)).Set(float64(bytesPerSecond[category]))
+	}
+	return s
+}
+
+func (s *IoScheduler) SetBytesPerSecond(category IoCategory, bytesPerSecond int64) error {
+	limiter, found := s.limiters[category]
+	if !found {
+		return fmt.Errorf("unknown io category %s", category)
+	}
+	if bytesPerSecond < 0 {
+		return fmt.Errorf("negative io limit %d for %s", bytesPerSecond, category)
+	}
+	limiter.SetBytesPerSecond(bytesPerSecond)
+	stats.VolumeServerIoLimitGauge.WithLabelValues(string(category)).Set(float64(bytesPerSecond))
+	return nil
+}
+
+func (s *IoScheduler) BytesPerSecond(category IoCategory) int64 {
+	if limiter, found := s.limiters[category]; found {
+		return limiter.BytesPerSecond()
+	}
+	return 0
+}
+
+// Throttler accounts the bytes processed by a background job to its category
+func (s *IoScheduler) Throttler(category IoCategory) util.Throttler {
+	return &ioThrottler{
+		category: string(category),
+		limiter:  s.limiters[category],
+	}
+}
+
+type ioThrottler struct {
+	category string
+	limiter  *util.BandwidthLimiter
+}
+
+func (t *ioThrottler) MaybeSlowdown(delta int64) {
+	stats.VolumeServerIoBytesCounter.WithLabelValues(t.category).Add(float64(delta))
+	if sleepTime := t.limiter.Wait(delta); sleepTime > 0 {
+		stats.VolumeServerIoThrottledSecondsCounter.WithLabelValues(t.category).Add(sleepTime.Seconds())
+	}
+}
@@ -30,12 +30,38 @@ func (n *Needle) Etag() string {
 	return fmt.Sprintf("%x", bits)
 }
 
+// MD5 is the hex md5 of the uncompressed content
 func (n *Needle) MD5() string {
 
-	hash := md5.New()
+	d, err := n.ContentDigests(false)
+	if err != nil {
+		return fmt.Sprintf("%x", md5.Sum(n.Data))
+	}
 
-	hash.Write(n.Data)
+	return fmt.Sprintf("%x", d.Md5)
 
-	return fmt.Sprintf("%x", hash.Sum(nil))
+}
+
+// ContentDigests computes the md5, and optionally the sha256, of the uncompressed content
+func (n *Needle) ContentDigests(withSha256 bool) (util.ContentDigests, error) {
+	content := n.Data
+	if compression := n.Compression(); compression != "" {
+		var err error
+		if content, err = util.DecompressData(compression, n.Data); err != nil {
+			return util.ContentDigests{}, fmt.Errorf("decompress %s: %v", n.String(), err)
+		}
+	}
+	return util.ComputeDigests(content, withSha256), nil
+}
 
+// VerifyContent checks the content against the digests sent by the client, and returns the md5 of the content
+func (n *Needle) VerifyContent(expected util.ContentDigests) (contentMd5 []byte, err error) {
+	actual, err := n.ContentDigests(expected.Sha256 != nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = expected.Verify(actual); err != nil {
+		return nil, err
+	}
+	return actual.Md5, nil
 }
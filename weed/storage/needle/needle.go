@@ -12,6 +12,7 @@ import (
 
 	"github.com/chrislusf/seaweedfs/weed/images"
 	. "github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
 const (
@@ -51,7 +52,7 @@ func (n *Needle) String() (str string) {
 }
 
 func ParseUpload(r *http.Request) (
-	fileName string, data []byte, mimeType string, pairMap map[string]string, isGzipped bool, originalDataSize int,
+	fileName string, data []byte, mimeType string, pairMap map[string]string, compression string, originalDataSize int,
 	modifiedTime uint64, ttl *TTL, isChunkedFile bool, e error) {
 	pairMap = make(map[string]string)
 	for k, v := range r.Header {
@@ -61,9 +62,9 @@ func ParseUpload(r *http.Request) (
 	}
 
 	if r.Method == "POST" {
-		fileName, data, mimeType, isGzipped, originalDataSize, isChunkedFile, e = parseMultipart(r)
+		fileName, data, mimeType, compression, originalDataSize, isChunkedFile, e = parseMultipart(r)
 	} else {
-		isGzipped = false
+		compression = ""
 		mimeType = r.Header.Get("Content-Type")
 		fileName = ""
 		data, e = ioutil.ReadAll(r.Body)
@@ -78,14 +79,52 @@ func ParseUpload(r *http.Request) (
 
 	return
 }
-func CreateNeedleFromRequest(r *http.Request, fixJpgOrientation bool) (n *Needle, originalSize int, e error) {
-	var pairMap map[string]string
-	fname, mimeType, isGzipped, isChunkedFile := "", "", false, false
-	n = new(Needle)
-	fname, n.Data, mimeType, pairMap, isGzipped, originalSize, n.LastModified, n.Ttl, isChunkedFile, e = ParseUpload(r)
+
+// CreateNeedleFromRequest also verifies the content against the Content-MD5 and x-amz-checksum-sha256 headers,
+// and returns the md5 of the uncompressed content.
+func CreateNeedleFromRequest(r *http.Request, fixJpgOrientation bool) (n *Needle, originalSize int, contentMd5 []byte, e error) {
+	expectedDigests, e := util.ParseContentDigests(r.Header)
+	if e != nil {
+		n = new(Needle)
+		return
+	}
+	fname, data, mimeType, pairMap, compression, originalSize, lastModified, ttl, isChunkedFile, e := ParseUpload(r)
 	if e != nil {
+		n = new(Needle)
+		return
+	}
+	trimmedPairMap := make(map[string]string)
+	for k, v := range pairMap {
+		trimmedPairMap[k[len(PairNamePrefix):]] = v
+	}
+	// the content with a digest is stored as is
+	fixJpgOrientation = fixJpgOrientation && expectedDigests.Md5 == nil && expectedDigests.Sha256 == nil
+	n = NewUploadedNeedle(fname, data, mimeType, trimmedPairMap, compression, lastModified, ttl, isChunkedFile, fixJpgOrientation)
+
+	if contentMd5, e = n.VerifyContent(expectedDigests); e != nil {
 		return
 	}
+
+	commaSep := strings.LastIndex(r.URL.Path, ",")
+	dotSep := strings.LastIndex(r.URL.Path, ".")
+	fid := r.URL.Path[commaSep+1:]
+	if dotSep > 0 {
+		fid = r.URL.Path[commaSep+1 : dotSep]
+	}
+
+	e = n.ParsePath(fid)
+
+	return
+}
+
+// NewUploadedNeedle creates a needle from the uploaded data and attributes, without the needle id and cookie.
+// The pair names should not have the PairNamePrefix.
+func NewUploadedNeedle(fname string, data []byte, mimeType string, pairMap map[string]string, compression string,
+	lastModified uint64, ttl *TTL, isChunkedFile bool, fixJpgOrientation bool) (n *Needle) {
+	n = new(Needle)
+	n.Data = data
+	n.LastModified = lastModified
+	n.Ttl = ttl
 	if len(fname) < 256 {
 		n.Name = []byte(fname)
 		n.SetHasName()
@@ -95,21 +134,14 @@ func CreateNeedleFromRequest(r *http.Request, fixJpgOrientation bool) (n *Needle
 		n.SetHasMime()
 	}
 	if len(pairMap) != 0 {
-		trimmedPairMap := make(map[string]string)
-		for k, v := range pairMap {
-			trimmedPairMap[k[len(PairNamePrefix):]] = v
-		}
-
-		pairs, _ := json.Marshal(trimmedPairMap)
+		pairs, _ := json.Marshal(pairMap)
 		if len(pairs) < 65536 {
 			n.Pairs = pairs
 			n.PairsSize = uint16(len(pairs))
 			n.SetHasPairs()
 		}
 	}
-	if isGzipped {
-		n.SetGzipped()
-	}
+	n.SetCompression(compression)
 	if n.LastModified == 0 {
 		n.LastModified = uint64(time.Now().Unix())
 	}
@@ -131,17 +163,9 @@ func CreateNeedleFromRequest(r *http.Request, fixJpgOrientation bool) (n *Needle
 
 	n.Checksum = NewCRC(n.Data)
 
-	commaSep := strings.LastIndex(r.URL.Path, ",")
-	dotSep := strings.LastIndex(r.URL.Path, ".")
-	fid := r.URL.Path[commaSep+1:]
-	if dotSep > 0 {
-		fid = r.URL.Path[commaSep+1 : dotSep]
-	}
-
-	e = n.ParsePath(fid)
-
 	return
 }
+
 func (n *Needle) ParsePath(fid string) (err error) {
 	length := len(fid)
 	if length <= CookieSize*2 {
@@ -14,7 +14,7 @@ import (
 )
 
 func parseMultipart(r *http.Request) (
-	fileName string, data []byte, mimeType string, isGzipped bool, originalDataSize int, isChunkedFile bool, e error) {
+	fileName string, data []byte, mimeType string, compression string, originalDataSize int, isChunkedFile bool, e error) {
 	defer func() {
 		if e != nil && r.Body != nil {
 			io.Copy(ioutil.Discard, r.Body)
@@ -77,30 +77,38 @@ func parseMultipart(r *http.Request) (
 	isChunkedFile, _ = strconv.ParseBool(r.FormValue("cm"))
 
 	if !isChunkedFile {
+		mimeType, data, compression, originalDataSize = PrepareUploadedData(fileName, part.Header.Get("Content-Type"), part.Header.Get("Content-Encoding"), data)
+	}
 
-		dotIndex := strings.LastIndex(fileName, ".")
-		ext, mtype := "", ""
-		if dotIndex > 0 {
-			ext = strings.ToLower(fileName[dotIndex:])
-			mtype = mime.TypeByExtension(ext)
-		}
-		contentType := part.Header.Get("Content-Type")
-		if contentType != "" && mtype != contentType {
-			mimeType = contentType //only return mime type if not deductable
-			mtype = contentType
-		}
+	return
+}
 
-		if part.Header.Get("Content-Encoding") == "gzip" {
-			if unzipped, e := util.UnGzipData(data); e == nil {
-				originalDataSize = len(unzipped)
-			}
-			isGzipped = true
-		} else if util.IsGzippable(ext, mtype, data) {
-			if compressedData, err := util.GzipData(data); err == nil {
-				if len(data) > len(compressedData) {
-					data = compressedData
-					isGzipped = true
-				}
+// PrepareUploadedData only keeps the mime type if it can not be deduced from the file name,
+// and compresses the data if it is not compressed yet and is worth compressing.
+func PrepareUploadedData(fileName string, contentType string, contentEncoding string, data []byte) (mimeType string, preparedData []byte, compression string, originalDataSize int) {
+	preparedData, originalDataSize = data, len(data)
+
+	dotIndex := strings.LastIndex(fileName, ".")
+	ext, mtype := "", ""
+	if dotIndex > 0 {
+		ext = strings.ToLower(fileName[dotIndex:])
+		mtype = mime.TypeByExtension(ext)
+	}
+	if contentType != "" && mtype != contentType {
+		mimeType = contentType //only return mime type if not deductable
+		mtype = contentType
+	}
+
+	if util.IsCompressionSupported(contentEncoding) {
+		if uncompressed, e := util.DecompressData(contentEncoding, data); e == nil {
+			originalDataSize = len(uncompressed)
+		}
+		compression = contentEncoding
+	} else if util.IsGzippable(ext, mtype, data) {
+		if compressedData, err := util.CompressData(util.DefaultCompression, data); err == nil {
+			if len(data) > len(compressedData) {
+				preparedData = compressedData
+				compression = util.DefaultCompression
 			}
 		}
 	}
@@ -19,6 +19,7 @@ const (
 	FlagHasLastModifiedDate = 0x08
 	FlagHasTtl              = 0x10
 	FlagHasPairs            = 0x20
+	FlagZstd                = 0x40
 	FlagIsChunkManifest     = 0x80
 	LastModifiedBytesLength = 5
 	TtlBytesLength          = 2
@@ -345,6 +346,31 @@ func (n *Needle) IsGzipped() bool {
 func (n *Needle) SetGzipped() {
 	n.Flags = n.Flags | FlagGzip
 }
+func (n *Needle) IsZstd() bool {
+	return n.Flags&FlagZstd > 0
+}
+func (n *Needle) SetZstd() {
+	n.Flags = n.Flags | FlagZstd
+}
+
+// Compression returns the codec of the compressed data, or "" if the data is not compressed
+func (n *Needle) Compression() string {
+	switch {
+	case n.IsGzipped():
+		return util.GzipCompression
+	case n.IsZstd():
+		return util.ZstdCompression
+	}
+	return ""
+}
+func (n *Needle) SetCompression(codec string) {
+	switch codec {
+	case util.GzipCompression:
+		n.SetGzipped()
+	case util.ZstdCompression:
+		n.SetZstd()
+	}
+}
 func (n *Needle) HasName() bool {
 	return n.Flags&FlagHasName > 0
 }
@@ -16,6 +16,7 @@ const (
 	NeedleMapLevelDb                     // small memory footprint, 4MB total, 1 write buffer, 3 block buffer
 	NeedleMapLevelDbMedium               // medium memory footprint, 8MB total, 3 write buffer, 5 block buffer
 	NeedleMapLevelDbLarge                // large memory footprint, 12MB total, 4write buffer, 8 block buffer
+	NeedleMapSortedFile                  // binary search in the sorted .sdx file, with an in-memory delta of the changes
 )
 
 type NeedleMapper interface {
@@ -30,6 +31,7 @@ type NeedleMapper interface {
 	DeletedCount() int
 	MaxFileKey() NeedleId
 	IndexFileSize() uint64
+	Sync() error
 }
 
 type baseNeedleMapper struct {
@@ -37,6 +39,8 @@ type baseNeedleMapper struct {
 
 	indexFile           *os.File
 	indexFileAccessLock sync.Mutex
+	// of the offsets in the index file
+	offsetSize int
 }
 
 func (nm *baseNeedleMapper) IndexFileSize() uint64 {
@@ -47,8 +51,13 @@ func (nm *baseNeedleMapper) IndexFileSize() uint64 {
 	return 0
 }
 
+// Sync flushes the index file to the disk. The other needle map files can be rebuilt from the index file.
+func (nm *baseNeedleMapper) Sync() error {
+	return nm.indexFile.Sync()
+}
+
 func (nm *baseNeedleMapper) appendToIndexFile(key NeedleId, offset Offset, size uint32) error {
-	bytes := needle_map.ToBytes(key, offset, size)
+	bytes := needle_map.ToBytes(key, offset, size, nm.offsetSize)
 
 	nm.indexFileAccessLock.Lock()
 	defer nm.indexFileAccessLock.Unlock()
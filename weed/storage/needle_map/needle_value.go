@@ -17,14 +17,15 @@ func (this NeedleValue) Less(than btree.Item) bool {
 	return this.Key < that.Key
 }
 
-func (nv NeedleValue) ToBytes() []byte {
-	return ToBytes(nv.Key, nv.Offset, nv.Size)
+func (nv NeedleValue) ToBytes(offsetSize int) []byte {
+	return ToBytes(nv.Key, nv.Offset, nv.Size, offsetSize)
 }
 
-func ToBytes(key NeedleId, offset Offset, size uint32) []byte {
-	bytes := make([]byte, NeedleIdSize+OffsetSize+SizeSize)
+// ToBytes encodes an index entry with the offset in offsetSize bytes
+func ToBytes(key NeedleId, offset Offset, size uint32, offsetSize int) []byte {
+	bytes := make([]byte, NeedleMapEntrySizeOf(offsetSize))
 	NeedleIdToBytes(bytes[0:NeedleIdSize], key)
-	OffsetToBytes(bytes[NeedleIdSize:NeedleIdSize+OffsetSize], offset)
-	util.Uint32toBytes(bytes[NeedleIdSize+OffsetSize:NeedleIdSize+OffsetSize+SizeSize], size)
+	OffsetToBytes(bytes[NeedleIdSize:NeedleIdSize+offsetSize], offset)
+	util.Uint32toBytes(bytes[NeedleIdSize+offsetSize:NeedleIdSize+offsetSize+SizeSize], size)
 	return bytes
 }
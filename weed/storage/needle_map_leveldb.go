@@ -21,12 +21,13 @@ type LevelDbNeedleMap struct {
 	db         *leveldb.DB
 }
 
-func NewLevelDbNeedleMap(dbFileName string, indexFile *os.File, opts *opt.Options) (m *LevelDbNeedleMap, err error) {
+func NewLevelDbNeedleMap(dbFileName string, indexFile *os.File, opts *opt.Options, offsetSize int) (m *LevelDbNeedleMap, err error) {
 	m = &LevelDbNeedleMap{dbFileName: dbFileName}
 	m.indexFile = indexFile
+	m.offsetSize = offsetSize
 	if !isLevelDbFresh(dbFileName, indexFile) {
 		glog.V(0).Infof("Start to Generate %s from %s", dbFileName, indexFile.Name())
-		generateLevelDbFile(dbFileName, indexFile)
+		generateLevelDbFile(dbFileName, indexFile, offsetSize)
 		glog.V(0).Infof("Finished Generating %s from %s", dbFileName, indexFile.Name())
 	}
 	glog.V(1).Infof("Opening %s...", dbFileName)
@@ -35,7 +36,7 @@ func NewLevelDbNeedleMap(dbFileName string, indexFile *os.File, opts *opt.Option
 		return
 	}
 	glog.V(1).Infof("Loading %s...", indexFile.Name())
-	mm, indexLoadError := newNeedleMapMetricFromIndexFile(indexFile)
+	mm, indexLoadError := newNeedleMapMetricFromIndexFile(indexFile, offsetSize)
 	if indexLoadError != nil {
 		return nil, indexLoadError
 	}
@@ -60,13 +61,13 @@ func isLevelDbFresh(dbFileName string, indexFile *os.File) bool {
 	return dbStat.ModTime().After(indexStat.ModTime())
 }
 
-func generateLevelDbFile(dbFileName string, indexFile *os.File) error {
+func generateLevelDbFile(dbFileName string, indexFile *os.File, offsetSize int) error {
 	db, err := leveldb.OpenFile(dbFileName, nil)
 	if err != nil {
 		return err
 	}
 	defer db.Close()
-	return idx.WalkIndexFile(indexFile, func(key NeedleId, offset Offset, size uint32) error {
+	return idx.WalkIndexFile(indexFile, offsetSize, func(key NeedleId, offset Offset, size uint32) error {
 		if !offset.IsZero() && size != TombstoneFileSize {
 			levelDbWrite(db, key, offset, size)
 		} else {
@@ -80,11 +81,13 @@ func (m *LevelDbNeedleMap) Get(key NeedleId) (element *needle_map.NeedleValue, o
 	bytes := make([]byte, NeedleIdSize)
 	NeedleIdToBytes(bytes[0:NeedleIdSize], key)
 	data, err := m.db.Get(bytes, nil)
-	if err != nil || len(data) != OffsetSize+SizeSize {
+	// the offsets are saved in 5 bytes, or in 4 bytes by the earlier versions
+	if err != nil || len(data) != 4+SizeSize && len(data) != MaxOffsetSize+SizeSize {
 		return nil, false
 	}
-	offset := BytesToOffset(data[0:OffsetSize])
-	size := util.BytesToUint32(data[OffsetSize : OffsetSize+SizeSize])
+	offsetSize := len(data) - SizeSize
+	offset := BytesToOffset(data[0:offsetSize])
+	size := util.BytesToUint32(data[offsetSize : offsetSize+SizeSize])
 	return &needle_map.NeedleValue{Key: key, Offset: offset, Size: size}, true
 }
 
@@ -103,9 +106,9 @@ func (m *LevelDbNeedleMap) Put(key NeedleId, offset Offset, size uint32) error {
 
 func levelDbWrite(db *leveldb.DB, key NeedleId, offset Offset, size uint32) error {
 
-	bytes := needle_map.ToBytes(key, offset, size)
+	bytes := needle_map.ToBytes(key, offset, size, MaxOffsetSize)
 
-	if err := db.Put(bytes[0:NeedleIdSize], bytes[NeedleIdSize:NeedleIdSize+OffsetSize+SizeSize], nil); err != nil {
+	if err := db.Put(bytes[0:NeedleIdSize], bytes[NeedleIdSize:NeedleIdSize+MaxOffsetSize+SizeSize], nil); err != nil {
 		return fmt.Errorf("failed to write leveldb: %v", err)
 	}
 	return nil
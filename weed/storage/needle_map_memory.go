@@ -14,34 +14,36 @@ type NeedleMap struct {
 	m needle_map.NeedleValueMap
 }
 
-func NewCompactNeedleMap(file *os.File) *NeedleMap {
+func NewCompactNeedleMap(file *os.File, offsetSize int) *NeedleMap {
 	nm := &NeedleMap{
 		m: needle_map.NewCompactMap(),
 	}
 	nm.indexFile = file
+	nm.offsetSize = offsetSize
 	return nm
 }
 
-func NewBtreeNeedleMap(file *os.File) *NeedleMap {
+func NewBtreeNeedleMap(file *os.File, offsetSize int) *NeedleMap {
 	nm := &NeedleMap{
 		m: needle_map.NewBtreeMap(),
 	}
 	nm.indexFile = file
+	nm.offsetSize = offsetSize
 	return nm
 }
 
-func LoadCompactNeedleMap(file *os.File) (*NeedleMap, error) {
-	nm := NewCompactNeedleMap(file)
+func LoadCompactNeedleMap(file *os.File, offsetSize int) (*NeedleMap, error) {
+	nm := NewCompactNeedleMap(file, offsetSize)
 	return doLoading(file, nm)
 }
 
-func LoadBtreeNeedleMap(file *os.File) (*NeedleMap, error) {
-	nm := NewBtreeNeedleMap(file)
+func LoadBtreeNeedleMap(file *os.File, offsetSize int) (*NeedleMap, error) {
+	nm := NewBtreeNeedleMap(file, offsetSize)
 	return doLoading(file, nm)
 }
 
 func doLoading(file *os.File, nm *NeedleMap) (*NeedleMap, error) {
-	e := idx.WalkIndexFile(file, func(key NeedleId, offset Offset, size uint32) error {
+	e := idx.WalkIndexFile(file, nm.offsetSize, func(key NeedleId, offset Offset, size uint32) error {
 		nm.MaybeSetMaxFileKey(key)
 		if !offset.IsZero() && size != TombstoneFileSize {
 			nm.FileCounter++
@@ -91,11 +91,11 @@ func (mm *mapMetric) MaybeSetMaxFileKey(key NeedleId) {
 	}
 }
 
-func newNeedleMapMetricFromIndexFile(r *os.File) (mm *mapMetric, err error) {
+func newNeedleMapMetricFromIndexFile(r *os.File, offsetSize int) (mm *mapMetric, err error) {
 	mm = &mapMetric{}
 	var bf *bloom.BloomFilter
 	buf := make([]byte, NeedleIdSize)
-	err = reverseWalkIndexFile(r, func(entryCount int64) {
+	err = reverseWalkIndexFile(r, offsetSize, func(entryCount int64) {
 		bf = bloom.NewWithEstimates(uint(entryCount), 0.001)
 	}, func(key NeedleId, offset Offset, size uint32) error {
 
@@ -121,22 +121,23 @@ func newNeedleMapMetricFromIndexFile(r *os.File) (mm *mapMetric, err error) {
 	return
 }
 
-func reverseWalkIndexFile(r *os.File, initFn func(entryCount int64), fn func(key NeedleId, offset Offset, size uint32) error) error {
+func reverseWalkIndexFile(r *os.File, offsetSize int, initFn func(entryCount int64), fn func(key NeedleId, offset Offset, size uint32) error) error {
 	fi, err := r.Stat()
 	if err != nil {
 		return fmt.Errorf("file %s stat error: %v", r.Name(), err)
 	}
 	fileSize := fi.Size()
-	if fileSize%NeedleMapEntrySize != 0 {
+	entrySize := int64(NeedleMapEntrySizeOf(offsetSize))
+	if fileSize%entrySize != 0 {
 		return fmt.Errorf("unexpected file %s size: %d", r.Name(), fileSize)
 	}
 
-	entryCount := fileSize / NeedleMapEntrySize
+	entryCount := fileSize / entrySize
 	initFn(entryCount)
 
 	batchSize := int64(1024 * 4)
 
-	bytes := make([]byte, NeedleMapEntrySize*batchSize)
+	bytes := make([]byte, entrySize*batchSize)
 	nextBatchSize := entryCount % batchSize
 	if nextBatchSize == 0 {
 		nextBatchSize = batchSize
@@ -144,13 +145,13 @@ func reverseWalkIndexFile(r *os.File, initFn func(entryCount int64), fn func(key
 	remainingCount := entryCount - nextBatchSize
 
 	for remainingCount >= 0 {
-		_, e := r.ReadAt(bytes[:NeedleMapEntrySize*nextBatchSize], NeedleMapEntrySize*remainingCount)
-		// glog.V(0).Infoln("file", r.Name(), "readerOffset", NeedleMapEntrySize*remainingCount, "count", count, "e", e)
+		_, e := r.ReadAt(bytes[:entrySize*nextBatchSize], entrySize*remainingCount)
+		// glog.V(0).Infoln("file", r.Name(), "readerOffset", entrySize*remainingCount, "count", count, "e", e)
 		if e != nil {
 			return e
 		}
 		for i := int(nextBatchSize) - 1; i >= 0; i-- {
-			key, offset, size := idx.IdxFileEntry(bytes[i*NeedleMapEntrySize : i*NeedleMapEntrySize+NeedleMapEntrySize])
+			key, offset, size := idx.IdxFileEntry(bytes[int64(i)*entrySize : int64(i)*entrySize+entrySize])
 			if e = fn(key, offset, size); e != nil {
 				return e
 			}
@@ -11,7 +11,7 @@ import (
 func TestFastLoadingNeedleMapMetrics(t *testing.T) {
 
 	idxFile, _ := ioutil.TempFile("", "tmp.idx")
-	nm := NewBtreeNeedleMap(idxFile)
+	nm := NewBtreeNeedleMap(idxFile, OffsetSize)
 
 	for i := 0; i < 10000; i++ {
 		nm.Put(Uint64ToNeedleId(uint64(i+1)), Uint32ToOffset(uint32(0)), uint32(1))
@@ -20,7 +20,7 @@ func TestFastLoadingNeedleMapMetrics(t *testing.T) {
 		}
 	}
 
-	mm, _ := newNeedleMapMetricFromIndexFile(idxFile)
+	mm, _ := newNeedleMapMetricFromIndexFile(idxFile, OffsetSize)
 
 	glog.V(0).Infof("FileCount expected %d actual %d", nm.FileCount(), mm.FileCount())
 	glog.V(0).Infof("DeletedSize expected %d actual %d", nm.DeletedSize(), mm.DeletedSize())
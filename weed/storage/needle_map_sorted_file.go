@@ -0,0 +1,322 @@
+package storage
+
+import (
+	"container/list"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/storage/erasure_coding"
+	"github.com/chrislusf/seaweedfs/weed/storage/idx"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle_map"
+	. "github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+const (
+	sortedIndexPageEntries = 256
+	sortedIndexCachedPages = 64
+)
+
+// sortedFileDeltaLimit is the number of changes kept in the delta, before it is merged into the .sdx file
+var sortedFileDeltaLimit = 64 * 1024
+
+// SortedFileNeedleMap looks up the needles by binary search in the .sdx file, which is the .idx file sorted by key,
+// instead of loading all the needles into memory.
+// The changes after the .sdx file is generated are kept in a small in-memory delta,
+// which is merged into the .sdx file in the background when it reaches sortedFileDeltaLimit changes,
+// and the .sdx file is generated again when the volume is loaded with a newer .idx file.
+// It suits read-only or full volumes, whose delta stays empty.
+type SortedFileNeedleMap struct {
+	baseNeedleMapper
+	sortedFileName string
+	lock           sync.RWMutex // guards the fields below, replaced when the delta is merged
+	sortedFile     *os.File
+	sorted         *sortedIndexPages
+	delta          *needle_map.CompactMap
+	deltaChanges   int
+	merging        *needle_map.CompactMap // the delta being merged into the .sdx file
+	isClosed       bool
+}
+
+func NewSortedFileNeedleMap(baseFileName string, indexFile *os.File, offsetSize int) (m *SortedFileNeedleMap, err error) {
+	m = &SortedFileNeedleMap{sortedFileName: baseFileName + ".sdx", delta: needle_map.NewCompactMap()}
+	m.indexFile = indexFile
+	m.offsetSize = offsetSize
+	if !isSortedFileFresh(m.sortedFileName, indexFile) {
+		glog.V(0).Infof("Start to Generate %s from %s", m.sortedFileName, indexFile.Name())
+		if err = erasure_coding.WriteSortedFileFromIdx(baseFileName, ".sdx"); err != nil {
+			return nil, err
+		}
+		glog.V(0).Infof("Finished Generating %s from %s", m.sortedFileName, indexFile.Name())
+	}
+	glog.V(1).Infof("Opening %s...", m.sortedFileName)
+
+	if m.sortedFile, m.sorted, err = openSortedFile(m.sortedFileName, offsetSize); err != nil {
+		return nil, err
+	}
+
+	mm, indexLoadError := newNeedleMapMetricFromIndexFile(indexFile, offsetSize)
+	if indexLoadError != nil {
+		m.sortedFile.Close()
+		return nil, indexLoadError
+	}
+	m.mapMetric = *mm
+	return
+}
+
+func openSortedFile(sortedFileName string, offsetSize int) (*os.File, *sortedIndexPages, error) {
+	sortedFile, err := os.OpenFile(sortedFileName, os.O_RDONLY, 0644)
+	if err != nil {
+		return nil, nil, err
+	}
+	fileSize, err := util.GetFileSize(sortedFile)
+	if err != nil {
+		sortedFile.Close()
+		return nil, nil, err
+	}
+	return sortedFile, newSortedIndexPages(sortedFile, fileSize, int64(NeedleMapEntrySizeOf(offsetSize))*sortedIndexPageEntries), nil
+}
+
+func isSortedFileFresh(sortedFileName string, indexFile *os.File) bool {
+	// the .sdx file is written after the index file, and the index file is changed afterwards only by new writes
+	sortedStat, sortedStatErr := os.Stat(sortedFileName)
+	if sortedStatErr != nil {
+		return false
+	}
+	indexStat, indexStatErr := indexFile.Stat()
+	if indexStatErr != nil {
+		glog.V(0).Infof("Can not stat file: %v", indexStatErr)
+		return false
+	}
+
+	return sortedStat.ModTime().After(indexStat.ModTime())
+}
+
+func (m *SortedFileNeedleMap) Get(key NeedleId) (element *needle_map.NeedleValue, ok bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	if element, ok = m.delta.Get(key); ok {
+		return
+	}
+	if m.merging != nil {
+		if element, ok = m.merging.Get(key); ok {
+			return
+		}
+	}
+	offset, size, err := erasure_coding.SearchNeedleFromSortedIndex(m.sorted, m.sorted.fileSize, m.offsetSize, key, nil)
+	if err != nil {
+		if err != erasure_coding.NotFoundError {
+			glog.V(0).Infof("search %d in %s: %v", key, m.sortedFileName, err)
+		}
+		return nil, false
+	}
+	return &needle_map.NeedleValue{Key: key, Offset: offset, Size: size}, true
+}
+
+func (m *SortedFileNeedleMap) Put(key NeedleId, offset Offset, size uint32) error {
+	var oldSize uint32
+	if oldNeedle, ok := m.Get(key); ok {
+		oldSize = oldNeedle.Size
+	}
+	m.logPut(key, oldSize, size)
+	// write to index file first
+	if err := m.appendToIndexFile(key, offset, size); err != nil {
+		return err
+	}
+	m.setDelta(key, offset, size)
+	return nil
+}
+
+func (m *SortedFileNeedleMap) Delete(key NeedleId, offset Offset) error {
+	if oldNeedle, ok := m.Get(key); ok && oldNeedle.Size != TombstoneFileSize {
+		m.logDelete(oldNeedle.Size)
+	}
+	// write to index file first
+	if err := m.appendToIndexFile(key, offset, TombstoneFileSize); err != nil {
+		return err
+	}
+	// kept as a tombstone, to hide the needle in the .sdx file
+	m.setDelta(key, offset, TombstoneFileSize)
+	return nil
+}
+
+func (m *SortedFileNeedleMap) setDelta(key NeedleId, offset Offset, size uint32) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.delta.Set(key, offset, size)
+	m.deltaChanges++
+	if m.deltaChanges < sortedFileDeltaLimit || m.merging != nil || m.isClosed {
+		return
+	}
+	m.merging, m.delta, m.deltaChanges = m.delta, needle_map.NewCompactMap(), 0
+	go m.mergeDelta(m.merging)
+}
+
+// mergeDelta writes a new .sdx file with the changes in the delta, and switches the lookups to it.
+// The new .sdx file is kept no newer than the .idx file, which has the changes after the delta,
+// so that it is generated again when the volume is loaded.
+func (m *SortedFileNeedleMap) mergeDelta(delta *needle_map.CompactMap) {
+	tmpFileName := m.sortedFileName + ".tmp"
+	defer os.Remove(tmpFileName)
+
+	err := m.writeMergedFile(tmpFileName, delta)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.isClosed {
+		return
+	}
+	if err == nil {
+		err = m.switchSortedFile(tmpFileName)
+	}
+	if err != nil {
+		glog.Errorf("merge delta into %s: %v", m.sortedFileName, err)
+		// keep the changes not overwritten since, to merge again later
+		delta.AscendingVisit(func(nv needle_map.NeedleValue) error {
+			if _, found := m.delta.Get(nv.Key); !found {
+				m.delta.Set(nv.Key, nv.Offset, nv.Size)
+				m.deltaChanges++
+			}
+			return nil
+		})
+	}
+	m.merging = nil
+}
+
+func (m *SortedFileNeedleMap) writeMergedFile(tmpFileName string, delta *needle_map.CompactMap) error {
+	var changes []needle_map.NeedleValue
+	delta.AscendingVisit(func(nv needle_map.NeedleValue) error {
+		changes = append(changes, nv)
+		return nil
+	})
+
+	sortedFile, err := os.OpenFile(m.sortedFileName, os.O_RDONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer sortedFile.Close()
+
+	tmpFile, err := os.OpenFile(tmpFileName, os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer tmpFile.Close()
+
+	if err = idx.MergeSortedEntries(tmpFile, m.offsetSize, idx.NewEntryReader(sortedFile, m.offsetSize), idx.NewEntrySliceReader(changes)); err != nil {
+		return err
+	}
+	return tmpFile.Sync()
+}
+
+func (m *SortedFileNeedleMap) switchSortedFile(tmpFileName string) error {
+	indexStat, err := m.indexFile.Stat()
+	if err != nil {
+		return err
+	}
+	if err = os.Chtimes(tmpFileName, indexStat.ModTime(), indexStat.ModTime()); err != nil {
+		return err
+	}
+	if err = os.Rename(tmpFileName, m.sortedFileName); err != nil {
+		return err
+	}
+	sortedFile, sorted, err := openSortedFile(m.sortedFileName, m.offsetSize)
+	if err != nil {
+		return err
+	}
+	m.sortedFile.Close()
+	m.sortedFile, m.sorted = sortedFile, sorted
+	return nil
+}
+
+func (m *SortedFileNeedleMap) Close() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.isClosed = true
+	m.indexFile.Close()
+	m.sortedFile.Close()
+}
+
+func (m *SortedFileNeedleMap) Destroy() error {
+	m.Close()
+	os.Remove(m.indexFile.Name())
+	return os.Remove(m.sortedFileName)
+}
+
+// sortedIndexPages reads the sorted index file through a small LRU cache of pages,
+// so that the first steps of the binary searches are served from memory.
+// The page size is a multiple of the entry size, so that an entry is always within one page.
+type sortedIndexPages struct {
+	file     *os.File
+	fileSize int64
+	pageSize int64
+	lock     sync.Mutex
+	pages    map[int64]*list.Element
+	lru      *list.List
+}
+
+type sortedIndexPage struct {
+	index int64
+	data  []byte
+}
+
+func newSortedIndexPages(file *os.File, fileSize int64, pageSize int64) *sortedIndexPages {
+	return &sortedIndexPages{
+		file:     file,
+		fileSize: fileSize,
+		pageSize: pageSize,
+		pages:    make(map[int64]*list.Element),
+		lru:      list.New(),
+	}
+}
+
+func (c *sortedIndexPages) ReadAt(p []byte, off int64) (n int, err error) {
+	for n < len(p) {
+		position := off + int64(n)
+		data, err := c.page(position / c.pageSize)
+		if err != nil {
+			return n, err
+		}
+		if position%c.pageSize >= int64(len(data)) {
+			return n, io.EOF
+		}
+		n += copy(p[n:], data[position%c.pageSize:])
+	}
+	return n, nil
+}
+
+func (c *sortedIndexPages) page(pageIndex int64) ([]byte, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if e, found := c.pages[pageIndex]; found {
+		c.lru.MoveToFront(e)
+		return e.Value.(*sortedIndexPage).data, nil
+	}
+
+	start := pageIndex * c.pageSize
+	size := c.pageSize
+	if start+size > c.fileSize {
+		size = c.fileSize - start
+	}
+	if size <= 0 {
+		return nil, io.EOF
+	}
+	data := make([]byte, size)
+	if _, err := c.file.ReadAt(data, start); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	c.pages[pageIndex] = c.lru.PushFront(&sortedIndexPage{index: pageIndex, data: data})
+	if c.lru.Len() > sortedIndexCachedPages {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.pages, oldest.Value.(*sortedIndexPage).index)
+	}
+	return data, nil
+}
@@ -0,0 +1,119 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	. "github.com/chrislusf/seaweedfs/weed/storage/types"
+)
+
+func TestSortedFileNeedleMap(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sorted")
+	if err != nil {
+		t.Fatalf("temp dir creation: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	baseFileName := path.Join(dir, "1")
+
+	indexFile, err := os.OpenFile(baseFileName+".idx", os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		t.Fatalf("create index file: %v", err)
+	}
+	nm := NewCompactNeedleMap(indexFile, OffsetSize)
+	// in descending order, across several pages of the sorted file
+	fileCount := 3 * sortedIndexPageEntries
+	for i := fileCount; i > 0; i-- {
+		nm.Put(NeedleId(i), ToOffset(int64(i)*NeedlePaddingSize), uint32(i))
+	}
+	nm.Delete(NeedleId(7), ToOffset(0))
+	nm.Close()
+
+	load := func() *SortedFileNeedleMap {
+		indexFile, err := os.OpenFile(baseFileName+".idx", os.O_RDWR, 0644)
+		if err != nil {
+			t.Fatalf("open index file: %v", err)
+		}
+		m, err := NewSortedFileNeedleMap(baseFileName, indexFile, OffsetSize)
+		if err != nil {
+			t.Fatalf("load sorted file: %v", err)
+		}
+		return m
+	}
+
+	m := load()
+	for _, i := range []int{1, 2, 255, 256, 257, 600, fileCount} {
+		nv, ok := m.Get(NeedleId(i))
+		if !ok || nv.Size != uint32(i) || nv.Offset.ToAcutalOffset() != int64(i)*NeedlePaddingSize {
+			t.Errorf("needle %d: %+v %v", i, nv, ok)
+		}
+	}
+	if nv, ok := m.Get(NeedleId(7)); ok && nv.Size != TombstoneFileSize {
+		t.Errorf("deleted needle 7 found: %+v", nv)
+	}
+	if _, ok := m.Get(NeedleId(fileCount + 1)); ok {
+		t.Errorf("unexpected needle %d", fileCount+1)
+	}
+	if m.FileCount() != fileCount-1 {
+		t.Errorf("file count %d, expecting %d", m.FileCount(), fileCount-1)
+	}
+
+	// the changes are in the delta
+	m.Put(NeedleId(fileCount+1), ToOffset(8), 1)
+	m.Delete(NeedleId(1), ToOffset(16))
+	if nv, ok := m.Get(NeedleId(fileCount + 1)); !ok || nv.Size != 1 {
+		t.Errorf("new needle: %+v %v", nv, ok)
+	}
+	if nv, ok := m.Get(NeedleId(1)); !ok || nv.Size != TombstoneFileSize {
+		t.Errorf("deleted needle 1: %+v %v", nv, ok)
+	}
+
+	// the delta is merged into the sorted file when it reaches the limit
+	defer func(limit int) { sortedFileDeltaLimit = limit }(sortedFileDeltaLimit)
+	sortedFileDeltaLimit = 3
+	m.Delete(NeedleId(2), ToOffset(24))
+	for i := 0; i < 100; i++ {
+		m.lock.RLock()
+		merged := m.merging == nil && m.deltaChanges == 0
+		m.lock.RUnlock()
+		if merged {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if nv, ok := m.delta.Get(NeedleId(fileCount + 1)); ok {
+		t.Errorf("new needle still in the delta: %+v", nv)
+	}
+	if nv, ok := m.Get(NeedleId(fileCount + 1)); !ok || nv.Size != 1 {
+		t.Errorf("new needle after merging: %+v %v", nv, ok)
+	}
+	for _, i := range []int{1, 2} {
+		if nv, ok := m.Get(NeedleId(i)); ok {
+			t.Errorf("deleted needle %d after merging: %+v", i, nv)
+		}
+	}
+	if nv, ok := m.Get(NeedleId(600)); !ok || nv.Size != 600 {
+		t.Errorf("needle 600 after merging: %+v %v", nv, ok)
+	}
+	if isSortedFileFresh(m.sortedFileName, m.indexFile) {
+		t.Errorf("merged sorted file is taken as fresh")
+	}
+	m.Close()
+
+	// the sorted file is generated again from the newer index file
+	past := time.Now().Add(-time.Hour)
+	os.Chtimes(baseFileName+".sdx", past, past)
+	m = load()
+	defer m.Close()
+	if nv, ok := m.Get(NeedleId(fileCount + 1)); !ok || nv.Size != 1 {
+		t.Errorf("new needle after reloading: %+v %v", nv, ok)
+	}
+	if nv, ok := m.Get(NeedleId(1)); ok && nv.Size != TombstoneFileSize {
+		t.Errorf("deleted needle 1 after reloading: %+v", nv)
+	}
+	if nv, ok := m.Get(NeedleId(2)); ok && nv.Size != TombstoneFileSize {
+		t.Errorf("deleted needle 2 after reloading: %+v", nv)
+	}
+}
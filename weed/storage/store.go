@@ -59,7 +59,7 @@ func NewStore(grpcDialOption grpc.DialOption, port int, ip, publicUrl string, di
 
 	return
 }
-func (s *Store) AddVolume(volumeId needle.VolumeId, collection string, needleMapKind NeedleMapType, replicaPlacement string, ttlString string, preallocate int64, MemoryMapMaxSizeMb uint32) error {
+func (s *Store) AddVolume(volumeId needle.VolumeId, collection string, needleMapKind NeedleMapType, replicaPlacement string, ttlString string, preallocate int64, MemoryMapMaxSizeMb uint32, durabilityString string) error {
 	rt, e := NewReplicaPlacementFromString(replicaPlacement)
 	if e != nil {
 		return e
@@ -68,7 +68,11 @@ func (s *Store) AddVolume(volumeId needle.VolumeId, collection string, needleMap
 	if e != nil {
 		return e
 	}
-	e = s.addVolume(volumeId, collection, needleMapKind, rt, ttl, preallocate, MemoryMapMaxSizeMb)
+	durability, e := ParseDurability(durabilityString)
+	if e != nil {
+		return e
+	}
+	e = s.addVolume(volumeId, collection, needleMapKind, rt, ttl, preallocate, MemoryMapMaxSizeMb, durability)
 	return e
 }
 func (s *Store) DeleteCollection(collection string) (e error) {
@@ -83,16 +87,23 @@ func (s *Store) DeleteCollection(collection string) (e error) {
 }
 
 func (s *Store) findVolume(vid needle.VolumeId) *Volume {
+	_, v := s.findVolumeLocation(vid)
+	return v
+}
+func (s *Store) findVolumeLocation(vid needle.VolumeId) (*DiskLocation, *Volume) {
 	for _, location := range s.Locations {
 		if v, found := location.FindVolume(vid); found {
-			return v
+			return location, v
 		}
 	}
-	return nil
+	return nil, nil
 }
 func (s *Store) FindFreeLocation() (ret *DiskLocation) {
 	max := 0
 	for _, location := range s.Locations {
+		if location.IsFailed() {
+			continue
+		}
 		currentFreeCount := location.MaxVolumeCount - location.VolumesLen()
 		if currentFreeCount > max {
 			max = currentFreeCount
@@ -101,14 +112,20 @@ func (s *Store) FindFreeLocation() (ret *DiskLocation) {
 	}
 	return ret
 }
-func (s *Store) addVolume(vid needle.VolumeId, collection string, needleMapKind NeedleMapType, replicaPlacement *ReplicaPlacement, ttl *needle.TTL, preallocate int64, memoryMapMaxSizeMb uint32) error {
+func (s *Store) addVolume(vid needle.VolumeId, collection string, needleMapKind NeedleMapType, replicaPlacement *ReplicaPlacement, ttl *needle.TTL, preallocate int64, memoryMapMaxSizeMb uint32, durability Durability) error {
 	if s.findVolume(vid) != nil {
 		return fmt.Errorf("Volume Id %d already exists!", vid)
 	}
 	if location := s.FindFreeLocation(); location != nil {
-		glog.V(0).Infof("In dir %s adds volume:%v collection:%s replicaPlacement:%v ttl:%v",
-			location.Directory, vid, collection, replicaPlacement, ttl)
+		glog.V(0).Infof("In dir %s adds volume:%v collection:%s replicaPlacement:%v ttl:%v durability:%v",
+			location.Directory, vid, collection, replicaPlacement, ttl, durability)
 		if volume, err := NewVolume(location.Directory, collection, vid, needleMapKind, replicaPlacement, ttl, preallocate, memoryMapMaxSizeMb); err == nil {
+			if durability.Mode != DurabilityNone {
+				if err = volume.SetDurability(durability); err != nil {
+					volume.Close()
+					return err
+				}
+			}
 			location.SetVolume(vid, volume)
 			glog.V(0).Infof("add volume %d", vid)
 			s.NewVolumesChan <- master_pb.VolumeShortInformationMessage{
@@ -143,6 +160,8 @@ func (s *Store) Status() []*VolumeInfo {
 				ReadOnly:         v.readOnly,
 				Ttl:              v.Ttl,
 				CompactRevision:  uint32(v.CompactionRevision),
+				Durability:       v.Durability().String(),
+				OffsetSize:       v.offsetSize,
 			}
 			stats = append(stats, s)
 		}
@@ -159,12 +178,27 @@ func (s *Store) SetRack(rack string) {
 	s.rack = rack
 }
 
+// CollectHeartbeat reports the disk statuses last checked by LoopCheckingDiskLocations,
+// so that a hanging disk does not hold up the heartbeat.
 func (s *Store) CollectHeartbeat() *master_pb.Heartbeat {
+
 	var volumeMessages []*master_pb.VolumeInformationMessage
+	var diskStatuses []*master_pb.DiskStatus
 	maxVolumeCount := 0
 	var maxFileKey NeedleId
 	collectionVolumeSize := make(map[string]uint64)
 	for _, location := range s.Locations {
+		diskStatuses = append(diskStatuses, location.ToDiskStatus())
+		if location.IsFailed() {
+			// report the read only volumes left on the failed disk, without any free slots
+			location.RLock()
+			for _, v := range location.volumes {
+				volumeMessages = append(volumeMessages, v.ToVolumeInformationMessage())
+			}
+			maxVolumeCount = maxVolumeCount + len(location.volumes)
+			location.RUnlock()
+			continue
+		}
 		var deleteVids []needle.VolumeId
 		maxVolumeCount = maxVolumeCount + location.MaxVolumeCount
 		location.RLock()
@@ -211,6 +245,7 @@ func (s *Store) CollectHeartbeat() *master_pb.Heartbeat {
 		Rack:           s.rack,
 		Volumes:        volumeMessages,
 		HasNoVolumes:   len(volumeMessages) == 0,
+		DiskStatuses:   diskStatuses,
 	}
 
 }
@@ -221,14 +256,21 @@ func (s *Store) Close() {
 	}
 }
 
-func (s *Store) WriteVolumeNeedle(i needle.VolumeId, n *needle.Needle) (size uint32, isUnchanged bool, err error) {
-	if v := s.findVolume(i); v != nil {
+// WriteVolumeNeedle writes the needle, and waits until it is durable,
+// with the stronger one of the requested durability and the volume's durability.
+func (s *Store) WriteVolumeNeedle(i needle.VolumeId, n *needle.Needle, durability Durability) (size uint32, isUnchanged bool, err error) {
+	if location, v := s.findVolumeLocation(i); v != nil {
 		if v.readOnly {
 			err = fmt.Errorf("volume %d is read only", i)
 			return
 		}
-		if MaxPossibleVolumeSize >= v.ContentSize()+uint64(needle.GetActualSize(size, v.version)) {
-			_, size, isUnchanged, err = v.writeNeedle(n)
+		if MaxPossibleVolumeSizeOf(v.offsetSize) >= v.ContentSize()+uint64(needle.GetActualSize(size, v.version)) {
+			if _, size, isUnchanged, err = v.writeNeedle(n); err == nil {
+				err = v.waitForDurability(durability)
+			}
+			if err != nil {
+				s.checkDiskLocationAfterError(location, err)
+			}
 		} else {
 			err = fmt.Errorf("volume size limit %d exceeded! current size is %d", s.GetVolumeSizeLimit(), v.ContentSize())
 		}
@@ -240,12 +282,19 @@ func (s *Store) WriteVolumeNeedle(i needle.VolumeId, n *needle.Needle) (size uin
 }
 
 func (s *Store) DeleteVolumeNeedle(i needle.VolumeId, n *needle.Needle) (uint32, error) {
-	if v := s.findVolume(i); v != nil {
+	if location, v := s.findVolumeLocation(i); v != nil {
 		if v.readOnly {
 			return 0, fmt.Errorf("volume %d is read only", i)
 		}
-		if MaxPossibleVolumeSize >= v.ContentSize()+uint64(needle.GetActualSize(0, v.version)) {
-			return v.deleteNeedle(n)
+		if MaxPossibleVolumeSizeOf(v.offsetSize) >= v.ContentSize()+uint64(needle.GetActualSize(0, v.version)) {
+			size, err := v.deleteNeedle(n)
+			if err == nil {
+				err = v.waitForDurability(Durability{})
+			}
+			if err != nil {
+				s.checkDiskLocationAfterError(location, err)
+			}
+			return size, err
 		} else {
 			return 0, fmt.Errorf("volume size limit %d exceeded! current size is %d", s.GetVolumeSizeLimit(), v.ContentSize())
 		}
@@ -279,6 +328,9 @@ func (s *Store) MarkVolumeReadonly(i needle.VolumeId) error {
 
 func (s *Store) MountVolume(i needle.VolumeId) error {
 	for _, location := range s.Locations {
+		if location.IsFailed() {
+			continue
+		}
 		if found := location.LoadVolume(i, s.NeedleMapType); found == true {
 			glog.V(0).Infof("mount volume %d", i)
 			v := s.findVolume(i)
@@ -23,6 +23,10 @@ func (s *Store) CollectErasureCodingHeartbeat() *master_pb.Heartbeat {
 	var ecShardMessages []*master_pb.VolumeEcShardInformationMessage
 	collectionEcShardSize := make(map[string]int64)
 	for _, location := range s.Locations {
+		// the ec shards on a failed disk are reported as missing, to be rebuilt elsewhere
+		if location.IsFailed() {
+			continue
+		}
 		location.ecVolumesLock.RLock()
 		for _, ecShards := range location.ecVolumes {
 			ecShardMessages = append(ecShardMessages, ecShards.ToVolumeEcShardInformationMessage()...)
@@ -47,6 +51,9 @@ func (s *Store) CollectErasureCodingHeartbeat() *master_pb.Heartbeat {
 
 func (s *Store) MountEcShards(collection string, vid needle.VolumeId, shardId erasure_coding.ShardId) error {
 	for _, location := range s.Locations {
+		if location.IsFailed() {
+			continue
+		}
 		if err := location.LoadEcShard(collection, vid, shardId); err == nil {
 			glog.V(0).Infof("MountEcShards %d.%d", vid, shardId)
 
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// MaxOffsetSize is the size of the offsets kept in memory, which are saved in 4 or 5 bytes
+	MaxOffsetSize = 4 + 1
+)
+
+type OffsetHigher struct {
+	b4 byte
+}
+
+// IsValidOffsetSize tells whether the offsets can be saved in the size
+func IsValidOffsetSize(offsetSize int) bool {
+	return offsetSize == 4 || offsetSize == 5
+}
+
+// NeedleMapEntrySizeOf is the size of an .idx or .ecx entry with the offset size
+func NeedleMapEntrySizeOf(offsetSize int) int {
+	return NeedleIdSize + offsetSize + SizeSize
+}
+
+// MaxPossibleVolumeSizeOf is the largest volume addressable with the offset size
+func MaxPossibleVolumeSizeOf(offsetSize int) uint64 {
+	return 4 * 1024 * 1024 * 1024 * 8 << (8 * uint(offsetSize-4))
+}
+
+// VolumeSizeLimitOf is the size the master lets a volume grow to with the offset size,
+// leaving room below MaxPossibleVolumeSizeOf for the writes assigned before the volume is full
+func VolumeSizeLimitOf(offsetSize int) uint64 {
+	return MaxPossibleVolumeSizeOf(offsetSize) / 32 * 30
+}
+
+// OffsetToBytes saves the offset in 4 bytes, or in 5 bytes if the slice has room for the extra byte
+func OffsetToBytes(bytes []byte, offset Offset) {
+	if len(bytes) > 4 {
+		bytes[4] = offset.b4
+	}
+	bytes[3] = offset.b0
+	bytes[2] = offset.b1
+	bytes[1] = offset.b2
+	bytes[0] = offset.b3
+}
+
+// only for testing, will be removed later.
+func Uint32ToOffset(offset uint32) Offset {
+	return Offset{
+		OffsetLower: OffsetLower{
+			b0: byte(offset),
+			b1: byte(offset >> 8),
+			b2: byte(offset >> 16),
+			b3: byte(offset >> 24),
+		},
+	}
+}
+
+// BytesToOffset reads the offset saved in 4 bytes, or in 5 bytes if the slice has the extra byte
+func BytesToOffset(bytes []byte) Offset {
+	offset := Offset{
+		OffsetLower: OffsetLower{
+			b0: bytes[3],
+			b1: bytes[2],
+			b2: bytes[1],
+			b3: bytes[0],
+		},
+	}
+	if len(bytes) > 4 {
+		offset.b4 = bytes[4]
+	}
+	return offset
+}
+
+func (offset Offset) IsZero() bool {
+	return offset.b0 == 0 && offset.b1 == 0 && offset.b2 == 0 && offset.b3 == 0 && offset.b4 == 0
+}
+
+func ToOffset(offset int64) Offset {
+	smaller := offset / int64(NeedlePaddingSize)
+	return Offset{
+		OffsetHigher: OffsetHigher{
+			b4: byte(smaller >> 32),
+		},
+		OffsetLower: OffsetLower{
+			b0: byte(smaller),
+			b1: byte(smaller >> 8),
+			b2: byte(smaller >> 16),
+			b3: byte(smaller >> 24),
+		},
+	}
+}
+
+func (offset Offset) ToAcutalOffset() (actualOffset int64) {
+	return (int64(offset.b0) + int64(offset.b1)<<8 + int64(offset.b2)<<16 + int64(offset.b3)<<24 + int64(offset.b4)<<32) * int64(NeedlePaddingSize)
+}
+
+func (offset Offset) String() string {
+	return fmt.Sprintf("%d", int64(offset.b0)+int64(offset.b1)<<8+int64(offset.b2)<<16+int64(offset.b3)<<24+int64(offset.b4)<<32)
+}
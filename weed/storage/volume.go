@@ -17,6 +17,9 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/glog"
 )
 
+// NewVolumeOffsetSize is the offset size of the .idx files of new volumes, 4 or 5 bytes
+var NewVolumeOffsetSize = types.OffsetSize
+
 type Volume struct {
 	Id                 needle.VolumeId
 	dir                string
@@ -26,6 +29,8 @@ type Volume struct {
 	needleMapKind      NeedleMapType
 	readOnly           bool
 	MemoryMapMaxSizeMb uint32
+	// of the offsets in the .idx file, as recorded in the .vif file
+	offsetSize int
 
 	SuperBlock
 
@@ -37,6 +42,13 @@ type Volume struct {
 	lastCompactRevision    uint16
 
 	isCompacting bool
+
+	// taken before dataFileAccessLock, to keep the files open during a sync
+	syncLock sync.Mutex
+
+	durability     Durability
+	durabilityLock sync.RWMutex
+	groupCommitter groupCommitter
 }
 
 func NewVolume(dirname string, collection string, id needle.VolumeId, needleMapKind NeedleMapType, replicaPlacement *ReplicaPlacement, ttl *needle.TTL, preallocate int64, memoryMapMaxSizeMb uint32) (v *Volume, e error) {
@@ -47,6 +59,12 @@ func NewVolume(dirname string, collection string, id needle.VolumeId, needleMapK
 	e = v.load(true, true, needleMapKind, preallocate)
 	return
 }
+
+// indexEntrySize is the size of the entries in the .idx file
+func (v *Volume) indexEntrySize() int64 {
+	return int64(types.NeedleMapEntrySizeOf(v.offsetSize))
+}
+
 func (v *Volume) String() string {
 	return fmt.Sprintf("Id:%v, dir:%s, Collection:%s, dataFile:%v, nm:%v, readOnly:%v", v.Id, v.dir, v.Collection, v.DataBackend, v.nm, v.readOnly)
 }
@@ -140,6 +158,8 @@ func (v *Volume) IndexFileSize() uint64 {
 
 // Close cleanly shuts down this volume
 func (v *Volume) Close() {
+	v.syncLock.Lock()
+	defer v.syncLock.Unlock()
 	v.dataFileAccessLock.Lock()
 	defer v.dataFileAccessLock.Unlock()
 	if v.nm != nil {
@@ -213,5 +233,7 @@ func (v *Volume) ToVolumeInformationMessage() *master_pb.VolumeInformationMessag
 		Ttl:              v.Ttl.ToUint32(),
 		CompactRevision:  uint32(v.SuperBlock.CompactionRevision),
 		ModifiedAtSecond: modTime.Unix(),
+		Durability:       v.Durability().String(),
+		OffsetSize:       uint32(v.offsetSize),
 	}
 }
@@ -135,16 +135,17 @@ func (v *Volume) locateLastAppendEntry() (Offset, error) {
 		return Offset{}, fmt.Errorf("file %s stat error: %v", indexFile.Name(), err)
 	}
 	fileSize := fi.Size()
-	if fileSize%NeedleMapEntrySize != 0 {
+	entrySize := v.indexEntrySize()
+	if fileSize%entrySize != 0 {
 		return Offset{}, fmt.Errorf("unexpected file %s size: %d", indexFile.Name(), fileSize)
 	}
 	if fileSize == 0 {
 		return Offset{}, nil
 	}
 
-	bytes := make([]byte, NeedleMapEntrySize)
-	n, e := indexFile.ReadAt(bytes, fileSize-NeedleMapEntrySize)
-	if n != NeedleMapEntrySize {
+	bytes := make([]byte, entrySize)
+	n, e := indexFile.ReadAt(bytes, fileSize-entrySize)
+	if int64(n) != entrySize {
 		return Offset{}, fmt.Errorf("file %s read error: %v", indexFile.Name(), e)
 	}
 	_, offset, _ := idx.IdxFileEntry(bytes)
@@ -181,13 +182,14 @@ func (v *Volume) BinarySearchByAppendAtNs(sinceNs uint64) (offset Offset, isLast
 		return
 	}
 	fileSize := fi.Size()
-	if fileSize%NeedleMapEntrySize != 0 {
+	entrySize := v.indexEntrySize()
+	if fileSize%entrySize != 0 {
 		err = fmt.Errorf("unexpected file %s size: %d", indexFile.Name(), fileSize)
 		return
 	}
 
-	bytes := make([]byte, NeedleMapEntrySize)
-	entryCount := fileSize / NeedleMapEntrySize
+	bytes := make([]byte, entrySize)
+	entryCount := fileSize / entrySize
 	l := int64(0)
 	h := entryCount
 
@@ -230,9 +232,9 @@ func (v *Volume) BinarySearchByAppendAtNs(sinceNs uint64) (offset Offset, isLast
 
 }
 
-// bytes is of size NeedleMapEntrySize
+// bytes is of the size of an index entry
 func (v *Volume) readAppendAtNsForIndexEntry(indexFile *os.File, bytes []byte, m int64) (Offset, error) {
-	if _, readErr := indexFile.ReadAt(bytes, m*NeedleMapEntrySize); readErr != nil && readErr != io.EOF {
+	if _, readErr := indexFile.ReadAt(bytes, m*int64(len(bytes))); readErr != nil && readErr != io.EOF {
 		return Offset{}, readErr
 	}
 	_, offset, _ := idx.IdxFileEntry(bytes)
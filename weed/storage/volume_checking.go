@@ -13,14 +13,14 @@ import (
 
 func CheckVolumeDataIntegrity(v *Volume, indexFile *os.File) (lastAppendAtNs uint64, e error) {
 	var indexSize int64
-	if indexSize, e = verifyIndexFileIntegrity(indexFile); e != nil {
+	if indexSize, e = verifyIndexFileIntegrity(indexFile, v.offsetSize); e != nil {
 		return 0, fmt.Errorf("verifyIndexFileIntegrity %s failed: %v", indexFile.Name(), e)
 	}
 	if indexSize == 0 {
 		return 0, nil
 	}
 	var lastIdxEntry []byte
-	if lastIdxEntry, e = readIndexEntryAtOffset(indexFile, indexSize-NeedleMapEntrySize); e != nil {
+	if lastIdxEntry, e = readIndexEntryAtOffset(indexFile, indexSize-v.indexEntrySize(), v.offsetSize); e != nil {
 		return 0, fmt.Errorf("readLastIndexEntry %s failed: %v", indexFile.Name(), e)
 	}
 	key, offset, size := idx.IdxFileEntry(lastIdxEntry)
@@ -36,21 +36,21 @@ func CheckVolumeDataIntegrity(v *Volume, indexFile *os.File) (lastAppendAtNs uin
 	return
 }
 
-func verifyIndexFileIntegrity(indexFile *os.File) (indexSize int64, err error) {
+func verifyIndexFileIntegrity(indexFile *os.File, offsetSize int) (indexSize int64, err error) {
 	if indexSize, err = util.GetFileSize(indexFile); err == nil {
-		if indexSize%NeedleMapEntrySize != 0 {
+		if indexSize%int64(NeedleMapEntrySizeOf(offsetSize)) != 0 {
 			err = fmt.Errorf("index file's size is %d bytes, maybe corrupted", indexSize)
 		}
 	}
 	return
 }
 
-func readIndexEntryAtOffset(indexFile *os.File, offset int64) (bytes []byte, err error) {
+func readIndexEntryAtOffset(indexFile *os.File, offset int64, offsetSize int) (bytes []byte, err error) {
 	if offset < 0 {
 		err = fmt.Errorf("offset %d for index file is invalid", offset)
 		return
 	}
-	bytes = make([]byte, NeedleMapEntrySize)
+	bytes = make([]byte, NeedleMapEntrySizeOf(offsetSize))
 	_, err = indexFile.ReadAt(bytes, offset)
 	return
 }
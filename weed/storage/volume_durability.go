@@ -0,0 +1,204 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/volume_info"
+)
+
+// DurabilityMode decides when the writes are synced to the disk, before they are acknowledged.
+// The modes are ordered from the weakest to the strongest.
+type DurabilityMode int
+
+const (
+	DurabilityNone        DurabilityMode = iota // leave the syncing to the operating system
+	DurabilityGroupCommit                       // sync the concurrent writes together after an interval
+	DurabilityFsync                             // sync after each write
+)
+
+const DefaultGroupCommitInterval = 10 * time.Millisecond
+
+type Durability struct {
+	Mode                DurabilityMode
+	GroupCommitInterval time.Duration
+}
+
+// ParseDurability parses "none", "fsync", "group", or "group:<interval>", e.g., "group:20ms".
+// An empty string means "none".
+func ParseDurability(durabilityString string) (d Durability, err error) {
+	mode, interval := durabilityString, ""
+	if sepIndex := strings.Index(durabilityString, ":"); sepIndex >= 0 {
+		mode, interval = durabilityString[:sepIndex], durabilityString[sepIndex+1:]
+	}
+	switch mode {
+	case "", "none":
+		d.Mode = DurabilityNone
+	case "fsync":
+		d.Mode = DurabilityFsync
+	case "group":
+		d.Mode = DurabilityGroupCommit
+		d.GroupCommitInterval = DefaultGroupCommitInterval
+		if interval != "" {
+			if d.GroupCommitInterval, err = time.ParseDuration(interval); err != nil || d.GroupCommitInterval <= 0 {
+				return Durability{}, fmt.Errorf("invalid group commit interval %q", interval)
+			}
+		}
+		return d, nil
+	default:
+		return Durability{}, fmt.Errorf("unknown durability %q", durabilityString)
+	}
+	if interval != "" {
+		return Durability{}, fmt.Errorf("durability %s does not take an interval", mode)
+	}
+	return d, nil
+}
+
+func (d Durability) String() string {
+	switch d.Mode {
+	case DurabilityFsync:
+		return "fsync"
+	case DurabilityGroupCommit:
+		return fmt.Sprintf("group:%v", d.GroupCommitInterval)
+	}
+	return "none"
+}
+
+// Stronger returns the stronger one of the two durabilities, or the shorter group commit interval.
+func (d Durability) Stronger(other Durability) Durability {
+	if other.Mode > d.Mode {
+		return other
+	}
+	if other.Mode == d.Mode && d.Mode == DurabilityGroupCommit && other.GroupCommitInterval < d.GroupCommitInterval {
+		return other
+	}
+	return d
+}
+
+// AtLeast tells whether the writes are synced at least as soon as with the other durability.
+func (d Durability) AtLeast(other Durability) bool {
+	return d.Stronger(other) == d
+}
+
+// groupCommitter syncs the writes of concurrent writers together.
+// Each writer waits at most its own interval, and one sync acknowledges all the waiting writers.
+type groupCommitter struct {
+	sync.Mutex
+	waiters  []chan error
+	timer    *time.Timer
+	commitAt time.Time
+}
+
+// wait should be called after the data is written, and returns after a sync that starts later.
+func (gc *groupCommitter) wait(interval time.Duration, syncFn func() error) error {
+	done := make(chan error, 1)
+	commitAt := time.Now().Add(interval)
+
+	gc.Lock()
+	gc.waiters = append(gc.waiters, done)
+	if gc.timer == nil {
+		gc.commitAt = commitAt
+		gc.timer = time.AfterFunc(interval, func() {
+			gc.commit(syncFn)
+		})
+	} else if commitAt.Before(gc.commitAt) && gc.timer.Stop() {
+		// the timer has not fired yet, so commit sooner for this writer
+		gc.commitAt = commitAt
+		gc.timer.Reset(interval)
+	}
+	gc.Unlock()
+
+	return <-done
+}
+
+func (gc *groupCommitter) commit(syncFn func() error) {
+	gc.Lock()
+	waiters := gc.waiters
+	gc.waiters, gc.timer = nil, nil
+	gc.Unlock()
+
+	err := syncFn()
+	for _, done := range waiters {
+		done <- err
+	}
+}
+
+func (v *Volume) Durability() Durability {
+	v.durabilityLock.RLock()
+	defer v.durabilityLock.RUnlock()
+	return v.durability
+}
+
+// SetDurability changes the durability of the volume, and records it in the .vif file.
+func (v *Volume) SetDurability(d Durability) error {
+	fileName := v.FileName() + ".vif"
+	volumeInfo, _, err := volume_info.MaybeLoadVolumeInfo(fileName)
+	if err != nil {
+		return err
+	}
+	if volumeInfo == nil {
+		return fmt.Errorf("volume info %s not found", fileName)
+	}
+	volumeInfo.Durability = d.String()
+	if err = volume_info.SaveVolumeInfo(fileName, volumeInfo); err != nil {
+		return err
+	}
+	v.durabilityLock.Lock()
+	v.durability = d
+	v.durabilityLock.Unlock()
+	return nil
+}
+
+// loadDurability reads the durability recorded in the .vif file, and defaults to "none".
+func (v *Volume) loadDurability() error {
+	fileName := v.FileName() + ".vif"
+	volumeInfo, found, err := volume_info.MaybeLoadVolumeInfo(fileName)
+	if err != nil || !found {
+		return err
+	}
+	d, err := ParseDurability(volumeInfo.Durability)
+	if err != nil {
+		return fmt.Errorf("volume info %s: %v", fileName, err)
+	}
+	v.durabilityLock.Lock()
+	v.durability = d
+	v.durabilityLock.Unlock()
+	return nil
+}
+
+// waitForDurability makes the previous writes durable, with the stronger one of the requested and the volume's durability.
+// It should be called after the write, without holding the dataFileAccessLock.
+func (v *Volume) waitForDurability(requested Durability) error {
+	d := v.Durability().Stronger(requested)
+	switch d.Mode {
+	case DurabilityFsync:
+		return v.syncWrites()
+	case DurabilityGroupCommit:
+		return v.groupCommitter.wait(d.GroupCommitInterval, v.syncWrites)
+	}
+	return nil
+}
+
+// syncWrites flushes the .dat and .idx files to the disk.
+// The files are not closed or replaced by a compaction during the sync, while the reads and writes go on.
+func (v *Volume) syncWrites() error {
+	v.syncLock.Lock()
+	defer v.syncLock.Unlock()
+
+	v.dataFileAccessLock.Lock()
+	dataBackend, nm := v.DataBackend, v.nm
+	v.dataFileAccessLock.Unlock()
+
+	if dataBackend == nil || nm == nil {
+		return fmt.Errorf("volume %d is closed", v.Id)
+	}
+	if err := dataBackend.Sync(); err != nil {
+		return fmt.Errorf("sync volume %d data file: %v", v.Id, err)
+	}
+	if err := nm.Sync(); err != nil {
+		return fmt.Errorf("sync volume %d index file: %v", v.Id, err)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+)
+
+func TestParseDurability(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Durability
+		err  bool
+	}{
+		{"", Durability{}, false},
+		{"none", Durability{}, false},
+		{"fsync", Durability{Mode: DurabilityFsync}, false},
+		{"group", Durability{Mode: DurabilityGroupCommit, GroupCommitInterval: DefaultGroupCommitInterval}, false},
+		{"group:20ms", Durability{Mode: DurabilityGroupCommit, GroupCommitInterval: 20 * time.Millisecond}, false},
+		{"group:0s", Durability{}, true},
+		{"group:abc", Durability{}, true},
+		{"fsync:1ms", Durability{}, true},
+		{"always", Durability{}, true},
+	}
+	for _, tt := range tests {
+		d, err := ParseDurability(tt.in)
+		if (err != nil) != tt.err || d != tt.want {
+			t.Errorf("ParseDurability(%q) = %+v, %v", tt.in, d, err)
+			continue
+		}
+		if err == nil {
+			if back, _ := ParseDurability(d.String()); back != d {
+				t.Errorf("%q does not parse back to %+v", d.String(), d)
+			}
+		}
+	}
+}
+
+func TestDurabilityStronger(t *testing.T) {
+	none := Durability{}
+	fsync := Durability{Mode: DurabilityFsync}
+	group10 := Durability{Mode: DurabilityGroupCommit, GroupCommitInterval: 10 * time.Millisecond}
+	group20 := Durability{Mode: DurabilityGroupCommit, GroupCommitInterval: 20 * time.Millisecond}
+
+	if none.Stronger(group20) != group20 || group20.Stronger(none) != group20 {
+		t.Errorf("group commit should be stronger than none")
+	}
+	if group10.Stronger(fsync) != fsync || fsync.Stronger(group10) != fsync {
+		t.Errorf("fsync should be stronger than group commit")
+	}
+	if group20.Stronger(group10) != group10 || group10.Stronger(group20) != group10 {
+		t.Errorf("the shorter group commit interval should be stronger")
+	}
+	if !fsync.AtLeast(group10) || !group10.AtLeast(group20) || group20.AtLeast(group10) || none.AtLeast(group20) || !none.AtLeast(none) {
+		t.Errorf("unexpected durability order")
+	}
+}
+
+func TestGroupCommit(t *testing.T) {
+	var gc groupCommitter
+	var syncCount int32
+	syncFn := func() error {
+		atomic.AddInt32(&syncCount, 1)
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := gc.wait(50*time.Millisecond, syncFn); err != nil {
+				t.Errorf("wait: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if count := atomic.LoadInt32(&syncCount); count < 1 || count > 2 {
+		t.Errorf("expecting the 20 writers synced together, but synced %d times", count)
+	}
+
+	// a writer with a shorter interval does not wait for the longer one
+	go gc.wait(time.Hour, syncFn)
+	time.Sleep(10 * time.Millisecond)
+	start := time.Now()
+	if err := gc.wait(time.Millisecond, syncFn); err != nil {
+		t.Errorf("wait: %v", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Errorf("the shorter interval is not honored")
+	}
+}
+
+func TestVolumeDurability(t *testing.T) {
+	dir, err := ioutil.TempDir("", "durability")
+	if err != nil {
+		t.Fatalf("temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	replicaPlacement, _ := NewReplicaPlacementFromString("000")
+	v, err := NewVolume(dir, "", 1, NeedleMapInMemory, replicaPlacement, &needle.TTL{}, 0, 0)
+	if err != nil {
+		t.Fatalf("new volume: %v", err)
+	}
+	if err = v.SetDurability(Durability{Mode: DurabilityGroupCommit, GroupCommitInterval: time.Millisecond}); err != nil {
+		t.Fatalf("set durability: %v", err)
+	}
+
+	n := &needle.Needle{Id: 1, Cookie: 0x12345678, Data: []byte("hello")}
+	if _, _, _, err = v.writeNeedle(n); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err = v.waitForDurability(Durability{Mode: DurabilityFsync}); err != nil {
+		t.Fatalf("fsync: %v", err)
+	}
+	if err = v.waitForDurability(Durability{}); err != nil {
+		t.Fatalf("group commit: %v", err)
+	}
+	if v.ToVolumeInformationMessage().Durability != "group:1ms" {
+		t.Errorf("unexpected durability %s", v.ToVolumeInformationMessage().Durability)
+	}
+	v.Close()
+
+	v, err = NewVolume(dir, "", 1, NeedleMapInMemory, replicaPlacement, &needle.TTL{}, 0, 0)
+	if err != nil {
+		t.Fatalf("load volume: %v", err)
+	}
+	defer v.Close()
+	if d := v.Durability(); d.Mode != DurabilityGroupCommit || d.GroupCommitInterval != time.Millisecond {
+		t.Errorf("durability is not loaded from the volume info: %+v", d)
+	}
+}
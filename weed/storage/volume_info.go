@@ -6,6 +6,7 @@ import (
 
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
 )
 
 type VolumeInfo struct {
@@ -21,6 +22,8 @@ type VolumeInfo struct {
 	ReadOnly         bool
 	CompactRevision  uint32
 	ModifiedAtSecond int64
+	Durability       string
+	OffsetSize       int
 }
 
 func NewVolumeInfo(m *master_pb.VolumeInformationMessage) (vi VolumeInfo, err error) {
@@ -35,6 +38,8 @@ func NewVolumeInfo(m *master_pb.VolumeInformationMessage) (vi VolumeInfo, err er
 		Version:          needle.Version(m.Version),
 		CompactRevision:  m.CompactRevision,
 		ModifiedAtSecond: m.ModifiedAtSecond,
+		Durability:       m.Durability,
+		OffsetSize:       int(m.OffsetSize),
 	}
 	rp, e := NewReplicaPlacementFromByte(byte(m.ReplicaPlacement))
 	if e != nil {
@@ -79,9 +84,24 @@ func (vi VolumeInfo) ToVolumeInformationMessage() *master_pb.VolumeInformationMe
 		Ttl:              vi.Ttl.ToUint32(),
 		CompactRevision:  vi.CompactRevision,
 		ModifiedAtSecond: vi.ModifiedAtSecond,
+		Durability:       vi.Durability,
+		OffsetSize:       uint32(vi.OffsetSize),
 	}
 }
 
+// SizeLimit is the volume size limit, lowered to what the index offset size can address
+func (vi VolumeInfo) SizeLimit(volumeSizeLimit uint64) uint64 {
+	offsetSize := vi.OffsetSize
+	if offsetSize == 0 {
+		// reported by a volume server not recording the offset size per volume
+		offsetSize = types.OffsetSize
+	}
+	if limit := types.VolumeSizeLimitOf(offsetSize); limit < volumeSizeLimit {
+		return limit
+	}
+	return volumeSizeLimit
+}
+
 /*VolumesInfo sorting*/
 
 type volumeInfos []*VolumeInfo
@@ -0,0 +1,78 @@
+package volume_info
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/golang/protobuf/jsonpb"
+)
+
+// MaybeLoadVolumeInfo reads the .vif file of a volume. found is false if there is no such file.
+func MaybeLoadVolumeInfo(fileName string) (volumeInfo *volume_server_pb.VolumeInfo, found bool, err error) {
+
+	data, err := ioutil.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("read volume info %s: %v", fileName, err)
+	}
+
+	volumeInfo = &volume_server_pb.VolumeInfo{}
+	if err = jsonpb.Unmarshal(bytes.NewReader(data), volumeInfo); err != nil {
+		return nil, false, fmt.Errorf("unmarshal volume info %s: %v", fileName, err)
+	}
+
+	return volumeInfo, true, nil
+}
+
+// SaveVolumeInfo writes the .vif file of a volume, replacing it atomically
+func SaveVolumeInfo(fileName string, volumeInfo *volume_server_pb.VolumeInfo) error {
+
+	m := jsonpb.Marshaler{EmitDefaults: true, Indent: "  "}
+	text, err := m.MarshalToString(volumeInfo)
+	if err != nil {
+		return fmt.Errorf("marshal volume info %s: %v", fileName, err)
+	}
+
+	tmpFileName := fileName + ".tmp"
+	if err = ioutil.WriteFile(tmpFileName, []byte(text), 0644); err != nil {
+		return fmt.Errorf("write volume info %s: %v", tmpFileName, err)
+	}
+	if err = os.Rename(tmpFileName, fileName); err != nil {
+		return fmt.Errorf("rename volume info %s: %v", tmpFileName, err)
+	}
+
+	return nil
+}
+
+// LoadOffsetSize returns the offset size of the .idx or .ecx file of a volume.
+// A volume without the .vif file is assumed to have the default offset size, which is then recorded.
+func LoadOffsetSize(fileName string) (offsetSize int, err error) {
+
+	volumeInfo, found, err := MaybeLoadVolumeInfo(fileName)
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		volumeInfo = &volume_server_pb.VolumeInfo{}
+	}
+
+	if volumeInfo.BytesOffset == 0 {
+		volumeInfo.BytesOffset = types.OffsetSize
+		if err = SaveVolumeInfo(fileName, volumeInfo); err != nil {
+			return 0, err
+		}
+	}
+
+	offsetSize = int(volumeInfo.BytesOffset)
+	if !types.IsValidOffsetSize(offsetSize) {
+		return 0, fmt.Errorf("volume info %s: invalid offset size %d", fileName, offsetSize)
+	}
+
+	return offsetSize, nil
+}
@@ -5,9 +5,11 @@ import (
 	"os"
 	"time"
 
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
 	"github.com/chrislusf/seaweedfs/weed/stats"
 	"github.com/chrislusf/seaweedfs/weed/storage/backend"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/storage/volume_info"
 	"github.com/syndtr/goleveldb/leveldb/opt"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
@@ -62,11 +64,24 @@ func (v *Volume) load(alsoLoadIndex bool, createDatIfMissing bool, needleMapKind
 
 	if alreadyHasSuperBlock {
 		e = v.readSuperBlock()
+		if e == nil {
+			v.offsetSize, e = volume_info.LoadOffsetSize(fileName + ".vif")
+		}
+		if e == nil {
+			e = v.loadDurability()
+		}
 	} else {
 		if !v.SuperBlock.Initialized() {
 			return fmt.Errorf("volume %s.dat not initialized", fileName)
 		}
 		e = v.maybeWriteSuperBlock()
+		if e == nil {
+			v.offsetSize = NewVolumeOffsetSize
+			e = volume_info.SaveVolumeInfo(fileName+".vif", &volume_server_pb.VolumeInfo{
+				Version:     uint32(v.Version()),
+				BytesOffset: uint32(v.offsetSize),
+			})
+		}
 	}
 	if e == nil && alsoLoadIndex {
 		var indexFile *os.File
@@ -88,7 +103,7 @@ func (v *Volume) load(alsoLoadIndex bool, createDatIfMissing bool, needleMapKind
 		switch needleMapKind {
 		case NeedleMapInMemory:
 			glog.V(0).Infoln("loading index", fileName+".idx", "to memory readonly", v.readOnly)
-			if v.nm, e = LoadCompactNeedleMap(indexFile); e != nil {
+			if v.nm, e = LoadCompactNeedleMap(indexFile, v.offsetSize); e != nil {
 				glog.V(0).Infof("loading index %s to memory error: %v", fileName+".idx", e)
 			}
 		case NeedleMapLevelDb:
@@ -98,7 +113,7 @@ func (v *Volume) load(alsoLoadIndex bool, createDatIfMissing bool, needleMapKind
 				WriteBuffer:                   1 * 1024 * 1024, // default value is 4MiB
 				CompactionTableSizeMultiplier: 10,              // default value is 1
 			}
-			if v.nm, e = NewLevelDbNeedleMap(fileName+".ldb", indexFile, opts); e != nil {
+			if v.nm, e = NewLevelDbNeedleMap(fileName+".ldb", indexFile, opts, v.offsetSize); e != nil {
 				glog.V(0).Infof("loading leveldb %s error: %v", fileName+".ldb", e)
 			}
 		case NeedleMapLevelDbMedium:
@@ -108,7 +123,7 @@ func (v *Volume) load(alsoLoadIndex bool, createDatIfMissing bool, needleMapKind
 				WriteBuffer:                   2 * 1024 * 1024, // default value is 4MiB
 				CompactionTableSizeMultiplier: 10,              // default value is 1
 			}
-			if v.nm, e = NewLevelDbNeedleMap(fileName+".ldb", indexFile, opts); e != nil {
+			if v.nm, e = NewLevelDbNeedleMap(fileName+".ldb", indexFile, opts, v.offsetSize); e != nil {
 				glog.V(0).Infof("loading leveldb %s error: %v", fileName+".ldb", e)
 			}
 		case NeedleMapLevelDbLarge:
@@ -118,9 +133,14 @@ func (v *Volume) load(alsoLoadIndex bool, createDatIfMissing bool, needleMapKind
 				WriteBuffer:                   4 * 1024 * 1024, // default value is 4MiB
 				CompactionTableSizeMultiplier: 10,              // default value is 1
 			}
-			if v.nm, e = NewLevelDbNeedleMap(fileName+".ldb", indexFile, opts); e != nil {
+			if v.nm, e = NewLevelDbNeedleMap(fileName+".ldb", indexFile, opts, v.offsetSize); e != nil {
 				glog.V(0).Infof("loading leveldb %s error: %v", fileName+".ldb", e)
 			}
+		case NeedleMapSortedFile:
+			glog.V(0).Infoln("loading sorted index", fileName+".sdx")
+			if v.nm, e = NewSortedFileNeedleMap(fileName, indexFile, v.offsetSize); e != nil {
+				glog.V(0).Infof("loading sorted index %s error: %v", fileName+".sdx", e)
+			}
 		}
 	}
 
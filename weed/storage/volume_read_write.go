@@ -12,6 +12,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/storage/backend"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 	. "github.com/chrislusf/seaweedfs/weed/storage/types"
+	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
 var ErrorNotFound = errors.New("not found")
@@ -52,6 +53,11 @@ func (v *Volume) Destroy() (err error) {
 	os.Remove(v.FileName() + ".cpx")
 	os.RemoveAll(v.FileName() + ".ldb")
 	os.RemoveAll(v.FileName() + ".bdb")
+	os.Remove(v.FileName() + ".sdx")
+	// the ec volume encoded from this volume keeps using the volume info
+	if !util.FileExists(v.FileName() + ".ecx") {
+		os.Remove(v.FileName() + ".vif")
+	}
 	return
 }
 
@@ -23,6 +23,11 @@ func (v *Volume) garbageLevel() float64 {
 }
 
 func (v *Volume) Compact(preallocate int64, compactionBytePerSecond int64) error {
+	return v.CompactWithThrottler(preallocate, util.NewWriteThrottler(compactionBytePerSecond))
+}
+
+// CompactWithThrottler copies the live needles with the bandwidth shared by other background jobs
+func (v *Volume) CompactWithThrottler(preallocate int64, throttler util.Throttler) error {
 
 	if v.MemoryMapMaxSizeMb == 0 { //it makes no sense to compact in memory
 		glog.V(3).Infof("Compacting volume %d ...", v.Id)
@@ -39,7 +44,7 @@ func (v *Volume) Compact(preallocate int64, compactionBytePerSecond int64) error
 		v.lastCompactIndexOffset = v.IndexFileSize()
 		v.lastCompactRevision = v.SuperBlock.CompactionRevision
 		glog.V(3).Infof("creating copies for volume %d ,last offset %d...", v.Id, v.lastCompactIndexOffset)
-		return v.copyDataAndGenerateIndexFile(filePath+".cpd", filePath+".cpx", preallocate, compactionBytePerSecond)
+		return v.copyDataAndGenerateIndexFile(filePath+".cpd", filePath+".cpx", preallocate, throttler)
 	} else {
 		return nil
 	}
@@ -72,6 +77,8 @@ func (v *Volume) CommitCompact() error {
 			v.isCompacting = false
 		}()
 
+		v.syncLock.Lock()
+		defer v.syncLock.Unlock()
 		v.dataFileAccessLock.Lock()
 		defer v.dataFileAccessLock.Unlock()
 
@@ -150,7 +157,7 @@ func (v *Volume) makeupDiff(newDatFileName, newIdxFileName, oldDatFileName, oldI
 	oldDatBackend := backend.NewDiskFile(oldDatFile)
 	defer oldDatBackend.Close()
 
-	if indexSize, err = verifyIndexFileIntegrity(oldIdxFile); err != nil {
+	if indexSize, err = verifyIndexFileIntegrity(oldIdxFile, v.offsetSize); err != nil {
 		return fmt.Errorf("verifyIndexFileIntegrity %s failed: %v", oldIdxFileName, err)
 	}
 	if indexSize == 0 || uint64(indexSize) <= v.lastCompactIndexOffset {
@@ -171,9 +178,9 @@ func (v *Volume) makeupDiff(newDatFileName, newIdxFileName, oldDatFileName, oldI
 	}
 	incrementedHasUpdatedIndexEntry := make(map[NeedleId]keyField)
 
-	for idxOffset := indexSize - NeedleMapEntrySize; uint64(idxOffset) >= v.lastCompactIndexOffset; idxOffset -= NeedleMapEntrySize {
+	for idxOffset := indexSize - v.indexEntrySize(); uint64(idxOffset) >= v.lastCompactIndexOffset; idxOffset -= v.indexEntrySize() {
 		var IdxEntry []byte
-		if IdxEntry, err = readIndexEntryAtOffset(oldIdxFile, idxOffset); err != nil {
+		if IdxEntry, err = readIndexEntryAtOffset(oldIdxFile, idxOffset, v.offsetSize); err != nil {
 			return fmt.Errorf("readIndexEntry %s at offset %d failed: %v", oldIdxFileName, idxOffset, err)
 		}
 		key, offset, size := idx2.IdxFileEntry(IdxEntry)
@@ -217,7 +224,7 @@ func (v *Volume) makeupDiff(newDatFileName, newIdxFileName, oldDatFileName, oldI
 
 	for key, increIdxEntry := range incrementedHasUpdatedIndexEntry {
 
-		idxEntryBytes := needle_map.ToBytes(key, increIdxEntry.offset, increIdxEntry.size)
+		idxEntryBytes := needle_map.ToBytes(key, increIdxEntry.offset, increIdxEntry.size, v.offsetSize)
 
 		var offset int64
 		if offset, err = dst.Seek(0, 2); err != nil {
@@ -243,7 +250,7 @@ func (v *Volume) makeupDiff(newDatFileName, newIdxFileName, oldDatFileName, oldI
 				return fmt.Errorf("ReadNeedleBlob %s key %d offset %d size %d failed: %v", oldDatFile.Name(), key, increIdxEntry.offset.ToAcutalOffset(), increIdxEntry.size, err)
 			}
 			dst.Write(needleBytes)
-			util.Uint32toBytes(idxEntryBytes[8:12], uint32(offset/NeedlePaddingSize))
+			OffsetToBytes(idxEntryBytes[NeedleIdSize:NeedleIdSize+v.offsetSize], ToOffset(offset))
 		} else { //deleted needle
 			//fakeDelNeedle 's default Data field is nil
 			fakeDelNeedle := new(needle.Needle)
@@ -254,7 +261,7 @@ func (v *Volume) makeupDiff(newDatFileName, newIdxFileName, oldDatFileName, oldI
 			if err != nil {
 				return fmt.Errorf("append deleted %d failed: %v", key, err)
 			}
-			util.Uint32toBytes(idxEntryBytes[8:12], uint32(0))
+			OffsetToBytes(idxEntryBytes[NeedleIdSize:NeedleIdSize+v.offsetSize], Offset{})
 		}
 
 		if _, err := idx.Seek(0, 2); err != nil {
@@ -274,7 +281,7 @@ type VolumeFileScanner4Vacuum struct {
 	nm             *NeedleMap
 	newOffset      int64
 	now            uint64
-	writeThrottler *util.WriteThrottler
+	writeThrottler util.Throttler
 }
 
 func (scanner *VolumeFileScanner4Vacuum) VisitSuperBlock(superBlock SuperBlock) error {
@@ -310,7 +317,7 @@ func (scanner *VolumeFileScanner4Vacuum) VisitNeedle(n *needle.Needle, offset in
 	return nil
 }
 
-func (v *Volume) copyDataAndGenerateIndexFile(dstName, idxName string, preallocate int64, compactionBytePerSecond int64) (err error) {
+func (v *Volume) copyDataAndGenerateIndexFile(dstName, idxName string, preallocate int64, throttler util.Throttler) (err error) {
 	var (
 		dst backend.BackendStorageFile
 		idx *os.File
@@ -328,9 +335,9 @@ func (v *Volume) copyDataAndGenerateIndexFile(dstName, idxName string, prealloca
 	scanner := &VolumeFileScanner4Vacuum{
 		v:              v,
 		now:            uint64(time.Now().Unix()),
-		nm:             NewBtreeNeedleMap(idx),
+		nm:             NewBtreeNeedleMap(idx, v.offsetSize),
 		dstBackend:     dst,
-		writeThrottler: util.NewWriteThrottler(compactionBytePerSecond),
+		writeThrottler: throttler,
 	}
 	err = ScanVolumeFile(v.dir, v.Collection, v.Id, v.needleMapKind, scanner)
 	return
@@ -356,14 +363,14 @@ func (v *Volume) copyDataBasedOnIndexFile(dstName, idxName string) (err error) {
 	}
 	defer oldIndexFile.Close()
 
-	nm := NewBtreeNeedleMap(idx)
+	nm := NewBtreeNeedleMap(idx, v.offsetSize)
 	now := uint64(time.Now().Unix())
 
 	v.SuperBlock.CompactionRevision++
 	dst.Write(v.SuperBlock.Bytes())
 	newOffset := int64(v.SuperBlock.BlockSize())
 
-	idx2.WalkIndexFile(oldIndexFile, func(key NeedleId, offset Offset, size uint32) error {
+	idx2.WalkIndexFile(oldIndexFile, v.offsetSize, func(key NeedleId, offset Offset, size uint32) error {
 		if offset.IsZero() || size == TombstoneFileSize {
 			return nil
 		}
@@ -27,6 +27,18 @@ func (dc *DataCenter) GetOrCreateRack(rackName string) *Rack {
 	return rack
 }
 
+// FreeSpace leaves out the slots of the draining data nodes, which are skipped when growing volumes
+func (dc *DataCenter) FreeSpace() int64 {
+	return dc.NodeImpl.FreeSpace() - dc.drainingFreeSpace()
+}
+
+func (dc *DataCenter) drainingFreeSpace() (freeSpace int64) {
+	for _, c := range dc.Children() {
+		freeSpace += c.(*Rack).drainingFreeSpace()
+	}
+	return
+}
+
 func (dc *DataCenter) ToMap() interface{} {
 	m := make(map[string]interface{})
 	m["Id"] = dc.Id()
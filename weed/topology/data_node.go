@@ -23,6 +23,8 @@ type DataNode struct {
 	LastSeen     int64 // unix time in seconds
 	ecShards     map[needle.VolumeId]*erasure_coding.EcVolumeInfo
 	ecShardsLock sync.RWMutex
+	draining     bool // only kept in the master memory, and lost when the master restarts or the node reconnects
+	diskStatuses []*master_pb.DiskStatus
 }
 
 func NewDataNode(id string) *DataNode {
@@ -117,6 +119,54 @@ func (dn *DataNode) GetVolumesById(id needle.VolumeId) (storage.VolumeInfo, erro
 	}
 }
 
+// IsDraining means the data node is being evacuated,
+// so no new volumes are grown on it and its volumes are not picked for writes.
+func (dn *DataNode) IsDraining() bool {
+	dn.RLock()
+	defer dn.RUnlock()
+	return dn.draining
+}
+
+func (dn *DataNode) SetDraining(draining bool) {
+	dn.Lock()
+	defer dn.Unlock()
+	dn.draining = draining
+}
+
+// FreeSpace of a draining data node is always 0, so that it is skipped when growing volumes or moving data to it.
+func (dn *DataNode) FreeSpace() int64 {
+	if dn.IsDraining() {
+		return 0
+	}
+	return dn.NodeImpl.FreeSpace()
+}
+
+// UpdateDiskStatuses saves the disk health reported by the volume server,
+// and returns the directories of the disks failed since the last report.
+func (dn *DataNode) UpdateDiskStatuses(diskStatuses []*master_pb.DiskStatus) (newlyFailedDirs []string) {
+	dn.Lock()
+	defer dn.Unlock()
+	failedDirs := make(map[string]bool)
+	for _, status := range dn.diskStatuses {
+		if status.IsFailed {
+			failedDirs[status.Dir] = true
+		}
+	}
+	for _, status := range diskStatuses {
+		if status.IsFailed && !failedDirs[status.Dir] {
+			newlyFailedDirs = append(newlyFailedDirs, status.Dir)
+		}
+	}
+	dn.diskStatuses = diskStatuses
+	return
+}
+
+func (dn *DataNode) GetDiskStatuses() []*master_pb.DiskStatus {
+	dn.RLock()
+	defer dn.RUnlock()
+	return dn.diskStatuses
+}
+
 func (dn *DataNode) GetDataCenter() *DataCenter {
 	return dn.Parent().Parent().(*NodeImpl).value.(*DataCenter)
 }
@@ -150,6 +200,7 @@ func (dn *DataNode) ToMap() interface{} {
 	ret["Max"] = dn.GetMaxVolumeCount()
 	ret["Free"] = dn.FreeSpace()
 	ret["PublicUrl"] = dn.PublicUrl
+	ret["Draining"] = dn.IsDraining()
 	return ret
 }
 
@@ -160,6 +211,8 @@ func (dn *DataNode) ToDataNodeInfo() *master_pb.DataNodeInfo {
 		MaxVolumeCount:    uint64(dn.GetMaxVolumeCount()),
 		FreeVolumeCount:   uint64(dn.FreeSpace()),
 		ActiveVolumeCount: uint64(dn.GetActiveVolumeCount()),
+		IsDraining:        dn.IsDraining(),
+		DiskStatuses:      dn.GetDiskStatuses(),
 	}
 	for _, v := range dn.GetVolumes() {
 		m.VolumeInfos = append(m.VolumeInfos, v.ToVolumeInformationMessage())
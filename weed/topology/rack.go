@@ -46,6 +46,24 @@ func (r *Rack) GetOrCreateDataNode(ip string, port int, publicUrl string, maxVol
 	return dn
 }
 
+// FreeSpace leaves out the slots of the draining data nodes, which are skipped when growing volumes
+func (r *Rack) FreeSpace() int64 {
+	return r.NodeImpl.FreeSpace() - r.drainingFreeSpace()
+}
+
+func (r *Rack) drainingFreeSpace() (freeSpace int64) {
+	for _, c := range r.Children() {
+		dn := c.(*DataNode)
+		if !dn.IsDraining() {
+			continue
+		}
+		if free := dn.NodeImpl.FreeSpace(); free > 0 {
+			freeSpace += free
+		}
+	}
+	return
+}
+
 func (r *Rack) ToMap() interface{} {
 	m := make(map[string]interface{})
 	m["Id"] = r.Id()
@@ -2,6 +2,7 @@ package topology
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -19,17 +20,36 @@ import (
 )
 
 func ReplicatedWrite(masterNode string, s *storage.Store,
-	volumeId needle.VolumeId, n *needle.Needle,
+	volumeId needle.VolumeId, n *needle.Needle, contentMd5 []byte,
 	r *http.Request) (size uint32, isUnchanged bool, err error) {
 
 	//check JWT
 	jwt := security.GetJwt(r)
 
-	size, isUnchanged, err = s.WriteVolumeNeedle(volumeId, n)
+	durability, err := storage.ParseDurability(r.FormValue("durability"))
+	if err != nil {
+		return
+	}
+
+	return ReplicatedWriteNeedle(masterNode, s, volumeId, n, contentMd5, r.URL.Path, r.FormValue("type") == "replicate", jwt, durability)
+}
+
+// ReplicatedWriteNeedle writes the needle locally, and then to the other replicas unless it is a replica write itself.
+// The path is the url path of the needle, e.g., "/3,01637037d6".
+// The replicas are written with the same durability as the local write,
+// and verify the content against the contentMd5 if it is known.
+func ReplicatedWriteNeedle(masterNode string, s *storage.Store,
+	volumeId needle.VolumeId, n *needle.Needle, contentMd5 []byte,
+	path string, isReplicate bool, jwt security.EncodedJwt, durability storage.Durability) (size uint32, isUnchanged bool, err error) {
+
+	size, isUnchanged, err = s.WriteVolumeNeedle(volumeId, n, durability)
 	if err != nil {
 		err = fmt.Errorf("failed to write to local disk: %v", err)
 		return
 	}
+	if v := s.GetVolume(volumeId); v != nil {
+		durability = v.Durability().Stronger(durability)
+	}
 
 	needToReplicate := !s.HasVolume(volumeId)
 	needToReplicate = needToReplicate || s.GetVolume(volumeId).NeedToReplicate()
@@ -37,13 +57,13 @@ func ReplicatedWrite(masterNode string, s *storage.Store,
 		needToReplicate = s.GetVolume(volumeId).NeedToReplicate()
 	}
 	if needToReplicate { //send to other replica locations
-		if r.FormValue("type") != "replicate" {
+		if !isReplicate {
 
 			if err = distributedOperation(masterNode, s, volumeId, func(location operation.Location) error {
 				u := url.URL{
 					Scheme: "http",
 					Host:   location.Url,
-					Path:   r.URL.Path,
+					Path:   path,
 				}
 				q := url.Values{
 					"type": {"replicate"},
@@ -55,6 +75,9 @@ func ReplicatedWrite(masterNode string, s *storage.Store,
 				if n.IsChunkedManifest() {
 					q.Set("cm", "true")
 				}
+				if durability.Mode != storage.DurabilityNone {
+					q.Set("durability", durability.String())
+				}
 				u.RawQuery = q.Encode()
 
 				pairMap := make(map[string]string)
@@ -68,9 +91,12 @@ func ReplicatedWrite(masterNode string, s *storage.Store,
 						pairMap[needle.PairNamePrefix+k] = v
 					}
 				}
+				if contentMd5 != nil {
+					pairMap[util.ContentMd5Header] = base64.StdEncoding.EncodeToString(contentMd5)
+				}
 
 				_, err := operation.Upload(u.String(),
-					string(n.Name), bytes.NewReader(n.Data), n.IsGzipped(), string(n.Mime),
+					string(n.Name), bytes.NewReader(n.Data), n.Compression(), string(n.Mime),
 					pairMap, jwt)
 				return err
 			}); err != nil {
@@ -192,6 +192,29 @@ func (t *Topology) GetOrCreateDataCenter(dcName string) *DataCenter {
 	return dc
 }
 
+// FindDataNode looks up the data node by its id, i.e., ip:port
+// FreeSpace leaves out the slots of the draining data nodes, which are skipped when growing volumes
+func (t *Topology) FreeSpace() int64 {
+	freeSpace := t.NodeImpl.FreeSpace()
+	for _, c := range t.Children() {
+		freeSpace -= c.(*DataCenter).drainingFreeSpace()
+	}
+	return freeSpace
+}
+
+func (t *Topology) FindDataNode(id string) *DataNode {
+	for _, c := range t.Children() {
+		for _, r := range c.Children() {
+			for _, n := range r.Children() {
+				if dn := n.(*DataNode); dn.Id() == NodeId(id) {
+					return dn
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func (t *Topology) SyncDataNodeRegistration(volumes []*master_pb.VolumeInformationMessage, dn *DataNode) (newVolumes, deletedVolumes []storage.VolumeInfo) {
 	// convert into in memory struct storage.VolumeInfo
 	var volumeInfos []storage.VolumeInfo
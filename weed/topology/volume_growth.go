@@ -29,6 +29,7 @@ type VolumeGrowOption struct {
 	Rack               string
 	DataNode           string
 	MemoryMapMaxSizeMb uint32
+	Durability         string
 }
 
 type VolumeGrowth struct {
@@ -39,6 +40,12 @@ func (o *VolumeGrowOption) String() string {
 	return fmt.Sprintf("Collection:%s, ReplicaPlacement:%v, Ttl:%v, DataCenter:%s, Rack:%s, DataNode:%s", o.Collection, o.ReplicaPlacement, o.Ttl, o.DataCenter, o.Rack, o.DataNode)
 }
 
+// durability is the requested durability, validated when the option is parsed
+func (o *VolumeGrowOption) durability() storage.Durability {
+	d, _ := storage.ParseDurability(o.Durability)
+	return d
+}
+
 func NewDefaultVolumeGrowth() *VolumeGrowth {
 	return &VolumeGrowth{}
 }
@@ -209,6 +216,7 @@ func (vg *VolumeGrowth) grow(grpcDialOption grpc.DialOption, topo *Topology, vid
 				ReplicaPlacement: option.ReplicaPlacement,
 				Ttl:              option.Ttl,
 				Version:          needle.CurrentVersion,
+				Durability:       option.Durability,
 			}
 			server.AddOrUpdateVolume(vi)
 			topo.RegisterVolumeLayout(vi, server)
@@ -130,3 +130,34 @@ func TestFindEmptySlotsForOneVolume(t *testing.T) {
 		fmt.Println("assigned node :", server.Id())
 	}
 }
+
+func TestFindEmptySlotsSkipsDrainingNode(t *testing.T) {
+	topo := setup(topologyLayout)
+	vg := NewDefaultVolumeGrowth()
+	topo.FindDataNode("server122").SetDraining(true)
+
+	rp, _ := storage.NewReplicaPlacementFromString("002")
+	if servers, err := vg.findEmptySlotsForOneVolume(topo, &VolumeGrowOption{ReplicaPlacement: rp, DataCenter: "dc1"}); err == nil {
+		t.Errorf("unexpected servers %v without the draining server122", servers)
+	}
+
+	// the free slots of a rack or data center leave out the draining server122
+	if free := topo.FindDataNode("server122").GetRack().FreeSpace(); free != 3 {
+		t.Errorf("rack2 has %d free slots, expecting 3", free)
+	}
+
+	for _, placement := range []string{"001", "010"} {
+		rp, _ = storage.NewReplicaPlacementFromString(placement)
+		for i := 0; i < 100; i++ {
+			servers, err := vg.findEmptySlotsForOneVolume(topo, &VolumeGrowOption{ReplicaPlacement: rp, DataCenter: "dc1"})
+			if err != nil {
+				t.Fatalf("finding empty slots for %s error: %v", placement, err)
+			}
+			for _, server := range servers {
+				if server.Id() == "server122" {
+					t.Fatalf("assigned the draining node %s for %s", server.Id(), placement)
+				}
+			}
+		}
+	}
+}
@@ -20,6 +20,7 @@ type VolumeLayout struct {
 	writables        []needle.VolumeId        // transient array of writable volume id
 	readonlyVolumes  map[needle.VolumeId]bool // transient set of readonly volumes
 	oversizedVolumes map[needle.VolumeId]bool // set of oversized volumes
+	vid2durability   map[needle.VolumeId]storage.Durability
 	volumeSizeLimit  uint64
 	accessLock       sync.RWMutex
 }
@@ -38,6 +39,7 @@ func NewVolumeLayout(rp *storage.ReplicaPlacement, ttl *needle.TTL, volumeSizeLi
 		writables:        *new([]needle.VolumeId),
 		readonlyVolumes:  make(map[needle.VolumeId]bool),
 		oversizedVolumes: make(map[needle.VolumeId]bool),
+		vid2durability:   make(map[needle.VolumeId]storage.Durability),
 		volumeSizeLimit:  volumeSizeLimit,
 	}
 }
@@ -54,6 +56,7 @@ func (vl *VolumeLayout) RegisterVolume(v *storage.VolumeInfo, dn *DataNode) {
 		vl.vid2location[v.Id] = NewVolumeLocationList()
 	}
 	vl.vid2location[v.Id].Set(dn)
+	vl.rememberDurability(v)
 	// glog.V(4).Infof("volume %d added to %s len %d copy %d", v.Id, dn.Id(), vl.vid2location[v.Id].Length(), v.ReplicaPlacement.GetCopyCount())
 	for _, dn := range vl.vid2location[v.Id].list {
 		if vInfo, err := dn.GetVolumesById(v.Id); err == nil {
@@ -84,6 +87,24 @@ func (vl *VolumeLayout) rememberOversizedVolume(v *storage.VolumeInfo) {
 	}
 }
 
+// rememberDurability keeps the durability of the volume, which is not reported in the incremental heartbeats
+func (vl *VolumeLayout) rememberDurability(v *storage.VolumeInfo) {
+	if v.Durability == "" {
+		return
+	}
+	d, err := storage.ParseDurability(v.Durability)
+	if err != nil {
+		glog.V(0).Infof("volume %d durability: %v", v.Id, err)
+		return
+	}
+	vl.vid2durability[v.Id] = d
+}
+
+// isDurableFor tells whether the volume syncs the writes at least as requested
+func (vl *VolumeLayout) isDurableFor(vid needle.VolumeId, requested storage.Durability) bool {
+	return vl.vid2durability[vid].AtLeast(requested)
+}
+
 func (vl *VolumeLayout) UnRegisterVolume(v *storage.VolumeInfo, dn *DataNode) {
 	vl.accessLock.Lock()
 	defer vl.accessLock.Unlock()
@@ -100,6 +121,7 @@ func (vl *VolumeLayout) UnRegisterVolume(v *storage.VolumeInfo, dn *DataNode) {
 
 		if location.Length() == 0 {
 			delete(vl.vid2location, v.Id)
+			delete(vl.vid2durability, v.Id)
 		}
 
 	}
@@ -125,7 +147,7 @@ func (vl *VolumeLayout) addToWritable(vid needle.VolumeId) {
 }
 
 func (vl *VolumeLayout) isOversized(v *storage.VolumeInfo) bool {
-	return uint64(v.Size) >= vl.volumeSizeLimit
+	return uint64(v.Size) >= v.SizeLimit(vl.volumeSizeLimit)
 }
 
 func (vl *VolumeLayout) isWritable(v *storage.VolumeInfo) bool {
@@ -170,19 +192,32 @@ func (vl *VolumeLayout) PickForWrite(count uint64, option *VolumeGrowOption) (*n
 		glog.V(0).Infoln("No more writable volumes!")
 		return nil, 0, nil, errors.New("No more writable volumes!")
 	}
+	durability := option.durability()
 	if option.DataCenter == "" {
 		vid := vl.writables[rand.Intn(lenWriters)]
 		locationList := vl.vid2location[vid]
-		if locationList != nil {
+		if locationList == nil {
+			return nil, 0, nil, errors.New("Strangely vid " + vid.String() + " is on no machine!")
+		}
+		if !locationList.HasDrainingNode() && vl.isDurableFor(vid, durability) {
 			return &vid, count, locationList, nil
 		}
-		return nil, 0, nil, errors.New("Strangely vid " + vid.String() + " is on no machine!")
 	}
 	var vid needle.VolumeId
 	var locationList *VolumeLocationList
 	counter := 0
 	for _, v := range vl.writables {
 		volumeLocationList := vl.vid2location[v]
+		if volumeLocationList.HasDrainingNode() || !vl.isDurableFor(v, durability) {
+			continue
+		}
+		if option.DataCenter == "" {
+			counter++
+			if rand.Intn(counter) < 1 {
+				vid, locationList = v, volumeLocationList
+			}
+			continue
+		}
 		for _, dn := range volumeLocationList.list {
 			if dn.GetDataCenter().Id() == NodeId(option.DataCenter) {
 				if option.Rack != "" && dn.GetRack().Id() != NodeId(option.Rack) {
@@ -198,6 +233,9 @@ func (vl *VolumeLayout) PickForWrite(count uint64, option *VolumeGrowOption) (*n
 			}
 		}
 	}
+	if locationList == nil {
+		return nil, 0, nil, errors.New("No more writable volumes off the draining nodes, with durability " + durability.String() + "!")
+	}
 	return &vid, count, locationList, nil
 }
 
@@ -205,11 +243,16 @@ func (vl *VolumeLayout) GetActiveVolumeCount(option *VolumeGrowOption) int {
 	vl.accessLock.RLock()
 	defer vl.accessLock.RUnlock()
 
-	if option.DataCenter == "" {
-		return len(vl.writables)
-	}
+	durability := option.durability()
 	counter := 0
 	for _, v := range vl.writables {
+		if vl.vid2location[v].HasDrainingNode() || !vl.isDurableFor(v, durability) {
+			continue
+		}
+		if option.DataCenter == "" {
+			counter++
+			continue
+		}
 		for _, dn := range vl.vid2location[v].list {
 			if dn.GetDataCenter().Id() == NodeId(option.DataCenter) {
 				if option.Rack != "" && dn.GetRack().Id() != NodeId(option.Rack) {
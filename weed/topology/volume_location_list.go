@@ -27,6 +27,16 @@ func (dnll *VolumeLocationList) Length() int {
 	return len(dnll.list)
 }
 
+// HasDrainingNode is true if any replica is on a draining data node
+func (dnll *VolumeLocationList) HasDrainingNode() bool {
+	for _, dn := range dnll.list {
+		if dn.IsDraining() {
+			return true
+		}
+	}
+	return false
+}
+
 func (dnll *VolumeLocationList) Set(loc *DataNode) {
 	for i := 0; i < len(dnll.list); i++ {
 		if loc.Ip == dnll.list[i].Ip && loc.Port == dnll.list[i].Port {
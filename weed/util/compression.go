@@ -4,13 +4,103 @@ import (
 	"bytes"
 	"compress/flate"
 	"compress/gzip"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"strings"
+	"sync"
 
 	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/klauspost/compress/zstd"
 	"golang.org/x/tools/godoc/util"
 )
 
+// the compression codecs of the needle data, named as the http Content-Encoding
+const (
+	GzipCompression = "gzip"
+	ZstdCompression = "zstd"
+)
+
+// DefaultCompression is the codec to compress the gzippable uploads, set by the "-compression" option
+var DefaultCompression = GzipCompression
+
+type compressionCodec struct {
+	compress   func(input []byte) ([]byte, error)
+	decompress func(input []byte) ([]byte, error)
+	reader     func(r io.Reader) (io.ReadCloser, error)
+}
+
+// the codecs are looked up by name, so that adding a codec does not touch the upload and read paths
+var compressionCodecs = map[string]*compressionCodec{
+	GzipCompression: {
+		compress:   GzipData,
+		decompress: UnGzipData,
+		reader: func(r io.Reader) (io.ReadCloser, error) {
+			return gzip.NewReader(r)
+		},
+	},
+	ZstdCompression: {
+		compress:   ZstdData,
+		decompress: UnZstdData,
+		reader: func(r io.Reader) (io.ReadCloser, error) {
+			d, err := zstd.NewReader(r)
+			if err != nil {
+				return nil, err
+			}
+			return &zstdReadCloser{d}, nil
+		},
+	},
+}
+
+func IsCompressionSupported(codec string) bool {
+	_, found := compressionCodecs[codec]
+	return found
+}
+
+func CompressData(codec string, input []byte) ([]byte, error) {
+	c, found := compressionCodecs[codec]
+	if !found {
+		return nil, fmt.Errorf("unsupported compression %s", codec)
+	}
+	return c.compress(input)
+}
+
+func DecompressData(codec string, input []byte) ([]byte, error) {
+	c, found := compressionCodecs[codec]
+	if !found {
+		return nil, fmt.Errorf("unsupported compression %s", codec)
+	}
+	return c.decompress(input)
+}
+
+// NewDecompressionReader decompresses the stream r compressed by the codec
+func NewDecompressionReader(codec string, r io.Reader) (io.ReadCloser, error) {
+	c, found := compressionCodecs[codec]
+	if !found {
+		return nil, fmt.Errorf("unsupported compression %s", codec)
+	}
+	return c.reader(r)
+}
+
+// AcceptsEncoding checks whether the http Accept-Encoding header allows the codec
+func AcceptsEncoding(acceptEncoding, codec string) bool {
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		params := strings.Split(part, ";")
+		name := strings.TrimSpace(params[0])
+		if name != codec && name != "*" {
+			continue
+		}
+		for _, param := range params[1:] {
+			param = strings.Replace(param, " ", "", -1)
+			if strings.HasPrefix(param, "q=") && strings.Trim(param[2:], "0.") == "" {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
 func GzipData(input []byte) ([]byte, error) {
 	buf := new(bytes.Buffer)
 	w, _ := gzip.NewWriterLevel(buf, flate.BestSpeed)
@@ -35,6 +125,49 @@ func UnGzipData(input []byte) ([]byte, error) {
 	return output, err
 }
 
+var (
+	zstdEncoder   *zstd.Encoder
+	zstdDecoder   *zstd.Decoder
+	zstdInitOnce  sync.Once
+	zstdInitError error
+)
+
+func initZstd() {
+	if zstdEncoder, zstdInitError = zstd.NewWriter(nil); zstdInitError != nil {
+		return
+	}
+	zstdDecoder, zstdInitError = zstd.NewReader(nil)
+}
+
+type zstdReadCloser struct {
+	*zstd.Decoder
+}
+
+func (r *zstdReadCloser) Close() error {
+	r.Decoder.Close()
+	return nil
+}
+
+func ZstdData(input []byte) ([]byte, error) {
+	zstdInitOnce.Do(initZstd)
+	if zstdInitError != nil {
+		return nil, zstdInitError
+	}
+	return zstdEncoder.EncodeAll(input, make([]byte, 0, len(input))), nil
+}
+
+func UnZstdData(input []byte) ([]byte, error) {
+	zstdInitOnce.Do(initZstd)
+	if zstdInitError != nil {
+		return nil, zstdInitError
+	}
+	output, err := zstdDecoder.DecodeAll(input, nil)
+	if err != nil {
+		glog.V(2).Infoln("error uncompressing data:", err)
+	}
+	return output, err
+}
+
 /*
 * Default more not to gzip since gzip can be done on client side.
  */func IsGzippable(ext, mtype string, data []byte) bool {
@@ -0,0 +1,65 @@
+package util
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestCompressionRoundTrip(t *testing.T) {
+	data := []byte(strings.Repeat("2019-12-01 10:00:00 INFO volume server started\n", 1000))
+
+	for _, codec := range []string{GzipCompression, ZstdCompression} {
+		compressed, err := CompressData(codec, data)
+		if err != nil {
+			t.Fatalf("%s compress: %v", codec, err)
+		}
+		if len(compressed) >= len(data) {
+			t.Errorf("%s compressed %d bytes into %d bytes", codec, len(data), len(compressed))
+		}
+
+		decompressed, err := DecompressData(codec, compressed)
+		if err != nil {
+			t.Fatalf("%s decompress: %v", codec, err)
+		}
+		if !bytes.Equal(data, decompressed) {
+			t.Errorf("%s decompressed data differs", codec)
+		}
+
+		reader, err := NewDecompressionReader(codec, bytes.NewReader(compressed))
+		if err != nil {
+			t.Fatalf("%s reader: %v", codec, err)
+		}
+		streamed, err := ioutil.ReadAll(reader)
+		reader.Close()
+		if err != nil || !bytes.Equal(data, streamed) {
+			t.Errorf("%s streamed decompression: %v", codec, err)
+		}
+	}
+
+	if _, err := CompressData("br", data); err == nil {
+		t.Errorf("expecting unsupported compression")
+	}
+}
+
+func TestAcceptsEncoding(t *testing.T) {
+	tests := []struct {
+		acceptEncoding string
+		codec          string
+		accepted       bool
+	}{
+		{"gzip", GzipCompression, true},
+		{"gzip, deflate, br", ZstdCompression, false},
+		{"gzip, zstd", ZstdCompression, true},
+		{"zstd;q=0.5, gzip", ZstdCompression, true},
+		{"zstd;q=0, gzip", ZstdCompression, false},
+		{"*", ZstdCompression, true},
+		{"", GzipCompression, false},
+	}
+	for _, tt := range tests {
+		if accepted := AcceptsEncoding(tt.acceptEncoding, tt.codec); accepted != tt.accepted {
+			t.Errorf("AcceptsEncoding(%q, %q) = %v", tt.acceptEncoding, tt.codec, accepted)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package util
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"net/http"
+)
+
+const (
+	ContentMd5Header    = "Content-Md5"
+	ContentSha256Header = "X-Amz-Checksum-Sha256"
+)
+
+// ContentDigests are the md5 and the optional sha256 of the uncompressed content.
+// A nil digest is not known or not requested.
+type ContentDigests struct {
+	Md5    []byte
+	Sha256 []byte
+}
+
+// DigestMismatchError is returned if the content does not match the digest sent by the client.
+type DigestMismatchError struct {
+	Name     string
+	Expected []byte
+	Actual   []byte
+}
+
+func (e *DigestMismatchError) Error() string {
+	return fmt.Sprintf("%s mismatch: expected %s, actual %s", e.Name,
+		base64.StdEncoding.EncodeToString(e.Expected), base64.StdEncoding.EncodeToString(e.Actual))
+}
+
+// ParseContentDigests reads the base64 encoded digests from the Content-MD5 and x-amz-checksum-sha256 headers.
+func ParseContentDigests(h http.Header) (d ContentDigests, err error) {
+	if d.Md5, err = parseDigestHeader(h, ContentMd5Header, md5.Size); err != nil {
+		return ContentDigests{}, err
+	}
+	if d.Sha256, err = parseDigestHeader(h, ContentSha256Header, sha256.Size); err != nil {
+		return ContentDigests{}, err
+	}
+	return d, nil
+}
+
+func parseDigestHeader(h http.Header, name string, length int) ([]byte, error) {
+	values, found := h[name]
+	if !found {
+		return nil, nil
+	}
+	if len(values) == 0 || values[0] == "" {
+		return nil, fmt.Errorf("%s header set to empty value", name)
+	}
+	digest, err := base64.StdEncoding.DecodeString(values[0])
+	if err != nil {
+		return nil, fmt.Errorf("%s header %q: %v", name, values[0], err)
+	}
+	if len(digest) != length {
+		return nil, fmt.Errorf("%s header %q: expecting %d bytes", name, values[0], length)
+	}
+	return digest, nil
+}
+
+// SetHeaders sets the known digests as base64 encoded headers.
+func (d ContentDigests) SetHeaders(h http.Header) {
+	if d.Md5 != nil {
+		h.Set(ContentMd5Header, base64.StdEncoding.EncodeToString(d.Md5))
+	}
+	if d.Sha256 != nil {
+		h.Set(ContentSha256Header, base64.StdEncoding.EncodeToString(d.Sha256))
+	}
+}
+
+// Verify checks the actual digests against the expected ones, skipping the digests not expected.
+func (d ContentDigests) Verify(actual ContentDigests) error {
+	if d.Md5 != nil && !bytes.Equal(d.Md5, actual.Md5) {
+		return &DigestMismatchError{Name: ContentMd5Header, Expected: d.Md5, Actual: actual.Md5}
+	}
+	if d.Sha256 != nil && !bytes.Equal(d.Sha256, actual.Sha256) {
+		return &DigestMismatchError{Name: ContentSha256Header, Expected: d.Sha256, Actual: actual.Sha256}
+	}
+	return nil
+}
+
+// ComputeDigests computes the md5 of the content, and the sha256 if withSha256 is set.
+func ComputeDigests(content []byte, withSha256 bool) ContentDigests {
+	w := NewDigestWriter(withSha256)
+	w.Write(content)
+	return w.Sum()
+}
+
+// VerifyContent computes the digests of the content, and checks them against the expected ones.
+func VerifyContent(content []byte, expected ContentDigests) (ContentDigests, error) {
+	actual := ComputeDigests(content, expected.Sha256 != nil)
+	return actual, expected.Verify(actual)
+}
+
+// DigestWriter computes the digests of the content written to it.
+type DigestWriter struct {
+	md5    hash.Hash
+	sha256 hash.Hash
+}
+
+func NewDigestWriter(withSha256 bool) *DigestWriter {
+	w := &DigestWriter{md5: md5.New()}
+	if withSha256 {
+		w.sha256 = sha256.New()
+	}
+	return w
+}
+
+func (w *DigestWriter) Write(p []byte) (int, error) {
+	w.md5.Write(p)
+	if w.sha256 != nil {
+		w.sha256.Write(p)
+	}
+	return len(p), nil
+}
+
+func (w *DigestWriter) Sum() (d ContentDigests) {
+	d.Md5 = w.md5.Sum(nil)
+	if w.sha256 != nil {
+		d.Sha256 = w.sha256.Sum(nil)
+	}
+	return d
+}
@@ -0,0 +1,56 @@
+package util
+
+import (
+	"encoding/base64"
+	"net/http"
+	"testing"
+)
+
+func TestContentDigests(t *testing.T) {
+	content := []byte("hello world")
+	digests := ComputeDigests(content, true)
+
+	h := make(http.Header)
+	digests.SetHeaders(h)
+	if h.Get("Content-MD5") != "XrY7u+Ae7tCTyyK7j1rNww==" {
+		t.Errorf("unexpected Content-MD5 %s", h.Get("Content-MD5"))
+	}
+
+	expected, err := ParseContentDigests(h)
+	if err != nil {
+		t.Fatalf("parse digests: %v", err)
+	}
+	if _, err = VerifyContent(content, expected); err != nil {
+		t.Errorf("verify content: %v", err)
+	}
+	if _, err = VerifyContent([]byte("hello world!"), expected); err == nil {
+		t.Errorf("changed content is not detected")
+	} else if _, ok := err.(*DigestMismatchError); !ok {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	// only the md5 is expected
+	if _, err = VerifyContent(content, ContentDigests{Md5: digests.Md5}); err != nil {
+		t.Errorf("verify md5 only: %v", err)
+	}
+}
+
+func TestParseContentDigests(t *testing.T) {
+	tests := []struct {
+		name   string
+		header http.Header
+		ok     bool
+	}{
+		{"none", http.Header{}, true},
+		{"md5", http.Header{ContentMd5Header: {"XrY7u+Ae7tCTyyK7j1rNww=="}}, true},
+		{"empty", http.Header{ContentMd5Header: {""}}, false},
+		{"not base64", http.Header{ContentMd5Header: {"not base64!"}}, false},
+		{"wrong length", http.Header{ContentSha256Header: {base64.StdEncoding.EncodeToString([]byte("short"))}}, false},
+	}
+	for _, tt := range tests {
+		_, err := ParseContentDigests(tt.header)
+		if (err == nil) != tt.ok {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		}
+	}
+}
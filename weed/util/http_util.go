@@ -2,7 +2,6 @@ package util
 
 import (
 	"bytes"
-	"compress/gzip"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -187,13 +186,30 @@ func NormalizeUrl(url string) string {
 	return "http://" + url
 }
 
+// ETagMatches checks whether the etag is in the list of entity tags of an If-Match or If-None-Match header.
+// Weak tags are compared as strong ones, and "*" matches any etag.
+func ETagMatches(header string, etag string) bool {
+	etag = strings.Trim(etag, "\"")
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			return true
+		}
+		tag = strings.TrimPrefix(tag, "W/")
+		if strings.Trim(tag, "\"") == etag {
+			return true
+		}
+	}
+	return false
+}
+
 func ReadUrl(fileUrl string, offset int64, size int, buf []byte, isReadRange bool) (n int64, e error) {
 
 	req, _ := http.NewRequest("GET", fileUrl, nil)
 	if isReadRange {
 		req.Header.Add("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+int64(size)))
 	} else {
-		req.Header.Set("Accept-Encoding", "gzip")
+		req.Header.Set("Accept-Encoding", "gzip, zstd")
 	}
 
 	r, err := client.Do(req)
@@ -207,11 +223,12 @@ func ReadUrl(fileUrl string, offset int64, size int, buf []byte, isReadRange boo
 	}
 
 	var reader io.ReadCloser
-	switch r.Header.Get("Content-Encoding") {
-	case "gzip":
-		reader, err = gzip.NewReader(r.Body)
+	if contentEncoding := r.Header.Get("Content-Encoding"); contentEncoding != "" {
+		if reader, err = NewDecompressionReader(contentEncoding, r.Body); err != nil {
+			return 0, err
+		}
 		defer reader.Close()
-	default:
+	} else {
 		reader = r.Body
 	}
 
@@ -0,0 +1,153 @@
+package util
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/user"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// IdentityMapper translates between the local uid/gid and the user/group names stored along with them,
+// so that a user owns the same files on all hosts, whatever the local uid is.
+// The names are resolved with the mapping file first, and then with the local user database.
+// A nil IdentityMapper keeps the numeric ids as is.
+type IdentityMapper struct {
+	uidToName map[uint32]string
+	nameToUid map[string]int64
+	gidToName map[uint32]string
+	nameToGid map[string]int64
+	lock      sync.Mutex
+}
+
+// LoadIdentityMapper reads the mapping file, which can be empty to use only the local user database.
+// Each line of the mapping file is "user <name> <uid>" or "group <name> <gid>", and "#" starts a comment.
+func LoadIdentityMapper(mappingFile string) (*IdentityMapper, error) {
+
+	m := &IdentityMapper{
+		uidToName: make(map[uint32]string),
+		nameToUid: make(map[string]int64),
+		gidToName: make(map[uint32]string),
+		nameToGid: make(map[string]int64),
+	}
+
+	if mappingFile == "" {
+		return m, nil
+	}
+
+	f, err := os.Open(mappingFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("%s:%d: expecting \"user|group <name> <id>\"", mappingFile, lineNumber)
+		}
+		id, err := strconv.ParseUint(fields[2], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: invalid id %s", mappingFile, lineNumber, fields[2])
+		}
+		switch fields[0] {
+		case "user":
+			m.uidToName[uint32(id)], m.nameToUid[fields[1]] = fields[1], int64(id)
+		case "group":
+			m.gidToName[uint32(id)], m.nameToGid[fields[1]] = fields[1], int64(id)
+		default:
+			return nil, fmt.Errorf("%s:%d: unknown kind %s", mappingFile, lineNumber, fields[0])
+		}
+	}
+
+	return m, scanner.Err()
+}
+
+// UserName returns the name of the local uid, or "" if unknown
+func (m *IdentityMapper) UserName(uid uint32) string {
+	if m == nil {
+		return ""
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	name, found := m.uidToName[uid]
+	if !found {
+		if u, err := user.LookupId(strconv.FormatUint(uint64(uid), 10)); err == nil {
+			name = u.Username
+		}
+		m.uidToName[uid] = name
+	}
+	return name
+}
+
+// GroupName returns the name of the local gid, or "" if unknown
+func (m *IdentityMapper) GroupName(gid uint32) string {
+	if m == nil {
+		return ""
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	name, found := m.gidToName[gid]
+	if !found {
+		if g, err := user.LookupGroupId(strconv.FormatUint(uint64(gid), 10)); err == nil {
+			name = g.Name
+		}
+		m.gidToName[gid] = name
+	}
+	return name
+}
+
+// Uid returns the local uid of the user name, or the stored uid if the name is empty or unknown
+func (m *IdentityMapper) Uid(userName string, storedUid uint32) uint32 {
+	if m == nil || userName == "" {
+		return storedUid
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, found := m.nameToUid[userName]; !found {
+		// -1 for an unknown name
+		m.nameToUid[userName] = -1
+		if u, err := user.Lookup(userName); err == nil {
+			if parsed, parseErr := strconv.ParseUint(u.Uid, 10, 32); parseErr == nil {
+				m.nameToUid[userName] = int64(parsed)
+			}
+		}
+	}
+	if uid := m.nameToUid[userName]; uid >= 0 {
+		return uint32(uid)
+	}
+	return storedUid
+}
+
+// Gid returns the local gid of the first group name, or the stored gid if there is no group name or it is unknown
+func (m *IdentityMapper) Gid(groupNames []string, storedGid uint32) uint32 {
+	if m == nil || len(groupNames) == 0 || groupNames[0] == "" {
+		return storedGid
+	}
+	groupName := groupNames[0]
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, found := m.nameToGid[groupName]; !found {
+		// -1 for an unknown name
+		m.nameToGid[groupName] = -1
+		if g, err := user.LookupGroup(groupName); err == nil {
+			if parsed, parseErr := strconv.ParseUint(g.Gid, 10, 32); parseErr == nil {
+				m.nameToGid[groupName] = int64(parsed)
+			}
+		}
+	}
+	if gid := m.nameToGid[groupName]; gid >= 0 {
+		return uint32(gid)
+	}
+	return storedGid
+}
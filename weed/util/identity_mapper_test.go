@@ -0,0 +1,74 @@
+package util
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestIdentityMapper(t *testing.T) {
+
+	f, err := ioutil.TempFile("", "identity_map")
+	if err != nil {
+		t.Fatalf("temp file: %v", err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString(`
+# the same users on all hosts
+user alice 2001
+user bob   2002 # trailing comment
+group staff 3001
+`)
+	f.Close()
+
+	m, err := LoadIdentityMapper(f.Name())
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	if name := m.UserName(2001); name != "alice" {
+		t.Errorf("unexpected user name %q", name)
+	}
+	if name := m.GroupName(3001); name != "staff" {
+		t.Errorf("unexpected group name %q", name)
+	}
+	if uid := m.Uid("bob", 1000); uid != 2002 {
+		t.Errorf("unexpected uid %d", uid)
+	}
+	if gid := m.Gid([]string{"staff"}, 1000); gid != 3001 {
+		t.Errorf("unexpected gid %d", gid)
+	}
+
+	// unknown names and no names keep the stored ids
+	if uid := m.Uid("no-such-user-for-seaweedfs", 1000); uid != 1000 {
+		t.Errorf("unexpected uid %d for an unknown name", uid)
+	}
+	if uid := m.Uid("no-such-user-for-seaweedfs", 1001); uid != 1001 {
+		t.Errorf("unexpected uid %d for a cached unknown name", uid)
+	}
+	if gid := m.Gid(nil, 1000); gid != 1000 {
+		t.Errorf("unexpected gid %d without a group name", gid)
+	}
+
+	var disabled *IdentityMapper
+	if disabled.UserName(2001) != "" || disabled.Uid("alice", 1000) != 1000 || disabled.Gid([]string{"staff"}, 1000) != 1000 {
+		t.Errorf("unexpected mapping without a mapper")
+	}
+
+}
+
+func TestIdentityMapperInvalidLine(t *testing.T) {
+
+	f, err := ioutil.TempFile("", "identity_map")
+	if err != nil {
+		t.Fatalf("temp file: %v", err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("user alice\n")
+	f.Close()
+
+	if _, err := LoadIdentityMapper(f.Name()); err == nil {
+		t.Errorf("expecting an error for a line without id")
+	}
+
+}
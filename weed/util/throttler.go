@@ -1,6 +1,15 @@
 package util
 
-import "time"
+import (
+	"io"
+	"sync"
+	"time"
+)
+
+// Throttler slows down the caller after processing some bytes
+type Throttler interface {
+	MaybeSlowdown(delta int64)
+}
 
 type WriteThrottler struct {
 	compactionBytePerSecond int64
@@ -32,3 +41,94 @@ func (wt *WriteThrottler) MaybeSlowdown(delta int64) {
 		}
 	}
 }
+
+// BandwidthLimiter is a token bucket shared by all concurrent callers, unlike WriteThrottler which is used by one caller.
+// The callers take turns in the order of their calls, and the limit can be changed on the fly.
+type BandwidthLimiter struct {
+	sync.Mutex
+	bytesPerSecond int64
+	tokens         float64 // negative when the bandwidth is reserved by the waiting callers
+	lastTime       time.Time
+}
+
+func NewBandwidthLimiter(bytesPerSecond int64) *BandwidthLimiter {
+	return &BandwidthLimiter{
+		bytesPerSecond: bytesPerSecond,
+		lastTime:       time.Now(),
+	}
+}
+
+// SetBytesPerSecond changes the limit, and 0 means unlimited
+func (bl *BandwidthLimiter) SetBytesPerSecond(bytesPerSecond int64) {
+	bl.Lock()
+	defer bl.Unlock()
+	bl.bytesPerSecond = bytesPerSecond
+	bl.tokens, bl.lastTime = 0, time.Now()
+}
+
+func (bl *BandwidthLimiter) BytesPerSecond() int64 {
+	bl.Lock()
+	defer bl.Unlock()
+	return bl.bytesPerSecond
+}
+
+// Wait takes the bandwidth for delta bytes, sleeps until the bandwidth is available, and returns the time slept
+func (bl *BandwidthLimiter) Wait(delta int64) time.Duration {
+	bl.Lock()
+	if bl.bytesPerSecond <= 0 {
+		bl.Unlock()
+		return 0
+	}
+	now := time.Now()
+	// allow a burst of up to 100ms of bandwidth after idling
+	bl.tokens += now.Sub(bl.lastTime).Seconds() * float64(bl.bytesPerSecond)
+	if burst := float64(bl.bytesPerSecond) / 10; bl.tokens > burst {
+		bl.tokens = burst
+	}
+	bl.tokens -= float64(delta)
+	bl.lastTime = now
+	var sleepTime time.Duration
+	if bl.tokens < 0 {
+		sleepTime = time.Duration(-bl.tokens / float64(bl.bytesPerSecond) * float64(time.Second))
+	}
+	bl.Unlock()
+
+	time.Sleep(sleepTime)
+	return sleepTime
+}
+
+func (bl *BandwidthLimiter) MaybeSlowdown(delta int64) {
+	bl.Wait(delta)
+}
+
+type throttledReader struct {
+	io.Reader
+	throttler Throttler
+}
+
+// NewThrottledReader slows down the reads of r with the throttler
+func NewThrottledReader(r io.Reader, throttler Throttler) io.Reader {
+	return &throttledReader{Reader: r, throttler: throttler}
+}
+
+func (r *throttledReader) Read(p []byte) (n int, err error) {
+	n, err = r.Reader.Read(p)
+	r.throttler.MaybeSlowdown(int64(n))
+	return
+}
+
+type throttledWriterAt struct {
+	io.WriterAt
+	throttler Throttler
+}
+
+// NewThrottledWriterAt slows down the writes to w with the throttler, which is shared by the concurrent writes
+func NewThrottledWriterAt(w io.WriterAt, throttler Throttler) io.WriterAt {
+	return &throttledWriterAt{WriterAt: w, throttler: throttler}
+}
+
+func (w *throttledWriterAt) WriteAt(p []byte, off int64) (n int, err error) {
+	n, err = w.WriterAt.WriteAt(p, off)
+	w.throttler.MaybeSlowdown(int64(n))
+	return
+}
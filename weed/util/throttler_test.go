@@ -0,0 +1,51 @@
+package util
+
+import (
+	"bytes"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBandwidthLimiterSharedByConcurrentCallers(t *testing.T) {
+	bl := NewBandwidthLimiter(1024 * 1024)
+
+	startTime := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 3; j++ {
+				bl.MaybeSlowdown(25 * 1024)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// 300KB at 1MB/s takes about 300ms
+	if elapsed := time.Since(startTime); elapsed < 150*time.Millisecond {
+		t.Errorf("300KB at 1MB/s took only %v", elapsed)
+	}
+
+	bl.SetBytesPerSecond(0)
+	if slept := bl.Wait(1024 * 1024 * 1024); slept != 0 {
+		t.Errorf("unlimited bandwidth slept %v", slept)
+	}
+}
+
+func TestThrottledReader(t *testing.T) {
+	bl := NewBandwidthLimiter(1024 * 1024)
+
+	startTime := time.Now()
+	data, err := ioutil.ReadAll(NewThrottledReader(bytes.NewReader(make([]byte, 300*1024)), bl))
+	if err != nil || len(data) != 300*1024 {
+		t.Fatalf("read %d bytes: %v", len(data), err)
+	}
+
+	// 300KB at 1MB/s takes about 300ms
+	if elapsed := time.Since(startTime); elapsed < 150*time.Millisecond {
+		t.Errorf("300KB at 1MB/s took only %v", elapsed)
+	}
+}